@@ -0,0 +1 @@
+DROP TABLE IF EXISTS job_vacancies;
\ No newline at end of file
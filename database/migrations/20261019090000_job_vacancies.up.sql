@@ -0,0 +1,16 @@
+CREATE TABLE job_vacancies (
+                               id VARCHAR(26) PRIMARY KEY,
+                               recruiter_id VARCHAR(26) NOT NULL,
+                               title VARCHAR(255) NOT NULL,
+                               description TEXT,
+                               requirements TEXT,
+                               location VARCHAR(255),
+                               job_type VARCHAR(50) NOT NULL,
+                               deadline TIMESTAMP,
+                               is_active BOOLEAN NOT NULL DEFAULT TRUE,
+                               created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               updated_at TIMESTAMP,
+                               FOREIGN KEY (recruiter_id) REFERENCES companies(id)
+);
+
+CREATE INDEX idx_job_vacancies_active ON job_vacancies (is_active, created_at DESC);
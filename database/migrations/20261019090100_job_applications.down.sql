@@ -0,0 +1 @@
+DROP TABLE IF EXISTS job_applications;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE job_applications (
+                                  id VARCHAR(26) PRIMARY KEY,
+                                  job_vacancy_id VARCHAR(26) NOT NULL,
+                                  user_id VARCHAR(26) NOT NULL,
+                                  status VARCHAR(50) NOT NULL DEFAULT 'applied',
+                                  cover_letter TEXT,
+                                  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  updated_at TIMESTAMP,
+                                  UNIQUE (job_vacancy_id, user_id),
+                                  FOREIGN KEY (job_vacancy_id) REFERENCES job_vacancies(id) ON DELETE CASCADE,
+                                  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_job_applications_user_id ON job_applications (user_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS saved_jobs;
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE saved_jobs (
+                            user_id VARCHAR(26) NOT NULL,
+                            job_vacancy_id VARCHAR(26) NOT NULL,
+                            created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                            PRIMARY KEY (user_id, job_vacancy_id),
+                            FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+                            FOREIGN KEY (job_vacancy_id) REFERENCES job_vacancies(id) ON DELETE CASCADE
+);
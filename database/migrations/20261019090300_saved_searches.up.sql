@@ -0,0 +1,18 @@
+CREATE TABLE saved_searches (
+                                id VARCHAR(26) PRIMARY KEY,
+                                user_id VARCHAR(26) NOT NULL,
+                                name VARCHAR(100) NOT NULL,
+                                query VARCHAR(255),
+                                location VARCHAR(255),
+                                job_type VARCHAR(50),
+                                frequency VARCHAR(10) NOT NULL DEFAULT 'DAILY',
+                                unsubscribe_token VARCHAR(64) NOT NULL UNIQUE,
+                                is_active BOOLEAN NOT NULL DEFAULT TRUE,
+                                last_notified_at TIMESTAMP,
+                                created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                updated_at TIMESTAMP,
+                                FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_saved_searches_user_id ON saved_searches (user_id);
+CREATE INDEX idx_saved_searches_frequency ON saved_searches (frequency) WHERE is_active = TRUE;
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN is_searchable BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS talent_pool_candidates;
+DROP TABLE IF EXISTS talent_pools;
\ No newline at end of file
@@ -0,0 +1,24 @@
+CREATE TABLE talent_pools (
+                              id VARCHAR(26) PRIMARY KEY,
+                              company_id VARCHAR(26) NOT NULL,
+                              name VARCHAR(100) NOT NULL,
+                              description TEXT,
+                              created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                              updated_at TIMESTAMP,
+                              UNIQUE (company_id, name),
+                              FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE
+);
+
+CREATE TABLE talent_pool_candidates (
+                                        talent_pool_id VARCHAR(26) NOT NULL,
+                                        user_id VARCHAR(26) NOT NULL,
+                                        tags TEXT[] NOT NULL DEFAULT '{}',
+                                        note TEXT,
+                                        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                        updated_at TIMESTAMP,
+                                        PRIMARY KEY (talent_pool_id, user_id),
+                                        FOREIGN KEY (talent_pool_id) REFERENCES talent_pools(id) ON DELETE CASCADE,
+                                        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_talent_pool_candidates_tags ON talent_pool_candidates USING GIN (tags);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS job_invitations;
\ No newline at end of file
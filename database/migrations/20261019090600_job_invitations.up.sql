@@ -0,0 +1,18 @@
+CREATE TABLE job_invitations (
+                                 id VARCHAR(26) PRIMARY KEY,
+                                 job_vacancy_id VARCHAR(26) NOT NULL,
+                                 user_id VARCHAR(26) NOT NULL,
+                                 company_id VARCHAR(26) NOT NULL,
+                                 talent_pool_id VARCHAR(26),
+                                 message TEXT,
+                                 status VARCHAR(20) NOT NULL DEFAULT 'pending',
+                                 created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                 updated_at TIMESTAMP,
+                                 UNIQUE (job_vacancy_id, user_id),
+                                 FOREIGN KEY (job_vacancy_id) REFERENCES job_vacancies(id) ON DELETE CASCADE,
+                                 FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+                                 FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE,
+                                 FOREIGN KEY (talent_pool_id) REFERENCES talent_pools(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_job_invitations_user_id ON job_invitations (user_id);
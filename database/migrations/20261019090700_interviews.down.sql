@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS interview_interviewers;
+DROP TABLE IF EXISTS interview_slots;
+DROP TABLE IF EXISTS interviews;
\ No newline at end of file
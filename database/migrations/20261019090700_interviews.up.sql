@@ -0,0 +1,38 @@
+CREATE TABLE interviews (
+                            id VARCHAR(26) PRIMARY KEY,
+                            job_application_id VARCHAR(26) NOT NULL,
+                            company_id VARCHAR(26) NOT NULL,
+                            title VARCHAR(255) NOT NULL,
+                            format VARCHAR(20) NOT NULL,
+                            location VARCHAR(255),
+                            meeting_link VARCHAR(255),
+                            timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+                            duration_minutes INTEGER NOT NULL,
+                            status VARCHAR(20) NOT NULL DEFAULT 'proposed',
+                            scheduled_start TIMESTAMP,
+                            scheduled_end TIMESTAMP,
+                            sequence INTEGER NOT NULL DEFAULT 0,
+                            created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                            updated_at TIMESTAMP,
+                            FOREIGN KEY (job_application_id) REFERENCES job_applications(id) ON DELETE CASCADE,
+                            FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_interviews_job_application_id ON interviews (job_application_id);
+
+CREATE TABLE interview_slots (
+                                 id VARCHAR(26) PRIMARY KEY,
+                                 interview_id VARCHAR(26) NOT NULL,
+                                 start_time TIMESTAMP NOT NULL,
+                                 end_time TIMESTAMP NOT NULL,
+                                 UNIQUE (interview_id, start_time),
+                                 FOREIGN KEY (interview_id) REFERENCES interviews(id) ON DELETE CASCADE
+);
+
+CREATE TABLE interview_interviewers (
+                                        interview_id VARCHAR(26) NOT NULL,
+                                        name VARCHAR(255) NOT NULL,
+                                        email VARCHAR(255) NOT NULL,
+                                        PRIMARY KEY (interview_id, email),
+                                        FOREIGN KEY (interview_id) REFERENCES interviews(id) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS scorecard_ratings;
+DROP TABLE IF EXISTS scorecards;
+DROP TABLE IF EXISTS scorecard_competencies;
+DROP TABLE IF EXISTS scorecard_templates;
\ No newline at end of file
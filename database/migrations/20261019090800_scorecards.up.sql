@@ -0,0 +1,47 @@
+CREATE TABLE scorecard_templates (
+                                     id VARCHAR(26) PRIMARY KEY,
+                                     job_vacancy_id VARCHAR(26) NOT NULL UNIQUE,
+                                     company_id VARCHAR(26) NOT NULL,
+                                     name VARCHAR(100) NOT NULL,
+                                     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     updated_at TIMESTAMP,
+                                     FOREIGN KEY (job_vacancy_id) REFERENCES job_vacancies(id) ON DELETE CASCADE,
+                                     FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE
+);
+
+CREATE TABLE scorecard_competencies (
+                                        id VARCHAR(26) PRIMARY KEY,
+                                        template_id VARCHAR(26) NOT NULL,
+                                        name VARCHAR(100) NOT NULL,
+                                        description TEXT,
+                                        scale_max INTEGER NOT NULL DEFAULT 5,
+                                        scale_labels TEXT[] NOT NULL DEFAULT '{}',
+                                        position INTEGER NOT NULL DEFAULT 0,
+                                        FOREIGN KEY (template_id) REFERENCES scorecard_templates(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_scorecard_competencies_template_id ON scorecard_competencies (template_id);
+
+CREATE TABLE scorecards (
+                            id VARCHAR(26) PRIMARY KEY,
+                            job_application_id VARCHAR(26) NOT NULL,
+                            template_id VARCHAR(26) NOT NULL,
+                            interviewer_id VARCHAR(26) NOT NULL,
+                            recommendation VARCHAR(20) NOT NULL,
+                            notes TEXT,
+                            submitted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                            updated_at TIMESTAMP,
+                            UNIQUE (job_application_id, interviewer_id),
+                            FOREIGN KEY (job_application_id) REFERENCES job_applications(id) ON DELETE CASCADE,
+                            FOREIGN KEY (template_id) REFERENCES scorecard_templates(id) ON DELETE CASCADE
+);
+
+CREATE TABLE scorecard_ratings (
+                                   scorecard_id VARCHAR(26) NOT NULL,
+                                   competency_id VARCHAR(26) NOT NULL,
+                                   rating INTEGER NOT NULL,
+                                   comment TEXT,
+                                   PRIMARY KEY (scorecard_id, competency_id),
+                                   FOREIGN KEY (scorecard_id) REFERENCES scorecards(id) ON DELETE CASCADE,
+                                   FOREIGN KEY (competency_id) REFERENCES scorecard_competencies(id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS offers;
+ALTER TABLE job_vacancies DROP COLUMN IF EXISTS headcount;
\ No newline at end of file
@@ -0,0 +1,22 @@
+ALTER TABLE job_vacancies ADD COLUMN headcount INTEGER NOT NULL DEFAULT 1;
+
+CREATE TABLE offers (
+                        id VARCHAR(26) PRIMARY KEY,
+                        job_application_id VARCHAR(26) NOT NULL,
+                        company_id VARCHAR(26) NOT NULL,
+                        salary_amount BIGINT NOT NULL,
+                        salary_currency VARCHAR(3) NOT NULL,
+                        salary_period VARCHAR(10) NOT NULL,
+                        start_date DATE NOT NULL,
+                        expires_at TIMESTAMP NOT NULL,
+                        terms TEXT NOT NULL,
+                        status VARCHAR(20) NOT NULL DEFAULT 'sent',
+                        access_token VARCHAR(64) NOT NULL UNIQUE,
+                        responded_at TIMESTAMP,
+                        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                        updated_at TIMESTAMP,
+                        FOREIGN KEY (job_application_id) REFERENCES job_applications(id) ON DELETE CASCADE,
+                        FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_offers_job_application_id ON offers (job_application_id);
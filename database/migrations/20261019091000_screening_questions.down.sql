@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS screening_answers;
+DROP TABLE IF EXISTS screening_questions;
+ALTER TABLE job_applications DROP COLUMN IF EXISTS screening_flagged;
\ No newline at end of file
@@ -0,0 +1,35 @@
+ALTER TABLE job_applications ADD COLUMN screening_flagged BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE screening_questions (
+                                     id VARCHAR(26) PRIMARY KEY,
+                                     job_vacancy_id VARCHAR(26) NOT NULL,
+                                     prompt VARCHAR(500) NOT NULL,
+                                     type VARCHAR(20) NOT NULL,
+                                     options TEXT[] NOT NULL DEFAULT '{}',
+                                     required BOOLEAN NOT NULL DEFAULT FALSE,
+                                     knockout_answers TEXT[] NOT NULL DEFAULT '{}',
+                                     knockout_min NUMERIC,
+                                     knockout_max NUMERIC,
+                                     knockout_action VARCHAR(10),
+                                     position INTEGER NOT NULL DEFAULT 0,
+                                     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     FOREIGN KEY (job_vacancy_id) REFERENCES job_vacancies(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_screening_questions_job_vacancy_id ON screening_questions (job_vacancy_id);
+
+CREATE TABLE screening_answers (
+                                   id VARCHAR(26) PRIMARY KEY,
+                                   job_application_id VARCHAR(26) NOT NULL,
+                                   question_id VARCHAR(26) NOT NULL,
+                                   answer_values TEXT[] NOT NULL DEFAULT '{}',
+                                   answer_number NUMERIC,
+                                   file_url TEXT,
+                                   knocked_out BOOLEAN NOT NULL DEFAULT FALSE,
+                                   created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                   UNIQUE (job_application_id, question_id),
+                                   FOREIGN KEY (job_application_id) REFERENCES job_applications(id) ON DELETE CASCADE,
+                                   FOREIGN KEY (question_id) REFERENCES screening_questions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_screening_answers_job_application_id ON screening_answers (job_application_id);
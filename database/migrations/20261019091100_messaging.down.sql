@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS message_reports;
+DROP TABLE IF EXISTS participant_blocks;
+DROP TABLE IF EXISTS conversation_reads;
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS conversations;
\ No newline at end of file
@@ -0,0 +1,59 @@
+CREATE TABLE conversations (
+                               id VARCHAR(26) PRIMARY KEY,
+                               company_id VARCHAR(26) NOT NULL,
+                               candidate_id VARCHAR(26) NOT NULL,
+                               job_application_id VARCHAR(26) UNIQUE,
+                               job_invitation_id VARCHAR(26) UNIQUE,
+                               last_message_at TIMESTAMP,
+                               created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               updated_at TIMESTAMP,
+                               CHECK (job_application_id IS NOT NULL OR job_invitation_id IS NOT NULL),
+                               FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE,
+                               FOREIGN KEY (candidate_id) REFERENCES users(id) ON DELETE CASCADE,
+                               FOREIGN KEY (job_application_id) REFERENCES job_applications(id) ON DELETE CASCADE,
+                               FOREIGN KEY (job_invitation_id) REFERENCES job_invitations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_conversations_company_id ON conversations (company_id);
+CREATE INDEX idx_conversations_candidate_id ON conversations (candidate_id);
+
+CREATE TABLE messages (
+                          id VARCHAR(26) PRIMARY KEY,
+                          conversation_id VARCHAR(26) NOT NULL,
+                          sender_id VARCHAR(26) NOT NULL,
+                          body TEXT,
+                          attachment_url TEXT,
+                          attachment_name VARCHAR(255),
+                          created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                          FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_messages_conversation_id ON messages (conversation_id, id);
+
+CREATE TABLE conversation_reads (
+                                    conversation_id VARCHAR(26) NOT NULL,
+                                    participant_id VARCHAR(26) NOT NULL,
+                                    last_read_at TIMESTAMP NOT NULL,
+                                    PRIMARY KEY (conversation_id, participant_id),
+                                    FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE
+);
+
+CREATE TABLE participant_blocks (
+                                    blocker_id VARCHAR(26) NOT NULL,
+                                    blocked_id VARCHAR(26) NOT NULL,
+                                    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    PRIMARY KEY (blocker_id, blocked_id)
+);
+
+CREATE TABLE message_reports (
+                                 id VARCHAR(26) PRIMARY KEY,
+                                 conversation_id VARCHAR(26) NOT NULL,
+                                 message_id VARCHAR(26),
+                                 reporter_id VARCHAR(26) NOT NULL,
+                                 reason VARCHAR(30) NOT NULL,
+                                 details TEXT,
+                                 status VARCHAR(20) NOT NULL DEFAULT 'open',
+                                 created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                 FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE,
+                                 FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE SET NULL
+);
@@ -0,0 +1,22 @@
+CREATE TABLE notifications (
+                               id VARCHAR(26) PRIMARY KEY,
+                               recipient_id VARCHAR(26) NOT NULL,
+                               type VARCHAR(32) NOT NULL,
+                               title VARCHAR(255) NOT NULL,
+                               body TEXT NOT NULL DEFAULT '',
+                               data JSONB NOT NULL DEFAULT '{}',
+                               read_at TIMESTAMP,
+                               created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_notifications_recipient_id ON notifications (recipient_id, id DESC);
+CREATE INDEX idx_notifications_unread ON notifications (recipient_id) WHERE read_at IS NULL;
+
+CREATE TABLE notification_preferences (
+                                          recipient_id VARCHAR(26) NOT NULL,
+                                          type VARCHAR(32) NOT NULL,
+                                          email BOOLEAN NOT NULL,
+                                          in_app BOOLEAN NOT NULL,
+                                          updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                          PRIMARY KEY (recipient_id, type)
+);
@@ -0,0 +1,47 @@
+CREATE TABLE webhook_endpoints (
+                                   id VARCHAR(26) PRIMARY KEY,
+                                   company_id VARCHAR(26) NOT NULL,
+                                   url TEXT NOT NULL,
+                                   description VARCHAR(255),
+                                   secret VARCHAR(128) NOT NULL,
+                                   events TEXT[] NOT NULL,
+                                   is_active BOOLEAN NOT NULL DEFAULT TRUE,
+                                   created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                   updated_at TIMESTAMP,
+                                   FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhook_endpoints_company_id ON webhook_endpoints (company_id);
+
+CREATE TABLE webhook_deliveries (
+                                    id VARCHAR(26) PRIMARY KEY,
+                                    endpoint_id VARCHAR(26) NOT NULL,
+                                    event_id VARCHAR(26) NOT NULL,
+                                    event_type VARCHAR(64) NOT NULL,
+                                    payload TEXT NOT NULL,
+                                    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+                                    attempts INT NOT NULL DEFAULT 0,
+                                    next_attempt_at TIMESTAMP,
+                                    last_status_code INT,
+                                    last_error TEXT,
+                                    delivered_at TIMESTAMP,
+                                    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    updated_at TIMESTAMP,
+                                    FOREIGN KEY (endpoint_id) REFERENCES webhook_endpoints(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhook_deliveries_endpoint_id ON webhook_deliveries (endpoint_id, id DESC);
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+CREATE TABLE webhook_delivery_attempts (
+                                           id VARCHAR(26) PRIMARY KEY,
+                                           delivery_id VARCHAR(26) NOT NULL,
+                                           status_code INT,
+                                           response_body TEXT,
+                                           error TEXT,
+                                           duration_ms INT NOT NULL,
+                                           attempted_at TIMESTAMP NOT NULL,
+                                           FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhook_delivery_attempts_delivery_id ON webhook_delivery_attempts (delivery_id);
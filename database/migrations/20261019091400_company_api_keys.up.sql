@@ -0,0 +1,15 @@
+CREATE TABLE company_api_keys (
+                                  id VARCHAR(26) PRIMARY KEY,
+                                  company_id VARCHAR(26) NOT NULL,
+                                  name VARCHAR(100) NOT NULL,
+                                  prefix VARCHAR(16) NOT NULL UNIQUE,
+                                  key_hash VARCHAR(64) NOT NULL,
+                                  scopes TEXT[] NOT NULL,
+                                  expires_at TIMESTAMP,
+                                  last_used_at TIMESTAMP,
+                                  revoked_at TIMESTAMP,
+                                  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_company_api_keys_company_id ON company_api_keys (company_id);
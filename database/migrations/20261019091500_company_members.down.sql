@@ -0,0 +1,6 @@
+ALTER TABLE job_applications DROP COLUMN IF EXISTS status_updated_by;
+ALTER TABLE job_vacancies DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE job_vacancies DROP COLUMN IF EXISTS created_by;
+
+DROP TABLE IF EXISTS company_invitations;
+DROP TABLE IF EXISTS company_members;
\ No newline at end of file
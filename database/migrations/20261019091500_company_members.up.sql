@@ -0,0 +1,42 @@
+CREATE TABLE company_members (
+                                 id VARCHAR(26) PRIMARY KEY,
+                                 company_id VARCHAR(26) NOT NULL,
+                                 email VARCHAR(255) UNIQUE NOT NULL,
+                                 password VARCHAR(255) NOT NULL,
+                                 name VARCHAR(255) NOT NULL,
+                                 role VARCHAR(16) NOT NULL,
+                                 created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                 updated_at TIMESTAMP,
+                                 FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_company_members_company_id ON company_members (company_id);
+CREATE UNIQUE INDEX idx_company_members_owner ON company_members (company_id) WHERE role = 'owner';
+
+-- Every existing company login becomes the owner member of its company,
+-- keeping its ID, email and password.
+INSERT INTO company_members (id, company_id, email, password, name, role, created_at, updated_at)
+SELECT id, id, email, password, name, 'owner', created_at, updated_at
+FROM companies
+WHERE deleted_at IS NULL AND password IS NOT NULL;
+
+CREATE TABLE company_invitations (
+                                     id VARCHAR(26) PRIMARY KEY,
+                                     company_id VARCHAR(26) NOT NULL,
+                                     email VARCHAR(255) NOT NULL,
+                                     role VARCHAR(16) NOT NULL,
+                                     token_hash VARCHAR(64) UNIQUE NOT NULL,
+                                     invited_by VARCHAR(26),
+                                     expires_at TIMESTAMP NOT NULL,
+                                     accepted_at TIMESTAMP,
+                                     revoked_at TIMESTAMP,
+                                     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE,
+                                     FOREIGN KEY (invited_by) REFERENCES company_members(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_company_invitations_company_id ON company_invitations (company_id);
+
+ALTER TABLE job_vacancies ADD COLUMN created_by VARCHAR(26) REFERENCES company_members(id) ON DELETE SET NULL;
+ALTER TABLE job_vacancies ADD COLUMN updated_by VARCHAR(26) REFERENCES company_members(id) ON DELETE SET NULL;
+ALTER TABLE job_applications ADD COLUMN status_updated_by VARCHAR(26) REFERENCES company_members(id) ON DELETE SET NULL;
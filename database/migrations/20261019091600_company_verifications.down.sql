@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS company_verifications;
+ALTER TABLE companies DROP COLUMN IF EXISTS verified_at;
+ALTER TABLE companies DROP COLUMN IF EXISTS is_verified;
\ No newline at end of file
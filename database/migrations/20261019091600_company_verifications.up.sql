@@ -0,0 +1,25 @@
+ALTER TABLE companies ADD COLUMN is_verified BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE companies ADD COLUMN verified_at TIMESTAMP;
+
+CREATE TABLE company_verifications (
+                                       id VARCHAR(26) PRIMARY KEY,
+                                       company_id VARCHAR(26) NOT NULL,
+                                       method VARCHAR(16) NOT NULL,
+                                       domain VARCHAR(255),
+                                       token VARCHAR(64),
+                                       documents TEXT[] NOT NULL DEFAULT '{}',
+                                       status VARCHAR(16) NOT NULL DEFAULT 'pending',
+                                       domain_verified_at TIMESTAMP,
+                                       submitted_by VARCHAR(26),
+                                       reviewed_by VARCHAR(26),
+                                       review_note TEXT,
+                                       reviewed_at TIMESTAMP,
+                                       created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                       updated_at TIMESTAMP,
+                                       FOREIGN KEY (company_id) REFERENCES companies(id) ON DELETE CASCADE,
+                                       FOREIGN KEY (submitted_by) REFERENCES company_members(id) ON DELETE SET NULL,
+                                       FOREIGN KEY (reviewed_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX idx_company_verifications_pending ON company_verifications (company_id) WHERE status = 'pending';
+CREATE INDEX idx_company_verifications_status ON company_verifications (status, created_at);
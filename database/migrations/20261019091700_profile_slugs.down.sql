@@ -0,0 +1 @@
+DROP TABLE IF EXISTS profile_slugs;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE profile_slugs (
+                               slug VARCHAR(40) PRIMARY KEY,
+                               owner_type VARCHAR(16) NOT NULL,
+                               owner_id VARCHAR(26) NOT NULL,
+                               is_current BOOLEAN NOT NULL DEFAULT TRUE,
+                               claimed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               retired_at TIMESTAMP,
+                               created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_profile_slugs_current ON profile_slugs (owner_type, owner_id) WHERE is_current;
+CREATE INDEX idx_profile_slugs_owner ON profile_slugs (owner_type, owner_id, claimed_at);
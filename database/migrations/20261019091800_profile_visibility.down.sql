@@ -0,0 +1,7 @@
+ALTER TABLE portfolios DROP COLUMN IF EXISTS is_hidden;
+ALTER TABLE educations DROP COLUMN IF EXISTS is_hidden;
+ALTER TABLE experiences DROP COLUMN IF EXISTS is_hidden;
+
+ALTER TABLE users DROP COLUMN IF EXISTS hide_phone_number;
+ALTER TABLE users DROP COLUMN IF EXISTS hide_email;
+ALTER TABLE users DROP COLUMN IF EXISTS profile_visibility;
\ No newline at end of file
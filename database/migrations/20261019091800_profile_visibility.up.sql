@@ -0,0 +1,7 @@
+ALTER TABLE users ADD COLUMN profile_visibility VARCHAR(16) NOT NULL DEFAULT 'public';
+ALTER TABLE users ADD COLUMN hide_email BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN hide_phone_number BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE experiences ADD COLUMN is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE educations ADD COLUMN is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE portfolios ADD COLUMN is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS idx_portfolios_user_period;
+DROP INDEX IF EXISTS idx_educations_user_period;
+DROP INDEX IF EXISTS idx_experiences_user_period;
+
+ALTER TABLE portfolios DROP CONSTRAINT IF EXISTS portfolios_period_check;
+ALTER TABLE portfolios ALTER COLUMN start_date TYPE VARCHAR(50) USING to_char(start_date, 'YYYY-MM');
+ALTER TABLE portfolios ALTER COLUMN end_date TYPE VARCHAR(50) USING to_char(end_date, 'YYYY-MM');
+ALTER TABLE portfolios DROP COLUMN IF EXISTS is_current;
+
+ALTER TABLE educations DROP CONSTRAINT IF EXISTS educations_period_check;
+ALTER TABLE educations ALTER COLUMN start_date TYPE VARCHAR(50) USING to_char(start_date, 'YYYY-MM');
+ALTER TABLE educations ALTER COLUMN end_date TYPE VARCHAR(50) USING to_char(end_date, 'YYYY-MM');
+ALTER TABLE educations DROP COLUMN IF EXISTS is_current;
+
+ALTER TABLE experiences DROP CONSTRAINT IF EXISTS experiences_period_check;
+ALTER TABLE experiences ALTER COLUMN start_date TYPE VARCHAR(50) USING to_char(start_date, 'YYYY-MM');
+ALTER TABLE experiences ALTER COLUMN end_date TYPE VARCHAR(50) USING to_char(end_date, 'YYYY-MM');
+ALTER TABLE experiences DROP COLUMN IF EXISTS is_current;
\ No newline at end of file
@@ -0,0 +1,89 @@
+-- Reads the free-text dates clients used to send; anything unreadable is NULL.
+CREATE FUNCTION parse_bio_month(value TEXT) RETURNS DATE AS $$
+DECLARE
+    v TEXT := btrim(value);
+BEGIN
+    IF v IS NULL OR v = '' THEN
+        RETURN NULL;
+    ELSIF v ~ '^\d{4}-\d{1,2}(-\d{1,2})?$' THEN
+        RETURN make_date(split_part(v, '-', 1)::INT, split_part(v, '-', 2)::INT, 1);
+    ELSIF v ~ '^\d{1,2}/\d{4}$' THEN
+        RETURN make_date(split_part(v, '/', 2)::INT, split_part(v, '/', 1)::INT, 1);
+    ELSIF v ~ '^[A-Za-z]{3} \d{4}$' THEN
+        RETURN to_date(v, 'Mon YYYY');
+    ELSIF v ~ '^[A-Za-z]+ \d{4}$' THEN
+        RETURN to_date(v, 'FMMonth YYYY');
+    ELSIF v ~ '^\d{4}$' THEN
+        RETURN make_date(v::INT, 1, 1);
+    END IF;
+    RETURN NULL;
+EXCEPTION WHEN OTHERS THEN
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+ALTER TABLE experiences RENAME COLUMN start_date TO start_date_text;
+ALTER TABLE experiences RENAME COLUMN end_date TO end_date_text;
+ALTER TABLE experiences ADD COLUMN start_date DATE;
+ALTER TABLE experiences ADD COLUMN end_date DATE;
+ALTER TABLE experiences ADD COLUMN is_current BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE educations RENAME COLUMN start_date TO start_date_text;
+ALTER TABLE educations RENAME COLUMN end_date TO end_date_text;
+ALTER TABLE educations ADD COLUMN start_date DATE;
+ALTER TABLE educations ADD COLUMN end_date DATE;
+ALTER TABLE educations ADD COLUMN is_current BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE portfolios RENAME COLUMN start_date TO start_date_text;
+ALTER TABLE portfolios RENAME COLUMN end_date TO end_date_text;
+ALTER TABLE portfolios ADD COLUMN start_date DATE;
+ALTER TABLE portfolios ADD COLUMN end_date DATE;
+ALTER TABLE portfolios ADD COLUMN is_current BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- A blank or "present" end date meant the entry is ongoing. Start dates that
+-- cannot be read fall back to the month the entry was created, and end dates
+-- that cannot be read or come before the start are dropped.
+UPDATE experiences
+SET start_date = COALESCE(parse_bio_month(start_date_text), date_trunc('month', created_at)::DATE),
+    is_current = COALESCE(btrim(end_date_text), '') = '' OR lower(btrim(end_date_text)) IN ('present', 'current', 'now');
+UPDATE experiences
+SET end_date = parse_bio_month(end_date_text)
+WHERE NOT is_current AND parse_bio_month(end_date_text) >= start_date;
+
+UPDATE educations
+SET start_date = COALESCE(parse_bio_month(start_date_text), date_trunc('month', created_at)::DATE),
+    is_current = COALESCE(btrim(end_date_text), '') = '' OR lower(btrim(end_date_text)) IN ('present', 'current', 'now');
+UPDATE educations
+SET end_date = parse_bio_month(end_date_text)
+WHERE NOT is_current AND parse_bio_month(end_date_text) >= start_date;
+
+UPDATE portfolios
+SET start_date = COALESCE(parse_bio_month(start_date_text), date_trunc('month', created_at)::DATE),
+    is_current = COALESCE(btrim(end_date_text), '') = '' OR lower(btrim(end_date_text)) IN ('present', 'current', 'now');
+UPDATE portfolios
+SET end_date = parse_bio_month(end_date_text)
+WHERE NOT is_current AND parse_bio_month(end_date_text) >= start_date;
+
+ALTER TABLE experiences DROP COLUMN start_date_text;
+ALTER TABLE experiences DROP COLUMN end_date_text;
+ALTER TABLE experiences ALTER COLUMN start_date SET NOT NULL;
+ALTER TABLE experiences ADD CONSTRAINT experiences_period_check
+    CHECK (NOT (is_current AND end_date IS NOT NULL) AND (end_date IS NULL OR end_date >= start_date));
+
+ALTER TABLE educations DROP COLUMN start_date_text;
+ALTER TABLE educations DROP COLUMN end_date_text;
+ALTER TABLE educations ALTER COLUMN start_date SET NOT NULL;
+ALTER TABLE educations ADD CONSTRAINT educations_period_check
+    CHECK (NOT (is_current AND end_date IS NOT NULL) AND (end_date IS NULL OR end_date >= start_date));
+
+ALTER TABLE portfolios DROP COLUMN start_date_text;
+ALTER TABLE portfolios DROP COLUMN end_date_text;
+ALTER TABLE portfolios ALTER COLUMN start_date SET NOT NULL;
+ALTER TABLE portfolios ADD CONSTRAINT portfolios_period_check
+    CHECK (NOT (is_current AND end_date IS NOT NULL) AND (end_date IS NULL OR end_date >= start_date));
+
+CREATE INDEX idx_experiences_user_period ON experiences (user_id, is_current DESC, end_date DESC NULLS LAST, start_date DESC);
+CREATE INDEX idx_educations_user_period ON educations (user_id, is_current DESC, end_date DESC NULLS LAST, start_date DESC);
+CREATE INDEX idx_portfolios_user_period ON portfolios (user_id, is_current DESC, end_date DESC NULLS LAST, start_date DESC);
+
+DROP FUNCTION parse_bio_month(TEXT);
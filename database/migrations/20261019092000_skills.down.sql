@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS vacancy_skills;
+DROP TABLE IF EXISTS user_skills;
+DROP TABLE IF EXISTS portfolio_skills;
+DROP TABLE IF EXISTS experience_skills;
+DROP TABLE IF EXISTS skill_aliases;
+DROP TABLE IF EXISTS skills;
\ No newline at end of file
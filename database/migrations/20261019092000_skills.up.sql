@@ -0,0 +1,258 @@
+CREATE TABLE skills (
+                        id VARCHAR(26) PRIMARY KEY,
+                        name VARCHAR(100) NOT NULL,
+                        normalized_name VARCHAR(100) NOT NULL UNIQUE,
+                        category VARCHAR(32),
+                        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_skills_normalized_name_prefix ON skills (normalized_name text_pattern_ops);
+CREATE INDEX idx_skills_category ON skills (category);
+
+CREATE TABLE skill_aliases (
+                               alias VARCHAR(100) PRIMARY KEY,
+                               skill_id VARCHAR(26) NOT NULL,
+                               FOREIGN KEY (skill_id) REFERENCES skills(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_skill_aliases_prefix ON skill_aliases (alias text_pattern_ops);
+CREATE INDEX idx_skill_aliases_skill_id ON skill_aliases (skill_id);
+
+CREATE TABLE experience_skills (
+                                   experience_id VARCHAR(26) NOT NULL,
+                                   skill_id VARCHAR(26) NOT NULL,
+                                   position INTEGER NOT NULL DEFAULT 0,
+                                   PRIMARY KEY (experience_id, skill_id),
+                                   FOREIGN KEY (experience_id) REFERENCES experiences(id) ON DELETE CASCADE,
+                                   FOREIGN KEY (skill_id) REFERENCES skills(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_experience_skills_skill_id ON experience_skills (skill_id);
+
+CREATE TABLE portfolio_skills (
+                                  portfolio_id VARCHAR(26) NOT NULL,
+                                  skill_id VARCHAR(26) NOT NULL,
+                                  position INTEGER NOT NULL DEFAULT 0,
+                                  PRIMARY KEY (portfolio_id, skill_id),
+                                  FOREIGN KEY (portfolio_id) REFERENCES portfolios(id) ON DELETE CASCADE,
+                                  FOREIGN KEY (skill_id) REFERENCES skills(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_portfolio_skills_skill_id ON portfolio_skills (skill_id);
+
+CREATE TABLE user_skills (
+                             user_id VARCHAR(26) NOT NULL,
+                             skill_id VARCHAR(26) NOT NULL,
+                             position INTEGER NOT NULL DEFAULT 0,
+                             created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                             PRIMARY KEY (user_id, skill_id),
+                             FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+                             FOREIGN KEY (skill_id) REFERENCES skills(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_skills_skill_id ON user_skills (skill_id);
+
+CREATE TABLE vacancy_skills (
+                                job_vacancy_id VARCHAR(26) NOT NULL,
+                                skill_id VARCHAR(26) NOT NULL,
+                                position INTEGER NOT NULL DEFAULT 0,
+                                PRIMARY KEY (job_vacancy_id, skill_id),
+                                FOREIGN KEY (job_vacancy_id) REFERENCES job_vacancies(id) ON DELETE CASCADE,
+                                FOREIGN KEY (skill_id) REFERENCES skills(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_vacancy_skills_skill_id ON vacancy_skills (skill_id);
+
+-- Same rule as skill.Normalize: trimmed, inner whitespace collapsed, lower case.
+CREATE FUNCTION normalize_skill_name(name TEXT) RETURNS TEXT AS $$
+    SELECT lower(btrim(regexp_replace(name, '\s+', ' ', 'g')))
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Builds a ULID (48-bit millisecond time, 80 random bits, Crockford base32)
+-- so backfilled skills sort like the ones the application creates.
+CREATE FUNCTION generate_skill_ulid() RETURNS VARCHAR(26) AS $$
+DECLARE
+    alphabet CONSTANT TEXT := '0123456789ABCDEFGHJKMNPQRSTVWXYZ';
+    ms BIGINT := floor(extract(epoch FROM clock_timestamp()) * 1000);
+    result TEXT := '';
+BEGIN
+    FOR i IN 1..10 LOOP
+        result := substr(alphabet, (ms % 32)::INT + 1, 1) || result;
+        ms := ms / 32;
+    END LOOP;
+    FOR i IN 1..16 LOOP
+        result := result || substr(alphabet, floor(random() * 32)::INT + 1, 1);
+    END LOOP;
+    RETURN result;
+END;
+$$ LANGUAGE plpgsql VOLATILE;
+
+-- A starting catalogue of common skills, with the spellings people use for
+-- them mapped on as aliases.
+INSERT INTO skills (id, name, normalized_name, category)
+SELECT generate_skill_ulid(), name, normalize_skill_name(name), category
+FROM (VALUES
+          ('Go', 'programming'),
+          ('Python', 'programming'),
+          ('Java', 'programming'),
+          ('JavaScript', 'programming'),
+          ('TypeScript', 'programming'),
+          ('PHP', 'programming'),
+          ('Ruby', 'programming'),
+          ('C', 'programming'),
+          ('C++', 'programming'),
+          ('C#', 'programming'),
+          ('Kotlin', 'programming'),
+          ('Swift', 'programming'),
+          ('Dart', 'programming'),
+          ('Rust', 'programming'),
+          ('SQL', 'programming'),
+          ('HTML', 'programming'),
+          ('CSS', 'programming'),
+          ('React', 'framework'),
+          ('Vue.js', 'framework'),
+          ('Angular', 'framework'),
+          ('Next.js', 'framework'),
+          ('Node.js', 'framework'),
+          ('Express', 'framework'),
+          ('Laravel', 'framework'),
+          ('Django', 'framework'),
+          ('Flask', 'framework'),
+          ('Spring Boot', 'framework'),
+          ('Flutter', 'framework'),
+          ('React Native', 'framework'),
+          ('Tailwind CSS', 'framework'),
+          ('PostgreSQL', 'database'),
+          ('MySQL', 'database'),
+          ('MongoDB', 'database'),
+          ('Redis', 'database'),
+          ('Elasticsearch', 'database'),
+          ('Amazon Web Services', 'cloud'),
+          ('Google Cloud Platform', 'cloud'),
+          ('Microsoft Azure', 'cloud'),
+          ('Docker', 'devops'),
+          ('Kubernetes', 'devops'),
+          ('Terraform', 'devops'),
+          ('Git', 'devops'),
+          ('CI/CD', 'devops'),
+          ('Linux', 'devops'),
+          ('Machine Learning', 'data'),
+          ('Data Analysis', 'data'),
+          ('TensorFlow', 'data'),
+          ('PyTorch', 'data'),
+          ('Microsoft Excel', 'data'),
+          ('Figma', 'design'),
+          ('UI/UX Design', 'design'),
+          ('Adobe Photoshop', 'design'),
+          ('Adobe Illustrator', 'design'),
+          ('Project Management', 'management'),
+          ('Agile', 'management'),
+          ('Scrum', 'management'),
+          ('Communication', 'soft_skill'),
+          ('Leadership', 'soft_skill'),
+          ('Teamwork', 'soft_skill'),
+          ('Problem Solving', 'soft_skill')
+     ) AS seed(name, category);
+
+INSERT INTO skill_aliases (alias, skill_id)
+SELECT normalize_skill_name(seed.alias), s.id
+FROM (VALUES
+          ('golang', 'Go'),
+          ('py', 'Python'),
+          ('js', 'JavaScript'),
+          ('ecmascript', 'JavaScript'),
+          ('ts', 'TypeScript'),
+          ('cpp', 'C++'),
+          ('csharp', 'C#'),
+          ('c sharp', 'C#'),
+          ('html5', 'HTML'),
+          ('css3', 'CSS'),
+          ('reactjs', 'React'),
+          ('react.js', 'React'),
+          ('vue', 'Vue.js'),
+          ('vuejs', 'Vue.js'),
+          ('angularjs', 'Angular'),
+          ('nextjs', 'Next.js'),
+          ('node', 'Node.js'),
+          ('nodejs', 'Node.js'),
+          ('express.js', 'Express'),
+          ('expressjs', 'Express'),
+          ('spring', 'Spring Boot'),
+          ('tailwind', 'Tailwind CSS'),
+          ('postgres', 'PostgreSQL'),
+          ('psql', 'PostgreSQL'),
+          ('mongo', 'MongoDB'),
+          ('aws', 'Amazon Web Services'),
+          ('gcp', 'Google Cloud Platform'),
+          ('google cloud', 'Google Cloud Platform'),
+          ('azure', 'Microsoft Azure'),
+          ('k8s', 'Kubernetes'),
+          ('ci cd', 'CI/CD'),
+          ('ml', 'Machine Learning'),
+          ('excel', 'Microsoft Excel'),
+          ('ui/ux', 'UI/UX Design'),
+          ('ux', 'UI/UX Design'),
+          ('ui design', 'UI/UX Design'),
+          ('photoshop', 'Adobe Photoshop'),
+          ('illustrator', 'Adobe Illustrator')
+     ) AS seed(alias, name)
+JOIN skills s ON s.normalized_name = normalize_skill_name(seed.name)
+WHERE normalize_skill_name(seed.alias) <> s.normalized_name;
+
+-- Split the free-text lists into one row per mention. Company required skills
+-- have no link table of their own, so they apply to the company's vacancies.
+CREATE TEMPORARY TABLE skill_mentions AS
+SELECT 'experience' AS source, e.id AS source_id, e.user_id, m.ordinality AS position,
+       btrim(regexp_replace(m.name, '\s+', ' ', 'g')) AS name, normalize_skill_name(m.name) AS normalized_name
+FROM experiences e
+CROSS JOIN LATERAL regexp_split_to_table(e.skill_used, ',') WITH ORDINALITY AS m(name, ordinality)
+WHERE e.skill_used IS NOT NULL
+UNION ALL
+SELECT 'company', c.id, NULL, m.ordinality,
+       btrim(regexp_replace(m.name, '\s+', ' ', 'g')), normalize_skill_name(m.name)
+FROM companies c
+CROSS JOIN LATERAL regexp_split_to_table(c.required_skill, ',') WITH ORDINALITY AS m(name, ordinality)
+WHERE c.required_skill IS NOT NULL AND c.deleted_at IS NULL;
+
+DELETE FROM skill_mentions WHERE normalized_name = '' OR char_length(name) > 100;
+
+-- Mentions the seed does not cover become uncategorized skills, named after
+-- their most common spelling.
+INSERT INTO skills (id, name, normalized_name)
+SELECT generate_skill_ulid(), mode() WITHIN GROUP (ORDER BY m.name), m.normalized_name
+FROM skill_mentions m
+WHERE NOT EXISTS (SELECT 1 FROM skills s WHERE s.normalized_name = m.normalized_name)
+  AND NOT EXISTS (SELECT 1 FROM skill_aliases a WHERE a.alias = m.normalized_name)
+GROUP BY m.normalized_name;
+
+CREATE TEMPORARY TABLE skill_mention_links AS
+SELECT m.source, m.source_id, m.user_id, m.position, COALESCE(s.id, a.skill_id) AS skill_id
+FROM skill_mentions m
+LEFT JOIN skills s ON s.normalized_name = m.normalized_name
+LEFT JOIN skill_aliases a ON a.alias = m.normalized_name;
+
+INSERT INTO experience_skills (experience_id, skill_id, position)
+SELECT source_id, skill_id, MIN(position) - 1
+FROM skill_mention_links
+WHERE source = 'experience'
+GROUP BY source_id, skill_id;
+
+INSERT INTO vacancy_skills (job_vacancy_id, skill_id, position)
+SELECT v.id, l.skill_id, MIN(l.position) - 1
+FROM skill_mention_links l
+JOIN job_vacancies v ON v.recruiter_id = l.source_id
+WHERE l.source = 'company'
+GROUP BY v.id, l.skill_id;
+
+-- A candidate's profile skills start as everything named on their
+-- experiences, most mentioned first.
+INSERT INTO user_skills (user_id, skill_id, position)
+SELECT user_id, skill_id, (ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY COUNT(*) DESC, skill_id) - 1)::INT
+FROM skill_mention_links
+WHERE source = 'experience'
+GROUP BY user_id, skill_id;
+
+DROP TABLE skill_mention_links;
+DROP TABLE skill_mentions;
+DROP FUNCTION generate_skill_ulid();
+DROP FUNCTION normalize_skill_name(TEXT);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS certifications;
\ No newline at end of file
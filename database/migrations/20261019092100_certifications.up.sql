@@ -0,0 +1,20 @@
+CREATE TABLE certifications (
+                                id VARCHAR(26) PRIMARY KEY,
+                                user_id VARCHAR(26) NOT NULL,
+                                name VARCHAR(255) NOT NULL,
+                                issuer VARCHAR(255) NOT NULL,
+                                issue_date DATE NOT NULL,
+                                expiry_date DATE,
+                                credential_id VARCHAR(255),
+                                credential_url VARCHAR(255),
+                                image VARCHAR(255),
+                                is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+                                expiry_reminder_sent_at TIMESTAMP,
+                                created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                updated_at TIMESTAMP,
+                                FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+                                CHECK (expiry_date IS NULL OR expiry_date >= issue_date)
+);
+
+CREATE INDEX idx_certifications_user_id ON certifications (user_id);
+CREATE INDEX idx_certifications_expiry_reminder ON certifications (expiry_date) WHERE expiry_reminder_sent_at IS NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS vacancy_languages;
+DROP TABLE IF EXISTS user_languages;
\ No newline at end of file
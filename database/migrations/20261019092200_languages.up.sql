@@ -0,0 +1,25 @@
+CREATE TABLE user_languages (
+                                user_id VARCHAR(26) NOT NULL,
+                                language VARCHAR(3) NOT NULL,
+                                level VARCHAR(8) NOT NULL,
+                                test_name VARCHAR(50),
+                                test_score VARCHAR(20),
+                                position INTEGER NOT NULL DEFAULT 0,
+                                created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                updated_at TIMESTAMP,
+                                PRIMARY KEY (user_id, language),
+                                FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+                                CHECK (level IN ('A1', 'A2', 'B1', 'B2', 'C1', 'C2', 'NATIVE'))
+);
+
+CREATE INDEX idx_user_languages_language_level ON user_languages (language, level);
+
+CREATE TABLE vacancy_languages (
+                                   job_vacancy_id VARCHAR(26) NOT NULL,
+                                   language VARCHAR(3) NOT NULL,
+                                   min_level VARCHAR(8) NOT NULL,
+                                   position INTEGER NOT NULL DEFAULT 0,
+                                   PRIMARY KEY (job_vacancy_id, language),
+                                   FOREIGN KEY (job_vacancy_id) REFERENCES job_vacancies(id) ON DELETE CASCADE,
+                                   CHECK (min_level IN ('A1', 'A2', 'B1', 'B2', 'C1', 'C2', 'NATIVE'))
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS skill_endorsements;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE skill_endorsements (
+                                    user_id VARCHAR(26) NOT NULL,
+                                    skill_id VARCHAR(26) NOT NULL,
+                                    endorser_id VARCHAR(26) NOT NULL,
+                                    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    revoked_at TIMESTAMP,
+                                    PRIMARY KEY (user_id, skill_id, endorser_id),
+                                    FOREIGN KEY (user_id, skill_id) REFERENCES user_skills(user_id, skill_id) ON DELETE CASCADE,
+                                    FOREIGN KEY (endorser_id) REFERENCES users(id) ON DELETE CASCADE,
+                                    CHECK (user_id <> endorser_id)
+);
+
+CREATE INDEX idx_skill_endorsements_endorser_created_at ON skill_endorsements (endorser_id, created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE company_api_keys DROP COLUMN IF EXISTS role;
+ALTER TABLE company_api_keys DROP COLUMN IF EXISTS member_id;
\ No newline at end of file
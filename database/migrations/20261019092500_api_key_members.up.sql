@@ -0,0 +1,9 @@
+ALTER TABLE company_api_keys ADD COLUMN member_id VARCHAR(26) REFERENCES company_members(id) ON DELETE SET NULL;
+ALTER TABLE company_api_keys ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'recruiter';
+
+-- Keys created before members existed came from the company login, which
+-- became the owner member.
+UPDATE company_api_keys k
+SET member_id = m.id
+FROM company_members m
+WHERE m.company_id = k.company_id AND m.role = 'owner';
@@ -0,0 +1,33 @@
+package apikey
+
+import (
+	"ProjectGolang/internal/entity"
+	"time"
+)
+
+// CreateAPIKey keys act as recruiters unless Role asks for a read-only
+// viewer. They never get to manage the company.
+type CreateAPIKey struct {
+	CompanyID string             `json:"-"`
+	MemberID  string             `json:"-"`
+	Name      string             `json:"name" validate:"required,max=100"`
+	Scopes    []string           `json:"scopes" validate:"required,min=1,unique,dive,oneof=vacancies:read vacancies:write applications:read applications:write"`
+	Role      entity.CompanyRole `json:"role" validate:"omitempty,oneof=recruiter viewer"`
+	ExpiresAt *time.Time         `json:"expires_at"`
+}
+
+type APIKeyResponse struct {
+	ID        string             `json:"id"`
+	Name      string             `json:"name"`
+	Prefix    string             `json:"prefix"`
+	Scopes    []string           `json:"scopes"`
+	Role      entity.CompanyRole `json:"role"`
+	CreatedBy string             `json:"created_by"`
+	// Key is the full secret. It is only returned when the key is created.
+	Key        string     `json:"key,omitempty"`
+	Active     bool       `json:"active"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
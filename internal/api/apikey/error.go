@@ -0,0 +1,14 @@
+package apikey
+
+import (
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrorAPIKeyNotFound      = response.New(fiber.StatusNotFound, "api key not found")
+	ErrorAPIKeyLimitReached  = response.New(fiber.StatusConflict, "active api key limit reached")
+	ErrorAPIKeyInvalidExpiry = response.New(fiber.StatusBadRequest, "api key expiry must be in the future")
+	ErrorRecruiterOnly       = response.New(fiber.StatusForbidden, "only recruiters can manage api keys")
+	ErrorCompanyManagerOnly  = response.New(fiber.StatusForbidden, "only company owners and admins can manage api keys")
+)
@@ -0,0 +1,100 @@
+package apikeyHandler
+
+import (
+	"ProjectGolang/internal/api/apikey"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *APIKeyHandler) CreateAPIKey(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to create api key")
+	}
+
+	var req apikey.CreateAPIKey
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse api key request")
+		return err
+	}
+	req.CompanyID = user.ID
+	req.MemberID = user.MemberID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for api key request")
+		return err
+	}
+
+	key, err := h.apiKeyService.CreateAPIKey(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to create api key")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(key)
+	}
+}
+
+func (h *APIKeyHandler) GetAPIKeys(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get api keys")
+	}
+
+	keys, err := h.apiKeyService.GetAPIKeys(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get api keys")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(keys)
+	}
+}
+
+func (h *APIKeyHandler) RevokeAPIKey(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to revoke api key")
+	}
+
+	if err := h.apiKeyService.RevokeAPIKey(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Failed to revoke api key")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
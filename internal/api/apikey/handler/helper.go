@@ -0,0 +1,27 @@
+package apikeyHandler
+
+import (
+	"ProjectGolang/internal/api/apikey"
+	"ProjectGolang/internal/entity"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"github.com/gofiber/fiber/v2"
+)
+
+// requireRecruiter returns the authenticated recruiter, whose user ID is
+// their company's ID.
+func (h *APIKeyHandler) requireRecruiter(ctx *fiber.Ctx) (entity.UserLoginData, error) {
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return entity.UserLoginData{}, err
+	}
+
+	if user.Role != entity.RoleRecruiter {
+		return entity.UserLoginData{}, apikey.ErrorRecruiterOnly
+	}
+
+	if user.CompanyRole != entity.CompanyRoleOwner && user.CompanyRole != entity.CompanyRoleAdmin {
+		return entity.UserLoginData{}, apikey.ErrorCompanyManagerOnly
+	}
+
+	return user, nil
+}
@@ -0,0 +1,34 @@
+package apikeyHandler
+
+import (
+	apikeyService "ProjectGolang/internal/api/apikey/service"
+	"ProjectGolang/internal/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+type APIKeyHandler struct {
+	apiKeyService apikeyService.APIKeyService
+	validator     *validator.Validate
+	middleware    middleware.Middleware
+	log           *logrus.Logger
+}
+
+func New(as apikeyService.APIKeyService, validate *validator.Validate, middleware middleware.Middleware, log *logrus.Logger) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: as,
+		validator:     validate,
+		middleware:    middleware,
+		log:           log,
+	}
+}
+
+// Start registers key management behind login tokens only, so a leaked key
+// cannot mint or revoke keys.
+func (h *APIKeyHandler) Start(srv fiber.Router) {
+	ak := srv.Group("/companies/me/api_keys")
+	ak.Post("/", h.middleware.NewTokenMiddleware, h.CreateAPIKey)
+	ak.Get("/", h.middleware.NewTokenMiddleware, h.GetAPIKeys)
+	ak.Delete("/:id", h.middleware.NewTokenMiddleware, h.RevokeAPIKey)
+}
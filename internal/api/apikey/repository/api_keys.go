@@ -0,0 +1,166 @@
+package apikeyRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+func (r *apiKeysRepository) CreateAPIKey(c context.Context, key entity.APIKey) error {
+	r.log.WithFields(map[string]interface{}{
+		"api_key_id": key.ID,
+		"company_id": key.CompanyID,
+		"prefix":     key.Prefix,
+	}).Debug("Creating API key in database")
+
+	var expiresAt sql.NullTime
+	if key.ExpiresAt != nil {
+		expiresAt = sql.NullTime{Time: *key.ExpiresAt, Valid: true}
+	}
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateAPIKey),
+		key.ID,
+		key.CompanyID,
+		key.MemberID,
+		key.Role,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		pq.Array(key.Scopes),
+		expiresAt,
+		key.CreatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating API key")
+		return err
+	}
+
+	return nil
+}
+
+func (r *apiKeysRepository) GetAPIKeyByID(c context.Context, id string) (entity.APIKey, error) {
+	return r.getAPIKey(c, queryGetAPIKeyByID, id)
+}
+
+// GetAPIKeyByPrefix finds the key a request presented. Keys of deleted
+// companies are reported missing.
+func (r *apiKeysRepository) GetAPIKeyByPrefix(c context.Context, prefix string) (entity.APIKey, error) {
+	return r.getAPIKey(c, queryGetAPIKeyByPrefix, prefix)
+}
+
+func (r *apiKeysRepository) getAPIKey(c context.Context, query string, arg string) (entity.APIKey, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(query), arg)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting API key")
+		return entity.APIKey{}, err
+	}
+	defer rows.Close()
+
+	keys, err := scanAPIKeys(rows, r.log)
+	if err != nil || len(keys) == 0 {
+		return entity.APIKey{}, err
+	}
+
+	return keys[0], nil
+}
+
+func (r *apiKeysRepository) GetAPIKeysByCompanyID(c context.Context, companyID string) ([]entity.APIKey, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetAPIKeysByCompanyID), companyID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when getting API keys")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanAPIKeys(rows, r.log)
+}
+
+func (r *apiKeysRepository) CountActiveAPIKeysByCompanyID(c context.Context, companyID string, now time.Time) (int, error) {
+	var count int
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryCountActiveAPIKeysByCompanyID), companyID, now).Scan(&count)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when counting API keys")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *apiKeysRepository) RevokeAPIKey(c context.Context, id string, revokedAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryRevokeAPIKey), revokedAt, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"api_key_id": id,
+		}).Error("Database error when revoking API key")
+		return err
+	}
+
+	return nil
+}
+
+func (r *apiKeysRepository) TouchAPIKey(c context.Context, id string, usedAt time.Time, staleBefore time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryTouchAPIKey), usedAt, id, staleBefore)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"api_key_id": id,
+		}).Error("Database error when recording API key use")
+		return err
+	}
+
+	return nil
+}
+
+func scanAPIKeys(rows *sql.Rows, log *logrus.Logger) ([]entity.APIKey, error) {
+	var keys []entity.APIKey
+	for rows.Next() {
+		var (
+			key        entity.APIKey
+			expiresAt  sql.NullTime
+			lastUsedAt sql.NullTime
+			revokedAt  sql.NullTime
+		)
+		err := rows.Scan(&key.ID, &key.CompanyID, &key.MemberID, &key.Role, &key.Name, &key.Prefix, &key.KeyHash,
+			pq.Array(&key.Scopes), &expiresAt, &lastUsedAt, &revokedAt, &key.CreatedAt, &key.CompanyName,
+			&key.CompanyEmail, &key.MemberRole)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning API key row")
+			return nil, err
+		}
+		if expiresAt.Valid {
+			key.ExpiresAt = &expiresAt.Time
+		}
+		if lastUsedAt.Valid {
+			key.LastUsedAt = &lastUsedAt.Time
+		}
+		if revokedAt.Valid {
+			key.RevokedAt = &revokedAt.Time
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through API key rows")
+		return nil, err
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,40 @@
+package apikeyRepository
+
+const (
+	queryCreateAPIKey = `
+    INSERT INTO company_api_keys (id, company_id, member_id, role, name, prefix, key_hash, scopes, expires_at, created_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryAPIKeyColumns = `
+    SELECT k.id, k.company_id, COALESCE(k.member_id, ''), k.role, k.name, k.prefix, k.key_hash, k.scopes,
+           k.expires_at, k.last_used_at, k.revoked_at, k.created_at, c.name, c.email, COALESCE(m.role, '')
+    FROM company_api_keys k
+    JOIN companies c ON c.id = k.company_id AND c.deleted_at IS NULL
+    LEFT JOIN company_members m ON m.id = k.member_id
+    `
+
+	queryGetAPIKeyByID = queryAPIKeyColumns + `WHERE k.id = ?`
+
+	queryGetAPIKeyByPrefix = queryAPIKeyColumns + `WHERE k.prefix = ?`
+
+	queryGetAPIKeysByCompanyID = queryAPIKeyColumns + `WHERE k.company_id = ? ORDER BY k.created_at DESC`
+
+	queryCountActiveAPIKeysByCompanyID = `
+    SELECT COUNT(*)
+    FROM company_api_keys
+    WHERE company_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?)
+    `
+
+	queryRevokeAPIKey = `
+    UPDATE company_api_keys SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL
+    `
+
+	// queryTouchAPIKey only writes when the stored time is stale, so busy
+	// keys do not turn every request into a row update.
+	queryTouchAPIKey = `
+    UPDATE company_api_keys
+    SET last_used_at = ?
+    WHERE id = ? AND (last_used_at IS NULL OR last_used_at < ?)
+    `
+)
@@ -0,0 +1,185 @@
+package apikeyService
+
+import (
+	"ProjectGolang/internal/api/apikey"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"crypto/subtle"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	// maxActiveKeysPerCompany bounds how many live credentials a company can
+	// hold at once.
+	maxActiveKeysPerCompany = 20
+	// lastUsedResolution is how stale last_used_at may get before a request
+	// updates it.
+	lastUsedResolution = time.Minute
+)
+
+func (s *apiKeyService) CreateAPIKey(c context.Context, req apikey.CreateAPIKey) (apikey.APIKeyResponse, error) {
+	now := time.Now()
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return apikey.APIKeyResponse{}, apikey.ErrorAPIKeyInvalidExpiry
+	}
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return apikey.APIKeyResponse{}, err
+	}
+
+	count, err := repo.APIKeys.CountActiveAPIKeysByCompanyID(c, req.CompanyID, now)
+	if err != nil {
+		return apikey.APIKeyResponse{}, err
+	}
+
+	if count >= maxActiveKeysPerCompany {
+		return apikey.APIKeyResponse{}, apikey.ErrorAPIKeyLimitReached
+	}
+
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return apikey.APIKeyResponse{}, err
+	}
+
+	raw, prefix, err := generateKey()
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate API key")
+		return apikey.APIKeyResponse{}, err
+	}
+
+	role := req.Role
+	if role == "" {
+		role = entity.CompanyRoleRecruiter
+	}
+
+	key := entity.APIKey{
+		ID:        id,
+		CompanyID: req.CompanyID,
+		MemberID:  req.MemberID,
+		Role:      role,
+		Name:      req.Name,
+		Prefix:    prefix,
+		KeyHash:   utils.HashToken(raw),
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+		CreatedAt: now,
+	}
+
+	if err := repo.APIKeys.CreateAPIKey(c, key); err != nil {
+		return apikey.APIKeyResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"api_key_id": key.ID,
+		"company_id": key.CompanyID,
+		"member_id":  key.MemberID,
+		"prefix":     key.Prefix,
+		"scopes":     key.Scopes,
+		"role":       key.Role,
+	}).Info("API key created")
+
+	response := makeAPIKeyResponse(key, now)
+	response.Key = raw
+	return response, nil
+}
+
+func (s *apiKeyService) GetAPIKeys(c context.Context, companyID string) ([]apikey.APIKeyResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	keys, err := repo.APIKeys.GetAPIKeysByCompanyID(c, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	response := make([]apikey.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		response[i] = makeAPIKeyResponse(key, now)
+	}
+
+	return response, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(c context.Context, id string, companyID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	key, err := repo.APIKeys.GetAPIKeyByID(c, id)
+	if err != nil {
+		return err
+	}
+
+	if key.ID == "" || key.CompanyID != companyID {
+		return apikey.ErrorAPIKeyNotFound
+	}
+
+	if err := repo.APIKeys.RevokeAPIKey(c, id, time.Now()); err != nil {
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"api_key_id": id,
+		"company_id": companyID,
+	}).Info("API key revoked")
+
+	return nil
+}
+
+func (s *apiKeyService) VerifyAPIKey(c context.Context, raw string) (entity.APIKey, error) {
+	prefix, ok := splitKey(raw)
+	if !ok {
+		return entity.APIKey{}, nil
+	}
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return entity.APIKey{}, err
+	}
+
+	key, err := repo.APIKeys.GetAPIKeyByPrefix(c, prefix)
+	if err != nil || key.ID == "" {
+		return entity.APIKey{}, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(key.KeyHash), []byte(utils.HashToken(raw))) != 1 {
+		s.log.WithFields(logrus.Fields{
+			"prefix": prefix,
+		}).Warn("API key secret mismatch")
+		return entity.APIKey{}, nil
+	}
+
+	now := time.Now()
+	if !isActive(key, now) {
+		return entity.APIKey{}, nil
+	}
+
+	// Failing to record the use must not lock the caller out.
+	_ = repo.APIKeys.TouchAPIKey(c, key.ID, now, now.Add(-lastUsedResolution))
+
+	return key, nil
+}
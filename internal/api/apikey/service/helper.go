@@ -0,0 +1,61 @@
+package apikeyService
+
+import (
+	"ProjectGolang/internal/api/apikey"
+	"ProjectGolang/internal/entity"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// keyPrefix marks a string as one of our API keys, so it is easy to spot in
+// logs and secret scanners.
+const keyPrefix = "pgk_"
+
+// generateKey returns a new key and its public prefix. Keys look like
+// "pgk_<12 hex>.<64 hex>"; the part before the dot is the prefix.
+func generateKey() (string, string, error) {
+	b := make([]byte, 38)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	prefix := keyPrefix + hex.EncodeToString(b[:6])
+	return prefix + "." + hex.EncodeToString(b[6:]), prefix, nil
+}
+
+// splitKey returns the prefix of a presented key, or false when it is not
+// shaped like one of ours.
+func splitKey(key string) (string, bool) {
+	prefix, secret, ok := strings.Cut(key, ".")
+	if !ok || !strings.HasPrefix(prefix, keyPrefix) || secret == "" {
+		return "", false
+	}
+	return prefix, true
+}
+
+func isActive(key entity.APIKey, now time.Time) bool {
+	return key.MemberID != "" && key.RevokedAt == nil && (key.ExpiresAt == nil || key.ExpiresAt.After(now))
+}
+
+func makeAPIKeyResponse(key entity.APIKey, now time.Time) apikey.APIKeyResponse {
+	scopes := key.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	return apikey.APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     scopes,
+		Role:       key.Role,
+		CreatedBy:  key.MemberID,
+		Active:     isActive(key, now),
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
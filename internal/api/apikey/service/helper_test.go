@@ -0,0 +1,75 @@
+package apikeyService
+
+import (
+	"ProjectGolang/internal/entity"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateKeySplitsBack(t *testing.T) {
+	key, prefix, err := generateKey()
+	if err != nil {
+		t.Fatalf("generateKey() error = %v", err)
+	}
+
+	if !strings.HasPrefix(prefix, keyPrefix) {
+		t.Errorf("prefix %q does not start with %q", prefix, keyPrefix)
+	}
+	if got, ok := splitKey(key); !ok || got != prefix {
+		t.Errorf("splitKey(generated) = %q, %v, want %q, true", got, ok, prefix)
+	}
+}
+
+func TestSplitKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		wantPrefix string
+		wantOK     bool
+	}{
+		{name: "well formed", key: "pgk_0123456789ab.secret", wantPrefix: "pgk_0123456789ab", wantOK: true},
+		{name: "secret may contain dots", key: "pgk_abc.sec.ret", wantPrefix: "pgk_abc", wantOK: true},
+		{name: "no dot", key: "pgk_0123456789ab", wantOK: false},
+		{name: "empty secret", key: "pgk_0123456789ab.", wantOK: false},
+		{name: "foreign prefix", key: "sk_0123456789ab.secret", wantOK: false},
+		{name: "prefix not at the start", key: "x.pgk_abc.secret", wantOK: false},
+		{name: "empty", key: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, ok := splitKey(tt.key)
+			if prefix != tt.wantPrefix || ok != tt.wantOK {
+				t.Errorf("splitKey(%q) = %q, %v, want %q, %v", tt.key, prefix, ok, tt.wantPrefix, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestIsActive(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		key  entity.APIKey
+		want bool
+	}{
+		{name: "no expiry", key: entity.APIKey{MemberID: "member"}, want: true},
+		{name: "expires later", key: entity.APIKey{MemberID: "member", ExpiresAt: &future}, want: true},
+		{name: "expired", key: entity.APIKey{MemberID: "member", ExpiresAt: &past}, want: false},
+		{name: "expires now", key: entity.APIKey{MemberID: "member", ExpiresAt: &now}, want: false},
+		{name: "revoked", key: entity.APIKey{MemberID: "member", RevokedAt: &past}, want: false},
+		{name: "creator removed from the company", key: entity.APIKey{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isActive(tt.key, now); got != tt.want {
+				t.Errorf("isActive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package apikeyService
+
+import (
+	"ProjectGolang/internal/api/apikey"
+	apikeyRepository "ProjectGolang/internal/api/apikey/repository"
+	"ProjectGolang/internal/entity"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+type APIKeyService interface {
+	CreateAPIKey(c context.Context, req apikey.CreateAPIKey) (apikey.APIKeyResponse, error)
+	GetAPIKeys(c context.Context, companyID string) ([]apikey.APIKeyResponse, error)
+	RevokeAPIKey(c context.Context, id string, companyID string) error
+
+	// VerifyAPIKey resolves a key presented by a request. Unknown, revoked
+	// and expired keys come back as a zero APIKey.
+	VerifyAPIKey(c context.Context, key string) (entity.APIKey, error)
+}
+
+type apiKeyService struct {
+	repo apikeyRepository.Repository
+	log  *logrus.Logger
+}
+
+func New(apiKeyRepo apikeyRepository.Repository, log *logrus.Logger) APIKeyService {
+	return &apiKeyService{
+		repo: apiKeyRepo,
+		log:  log,
+	}
+}
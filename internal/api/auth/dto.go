@@ -37,6 +37,11 @@ type UpdateUser struct {
 	PhoneNumber    string `form:"phone_number" validate:"omitempty"`
 	Location       string `form:"location" validate:"omitempty"`
 	Headline       string `form:"headline" validate:"omitempty"`
+	IsSearchable   *bool  `form:"is_searchable" validate:"omitempty"`
+
+	ProfileVisibility entity.ProfileVisibility `form:"profile_visibility" validate:"omitempty,oneof=public logged_in recruiters private"`
+	HideEmail         *bool                    `form:"hide_email" validate:"omitempty"`
+	HidePhoneNumber   *bool                    `form:"hide_phone_number" validate:"omitempty"`
 }
 
 type UpdateCompany struct {
@@ -53,23 +58,132 @@ type UpdateCompany struct {
 	RequiredSkill   string `form:"required_skill" validate:"omitempty"`
 }
 
+type InviteCompanyMember struct {
+	Email string             `json:"email" validate:"required,email"`
+	Role  entity.CompanyRole `json:"role" validate:"required,oneof=admin recruiter viewer"`
+}
+
+type AcceptCompanyInvitation struct {
+	Name     string `json:"name" validate:"required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+type UpdateCompanyMemberRole struct {
+	Role entity.CompanyRole `json:"role" validate:"required,oneof=admin recruiter viewer"`
+}
+
+type CompanyMemberResponse struct {
+	ID        string             `json:"id"`
+	Email     string             `json:"email"`
+	Name      string             `json:"name"`
+	Role      entity.CompanyRole `json:"role"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type CompanyInvitationResponse struct {
+	ID          string             `json:"id"`
+	CompanyID   string             `json:"company_id"`
+	CompanyName string             `json:"company_name"`
+	Email       string             `json:"email"`
+	Role        entity.CompanyRole `json:"role"`
+	ExpiresAt   time.Time          `json:"expires_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type SubmitCompanyVerification struct {
+	Method entity.VerificationMethod `form:"method" json:"method" validate:"required,oneof=dns_txt email_domain document"`
+	Domain string                    `form:"domain" json:"domain" validate:"required_unless=Method document,omitempty,fqdn,max=255"`
+}
+
+type VerificationDNSRecord struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type CompanyVerificationResponse struct {
+	ID             string                    `json:"id"`
+	CompanyID      string                    `json:"company_id"`
+	CompanyName    string                    `json:"company_name"`
+	Method         entity.VerificationMethod `json:"method"`
+	Domain         string                    `json:"domain,omitempty"`
+	DNSRecord      *VerificationDNSRecord    `json:"dns_record,omitempty"`
+	Documents      []string                  `json:"documents,omitempty"`
+	Status         entity.VerificationStatus `json:"status"`
+	DomainVerified bool                      `json:"domain_verified"`
+	ReviewNote     string                    `json:"review_note,omitempty"`
+	ReviewedAt     *time.Time                `json:"reviewed_at,omitempty"`
+	CreatedAt      time.Time                 `json:"created_at"`
+
+	// CompanyEmail is only reported to admins.
+	CompanyEmail string `json:"company_email,omitempty"`
+}
+
+type CompanyVerificationStatusResponse struct {
+	IsVerified bool                         `json:"is_verified"`
+	VerifiedAt *time.Time                   `json:"verified_at,omitempty"`
+	Latest     *CompanyVerificationResponse `json:"latest,omitempty"`
+}
+
+type GetCompanyVerifications struct {
+	Status   entity.VerificationStatus `query:"status" validate:"omitempty,oneof=pending approved rejected cancelled"`
+	Page     int                       `query:"page" validate:"min=1"`
+	PageSize int                       `query:"page_size" validate:"min=1,max=100"`
+}
+
+type PaginatedCompanyVerificationsResponse struct {
+	Verifications []CompanyVerificationResponse `json:"verifications"`
+	TotalCount    int                           `json:"total_count"`
+	TotalPages    int                           `json:"total_pages"`
+	CurrentPage   int                           `json:"current_page"`
+	PageSize      int                           `json:"page_size"`
+}
+
+type ReviewCompanyVerification struct {
+	Note string `json:"note" validate:"max=1000"`
+}
+
+type RejectCompanyVerification struct {
+	Note string `json:"note" validate:"required,max=1000"`
+}
+
+type CompanyResponse struct {
+	ID              string     `json:"id"`
+	Slug            string     `json:"slug,omitempty"`
+	Name            string     `json:"name"`
+	ProfilePicture  string     `json:"profile_picture"`
+	BannerPicture   string     `json:"banner_picture"`
+	Location        string     `json:"location"`
+	AboutUs         string     `json:"about_us"`
+	IndustryTypes   string     `json:"industry_types"`
+	NumberEmployees int        `json:"number_employees"`
+	EstablishedDate time.Time  `json:"established_date"`
+	CompanyURL      string     `json:"company_url"`
+	IsVerified      bool       `json:"is_verified"`
+	VerifiedAt      *time.Time `json:"verified_at,omitempty"`
+}
+
 type UserDB struct {
-	ID             sql.NullString `db:"id"`
-	Email          sql.NullString `db:"email"`
-	Password       sql.NullString `db:"password"`
-	PhoneNumber    sql.NullString `db:"phone_number"`
-	Name           sql.NullString `db:"name"`
-	Role           sql.NullString `db:"role"`
-	Location       sql.NullString `db:"location"`
-	ProfilePicture sql.NullString `db:"profile_picture"`
-	BannerPicture  sql.NullString `db:"banner_picture"`
-	IsPremium      sql.NullBool   `db:"is_premium"`
-	PremiumUntil   sql.NullTime   `db:"premium_until"`
-	Headline       sql.NullString `db:"headline"`
-	Address        sql.NullString `db:"address"`
-	CreatedAt      sql.NullTime   `db:"created_at"`
-	UpdatedAt      sql.NullTime   `db:"updated_at"`
-	DeletedAt      sql.NullTime   `db:"deleted_at"`
+	ID                sql.NullString `db:"id"`
+	Email             sql.NullString `db:"email"`
+	Password          sql.NullString `db:"password"`
+	PhoneNumber       sql.NullString `db:"phone_number"`
+	Name              sql.NullString `db:"name"`
+	Role              sql.NullString `db:"role"`
+	Location          sql.NullString `db:"location"`
+	ProfilePicture    sql.NullString `db:"profile_picture"`
+	BannerPicture     sql.NullString `db:"banner_picture"`
+	IsPremium         sql.NullBool   `db:"is_premium"`
+	PremiumUntil      sql.NullTime   `db:"premium_until"`
+	Headline          sql.NullString `db:"headline"`
+	IsSearchable      sql.NullBool   `db:"is_searchable"`
+	ProfileVisibility sql.NullString `db:"profile_visibility"`
+	HideEmail         sql.NullBool   `db:"hide_email"`
+	HidePhoneNumber   sql.NullBool   `db:"hide_phone_number"`
+	Address           sql.NullString `db:"address"`
+	CreatedAt         sql.NullTime   `db:"created_at"`
+	UpdatedAt         sql.NullTime   `db:"updated_at"`
+	DeletedAt         sql.NullTime   `db:"deleted_at"`
 }
 
 type CompanyDB struct {
@@ -87,7 +201,46 @@ type CompanyDB struct {
 	EstablishedDate sql.NullTime   `db:"established_date"`
 	CompanyURL      sql.NullString `db:"company_url"`
 	RequiredSkill   sql.NullString `db:"required_skill"`
+	IsVerified      sql.NullBool   `db:"is_verified"`
+	VerifiedAt      sql.NullTime   `db:"verified_at"`
 	CreatedAt       sql.NullTime   `db:"created_at"`
 	UpdatedAt       sql.NullTime   `db:"updated_at"`
 	DeletedAt       sql.NullTime   `db:"deleted_at"`
 }
+
+type UpdateProfileSlug struct {
+	Slug string `json:"slug" validate:"required,min=3,max=40"`
+}
+
+type ProfileSlugHistoryEntry struct {
+	Slug      string     `json:"slug"`
+	IsCurrent bool       `json:"is_current"`
+	ClaimedAt time.Time  `json:"claimed_at"`
+	RetiredAt *time.Time `json:"retired_at,omitempty"`
+}
+
+type ProfileSlugResponse struct {
+	Slug    string                    `json:"slug"`
+	URL     string                    `json:"url"`
+	History []ProfileSlugHistoryEntry `json:"history"`
+}
+
+// OpenGraphMetadata carries the og:* tags a client renders for share previews.
+type OpenGraphMetadata struct {
+	Title       string `json:"og:title"`
+	Description string `json:"og:description"`
+	Image       string `json:"og:image,omitempty"`
+	URL         string `json:"og:url"`
+	Type        string `json:"og:type"`
+}
+
+type ResolvedProfileResponse struct {
+	OwnerType entity.ProfileOwnerType `json:"owner_type"`
+	OwnerID   string                  `json:"owner_id"`
+	Slug      string                  `json:"slug"`
+	Name      string                  `json:"name"`
+	Headline  string                  `json:"headline"`
+	Avatar    string                  `json:"avatar"`
+	URL       string                  `json:"url"`
+	OpenGraph OpenGraphMetadata       `json:"open_graph"`
+}
@@ -10,4 +10,34 @@ var (
 	ErrorInvalidCredentials = response.New(fiber.StatusBadRequest, "invalid credentials")
 	ErrorUserNotFound       = response.New(fiber.StatusNotFound, "user not found")
 	ErrorInvalidOTP         = response.New(fiber.StatusBadRequest, "invalid otp")
+
+	ErrorRecruiterOnly                 = response.New(fiber.StatusForbidden, "only recruiters can access company members")
+	ErrorCompanyManagerOnly            = response.New(fiber.StatusForbidden, "only company owners and admins can manage members")
+	ErrorCompanyRoleNotAllowed         = response.New(fiber.StatusForbidden, "you cannot assign or change this role")
+	ErrorCompanyMemberNotFound         = response.New(fiber.StatusNotFound, "company member not found")
+	ErrorCompanyOwnerImmutable         = response.New(fiber.StatusBadRequest, "the company owner cannot be changed or removed")
+	ErrorCompanyMemberSelf             = response.New(fiber.StatusBadRequest, "you cannot change your own membership")
+	ErrorInvitationNotFound            = response.New(fiber.StatusNotFound, "invitation not found")
+	ErrorInvitationNotPending          = response.New(fiber.StatusGone, "invitation is no longer valid")
+	ErrorCompanyNotFound               = response.New(fiber.StatusNotFound, "company not found")
+	ErrorAdminOnly                     = response.New(fiber.StatusForbidden, "only admins can review company verifications")
+	ErrorCompanyAlreadyVerified        = response.New(fiber.StatusConflict, "company is already verified")
+	ErrorVerificationNotFound          = response.New(fiber.StatusNotFound, "verification request not found")
+	ErrorVerificationNotPending        = response.New(fiber.StatusConflict, "verification request is no longer pending")
+	ErrorVerificationNotDNS            = response.New(fiber.StatusBadRequest, "only dns_txt verification requests can be checked")
+	ErrorVerificationRecordMissing     = response.New(fiber.StatusBadRequest, "verification TXT record was not found on the domain")
+	ErrorVerificationDomainMismatch    = response.New(fiber.StatusBadRequest, "your email domain does not match the submitted domain")
+	ErrorVerificationFreeEmailDomain   = response.New(fiber.StatusBadRequest, "free email providers cannot verify a company domain")
+	ErrorVerificationDocumentsRequired = response.New(fiber.StatusBadRequest, "between 1 and 5 documents are required")
+	ErrorVerificationDocumentInvalid   = response.New(fiber.StatusBadRequest, "documents must be PDF, JPEG or PNG files up to 10MB")
+	ErrorVerificationDomainNotProven   = response.New(fiber.StatusConflict, "domain ownership has not been proven yet")
+	ErrorInvitationEmailConflict       = response.New(fiber.StatusConflict, "an account with this email already exists")
+
+	ErrorSlugInvalid        = response.New(fiber.StatusBadRequest, "slug must be 3 to 40 lowercase letters, digits or single hyphens")
+	ErrorSlugReserved       = response.New(fiber.StatusBadRequest, "this slug is reserved")
+	ErrorSlugTaken          = response.New(fiber.StatusConflict, "this slug is already taken")
+	ErrorSlugChangeTooSoon  = response.New(fiber.StatusTooManyRequests, "the profile slug can only be changed once every 7 days")
+	ErrorSlugCandidatesOnly = response.New(fiber.StatusForbidden, "recruiters manage the company slug instead")
+	ErrorProfileNotFound    = response.New(fiber.StatusNotFound, "profile not found")
+	ErrorProfileNotVisible  = response.New(fiber.StatusForbidden, "this profile is not visible to you")
 )
@@ -2,8 +2,10 @@ package authHandler
 
 import (
 	"ProjectGolang/internal/api/auth"
+	"ProjectGolang/internal/entity"
 	contextPkg "ProjectGolang/pkg/context"
 	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
 	"errors"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/utils"
@@ -197,6 +199,19 @@ func (h *AuthHandler) UpdateUser(ctx *fiber.Ctx) error {
 		PhoneNumber: ctx.FormValue("phone_number"),
 		Location:    ctx.FormValue("location"),
 		Headline:    ctx.FormValue("headline"),
+
+		ProfileVisibility: entity.ProfileVisibility(ctx.FormValue("profile_visibility")),
+	}
+
+	var err error
+	if req.IsSearchable, err = formBool(ctx, "is_searchable"); err != nil {
+		return response.WriteError(ctx, err, "User update failed")
+	}
+	if req.HideEmail, err = formBool(ctx, "hide_email"); err != nil {
+		return response.WriteError(ctx, err, "User update failed")
+	}
+	if req.HidePhoneNumber, err = formBool(ctx, "hide_phone_number"); err != nil {
+		return response.WriteError(ctx, err, "User update failed")
 	}
 
 	profileFile, err := ctx.FormFile("profile_picture")
@@ -1 +1,52 @@
 package authHandler
+
+import (
+	"ProjectGolang/internal/api/auth"
+	"ProjectGolang/internal/entity"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// requireRecruiter returns the authenticated recruiter, whose user ID is
+// their company's ID.
+func (h *AuthHandler) requireRecruiter(ctx *fiber.Ctx) (entity.UserLoginData, error) {
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return entity.UserLoginData{}, err
+	}
+
+	if user.Role != entity.RoleRecruiter {
+		return entity.UserLoginData{}, auth.ErrorRecruiterOnly
+	}
+
+	return user, nil
+}
+
+func (h *AuthHandler) requireAdmin(ctx *fiber.Ctx) (entity.UserLoginData, error) {
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return entity.UserLoginData{}, err
+	}
+
+	if user.Role != entity.RoleAdmin {
+		return entity.UserLoginData{}, auth.ErrorAdminOnly
+	}
+
+	return user, nil
+}
+
+// formBool reads an optional boolean form field; nil means it was not sent.
+func formBool(ctx *fiber.Ctx, key string) (*bool, error) {
+	value := ctx.FormValue(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("%s must be true or false", key))
+	}
+	return &parsed, nil
+}
@@ -28,9 +28,33 @@ func (h *AuthHandler) Start(srv fiber.Router) {
 	users.Post("/otp", h.RequestOTP)
 	users.Post("/", h.CreateUser)
 	users.Post("/login", h.Login)
+	users.Get("/me/slug", h.middleware.NewTokenMiddleware, h.GetUserSlug)
+	users.Put("/me/slug", h.middleware.NewTokenMiddleware, h.UpdateUserSlug)
 	users.Put("/:id", h.middleware.NewTokenMiddleware, h.UpdateUser)
 
 	companies := srv.Group("/companies")
+	companies.Get("/invitations/:token", h.GetCompanyInvitation)
+	companies.Post("/invitations/:token/accept", h.AcceptCompanyInvitation)
+	companies.Post("/me/members/invitations", h.middleware.NewTokenMiddleware, h.InviteCompanyMember)
+	companies.Get("/me/members/invitations", h.middleware.NewTokenMiddleware, h.GetCompanyInvitations)
+	companies.Delete("/me/members/invitations/:id", h.middleware.NewTokenMiddleware, h.RevokeCompanyInvitation)
+	companies.Get("/me/members", h.middleware.NewTokenMiddleware, h.GetCompanyMembers)
+	companies.Put("/me/members/:id", h.middleware.NewTokenMiddleware, h.UpdateCompanyMemberRole)
+	companies.Delete("/me/members/:id", h.middleware.NewTokenMiddleware, h.RemoveCompanyMember)
+	companies.Post("/me/verification", h.middleware.NewTokenMiddleware, h.SubmitCompanyVerification)
+	companies.Get("/me/verification", h.middleware.NewTokenMiddleware, h.GetCompanyVerificationStatus)
+	companies.Post("/me/verification/check", h.middleware.NewTokenMiddleware, h.CheckCompanyVerification)
+	companies.Get("/me/slug", h.middleware.NewTokenMiddleware, h.GetCompanySlug)
+	companies.Put("/me/slug", h.middleware.NewTokenMiddleware, h.UpdateCompanySlug)
+	companies.Get("/:id", h.GetCompany)
 	companies.Put("/:id", h.middleware.NewTokenMiddleware, h.UpdateCompany)
 
+	profiles := srv.Group("/profiles")
+	profiles.Get("/:slug", h.middleware.NewOptionalTokenMiddleware, h.ResolveProfileSlug)
+
+	verifications := srv.Group("/admin/company_verifications")
+	verifications.Get("/", h.middleware.NewTokenMiddleware, h.GetCompanyVerifications)
+	verifications.Post("/:id/approve", h.middleware.NewTokenMiddleware, h.ApproveCompanyVerification)
+	verifications.Post("/:id/reject", h.middleware.NewTokenMiddleware, h.RejectCompanyVerification)
+
 }
@@ -0,0 +1,236 @@
+package authHandler
+
+import (
+	"ProjectGolang/internal/api/auth"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *AuthHandler) InviteCompanyMember(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to invite company member")
+	}
+
+	var req auth.InviteCompanyMember
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse company invitation request")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for company invitation request")
+		return err
+	}
+
+	invitation, err := h.authService.InviteCompanyMember(c, user, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to invite company member")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(invitation)
+	}
+}
+
+func (h *AuthHandler) GetCompanyInvitations(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get company invitations")
+	}
+
+	invitations, err := h.authService.GetCompanyInvitations(c, user)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get company invitations")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(invitations)
+	}
+}
+
+func (h *AuthHandler) RevokeCompanyInvitation(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to revoke company invitation")
+	}
+
+	if err := h.authService.RevokeCompanyInvitation(c, user, ctx.Params("id")); err != nil {
+		return response.WriteError(ctx, err, "Failed to revoke company invitation")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *AuthHandler) GetCompanyInvitation(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	invitation, err := h.authService.GetCompanyInvitation(c, ctx.Params("token"))
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get company invitation")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(invitation)
+	}
+}
+
+func (h *AuthHandler) AcceptCompanyInvitation(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	var req auth.AcceptCompanyInvitation
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse invitation acceptance request")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for invitation acceptance request")
+		return err
+	}
+
+	if err := h.authService.AcceptCompanyInvitation(c, ctx.Params("token"), req); err != nil {
+		return response.WriteError(ctx, err, "Failed to accept company invitation")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusCreated)
+	}
+}
+
+func (h *AuthHandler) GetCompanyMembers(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get company members")
+	}
+
+	members, err := h.authService.GetCompanyMembers(c, user)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get company members")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(members)
+	}
+}
+
+func (h *AuthHandler) UpdateCompanyMemberRole(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update company member")
+	}
+
+	var req auth.UpdateCompanyMemberRole
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse company member update request")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for company member update request")
+		return err
+	}
+
+	if err := h.authService.UpdateCompanyMemberRole(c, user, ctx.Params("id"), req); err != nil {
+		return response.WriteError(ctx, err, "Failed to update company member")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
+
+func (h *AuthHandler) RemoveCompanyMember(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to remove company member")
+	}
+
+	if err := h.authService.RemoveCompanyMember(c, user, ctx.Params("id")); err != nil {
+		return response.WriteError(ctx, err, "Failed to remove company member")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
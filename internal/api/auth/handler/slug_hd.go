@@ -0,0 +1,126 @@
+package authHandler
+
+import (
+	"ProjectGolang/internal/api/auth"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"net/url"
+	"time"
+)
+
+func (h *AuthHandler) UpdateUserSlug(ctx *fiber.Ctx) error {
+	return h.updateProfileSlug(ctx, h.authService.UpdateUserSlug)
+}
+
+func (h *AuthHandler) UpdateCompanySlug(ctx *fiber.Ctx) error {
+	return h.updateProfileSlug(ctx, h.authService.UpdateCompanySlug)
+}
+
+func (h *AuthHandler) updateProfileSlug(ctx *fiber.Ctx,
+	update func(c context.Context, actor entity.UserLoginData, req auth.UpdateProfileSlug) (auth.ProfileSlugResponse, error)) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update profile slug")
+	}
+
+	var req auth.UpdateProfileSlug
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse profile slug request")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for profile slug request")
+		return err
+	}
+
+	slug, err := update(c, user, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update profile slug")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(slug)
+	}
+}
+
+func (h *AuthHandler) GetUserSlug(ctx *fiber.Ctx) error {
+	return h.getProfileSlug(ctx, h.authService.GetUserSlug)
+}
+
+func (h *AuthHandler) GetCompanySlug(ctx *fiber.Ctx) error {
+	return h.getProfileSlug(ctx, h.authService.GetCompanySlug)
+}
+
+func (h *AuthHandler) getProfileSlug(ctx *fiber.Ctx,
+	get func(c context.Context, actor entity.UserLoginData) (auth.ProfileSlugResponse, error)) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get profile slug")
+	}
+
+	slug, err := get(c, user)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get profile slug")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(slug)
+	}
+}
+
+// ResolveProfileSlug answers with the profile's share metadata, or a
+// permanent redirect when the slug is an owner's old one.
+func (h *AuthHandler) ResolveProfileSlug(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	// Anonymous requests are allowed and resolve as an empty viewer.
+	viewer, _ := jwtPkg.GetUserLoginData(ctx)
+
+	slug := ctx.Params("slug")
+	profile, err := h.authService.ResolveProfileSlug(c, viewer, slug)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to resolve profile")
+	}
+
+	if profile.Slug != slug {
+		return ctx.Redirect(fmt.Sprintf("/api/v1/profiles/%s", url.PathEscape(profile.Slug)), fiber.StatusMovedPermanently)
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(profile)
+	}
+}
@@ -0,0 +1,246 @@
+package authHandler
+
+import (
+	"ProjectGolang/internal/api/auth"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"mime/multipart"
+	"time"
+)
+
+func (h *AuthHandler) GetCompany(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	company, err := h.authService.GetCompany(c, ctx.Params("id"))
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get company")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(company)
+	}
+}
+
+func (h *AuthHandler) SubmitCompanyVerification(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	// Documents are uploaded to storage before the request is stored.
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 30*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to submit company verification")
+	}
+
+	var req auth.SubmitCompanyVerification
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse company verification request")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for company verification request")
+		return err
+	}
+
+	var documents []*multipart.FileHeader
+	if form, err := ctx.MultipartForm(); err == nil {
+		documents = form.File["documents"]
+	}
+
+	verification, err := h.authService.SubmitCompanyVerification(c, user, req, documents)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to submit company verification")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(verification)
+	}
+}
+
+func (h *AuthHandler) GetCompanyVerificationStatus(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get company verification")
+	}
+
+	status, err := h.authService.GetCompanyVerificationStatus(c, user)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get company verification")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(status)
+	}
+}
+
+func (h *AuthHandler) CheckCompanyVerification(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 10*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to check company verification")
+	}
+
+	verification, err := h.authService.CheckCompanyVerification(c, user)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to check company verification")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(verification)
+	}
+}
+
+func (h *AuthHandler) GetCompanyVerifications(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	if _, err := h.requireAdmin(ctx); err != nil {
+		return response.WriteError(ctx, err, "Failed to get company verifications")
+	}
+
+	req := auth.GetCompanyVerifications{Page: 1, PageSize: 20}
+	if err := ctx.QueryParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse company verifications query")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for company verifications query")
+		return err
+	}
+
+	verifications, err := h.authService.GetCompanyVerifications(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get company verifications")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(verifications)
+	}
+}
+
+func (h *AuthHandler) ApproveCompanyVerification(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireAdmin(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to approve company verification")
+	}
+
+	var req auth.ReviewCompanyVerification
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			h.log.WithFields(log.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Warn("Failed to parse company verification approval")
+			return err
+		}
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for company verification approval")
+		return err
+	}
+
+	if err := h.authService.ApproveCompanyVerification(c, user.ID, ctx.Params("id"), req); err != nil {
+		return response.WriteError(ctx, err, "Failed to approve company verification")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
+
+func (h *AuthHandler) RejectCompanyVerification(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireAdmin(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to reject company verification")
+	}
+
+	var req auth.RejectCompanyVerification
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse company verification rejection")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for company verification rejection")
+		return err
+	}
+
+	if err := h.authService.RejectCompanyVerification(c, user.ID, ctx.Params("id"), req); err != nil {
+		return response.WriteError(ctx, err, "Failed to reject company verification")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
@@ -48,7 +48,7 @@ func (r *companyRepository) CheckEmailExists(c context.Context, email string) (b
 
 	var exists bool
 	query := r.q.Rebind(queryCheckCompanyEmailExists)
-	err := r.q.QueryRowxContext(c, query, email).Scan(&exists)
+	err := r.q.QueryRowxContext(c, query, email, email).Scan(&exists)
 	if err != nil {
 		r.log.WithFields(logrus.Fields{
 			"error": err.Error(),
@@ -86,6 +86,8 @@ func (r *companyRepository) GetCompanyByEmail(c context.Context, email string) (
 		&res.RequiredSkill,
 		&res.Location,
 		&res.PhoneNumber,
+		&res.IsVerified,
+		&res.VerifiedAt,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 		&res.DeletedAt,
@@ -150,6 +152,8 @@ func (r *companyRepository) GetCompanyByID(c context.Context, id string) (entity
 		&company.RequiredSkill,
 		&company.Location,
 		&company.PhoneNumber,
+		&company.IsVerified,
+		&company.VerifiedAt,
 		&company.CreatedAt,
 		&company.UpdatedAt,
 		&company.DeletedAt,
@@ -317,6 +321,20 @@ func (r *companyRepository) HardDeleteExpiredCompanies(c context.Context, thresh
 	return nil
 }
 
+func (r *companyRepository) SetCompanyVerified(c context.Context, id string, verifiedAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(querySetCompanyVerified), verifiedAt, verifiedAt, id)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Database error when marking company verified")
+		return err
+	}
+
+	return nil
+}
+
 func (r *companyRepository) makeCompany(company auth.CompanyDB) entity.Company {
 	companyRes := entity.Company{
 		ID:              company.ID.String,
@@ -333,10 +351,15 @@ func (r *companyRepository) makeCompany(company auth.CompanyDB) entity.Company {
 		EstablishedDate: company.EstablishedDate.Time,
 		CompanyURL:      company.CompanyURL.String,
 		RequiredSkill:   company.RequiredSkill.String,
+		IsVerified:      company.IsVerified.Bool,
 		CreatedAt:       company.CreatedAt.Time,
 		UpdatedAt:       company.UpdatedAt.Time,
 	}
 
+	if company.VerifiedAt.Valid {
+		companyRes.VerifiedAt = &company.VerifiedAt.Time
+	}
+
 	if company.DeletedAt.Valid {
 		companyRes.DeletedAt = &company.DeletedAt.Time
 	} else {
@@ -0,0 +1,292 @@
+package authRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (r *companyMemberRepository) CreateCompanyMember(c context.Context, member entity.CompanyMember) error {
+	requestID := contextPkg.GetRequestID(c)
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateCompanyMember),
+		member.ID,
+		member.CompanyID,
+		member.Email,
+		member.Password,
+		member.Name,
+		member.Role,
+		member.CreatedAt,
+		member.UpdatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"company_id": member.CompanyID,
+		}).Error("Database error when creating company member")
+		return err
+	}
+
+	return nil
+}
+
+func (r *companyMemberRepository) GetCompanyMemberByID(c context.Context, id string) (entity.CompanyMember, error) {
+	return r.getCompanyMember(c, queryGetCompanyMemberByID, id)
+}
+
+// GetCompanyMemberByEmail finds the member logging in. Members of deleted
+// companies are reported missing.
+func (r *companyMemberRepository) GetCompanyMemberByEmail(c context.Context, email string) (entity.CompanyMember, error) {
+	return r.getCompanyMember(c, queryGetCompanyMemberByEmail, email)
+}
+
+func (r *companyMemberRepository) GetCompanyOwner(c context.Context, companyID string) (entity.CompanyMember, error) {
+	return r.getCompanyMember(c, queryGetCompanyOwner, companyID)
+}
+
+func (r *companyMemberRepository) getCompanyMember(c context.Context, query string, arg string) (entity.CompanyMember, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(query), arg)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Database error when getting company member")
+		return entity.CompanyMember{}, err
+	}
+	defer rows.Close()
+
+	members, err := scanCompanyMembers(rows, r.log)
+	if err != nil || len(members) == 0 {
+		return entity.CompanyMember{}, err
+	}
+
+	return members[0], nil
+}
+
+func (r *companyMemberRepository) GetCompanyMembersByCompanyID(c context.Context, companyID string) ([]entity.CompanyMember, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetCompanyMembersByCompanyID), companyID)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when getting company members")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCompanyMembers(rows, r.log)
+}
+
+func (r *companyMemberRepository) UpdateCompanyMemberRole(c context.Context, id string, role entity.CompanyRole, updatedAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryUpdateCompanyMemberRole), role, updatedAt, id)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"member_id":  id,
+		}).Error("Database error when updating company member role")
+		return err
+	}
+
+	return nil
+}
+
+func (r *companyMemberRepository) DeleteCompanyMember(c context.Context, id string) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryDeleteCompanyMember), id)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"member_id":  id,
+		}).Error("Database error when deleting company member")
+		return err
+	}
+
+	return nil
+}
+
+func scanCompanyMembers(rows *sql.Rows, log *logrus.Logger) ([]entity.CompanyMember, error) {
+	var members []entity.CompanyMember
+	for rows.Next() {
+		var (
+			member    entity.CompanyMember
+			updatedAt sql.NullTime
+		)
+		err := rows.Scan(&member.ID, &member.CompanyID, &member.Email, &member.Password, &member.Name,
+			&member.Role, &member.CreatedAt, &updatedAt, &member.CompanyName)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Error scanning company member row")
+			return nil, err
+		}
+		member.UpdatedAt = updatedAt.Time
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Error iterating through company member rows")
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (r *companyInvitationRepository) CreateCompanyInvitation(c context.Context, invitation entity.CompanyInvitation) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateCompanyInvitation),
+		invitation.ID,
+		invitation.CompanyID,
+		invitation.Email,
+		invitation.Role,
+		invitation.TokenHash,
+		sql.NullString{String: invitation.InvitedBy, Valid: invitation.InvitedBy != ""},
+		invitation.ExpiresAt,
+		invitation.CreatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"company_id": invitation.CompanyID,
+		}).Error("Database error when creating company invitation")
+		return err
+	}
+
+	return nil
+}
+
+func (r *companyInvitationRepository) GetCompanyInvitationByID(c context.Context, id string) (entity.CompanyInvitation, error) {
+	return r.getCompanyInvitation(c, queryGetCompanyInvitationByID, id)
+}
+
+func (r *companyInvitationRepository) GetCompanyInvitationByTokenHash(c context.Context, tokenHash string) (entity.CompanyInvitation, error) {
+	return r.getCompanyInvitation(c, queryGetCompanyInvitationByTokenHash, tokenHash)
+}
+
+func (r *companyInvitationRepository) getCompanyInvitation(c context.Context, query string, arg string) (entity.CompanyInvitation, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(query), arg)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Database error when getting company invitation")
+		return entity.CompanyInvitation{}, err
+	}
+	defer rows.Close()
+
+	invitations, err := scanCompanyInvitations(rows, r.log)
+	if err != nil || len(invitations) == 0 {
+		return entity.CompanyInvitation{}, err
+	}
+
+	return invitations[0], nil
+}
+
+func (r *companyInvitationRepository) GetPendingCompanyInvitations(c context.Context, companyID string, now time.Time) ([]entity.CompanyInvitation, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetPendingCompanyInvitations), companyID, now)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when getting company invitations")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCompanyInvitations(rows, r.log)
+}
+
+// AcceptCompanyInvitation reports whether the invitation was still open, so
+// two concurrent acceptances cannot both succeed.
+func (r *companyInvitationRepository) AcceptCompanyInvitation(c context.Context, id string, acceptedAt time.Time) (bool, error) {
+	result, err := r.q.ExecContext(c, r.q.Rebind(queryAcceptCompanyInvitation), acceptedAt, id)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id":    contextPkg.GetRequestID(c),
+			"error":         err.Error(),
+			"invitation_id": id,
+		}).Error("Database error when accepting company invitation")
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *companyInvitationRepository) RevokeCompanyInvitation(c context.Context, id string, revokedAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryRevokeCompanyInvitation), revokedAt, id)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id":    contextPkg.GetRequestID(c),
+			"error":         err.Error(),
+			"invitation_id": id,
+		}).Error("Database error when revoking company invitation")
+		return err
+	}
+
+	return nil
+}
+
+func (r *companyInvitationRepository) RevokePendingCompanyInvitations(c context.Context, companyID string, email string, revokedAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryRevokePendingCompanyInvitations), revokedAt, companyID, email)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when revoking pending company invitations")
+		return err
+	}
+
+	return nil
+}
+
+func scanCompanyInvitations(rows *sql.Rows, log *logrus.Logger) ([]entity.CompanyInvitation, error) {
+	var invitations []entity.CompanyInvitation
+	for rows.Next() {
+		var (
+			invitation entity.CompanyInvitation
+			invitedBy  sql.NullString
+			acceptedAt sql.NullTime
+			revokedAt  sql.NullTime
+		)
+		err := rows.Scan(&invitation.ID, &invitation.CompanyID, &invitation.Email, &invitation.Role,
+			&invitation.TokenHash, &invitedBy, &invitation.ExpiresAt, &acceptedAt, &revokedAt,
+			&invitation.CreatedAt, &invitation.CompanyName)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Error scanning company invitation row")
+			return nil, err
+		}
+		invitation.InvitedBy = invitedBy.String
+		if acceptedAt.Valid {
+			invitation.AcceptedAt = &acceptedAt.Time
+		}
+		if revokedAt.Valid {
+			invitation.RevokedAt = &revokedAt.Time
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Error iterating through company invitation rows")
+		return nil, err
+	}
+
+	return invitations, nil
+}
@@ -13,8 +13,9 @@ const (
     `
 
 	queryGetUserByID = `
-    SELECT id, email, password, name, role, profile_picture, 
-           is_premium, premium_until, headline, created_at, updated_at, deleted_at
+    SELECT id, email, password, name, role, phone_number, profile_picture, banner_picture,
+           is_premium, premium_until, headline, location, is_searchable, profile_visibility, hide_email,
+           hide_phone_number, created_at, updated_at, deleted_at
     FROM users
     WHERE id = ? AND deleted_at IS NULL
     `
@@ -31,6 +32,10 @@ const (
         premium_until = :premium_until,
         headline = :headline,
         location = :location,
+        is_searchable = :is_searchable,
+        profile_visibility = :profile_visibility,
+        hide_email = :hide_email,
+        hide_phone_number = :hide_phone_number,
         updated_at = :updated_at,
         phone_number = :phone_number,
     	deleted_at = :deleted_at
@@ -38,8 +43,9 @@ const (
     `
 
 	queryGetUserByEmail = `
-    SELECT id, email, password, name, role, profile_picture, 
-           is_premium, premium_until, headline, created_at, updated_at, deleted_at
+    SELECT id, email, password, name, role, phone_number, profile_picture, banner_picture,
+           is_premium, premium_until, headline, location, is_searchable, profile_visibility, hide_email,
+           hide_phone_number, created_at, updated_at, deleted_at
     FROM users
     WHERE email = ? AND deleted_at IS NULL
     `
@@ -66,13 +72,14 @@ const (
 
 	queryCheckCompanyEmailExists = `
     SELECT EXISTS (SELECT 1 FROM companies WHERE email = ? AND deleted_at IS NULL)
+        OR EXISTS (SELECT 1 FROM company_members WHERE email = ?)
     `
 
 	queryGetCompanyByID = `
    SELECT id, profile_picture, banner_picture, email, password, name, 
           about_us, industry_types, number_employees, established_date, 
           company_url, required_skill, location, phone_number,
-          created_at, updated_at, deleted_at
+          is_verified, verified_at, created_at, updated_at, deleted_at
    FROM companies
    WHERE id = ? AND deleted_at IS NULL
 `
@@ -101,9 +108,15 @@ const (
    SELECT id, email, password, name, profile_picture, banner_picture,
           about_us, industry_types, number_employees, established_date,
           company_url, required_skill, location, phone_number,
-          created_at, updated_at, deleted_at
+          is_verified, verified_at, created_at, updated_at, deleted_at
    FROM companies
    WHERE email = ? AND deleted_at IS NULL
+   `
+
+	querySetCompanyVerified = `
+   UPDATE companies
+   SET is_verified = TRUE, verified_at = ?, updated_at = ?
+   WHERE id = ?
    `
 
 	querySoftDeleteCompany = `
@@ -117,3 +130,162 @@ const (
    WHERE deleted_at IS NOT NULL AND deleted_at <= ?
    `
 )
+
+const (
+	queryCreateCompanyMember = `
+    INSERT INTO company_members (id, company_id, email, password, name, role, created_at, updated_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryCompanyMemberColumns = `
+    SELECT m.id, m.company_id, m.email, m.password, m.name, m.role, m.created_at, m.updated_at, c.name
+    FROM company_members m
+    JOIN companies c ON c.id = m.company_id AND c.deleted_at IS NULL
+    `
+
+	queryGetCompanyMemberByID = queryCompanyMemberColumns + `WHERE m.id = ?`
+
+	queryGetCompanyMemberByEmail = queryCompanyMemberColumns + `WHERE m.email = ?`
+
+	queryGetCompanyOwner = queryCompanyMemberColumns + `WHERE m.company_id = ? AND m.role = 'owner'`
+
+	queryGetCompanyMembersByCompanyID = queryCompanyMemberColumns + `WHERE m.company_id = ? ORDER BY m.created_at`
+
+	queryUpdateCompanyMemberRole = `
+    UPDATE company_members SET role = ?, updated_at = ? WHERE id = ?
+    `
+
+	queryDeleteCompanyMember = `
+    DELETE FROM company_members WHERE id = ?
+    `
+)
+
+const (
+	queryCreateCompanyInvitation = `
+    INSERT INTO company_invitations (id, company_id, email, role, token_hash, invited_by, expires_at, created_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryCompanyInvitationColumns = `
+    SELECT i.id, i.company_id, i.email, i.role, i.token_hash, i.invited_by, i.expires_at, i.accepted_at,
+           i.revoked_at, i.created_at, c.name
+    FROM company_invitations i
+    JOIN companies c ON c.id = i.company_id AND c.deleted_at IS NULL
+    `
+
+	queryGetCompanyInvitationByID = queryCompanyInvitationColumns + `WHERE i.id = ?`
+
+	queryGetCompanyInvitationByTokenHash = queryCompanyInvitationColumns + `WHERE i.token_hash = ?`
+
+	queryGetPendingCompanyInvitations = queryCompanyInvitationColumns + `
+    WHERE i.company_id = ? AND i.accepted_at IS NULL AND i.revoked_at IS NULL AND i.expires_at > ?
+    ORDER BY i.created_at DESC
+    `
+
+	queryAcceptCompanyInvitation = `
+    UPDATE company_invitations
+    SET accepted_at = ?
+    WHERE id = ? AND accepted_at IS NULL AND revoked_at IS NULL
+    `
+
+	queryRevokeCompanyInvitation = `
+    UPDATE company_invitations SET revoked_at = ? WHERE id = ? AND accepted_at IS NULL AND revoked_at IS NULL
+    `
+
+	queryRevokePendingCompanyInvitations = `
+    UPDATE company_invitations
+    SET revoked_at = ?
+    WHERE company_id = ? AND email = ? AND accepted_at IS NULL AND revoked_at IS NULL
+    `
+)
+
+const (
+	queryCreateCompanyVerification = `
+    INSERT INTO company_verifications (
+        id, company_id, method, domain, token, documents, status, domain_verified_at, submitted_by, created_at, updated_at
+    ) VALUES (?, ?, ?, NULLIF(?, ''), NULLIF(?, ''), ?, ?, ?, NULLIF(?, ''), ?, ?)
+    `
+
+	queryCompanyVerificationColumns = `
+    SELECT v.id, v.company_id, v.method, v.domain, v.token, v.documents, v.status, v.domain_verified_at,
+           v.submitted_by, v.reviewed_by, v.review_note, v.reviewed_at, v.created_at, v.updated_at,
+           c.name, c.email
+    FROM company_verifications v
+    JOIN companies c ON c.id = v.company_id AND c.deleted_at IS NULL
+    `
+
+	queryGetCompanyVerificationByID = queryCompanyVerificationColumns + `WHERE v.id = ?`
+
+	queryGetLatestCompanyVerification = queryCompanyVerificationColumns + `
+    WHERE v.company_id = ?
+    ORDER BY v.created_at DESC
+    LIMIT 1
+    `
+
+	queryGetCompanyVerificationsByStatus = queryCompanyVerificationColumns + `
+    WHERE v.status = ?
+    ORDER BY v.created_at
+    LIMIT ? OFFSET ?
+    `
+
+	queryCountCompanyVerificationsByStatus = `
+    SELECT COUNT(*)
+    FROM company_verifications v
+    JOIN companies c ON c.id = v.company_id AND c.deleted_at IS NULL
+    WHERE v.status = ?
+    `
+
+	queryCancelPendingCompanyVerification = `
+    UPDATE company_verifications
+    SET status = 'cancelled', updated_at = ?
+    WHERE company_id = ? AND status = 'pending'
+    `
+
+	queryMarkCompanyDomainVerified = `
+    UPDATE company_verifications
+    SET domain_verified_at = ?, updated_at = ?
+    WHERE id = ? AND status = 'pending'
+    `
+
+	queryReviewCompanyVerification = `
+    UPDATE company_verifications
+    SET status = ?, reviewed_by = ?, review_note = NULLIF(?, ''), reviewed_at = ?, updated_at = ?
+    WHERE id = ? AND status = 'pending'
+    `
+)
+
+const (
+	queryCreateProfileSlug = `
+    INSERT INTO profile_slugs (slug, owner_type, owner_id, is_current, claimed_at, created_at)
+    VALUES (?, ?, ?, TRUE, ?, ?)
+    ON CONFLICT (slug) DO NOTHING
+    `
+
+	queryProfileSlugColumns = `
+    SELECT slug, owner_type, owner_id, is_current, claimed_at, retired_at, created_at
+    FROM profile_slugs
+    `
+
+	queryGetProfileSlug = queryProfileSlugColumns + `WHERE slug = ?`
+
+	queryGetCurrentProfileSlug = queryProfileSlugColumns + `
+    WHERE owner_type = ? AND owner_id = ? AND is_current
+    `
+
+	queryGetProfileSlugHistory = queryProfileSlugColumns + `
+    WHERE owner_type = ? AND owner_id = ?
+    ORDER BY claimed_at DESC
+    `
+
+	queryRetireCurrentProfileSlug = `
+    UPDATE profile_slugs
+    SET is_current = FALSE, retired_at = ?
+    WHERE owner_type = ? AND owner_id = ? AND is_current
+    `
+
+	queryReactivateProfileSlug = `
+    UPDATE profile_slugs
+    SET is_current = TRUE, claimed_at = ?, retired_at = NULL
+    WHERE slug = ? AND owner_type = ? AND owner_id = ?
+    `
+)
@@ -50,8 +50,12 @@ func (r *repository) NewClient(tx bool) (Client, error) {
 	}
 
 	return Client{
-		User:    &userRepository{q: db, log: r.log},
-		Company: &companyRepository{q: db, log: r.log},
+		User:                &userRepository{q: db, log: r.log},
+		Company:             &companyRepository{q: db, log: r.log},
+		CompanyMember:       &companyMemberRepository{q: db, log: r.log},
+		CompanyInvitation:   &companyInvitationRepository{q: db, log: r.log},
+		CompanyVerification: &companyVerificationRepository{q: db, log: r.log},
+		ProfileSlug:         &profileSlugRepository{q: db, log: r.log},
 
 		Commit: func() error {
 			if tx {
@@ -87,6 +91,46 @@ type Client struct {
 		UpdateCompany(c context.Context, company entity.Company) error
 		SoftDeleteCompany(c context.Context, id string, deletedAt time.Time) error
 		HardDeleteExpiredCompanies(c context.Context, threshold time.Time) error
+		SetCompanyVerified(c context.Context, id string, verifiedAt time.Time) error
+	}
+
+	CompanyMember interface {
+		CreateCompanyMember(c context.Context, member entity.CompanyMember) error
+		GetCompanyMemberByID(c context.Context, id string) (entity.CompanyMember, error)
+		GetCompanyMemberByEmail(c context.Context, email string) (entity.CompanyMember, error)
+		GetCompanyOwner(c context.Context, companyID string) (entity.CompanyMember, error)
+		GetCompanyMembersByCompanyID(c context.Context, companyID string) ([]entity.CompanyMember, error)
+		UpdateCompanyMemberRole(c context.Context, id string, role entity.CompanyRole, updatedAt time.Time) error
+		DeleteCompanyMember(c context.Context, id string) error
+	}
+
+	CompanyInvitation interface {
+		CreateCompanyInvitation(c context.Context, invitation entity.CompanyInvitation) error
+		GetCompanyInvitationByID(c context.Context, id string) (entity.CompanyInvitation, error)
+		GetCompanyInvitationByTokenHash(c context.Context, tokenHash string) (entity.CompanyInvitation, error)
+		GetPendingCompanyInvitations(c context.Context, companyID string, now time.Time) ([]entity.CompanyInvitation, error)
+		AcceptCompanyInvitation(c context.Context, id string, acceptedAt time.Time) (bool, error)
+		RevokeCompanyInvitation(c context.Context, id string, revokedAt time.Time) error
+		RevokePendingCompanyInvitations(c context.Context, companyID string, email string, revokedAt time.Time) error
+	}
+
+	CompanyVerification interface {
+		CreateCompanyVerification(c context.Context, verification entity.CompanyVerification) error
+		GetCompanyVerificationByID(c context.Context, id string) (entity.CompanyVerification, error)
+		GetLatestCompanyVerification(c context.Context, companyID string) (entity.CompanyVerification, error)
+		GetCompanyVerificationsByStatus(c context.Context, status entity.VerificationStatus, limit, offset int) ([]entity.CompanyVerification, int, error)
+		CancelPendingCompanyVerification(c context.Context, companyID string, cancelledAt time.Time) error
+		MarkCompanyDomainVerified(c context.Context, id string, verifiedAt time.Time) error
+		ReviewCompanyVerification(c context.Context, verification entity.CompanyVerification) (bool, error)
+	}
+
+	ProfileSlug interface {
+		CreateProfileSlug(c context.Context, slug entity.ProfileSlug) (bool, error)
+		GetProfileSlug(c context.Context, slug string) (entity.ProfileSlug, error)
+		GetCurrentProfileSlug(c context.Context, ownerType entity.ProfileOwnerType, ownerID string) (entity.ProfileSlug, error)
+		GetProfileSlugHistory(c context.Context, ownerType entity.ProfileOwnerType, ownerID string) ([]entity.ProfileSlug, error)
+		RetireCurrentProfileSlug(c context.Context, ownerType entity.ProfileOwnerType, ownerID string, retiredAt time.Time) error
+		ReactivateProfileSlug(c context.Context, slug entity.ProfileSlug) error
 	}
 
 	Commit   func() error
@@ -102,3 +146,23 @@ type companyRepository struct {
 	q   sqlx.ExtContext
 	log *logrus.Logger
 }
+
+type companyMemberRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type companyInvitationRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type companyVerificationRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type profileSlugRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
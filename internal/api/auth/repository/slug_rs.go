@@ -0,0 +1,137 @@
+package authRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// CreateProfileSlug claims a new slug and reports whether it was still free,
+// so two owners racing for the same name cannot both get it.
+func (r *profileSlugRepository) CreateProfileSlug(c context.Context, slug entity.ProfileSlug) (bool, error) {
+	result, err := r.q.ExecContext(c, r.q.Rebind(queryCreateProfileSlug),
+		slug.Slug,
+		slug.OwnerType,
+		slug.OwnerID,
+		slug.ClaimedAt,
+		slug.CreatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"slug":       slug.Slug,
+		}).Error("Database error when creating profile slug")
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *profileSlugRepository) GetProfileSlug(c context.Context, slug string) (entity.ProfileSlug, error) {
+	slugs, err := r.getProfileSlugs(c, queryGetProfileSlug, slug)
+	if err != nil || len(slugs) == 0 {
+		return entity.ProfileSlug{}, err
+	}
+
+	return slugs[0], nil
+}
+
+func (r *profileSlugRepository) GetCurrentProfileSlug(c context.Context, ownerType entity.ProfileOwnerType, ownerID string) (entity.ProfileSlug, error) {
+	slugs, err := r.getProfileSlugs(c, queryGetCurrentProfileSlug, ownerType, ownerID)
+	if err != nil || len(slugs) == 0 {
+		return entity.ProfileSlug{}, err
+	}
+
+	return slugs[0], nil
+}
+
+func (r *profileSlugRepository) GetProfileSlugHistory(c context.Context, ownerType entity.ProfileOwnerType, ownerID string) ([]entity.ProfileSlug, error) {
+	return r.getProfileSlugs(c, queryGetProfileSlugHistory, ownerType, ownerID)
+}
+
+func (r *profileSlugRepository) getProfileSlugs(c context.Context, query string, args ...interface{}) ([]entity.ProfileSlug, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(query), args...)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Database error when getting profile slugs")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanProfileSlugs(rows, r.log)
+}
+
+func (r *profileSlugRepository) RetireCurrentProfileSlug(c context.Context, ownerType entity.ProfileOwnerType, ownerID string, retiredAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryRetireCurrentProfileSlug), retiredAt, ownerType, ownerID)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"owner_id":   ownerID,
+		}).Error("Database error when retiring profile slug")
+		return err
+	}
+
+	return nil
+}
+
+func (r *profileSlugRepository) ReactivateProfileSlug(c context.Context, slug entity.ProfileSlug) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryReactivateProfileSlug),
+		slug.ClaimedAt,
+		slug.Slug,
+		slug.OwnerType,
+		slug.OwnerID,
+	)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"slug":       slug.Slug,
+		}).Error("Database error when reactivating profile slug")
+		return err
+	}
+
+	return nil
+}
+
+func scanProfileSlugs(rows *sql.Rows, log *logrus.Logger) ([]entity.ProfileSlug, error) {
+	var slugs []entity.ProfileSlug
+	for rows.Next() {
+		var (
+			slug      entity.ProfileSlug
+			retiredAt sql.NullTime
+		)
+		err := rows.Scan(&slug.Slug, &slug.OwnerType, &slug.OwnerID, &slug.IsCurrent,
+			&slug.ClaimedAt, &retiredAt, &slug.CreatedAt)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Error scanning profile slug row")
+			return nil, err
+		}
+		if retiredAt.Valid {
+			slug.RetiredAt = &retiredAt.Time
+		}
+		slugs = append(slugs, slug)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Error iterating through profile slug rows")
+		return nil, err
+	}
+
+	return slugs, nil
+}
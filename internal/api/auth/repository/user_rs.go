@@ -76,14 +76,21 @@ func (r *userRepository) GetUserByEmail(c context.Context, email string) (entity
 		&res.Email,
 		&res.Password,
 		&res.Name,
-		&res.PhoneNumber,
 		&res.Role,
+		&res.PhoneNumber,
 		&res.ProfilePicture,
+		&res.BannerPicture,
 		&res.IsPremium,
 		&res.PremiumUntil,
 		&res.Headline,
+		&res.Location,
+		&res.IsSearchable,
+		&res.ProfileVisibility,
+		&res.HideEmail,
+		&res.HidePhoneNumber,
 		&res.CreatedAt,
 		&res.UpdatedAt,
+		&res.DeletedAt,
 	)
 
 	if err != nil {
@@ -136,10 +143,17 @@ func (r *userRepository) GetUserByID(c context.Context, id string) (entity.User,
 		&user.Password,
 		&user.Name,
 		&user.Role,
+		&user.PhoneNumber,
 		&user.ProfilePicture,
+		&user.BannerPicture,
 		&user.IsPremium,
 		&user.PremiumUntil,
 		&user.Headline,
+		&user.Location,
+		&user.IsSearchable,
+		&user.ProfileVisibility,
+		&user.HideEmail,
+		&user.HidePhoneNumber,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.DeletedAt,
@@ -313,12 +327,19 @@ func (r *userRepository) makeUser(user auth.UserDB) entity.User {
 		Name:           user.Name.String,
 		Role:           entity.UserRole(user.Role.String),
 		ProfilePicture: user.ProfilePicture.String,
+		BannerPicture:  user.BannerPicture.String,
+		PhoneNumber:    user.PhoneNumber.String,
 		IsPremium:      user.IsPremium.Bool,
 		PremiumUntil:   user.PremiumUntil.Time,
 		Headline:       user.Headline.String,
 		CreatedAt:      user.CreatedAt.Time,
 		UpdatedAt:      user.UpdatedAt.Time,
 		Location:       user.Location.String,
+		IsSearchable:   user.IsSearchable.Bool,
+
+		ProfileVisibility: entity.ProfileVisibility(user.ProfileVisibility.String),
+		HideEmail:         user.HideEmail.Bool,
+		HidePhoneNumber:   user.HidePhoneNumber.Bool,
 	}
 
 	if user.DeletedAt.Valid {
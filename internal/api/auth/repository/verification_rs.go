@@ -0,0 +1,207 @@
+package authRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"database/sql"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (r *companyVerificationRepository) CreateCompanyVerification(c context.Context, verification entity.CompanyVerification) error {
+	var domainVerifiedAt sql.NullTime
+	if verification.DomainVerifiedAt != nil {
+		domainVerifiedAt = sql.NullTime{Time: *verification.DomainVerifiedAt, Valid: true}
+	}
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateCompanyVerification),
+		verification.ID,
+		verification.CompanyID,
+		verification.Method,
+		verification.Domain,
+		verification.Token,
+		pq.Array(verification.Documents),
+		verification.Status,
+		domainVerifiedAt,
+		verification.SubmittedBy,
+		verification.CreatedAt,
+		verification.UpdatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"company_id": verification.CompanyID,
+		}).Error("Database error when creating company verification")
+		return err
+	}
+
+	return nil
+}
+
+func (r *companyVerificationRepository) GetCompanyVerificationByID(c context.Context, id string) (entity.CompanyVerification, error) {
+	return r.getCompanyVerification(c, queryGetCompanyVerificationByID, id)
+}
+
+func (r *companyVerificationRepository) GetLatestCompanyVerification(c context.Context, companyID string) (entity.CompanyVerification, error) {
+	return r.getCompanyVerification(c, queryGetLatestCompanyVerification, companyID)
+}
+
+func (r *companyVerificationRepository) getCompanyVerification(c context.Context, query string, arg string) (entity.CompanyVerification, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(query), arg)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Database error when getting company verification")
+		return entity.CompanyVerification{}, err
+	}
+	defer rows.Close()
+
+	verifications, err := scanCompanyVerifications(rows, r.log)
+	if err != nil || len(verifications) == 0 {
+		return entity.CompanyVerification{}, err
+	}
+
+	return verifications[0], nil
+}
+
+func (r *companyVerificationRepository) GetCompanyVerificationsByStatus(c context.Context, status entity.VerificationStatus, limit, offset int) ([]entity.CompanyVerification, int, error) {
+	var total int
+	if err := r.q.QueryRowxContext(c, r.q.Rebind(queryCountCompanyVerificationsByStatus), status).Scan(&total); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Database error when counting company verifications")
+		return nil, 0, err
+	}
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetCompanyVerificationsByStatus), status, limit, offset)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Database error when getting company verifications")
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	verifications, err := scanCompanyVerifications(rows, r.log)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return verifications, total, nil
+}
+
+func (r *companyVerificationRepository) CancelPendingCompanyVerification(c context.Context, companyID string, cancelledAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCancelPendingCompanyVerification), cancelledAt, companyID)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when cancelling company verification")
+		return err
+	}
+
+	return nil
+}
+
+func (r *companyVerificationRepository) MarkCompanyDomainVerified(c context.Context, id string, verifiedAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryMarkCompanyDomainVerified), verifiedAt, verifiedAt, id)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id":      contextPkg.GetRequestID(c),
+			"error":           err.Error(),
+			"verification_id": id,
+		}).Error("Database error when marking company domain verified")
+		return err
+	}
+
+	return nil
+}
+
+// ReviewCompanyVerification records an admin decision and reports whether
+// the request was still pending, so two reviewers cannot both decide it.
+func (r *companyVerificationRepository) ReviewCompanyVerification(c context.Context, verification entity.CompanyVerification) (bool, error) {
+	var reviewedAt time.Time
+	if verification.ReviewedAt != nil {
+		reviewedAt = *verification.ReviewedAt
+	}
+
+	result, err := r.q.ExecContext(c, r.q.Rebind(queryReviewCompanyVerification),
+		verification.Status,
+		verification.ReviewedBy,
+		verification.ReviewNote,
+		reviewedAt,
+		verification.UpdatedAt,
+		verification.ID,
+	)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id":      contextPkg.GetRequestID(c),
+			"error":           err.Error(),
+			"verification_id": verification.ID,
+		}).Error("Database error when reviewing company verification")
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func scanCompanyVerifications(rows *sql.Rows, log *logrus.Logger) ([]entity.CompanyVerification, error) {
+	var verifications []entity.CompanyVerification
+	for rows.Next() {
+		var (
+			verification     entity.CompanyVerification
+			domain           sql.NullString
+			token            sql.NullString
+			domainVerifiedAt sql.NullTime
+			submittedBy      sql.NullString
+			reviewedBy       sql.NullString
+			reviewNote       sql.NullString
+			reviewedAt       sql.NullTime
+			updatedAt        sql.NullTime
+		)
+		err := rows.Scan(&verification.ID, &verification.CompanyID, &verification.Method, &domain, &token,
+			pq.Array(&verification.Documents), &verification.Status, &domainVerifiedAt, &submittedBy,
+			&reviewedBy, &reviewNote, &reviewedAt, &verification.CreatedAt, &updatedAt,
+			&verification.CompanyName, &verification.CompanyEmail)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Error scanning company verification row")
+			return nil, err
+		}
+		verification.Domain = domain.String
+		verification.Token = token.String
+		verification.SubmittedBy = submittedBy.String
+		verification.ReviewedBy = reviewedBy.String
+		verification.ReviewNote = reviewNote.String
+		verification.UpdatedAt = updatedAt.Time
+		if domainVerifiedAt.Valid {
+			verification.DomainVerifiedAt = &domainVerifiedAt.Time
+		}
+		if reviewedAt.Valid {
+			verification.ReviewedAt = &reviewedAt.Time
+		}
+		verifications = append(verifications, verification)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Error iterating through company verification rows")
+		return nil, err
+	}
+
+	return verifications, nil
+}
@@ -6,6 +6,7 @@ import (
 	"ProjectGolang/pkg/bcrypt"
 	contextPkg "ProjectGolang/pkg/context"
 	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/utils"
 	"context"
 	"github.com/sirupsen/logrus"
 	"mime/multipart"
@@ -146,7 +147,7 @@ func (s *authService) CreateUser(c context.Context, req auth.CreateUser) error {
 	}
 
 	now := time.Now()
-	id, err := NewUlidFromTimestamp(now)
+	id, err := utils.NewUlidFromTimestamp(now)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -193,7 +194,6 @@ func (s *authService) Login(c context.Context, req auth.LoginRequest) (auth.Logi
 		return auth.LoginResponse{}, err
 	}
 
-	var foundComp entity.Company
 	foundUser, err := repo.User.GetUserByEmail(c, req.Email)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -204,35 +204,35 @@ func (s *authService) Login(c context.Context, req auth.LoginRequest) (auth.Logi
 		return auth.LoginResponse{}, err
 	}
 
-	if foundUser.ID == "" {
-		foundComp, err = repo.Company.GetCompanyByEmail(c, req.Email)
-		if err != nil {
+	var userData map[string]interface{}
+	if foundUser.ID != "" {
+		err = bcrypt.ComparePassword(foundUser.Password, req.Password)
+		userData = makeUserData(foundUser)
+	} else {
+		// Recruiters log in as a member of their company; the owner's
+		// credentials mirror the company's own.
+		member, memberErr := repo.CompanyMember.GetCompanyMemberByEmail(c, req.Email)
+		if memberErr != nil {
 			s.log.WithFields(logrus.Fields{
 				"request_id": requestID,
-				"error":      err.Error(),
+				"error":      memberErr.Error(),
 				"email":      req.Email,
-			}).Error("Failed to get company by email")
-			return auth.LoginResponse{}, err
+			}).Error("Failed to get company member by email")
+			return auth.LoginResponse{}, memberErr
 		}
 
-		if foundComp.ID == "" {
+		if member.ID == "" {
 			s.log.WithFields(logrus.Fields{
 				"request_id": requestID,
 				"email":      req.Email,
 			}).Warn("User not found")
 			return auth.LoginResponse{}, auth.ErrorInvalidCredentials
 		}
-	}
 
-	if foundUser.ID == "" {
-		foundUser.ID = foundComp.ID
-		foundUser.Name = foundComp.Name
-		foundUser.Role = "recruiter"
-		foundUser.IsPremium = false
-		foundUser.Password = foundComp.Password
+		err = bcrypt.ComparePassword(member.Password, req.Password)
+		userData = makeMemberData(member)
 	}
 
-	err = bcrypt.ComparePassword(foundUser.Password, req.Password)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -242,7 +242,6 @@ func (s *authService) Login(c context.Context, req auth.LoginRequest) (auth.Logi
 		return auth.LoginResponse{}, auth.ErrorInvalidCredentials
 	}
 
-	userData := makeUserData(foundUser)
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"user_id":    userData["id"],
@@ -268,9 +267,9 @@ func (s *authService) Login(c context.Context, req auth.LoginRequest) (auth.Logi
 
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
-		"id":         foundUser.ID,
-		"email":      foundUser.Email,
-		"name":       foundUser.Name,
+		"id":         userData["id"],
+		"email":      userData["email"],
+		"name":       userData["name"],
 	}).Info("User logged in successfully")
 
 	return loginResponse, nil
@@ -350,6 +349,9 @@ func (s *authService) UpdateUser(c context.Context, req auth.UpdateUser, id stri
 		return err
 	}
 
+	s.invalidateRecommendedJobs(c, updatedUser.ID)
+	s.invalidateProfile(c, updatedUser.ID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"id":         updatedUser.ID,
@@ -396,6 +398,8 @@ func (s *authService) DeleteUser(c context.Context, id string) error {
 		return err
 	}
 
+	s.invalidateProfile(c, id)
+
 	s.log.WithFields(logrus.Fields{
 		"id":    id,
 		"email": existingUser.Email,
@@ -430,6 +434,22 @@ func (s *authService) updateUserChanges(existingUser entity.User, req auth.Updat
 		updatedUser.Headline = req.Headline
 	}
 
+	if req.IsSearchable != nil {
+		updatedUser.IsSearchable = *req.IsSearchable
+	}
+
+	if req.ProfileVisibility != "" {
+		updatedUser.ProfileVisibility = req.ProfileVisibility
+	}
+
+	if req.HideEmail != nil {
+		updatedUser.HideEmail = *req.HideEmail
+	}
+
+	if req.HidePhoneNumber != nil {
+		updatedUser.HidePhoneNumber = *req.HidePhoneNumber
+	}
+
 	updatedUser.UpdatedAt = time.Now()
 
 	return updatedUser, nil
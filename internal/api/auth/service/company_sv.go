@@ -5,6 +5,7 @@ import (
 	"ProjectGolang/internal/entity"
 	"ProjectGolang/pkg/bcrypt"
 	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/utils"
 	"fmt"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -15,7 +16,7 @@ import (
 func (s *authService) CreateCompany(c context.Context, req auth.CreateUser) error {
 	requestID := contextPkg.GetRequestID(c)
 
-	repo, err := s.authrepository.NewClient(false)
+	repo, err := s.authrepository.NewClient(true)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -23,6 +24,7 @@ func (s *authService) CreateCompany(c context.Context, req auth.CreateUser) erro
 		}).Error("Failed to create repository client")
 		return err
 	}
+	defer repo.Rollback()
 
 	code, err := s.redis.GetOTP(c, req.Email)
 	if err != nil {
@@ -52,7 +54,7 @@ func (s *authService) CreateCompany(c context.Context, req auth.CreateUser) erro
 	}
 
 	now := time.Now()
-	id, err := NewUlidFromTimestamp(now)
+	id, err := utils.NewUlidFromTimestamp(now)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -75,6 +77,38 @@ func (s *authService) CreateCompany(c context.Context, req auth.CreateUser) erro
 		return err
 	}
 
+	memberID, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to generate ULID")
+		return err
+	}
+
+	owner := entity.CompanyMember{
+		ID:        memberID,
+		CompanyID: newCompany.ID,
+		Email:     newCompany.Email,
+		Password:  hashedPassword,
+		Name:      newCompany.Name,
+		Role:      entity.CompanyRoleOwner,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := repo.CompanyMember.CreateCompanyMember(c, owner); err != nil {
+		return err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to commit company creation")
+		return err
+	}
+
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"id":         newCompany.ID,
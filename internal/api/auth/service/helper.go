@@ -1,24 +1,67 @@
 package authService
 
 import (
+	"ProjectGolang/internal/api/auth"
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/api/recruitment"
 	"ProjectGolang/internal/entity"
+	"context"
 	"crypto/rand"
 	"fmt"
-	"github.com/oklog/ulid/v2"
+	"github.com/sirupsen/logrus"
 	"math/big"
-	"time"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 )
 
-func NewUlidFromTimestamp(time time.Time) (string, error) {
-	ms := ulid.Timestamp(time)
-	entropy := ulid.Monotonic(rand.Reader, 0)
+const verificationRecordPrefix = "projectgolang-verification="
 
-	id, err := ulid.New(ms, entropy)
-	if err != nil {
-		return "", err
-	}
+// ogDescriptionLimit keeps share previews within what most sites display.
+const ogDescriptionLimit = 200
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// reservedSlugs would clash with app routes or impersonate the platform.
+var reservedSlugs = map[string]bool{
+	"about": true, "admin": true, "administrator": true, "api": true, "app": true,
+	"assets": true, "auth": true, "blog": true, "careers": true, "companies": true,
+	"company": true, "dashboard": true, "edit": true, "help": true, "jobs": true,
+	"login": true, "logout": true, "me": true, "messages": true, "new": true,
+	"notifications": true, "null": true, "privacy": true, "profile": true, "profiles": true,
+	"projectgolang": true, "register": true, "root": true, "search": true, "settings": true,
+	"signup": true, "static": true, "support": true, "system": true, "terms": true,
+	"undefined": true, "user": true, "users": true, "vacancies": true, "www": true,
+}
 
-	return id.String(), nil
+// freeEmailDomains can't prove a company owns its domain, since anyone can
+// register an address on them.
+var freeEmailDomains = map[string]bool{
+	"gmail.com":      true,
+	"googlemail.com": true,
+	"yahoo.com":      true,
+	"yahoo.co.id":    true,
+	"outlook.com":    true,
+	"hotmail.com":    true,
+	"live.com":       true,
+	"icloud.com":     true,
+	"me.com":         true,
+	"aol.com":        true,
+	"proton.me":      true,
+	"protonmail.com": true,
+	"gmx.com":        true,
+	"mail.com":       true,
+	"yandex.com":     true,
+	"zoho.com":       true,
+}
+
+var verificationDocumentExtensions = map[string]bool{
+	".pdf":  true,
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
 }
 
 //
@@ -52,6 +95,21 @@ func makeUserData(user entity.User) map[string]interface{} {
 	}
 }
 
+// makeMemberData builds the token claims for a company member. The id claim
+// stays the company's ID so every company-scoped check keeps working.
+func makeMemberData(member entity.CompanyMember) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           member.CompanyID,
+		"email":        member.Email,
+		"name":         member.CompanyName,
+		"role":         entity.RoleRecruiter,
+		"is_premium":   false,
+		"company_id":   member.CompanyID,
+		"member_id":    member.ID,
+		"company_role": member.Role,
+	}
+}
+
 func generateOTP(length int) (string, error) {
 	if length <= 0 {
 		return "", fmt.Errorf("OTP length must be greater than 0")
@@ -71,3 +129,149 @@ func generateOTP(length int) (string, error) {
 
 	return otp, nil
 }
+
+// invalidateRecommendedJobs drops the user's cached job feed so the next
+// request is ranked against the updated headline and location.
+func (s *authService) invalidateRecommendedJobs(c context.Context, userID string) {
+	if err := s.redis.DeleteCache(c, recruitment.RecommendedJobsCacheKey(userID)); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Warn("Failed to invalidate recommended jobs cache")
+	}
+}
+
+// invalidateProfile drops the user's cached aggregated profile, which embeds
+// the name, headline and pictures changed here. Stored résumés are keyed by
+// content, so they go stale on their own.
+func (s *authService) invalidateProfile(c context.Context, userID string) {
+	if err := s.redis.DeleteCache(c, bio.ProfileCacheKey(userID)); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Warn("Failed to invalidate profile cache")
+	}
+}
+
+// requireCompanyManager rejects anyone but a company's owner or admins.
+// Tokens without a company role, such as API keys, are rejected too.
+func requireCompanyManager(actor entity.UserLoginData) error {
+	if actor.Role != entity.RoleRecruiter {
+		return auth.ErrorCompanyManagerOnly
+	}
+	if actor.CompanyRole != entity.CompanyRoleOwner && actor.CompanyRole != entity.CompanyRoleAdmin {
+		return auth.ErrorCompanyManagerOnly
+	}
+	return nil
+}
+
+// canAssignCompanyRole reports whether a manager may hand out or take away
+// the given role. Nobody assigns ownership; admins only manage recruiters
+// and viewers.
+func canAssignCompanyRole(manager entity.CompanyRole, role entity.CompanyRole) bool {
+	switch role {
+	case entity.CompanyRoleOwner:
+		return false
+	case entity.CompanyRoleAdmin:
+		return manager == entity.CompanyRoleOwner
+	default:
+		return manager == entity.CompanyRoleOwner || manager == entity.CompanyRoleAdmin
+	}
+}
+
+func makeCompanyInvitationResponse(invitation entity.CompanyInvitation) auth.CompanyInvitationResponse {
+	return auth.CompanyInvitationResponse{
+		ID:          invitation.ID,
+		CompanyID:   invitation.CompanyID,
+		CompanyName: invitation.CompanyName,
+		Email:       invitation.Email,
+		Role:        invitation.Role,
+		ExpiresAt:   invitation.ExpiresAt,
+		CreatedAt:   invitation.CreatedAt,
+	}
+}
+
+func verificationRecordValue(token string) string {
+	return verificationRecordPrefix + token
+}
+
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
+
+func isFreeEmailDomain(domain string) bool {
+	return freeEmailDomains[strings.ToLower(domain)]
+}
+
+func isVerificationDocument(file *multipart.FileHeader) bool {
+	return verificationDocumentExtensions[strings.ToLower(filepath.Ext(file.Filename))]
+}
+
+func makeCompanyVerificationResponse(verification entity.CompanyVerification) auth.CompanyVerificationResponse {
+	response := auth.CompanyVerificationResponse{
+		ID:             verification.ID,
+		CompanyID:      verification.CompanyID,
+		CompanyName:    verification.CompanyName,
+		Method:         verification.Method,
+		Domain:         verification.Domain,
+		Documents:      verification.Documents,
+		Status:         verification.Status,
+		DomainVerified: verification.DomainVerifiedAt != nil,
+		ReviewNote:     verification.ReviewNote,
+		ReviewedAt:     verification.ReviewedAt,
+		CreatedAt:      verification.CreatedAt,
+	}
+
+	if verification.Method == entity.VerificationMethodDNS && verification.Status == entity.VerificationStatusPending {
+		response.DNSRecord = &auth.VerificationDNSRecord{
+			Type:  "TXT",
+			Name:  verification.Domain,
+			Value: verificationRecordValue(verification.Token),
+		}
+	}
+
+	return response
+}
+
+func normalizeSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+func isValidSlug(slug string) bool {
+	return len(slug) >= 3 && len(slug) <= 40 && slugPattern.MatchString(slug)
+}
+
+func isReservedSlug(slug string) bool {
+	return reservedSlugs[slug]
+}
+
+func profileURL(slug string) string {
+	return fmt.Sprintf("%s/api/v1/profiles/%s", strings.TrimRight(os.Getenv("APP_URL"), "/"), slug)
+}
+
+func truncateRunes(value string, limit int) string {
+	runes := []rune(strings.TrimSpace(value))
+	if len(runes) <= limit {
+		return string(runes)
+	}
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
+
+func makeOpenGraphMetadata(profile auth.ResolvedProfileResponse) auth.OpenGraphMetadata {
+	ogType := "profile"
+	if profile.OwnerType == entity.ProfileOwnerCompany {
+		ogType = "website"
+	}
+
+	return auth.OpenGraphMetadata{
+		Title:       profile.Name,
+		Description: truncateRunes(profile.Headline, ogDescriptionLimit),
+		Image:       profile.Avatar,
+		URL:         profile.URL,
+		Type:        ogType,
+	}
+}
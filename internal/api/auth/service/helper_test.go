@@ -0,0 +1,79 @@
+package authService
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeSlug(t *testing.T) {
+	tests := []struct {
+		slug string
+		want string
+	}{
+		{slug: "jane-doe", want: "jane-doe"},
+		{slug: "  Jane-Doe ", want: "jane-doe"},
+		{slug: "ACME", want: "acme"},
+		{slug: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			if got := normalizeSlug(tt.slug); got != tt.want {
+				t.Errorf("normalizeSlug(%q) = %q, want %q", tt.slug, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		slug string
+		want bool
+	}{
+		{name: "letters", slug: "jane", want: true},
+		{name: "hyphenated", slug: "jane-doe-2", want: true},
+		{name: "digits", slug: "123", want: true},
+		{name: "shortest", slug: "abc", want: true},
+		{name: "longest", slug: strings.Repeat("a", 40), want: true},
+		{name: "too short", slug: "ab", want: false},
+		{name: "too long", slug: strings.Repeat("a", 41), want: false},
+		{name: "uppercase", slug: "Jane", want: false},
+		{name: "leading hyphen", slug: "-jane", want: false},
+		{name: "trailing hyphen", slug: "jane-", want: false},
+		{name: "double hyphen", slug: "jane--doe", want: false},
+		{name: "underscore", slug: "jane_doe", want: false},
+		{name: "space", slug: "jane doe", want: false},
+		{name: "non-ascii", slug: "jané", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isValidSlug(tt.slug); got != tt.want {
+				t.Errorf("isValidSlug(%q) = %v, want %v", tt.slug, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsReservedSlug(t *testing.T) {
+	tests := []struct {
+		slug string
+		want bool
+	}{
+		{slug: "admin", want: true},
+		{slug: "api", want: true},
+		{slug: "me", want: true},
+		{slug: "projectgolang", want: true},
+		{slug: "jane-doe", want: false},
+		{slug: "admins", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			if got := isReservedSlug(tt.slug); got != tt.want {
+				t.Errorf("isReservedSlug(%q) = %v, want %v", tt.slug, got, tt.want)
+			}
+		})
+	}
+}
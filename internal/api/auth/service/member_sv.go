@@ -0,0 +1,482 @@
+package authService
+
+import (
+	"ProjectGolang/internal/api/auth"
+	authRepository "ProjectGolang/internal/api/auth/repository"
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/bcrypt"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/smtp"
+	"ProjectGolang/pkg/utils"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"html"
+	"os"
+	"strings"
+	"time"
+)
+
+const companyInvitationTTL = 7 * 24 * time.Hour
+
+func (s *authService) InviteCompanyMember(c context.Context, actor entity.UserLoginData, req auth.InviteCompanyMember) (auth.CompanyInvitationResponse, error) {
+	requestID := contextPkg.GetRequestID(c)
+
+	if err := requireCompanyManager(actor); err != nil {
+		return auth.CompanyInvitationResponse{}, err
+	}
+	if !canAssignCompanyRole(actor.CompanyRole, req.Role) {
+		return auth.CompanyInvitationResponse{}, auth.ErrorCompanyRoleNotAllowed
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+
+	repo, err := s.authrepository.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.CompanyInvitationResponse{}, err
+	}
+	defer repo.Rollback()
+
+	if exists, err := s.emailTaken(c, repo, email); err != nil {
+		return auth.CompanyInvitationResponse{}, err
+	} else if exists {
+		return auth.CompanyInvitationResponse{}, auth.ErrorInvitationEmailConflict
+	}
+
+	company, err := repo.Company.GetCompanyByID(c, actor.ID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"company_id": actor.ID,
+		}).Error("Failed to get company for invitation")
+		return auth.CompanyInvitationResponse{}, err
+	}
+
+	now := time.Now()
+	if err := repo.CompanyInvitation.RevokePendingCompanyInvitations(c, actor.ID, email, now); err != nil {
+		return auth.CompanyInvitationResponse{}, err
+	}
+
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to generate ULID")
+		return auth.CompanyInvitationResponse{}, err
+	}
+
+	token, err := utils.GenerateToken(32)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to generate invitation token")
+		return auth.CompanyInvitationResponse{}, err
+	}
+
+	invitation := entity.CompanyInvitation{
+		ID:          id,
+		CompanyID:   actor.ID,
+		Email:       email,
+		Role:        req.Role,
+		TokenHash:   utils.HashToken(token),
+		InvitedBy:   actor.MemberID,
+		ExpiresAt:   now.Add(companyInvitationTTL),
+		CreatedAt:   now,
+		CompanyName: company.Name,
+	}
+
+	if err := repo.CompanyInvitation.CreateCompanyInvitation(c, invitation); err != nil {
+		return auth.CompanyInvitationResponse{}, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to commit company invitation")
+		return auth.CompanyInvitationResponse{}, err
+	}
+
+	s.sendInvitationMail(c, actor, invitation, token)
+
+	s.log.WithFields(logrus.Fields{
+		"request_id":    requestID,
+		"company_id":    actor.ID,
+		"invitation_id": invitation.ID,
+		"role":          invitation.Role,
+	}).Info("Company member invited")
+
+	return makeCompanyInvitationResponse(invitation), nil
+}
+
+func (s *authService) GetCompanyInvitations(c context.Context, actor entity.UserLoginData) ([]auth.CompanyInvitationResponse, error) {
+	if err := requireCompanyManager(actor); err != nil {
+		return nil, err
+	}
+
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	invitations, err := repo.CompanyInvitation.GetPendingCompanyInvitations(c, actor.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]auth.CompanyInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		responses = append(responses, makeCompanyInvitationResponse(invitation))
+	}
+
+	return responses, nil
+}
+
+func (s *authService) RevokeCompanyInvitation(c context.Context, actor entity.UserLoginData, id string) error {
+	requestID := contextPkg.GetRequestID(c)
+
+	if err := requireCompanyManager(actor); err != nil {
+		return err
+	}
+
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	invitation, err := repo.CompanyInvitation.GetCompanyInvitationByID(c, id)
+	if err != nil {
+		return err
+	}
+	if invitation.ID == "" || invitation.CompanyID != actor.ID {
+		return auth.ErrorInvitationNotFound
+	}
+	if invitation.AcceptedAt != nil || invitation.RevokedAt != nil {
+		return auth.ErrorInvitationNotPending
+	}
+
+	if err := repo.CompanyInvitation.RevokeCompanyInvitation(c, id, time.Now()); err != nil {
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"request_id":    requestID,
+		"company_id":    actor.ID,
+		"invitation_id": id,
+	}).Info("Company invitation revoked")
+
+	return nil
+}
+
+// GetCompanyInvitation previews an invitation by its emailed token so the
+// invitee can see which company and role they are joining before accepting.
+func (s *authService) GetCompanyInvitation(c context.Context, token string) (auth.CompanyInvitationResponse, error) {
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.CompanyInvitationResponse{}, err
+	}
+
+	invitation, err := s.getPendingInvitation(c, repo.CompanyInvitation, token)
+	if err != nil {
+		return auth.CompanyInvitationResponse{}, err
+	}
+
+	return makeCompanyInvitationResponse(invitation), nil
+}
+
+func (s *authService) AcceptCompanyInvitation(c context.Context, token string, req auth.AcceptCompanyInvitation) error {
+	requestID := contextPkg.GetRequestID(c)
+
+	repo, err := s.authrepository.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+	defer repo.Rollback()
+
+	invitation, err := s.getPendingInvitation(c, repo.CompanyInvitation, token)
+	if err != nil {
+		return err
+	}
+
+	if exists, err := s.emailTaken(c, repo, invitation.Email); err != nil {
+		return err
+	} else if exists {
+		return auth.ErrorInvitationEmailConflict
+	}
+
+	now := time.Now()
+	accepted, err := repo.CompanyInvitation.AcceptCompanyInvitation(c, invitation.ID, now)
+	if err != nil {
+		return err
+	}
+	if !accepted {
+		return auth.ErrorInvitationNotPending
+	}
+
+	hashedPassword, err := bcrypt.HashPassword(req.Password)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to hash password")
+		return err
+	}
+
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to generate ULID")
+		return err
+	}
+
+	member := entity.CompanyMember{
+		ID:        id,
+		CompanyID: invitation.CompanyID,
+		Email:     invitation.Email,
+		Password:  hashedPassword,
+		Name:      req.Name,
+		Role:      invitation.Role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := repo.CompanyMember.CreateCompanyMember(c, member); err != nil {
+		return err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to commit company invitation acceptance")
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"company_id": member.CompanyID,
+		"member_id":  member.ID,
+		"role":       member.Role,
+	}).Info("Company invitation accepted")
+
+	return nil
+}
+
+func (s *authService) GetCompanyMembers(c context.Context, actor entity.UserLoginData) ([]auth.CompanyMemberResponse, error) {
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	members, err := repo.CompanyMember.GetCompanyMembersByCompanyID(c, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]auth.CompanyMemberResponse, 0, len(members))
+	for _, member := range members {
+		responses = append(responses, auth.CompanyMemberResponse{
+			ID:        member.ID,
+			Email:     member.Email,
+			Name:      member.Name,
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt,
+		})
+	}
+
+	return responses, nil
+}
+
+// GetCompanyOwner backs the token middleware for recruiter tokens issued
+// before company members existed. Those were company logins, which became
+// the owner member.
+func (s *authService) GetCompanyOwner(c context.Context, companyID string) (entity.CompanyMember, error) {
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return entity.CompanyMember{}, err
+	}
+
+	return repo.CompanyMember.GetCompanyOwner(c, companyID)
+}
+
+func (s *authService) UpdateCompanyMemberRole(c context.Context, actor entity.UserLoginData, id string, req auth.UpdateCompanyMemberRole) error {
+	requestID := contextPkg.GetRequestID(c)
+
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	member, err := s.getManageableMember(c, repo.CompanyMember, actor, id)
+	if err != nil {
+		return err
+	}
+	if !canAssignCompanyRole(actor.CompanyRole, req.Role) {
+		return auth.ErrorCompanyRoleNotAllowed
+	}
+
+	if err := repo.CompanyMember.UpdateCompanyMemberRole(c, member.ID, req.Role, time.Now()); err != nil {
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"company_id": actor.ID,
+		"member_id":  member.ID,
+		"from":       member.Role,
+		"to":         req.Role,
+	}).Info("Company member role updated")
+
+	return nil
+}
+
+func (s *authService) RemoveCompanyMember(c context.Context, actor entity.UserLoginData, id string) error {
+	requestID := contextPkg.GetRequestID(c)
+
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	member, err := s.getManageableMember(c, repo.CompanyMember, actor, id)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.CompanyMember.DeleteCompanyMember(c, member.ID); err != nil {
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"company_id": actor.ID,
+		"member_id":  member.ID,
+	}).Info("Company member removed")
+
+	return nil
+}
+
+// getManageableMember loads a member of the actor's company that the actor
+// is allowed to change: never the owner, never themselves, and admins only
+// manage recruiters and viewers.
+func (s *authService) getManageableMember(c context.Context, members interface {
+	GetCompanyMemberByID(c context.Context, id string) (entity.CompanyMember, error)
+}, actor entity.UserLoginData, id string) (entity.CompanyMember, error) {
+	if err := requireCompanyManager(actor); err != nil {
+		return entity.CompanyMember{}, err
+	}
+
+	member, err := members.GetCompanyMemberByID(c, id)
+	if err != nil {
+		return entity.CompanyMember{}, err
+	}
+	if member.ID == "" || member.CompanyID != actor.ID {
+		return entity.CompanyMember{}, auth.ErrorCompanyMemberNotFound
+	}
+	if member.Role == entity.CompanyRoleOwner {
+		return entity.CompanyMember{}, auth.ErrorCompanyOwnerImmutable
+	}
+	if member.ID == actor.MemberID {
+		return entity.CompanyMember{}, auth.ErrorCompanyMemberSelf
+	}
+	if !canAssignCompanyRole(actor.CompanyRole, member.Role) {
+		return entity.CompanyMember{}, auth.ErrorCompanyRoleNotAllowed
+	}
+
+	return member, nil
+}
+
+func (s *authService) getPendingInvitation(c context.Context, invitations interface {
+	GetCompanyInvitationByTokenHash(c context.Context, tokenHash string) (entity.CompanyInvitation, error)
+}, token string) (entity.CompanyInvitation, error) {
+	if token == "" {
+		return entity.CompanyInvitation{}, auth.ErrorInvitationNotFound
+	}
+
+	invitation, err := invitations.GetCompanyInvitationByTokenHash(c, utils.HashToken(token))
+	if err != nil {
+		return entity.CompanyInvitation{}, err
+	}
+	if invitation.ID == "" {
+		return entity.CompanyInvitation{}, auth.ErrorInvitationNotFound
+	}
+	if invitation.AcceptedAt != nil || invitation.RevokedAt != nil || time.Now().After(invitation.ExpiresAt) {
+		return entity.CompanyInvitation{}, auth.ErrorInvitationNotPending
+	}
+
+	return invitation, nil
+}
+
+// emailTaken reports whether the email already logs in as a candidate, a
+// company or a company member.
+func (s *authService) emailTaken(c context.Context, repo authRepository.Client, email string) (bool, error) {
+	exists, err := repo.User.CheckEmailExists(c, email)
+	if err != nil || exists {
+		return exists, err
+	}
+
+	return repo.Company.CheckEmailExists(c, email)
+}
+
+// sendInvitationMail goes through the notifier without a recipient, since the
+// invitee has no account to keep an in-app notification or preferences on.
+func (s *authService) sendInvitationMail(c context.Context, actor entity.UserLoginData, invitation entity.CompanyInvitation, token string) {
+	link := fmt.Sprintf("%s/api/v1/companies/invitations/%s",
+		strings.TrimRight(os.Getenv("APP_URL"), "/"), token)
+
+	mail := smtp.Mail{
+		To:      invitation.Email,
+		Subject: fmt.Sprintf("You're invited to join %s", invitation.CompanyName),
+		Body: fmt.Sprintf("<p>%s invited you to join <b>%s</b> as %s.</p><p><a href=\"%s\">Accept the invitation</a></p><p>This link expires on %s.</p>",
+			html.EscapeString(actor.Name), html.EscapeString(invitation.CompanyName), invitation.Role, link,
+			invitation.ExpiresAt.Format("2 January 2006")),
+	}
+
+	s.notifier.Notify(c, notification.Event{
+		Type:  entity.NotificationMemberInvitation,
+		Title: mail.Subject,
+		Mail:  &mail,
+	})
+}
@@ -0,0 +1,84 @@
+package authService
+
+import (
+	"ProjectGolang/internal/api/auth"
+	"ProjectGolang/internal/entity"
+	"errors"
+	"testing"
+)
+
+func TestRequireCompanyManager(t *testing.T) {
+	tests := []struct {
+		name  string
+		actor entity.UserLoginData
+		want  error
+	}{
+		{name: "owner", actor: entity.UserLoginData{Role: entity.RoleRecruiter, CompanyRole: entity.CompanyRoleOwner}},
+		{name: "admin", actor: entity.UserLoginData{Role: entity.RoleRecruiter, CompanyRole: entity.CompanyRoleAdmin}},
+		{name: "recruiter", actor: entity.UserLoginData{Role: entity.RoleRecruiter, CompanyRole: entity.CompanyRoleRecruiter}, want: auth.ErrorCompanyManagerOnly},
+		{name: "viewer", actor: entity.UserLoginData{Role: entity.RoleRecruiter, CompanyRole: entity.CompanyRoleViewer}, want: auth.ErrorCompanyManagerOnly},
+		{name: "no company role", actor: entity.UserLoginData{Role: entity.RoleRecruiter}, want: auth.ErrorCompanyManagerOnly},
+		{name: "candidate", actor: entity.UserLoginData{Role: entity.RoleCandidate, CompanyRole: entity.CompanyRoleOwner}, want: auth.ErrorCompanyManagerOnly},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := requireCompanyManager(tt.actor); !errors.Is(err, tt.want) {
+				t.Errorf("requireCompanyManager() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanAssignCompanyRole(t *testing.T) {
+	tests := []struct {
+		manager entity.CompanyRole
+		role    entity.CompanyRole
+		want    bool
+	}{
+		{entity.CompanyRoleOwner, entity.CompanyRoleOwner, false},
+		{entity.CompanyRoleOwner, entity.CompanyRoleAdmin, true},
+		{entity.CompanyRoleOwner, entity.CompanyRoleRecruiter, true},
+		{entity.CompanyRoleOwner, entity.CompanyRoleViewer, true},
+		{entity.CompanyRoleAdmin, entity.CompanyRoleOwner, false},
+		{entity.CompanyRoleAdmin, entity.CompanyRoleAdmin, false},
+		{entity.CompanyRoleAdmin, entity.CompanyRoleRecruiter, true},
+		{entity.CompanyRoleAdmin, entity.CompanyRoleViewer, true},
+		{entity.CompanyRoleRecruiter, entity.CompanyRoleViewer, false},
+		{entity.CompanyRoleViewer, entity.CompanyRoleViewer, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.manager)+"->"+string(tt.role), func(t *testing.T) {
+			if got := canAssignCompanyRole(tt.manager, tt.role); got != tt.want {
+				t.Errorf("canAssignCompanyRole(%s, %s) = %v, want %v", tt.manager, tt.role, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeMemberData(t *testing.T) {
+	member := entity.CompanyMember{
+		ID:          "member-1",
+		CompanyID:   "company-1",
+		CompanyName: "Acme",
+		Email:       "jane@acme.example.com",
+		Role:        entity.CompanyRoleAdmin,
+	}
+
+	claims := makeMemberData(member)
+
+	want := map[string]interface{}{
+		"id":           "company-1",
+		"role":         entity.RoleRecruiter,
+		"company_id":   "company-1",
+		"member_id":    "member-1",
+		"company_role": entity.CompanyRoleAdmin,
+		"email":        "jane@acme.example.com",
+	}
+	for key, value := range want {
+		if claims[key] != value {
+			t.Errorf("claim %s = %v, want %v", key, claims[key], value)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package authService
 import (
 	"ProjectGolang/internal/api/auth"
 	authRepository "ProjectGolang/internal/api/auth/repository"
+	notificationService "ProjectGolang/internal/api/notification/service"
+	"ProjectGolang/internal/entity"
 	"ProjectGolang/pkg/redis"
 	"ProjectGolang/pkg/s3"
 	"ProjectGolang/pkg/smtp"
@@ -17,6 +19,7 @@ type authService struct {
 	smtp           smtp.ItfSmtp
 	redis          redis.ItfRedis
 	s3             s3.ItfS3
+	notifier       notificationService.Notifier
 }
 
 type AuthService interface {
@@ -29,18 +32,44 @@ type AuthService interface {
 	CreateCompany(c context.Context, req auth.CreateUser) error
 	UpdateCompany(c context.Context, req auth.UpdateCompany, id string, banner *multipart.FileHeader, profile *multipart.FileHeader) error
 	DeleteCompany(c context.Context, id string) error
+
+	InviteCompanyMember(c context.Context, actor entity.UserLoginData, req auth.InviteCompanyMember) (auth.CompanyInvitationResponse, error)
+	GetCompanyInvitations(c context.Context, actor entity.UserLoginData) ([]auth.CompanyInvitationResponse, error)
+	RevokeCompanyInvitation(c context.Context, actor entity.UserLoginData, id string) error
+	GetCompanyInvitation(c context.Context, token string) (auth.CompanyInvitationResponse, error)
+	AcceptCompanyInvitation(c context.Context, token string, req auth.AcceptCompanyInvitation) error
+	GetCompanyMembers(c context.Context, actor entity.UserLoginData) ([]auth.CompanyMemberResponse, error)
+	UpdateCompanyMemberRole(c context.Context, actor entity.UserLoginData, id string, req auth.UpdateCompanyMemberRole) error
+	RemoveCompanyMember(c context.Context, actor entity.UserLoginData, id string) error
+	GetCompanyOwner(c context.Context, companyID string) (entity.CompanyMember, error)
+
+	GetCompany(c context.Context, id string) (auth.CompanyResponse, error)
+	SubmitCompanyVerification(c context.Context, actor entity.UserLoginData, req auth.SubmitCompanyVerification, documents []*multipart.FileHeader) (auth.CompanyVerificationResponse, error)
+	GetCompanyVerificationStatus(c context.Context, actor entity.UserLoginData) (auth.CompanyVerificationStatusResponse, error)
+	CheckCompanyVerification(c context.Context, actor entity.UserLoginData) (auth.CompanyVerificationResponse, error)
+	GetCompanyVerifications(c context.Context, req auth.GetCompanyVerifications) (auth.PaginatedCompanyVerificationsResponse, error)
+	ApproveCompanyVerification(c context.Context, adminID string, id string, req auth.ReviewCompanyVerification) error
+	RejectCompanyVerification(c context.Context, adminID string, id string, req auth.RejectCompanyVerification) error
+
+	UpdateUserSlug(c context.Context, actor entity.UserLoginData, req auth.UpdateProfileSlug) (auth.ProfileSlugResponse, error)
+	UpdateCompanySlug(c context.Context, actor entity.UserLoginData, req auth.UpdateProfileSlug) (auth.ProfileSlugResponse, error)
+	GetUserSlug(c context.Context, actor entity.UserLoginData) (auth.ProfileSlugResponse, error)
+	GetCompanySlug(c context.Context, actor entity.UserLoginData) (auth.ProfileSlugResponse, error)
+	ResolveProfileSlug(c context.Context, viewer entity.UserLoginData, slug string) (auth.ResolvedProfileResponse, error)
 }
 
 func New(authRepo authRepository.Repository,
 	log *logrus.Logger,
 	smtp smtp.ItfSmtp,
 	redis redis.ItfRedis,
-	s3 s3.ItfS3) AuthService {
+	s3 s3.ItfS3,
+	notifier notificationService.Notifier) AuthService {
 	return &authService{
 		authrepository: authRepo,
 		log:            log,
 		smtp:           smtp,
 		redis:          redis,
 		s3:             s3,
+		notifier:       notifier,
 	}
 }
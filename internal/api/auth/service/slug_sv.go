@@ -0,0 +1,282 @@
+package authService
+
+import (
+	"ProjectGolang/internal/api/auth"
+	authRepository "ProjectGolang/internal/api/auth/repository"
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// slugChangeCooldown stops owners from cycling through names, since every
+// retired slug stays reserved for them.
+const slugChangeCooldown = 7 * 24 * time.Hour
+
+func (s *authService) UpdateUserSlug(c context.Context, actor entity.UserLoginData, req auth.UpdateProfileSlug) (auth.ProfileSlugResponse, error) {
+	if actor.Role == entity.RoleRecruiter {
+		return auth.ProfileSlugResponse{}, auth.ErrorSlugCandidatesOnly
+	}
+
+	return s.updateProfileSlug(c, entity.ProfileOwnerUser, actor.ID, req)
+}
+
+func (s *authService) UpdateCompanySlug(c context.Context, actor entity.UserLoginData, req auth.UpdateProfileSlug) (auth.ProfileSlugResponse, error) {
+	if err := requireCompanyManager(actor); err != nil {
+		return auth.ProfileSlugResponse{}, err
+	}
+
+	return s.updateProfileSlug(c, entity.ProfileOwnerCompany, actor.ID, req)
+}
+
+func (s *authService) updateProfileSlug(c context.Context, ownerType entity.ProfileOwnerType, ownerID string, req auth.UpdateProfileSlug) (auth.ProfileSlugResponse, error) {
+	requestID := contextPkg.GetRequestID(c)
+
+	slug := normalizeSlug(req.Slug)
+	if !isValidSlug(slug) {
+		return auth.ProfileSlugResponse{}, auth.ErrorSlugInvalid
+	}
+	if isReservedSlug(slug) {
+		return auth.ProfileSlugResponse{}, auth.ErrorSlugReserved
+	}
+
+	repo, err := s.authrepository.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.ProfileSlugResponse{}, err
+	}
+	defer repo.Rollback()
+
+	if err := s.ensureProfileOwner(c, repo, ownerType, ownerID); err != nil {
+		return auth.ProfileSlugResponse{}, err
+	}
+
+	current, err := repo.ProfileSlug.GetCurrentProfileSlug(c, ownerType, ownerID)
+	if err != nil {
+		return auth.ProfileSlugResponse{}, err
+	}
+
+	if current.Slug == slug {
+		return s.getProfileSlugResponse(c, repo, ownerType, ownerID)
+	}
+
+	now := time.Now()
+	if current.Slug != "" && now.Sub(current.ClaimedAt) < slugChangeCooldown {
+		return auth.ProfileSlugResponse{}, auth.ErrorSlugChangeTooSoon
+	}
+
+	existing, err := repo.ProfileSlug.GetProfileSlug(c, slug)
+	if err != nil {
+		return auth.ProfileSlugResponse{}, err
+	}
+	if existing.Slug != "" && (existing.OwnerType != ownerType || existing.OwnerID != ownerID) {
+		return auth.ProfileSlugResponse{}, auth.ErrorSlugTaken
+	}
+
+	if err := repo.ProfileSlug.RetireCurrentProfileSlug(c, ownerType, ownerID, now); err != nil {
+		return auth.ProfileSlugResponse{}, err
+	}
+
+	profileSlug := entity.ProfileSlug{
+		Slug:      slug,
+		OwnerType: ownerType,
+		OwnerID:   ownerID,
+		IsCurrent: true,
+		ClaimedAt: now,
+		CreatedAt: now,
+	}
+
+	// Owners may go back to one of their own retired slugs.
+	if existing.Slug != "" {
+		if err := repo.ProfileSlug.ReactivateProfileSlug(c, profileSlug); err != nil {
+			return auth.ProfileSlugResponse{}, err
+		}
+	} else {
+		claimed, err := repo.ProfileSlug.CreateProfileSlug(c, profileSlug)
+		if err != nil {
+			return auth.ProfileSlugResponse{}, err
+		}
+		if !claimed {
+			return auth.ProfileSlugResponse{}, auth.ErrorSlugTaken
+		}
+	}
+
+	resp, err := s.getProfileSlugResponse(c, repo, ownerType, ownerID)
+	if err != nil {
+		return auth.ProfileSlugResponse{}, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to commit profile slug")
+		return auth.ProfileSlugResponse{}, err
+	}
+
+	if ownerType == entity.ProfileOwnerUser {
+		s.invalidateProfile(c, ownerID)
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"owner_type": ownerType,
+		"owner_id":   ownerID,
+		"slug":       slug,
+		"previous":   current.Slug,
+	}).Info("Profile slug updated")
+
+	return resp, nil
+}
+
+func (s *authService) GetUserSlug(c context.Context, actor entity.UserLoginData) (auth.ProfileSlugResponse, error) {
+	if actor.Role == entity.RoleRecruiter {
+		return auth.ProfileSlugResponse{}, auth.ErrorSlugCandidatesOnly
+	}
+
+	return s.getOwnProfileSlug(c, entity.ProfileOwnerUser, actor.ID)
+}
+
+func (s *authService) GetCompanySlug(c context.Context, actor entity.UserLoginData) (auth.ProfileSlugResponse, error) {
+	if actor.Role != entity.RoleRecruiter {
+		return auth.ProfileSlugResponse{}, auth.ErrorRecruiterOnly
+	}
+
+	return s.getOwnProfileSlug(c, entity.ProfileOwnerCompany, actor.ID)
+}
+
+func (s *authService) getOwnProfileSlug(c context.Context, ownerType entity.ProfileOwnerType, ownerID string) (auth.ProfileSlugResponse, error) {
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.ProfileSlugResponse{}, err
+	}
+
+	return s.getProfileSlugResponse(c, repo, ownerType, ownerID)
+}
+
+func (s *authService) getProfileSlugResponse(c context.Context, repo authRepository.Client, ownerType entity.ProfileOwnerType, ownerID string) (auth.ProfileSlugResponse, error) {
+	history, err := repo.ProfileSlug.GetProfileSlugHistory(c, ownerType, ownerID)
+	if err != nil {
+		return auth.ProfileSlugResponse{}, err
+	}
+
+	resp := auth.ProfileSlugResponse{History: make([]auth.ProfileSlugHistoryEntry, 0, len(history))}
+	for _, slug := range history {
+		if slug.IsCurrent {
+			resp.Slug = slug.Slug
+			resp.URL = profileURL(slug.Slug)
+		}
+		resp.History = append(resp.History, auth.ProfileSlugHistoryEntry{
+			Slug:      slug.Slug,
+			IsCurrent: slug.IsCurrent,
+			ClaimedAt: slug.ClaimedAt,
+			RetiredAt: slug.RetiredAt,
+		})
+	}
+
+	return resp, nil
+}
+
+// ResolveProfileSlug looks up the profile behind a slug. The returned Slug is
+// always the owner's current one; callers redirect when it differs from the
+// slug they were given.
+func (s *authService) ResolveProfileSlug(c context.Context, viewer entity.UserLoginData, slug string) (auth.ResolvedProfileResponse, error) {
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.ResolvedProfileResponse{}, err
+	}
+
+	found, err := repo.ProfileSlug.GetProfileSlug(c, normalizeSlug(slug))
+	if err != nil {
+		return auth.ResolvedProfileResponse{}, err
+	}
+	if found.Slug == "" {
+		return auth.ResolvedProfileResponse{}, auth.ErrorProfileNotFound
+	}
+
+	if !found.IsCurrent {
+		found, err = repo.ProfileSlug.GetCurrentProfileSlug(c, found.OwnerType, found.OwnerID)
+		if err != nil {
+			return auth.ResolvedProfileResponse{}, err
+		}
+		if found.Slug == "" {
+			return auth.ResolvedProfileResponse{}, auth.ErrorProfileNotFound
+		}
+	}
+
+	resp := auth.ResolvedProfileResponse{
+		OwnerType: found.OwnerType,
+		OwnerID:   found.OwnerID,
+		Slug:      found.Slug,
+		URL:       profileURL(found.Slug),
+	}
+
+	switch found.OwnerType {
+	case entity.ProfileOwnerUser:
+		user, err := repo.User.GetUserByID(c, found.OwnerID)
+		if err != nil {
+			return auth.ResolvedProfileResponse{}, err
+		}
+		if user.ID == "" {
+			return auth.ResolvedProfileResponse{}, auth.ErrorProfileNotFound
+		}
+		if !bio.CanViewProfile(user, viewer) {
+			return auth.ResolvedProfileResponse{}, auth.ErrorProfileNotVisible
+		}
+		resp.Name = user.Name
+		resp.Headline = user.Headline
+		resp.Avatar = user.ProfilePicture
+	case entity.ProfileOwnerCompany:
+		company, err := repo.Company.GetCompanyByID(c, found.OwnerID)
+		if err != nil {
+			return auth.ResolvedProfileResponse{}, err
+		}
+		if company.ID == "" {
+			return auth.ResolvedProfileResponse{}, auth.ErrorProfileNotFound
+		}
+		resp.Name = company.Name
+		resp.Headline = truncateRunes(company.AboutUs, ogDescriptionLimit)
+		resp.Avatar = company.ProfilePicture
+	default:
+		return auth.ResolvedProfileResponse{}, auth.ErrorProfileNotFound
+	}
+
+	resp.OpenGraph = makeOpenGraphMetadata(resp)
+
+	return resp, nil
+}
+
+func (s *authService) ensureProfileOwner(c context.Context, repo authRepository.Client, ownerType entity.ProfileOwnerType, ownerID string) error {
+	if ownerType == entity.ProfileOwnerCompany {
+		company, err := repo.Company.GetCompanyByID(c, ownerID)
+		if err != nil {
+			return err
+		}
+		if company.ID == "" {
+			return auth.ErrorCompanyNotFound
+		}
+		return nil
+	}
+
+	user, err := repo.User.GetUserByID(c, ownerID)
+	if err != nil {
+		return err
+	}
+	if user.ID == "" {
+		return auth.ErrorUserNotFound
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+package authService
+
+import (
+	"ProjectGolang/internal/api/auth"
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/smtp"
+	"ProjectGolang/pkg/utils"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"html"
+	"mime/multipart"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	maxVerificationDocuments    = 5
+	maxVerificationDocumentSize = 10 * 1024 * 1024
+)
+
+func (s *authService) SubmitCompanyVerification(c context.Context, actor entity.UserLoginData, req auth.SubmitCompanyVerification, documents []*multipart.FileHeader) (auth.CompanyVerificationResponse, error) {
+	requestID := contextPkg.GetRequestID(c)
+
+	if err := requireCompanyManager(actor); err != nil {
+		return auth.CompanyVerificationResponse{}, err
+	}
+
+	repo, err := s.authrepository.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.CompanyVerificationResponse{}, err
+	}
+	defer repo.Rollback()
+
+	company, err := repo.Company.GetCompanyByID(c, actor.ID)
+	if err != nil {
+		return auth.CompanyVerificationResponse{}, err
+	}
+	if company.ID == "" {
+		return auth.CompanyVerificationResponse{}, auth.ErrorCompanyNotFound
+	}
+	if company.IsVerified {
+		return auth.CompanyVerificationResponse{}, auth.ErrorCompanyAlreadyVerified
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to generate ULID")
+		return auth.CompanyVerificationResponse{}, err
+	}
+
+	verification := entity.CompanyVerification{
+		ID:          id,
+		CompanyID:   company.ID,
+		Method:      req.Method,
+		Domain:      strings.ToLower(strings.TrimSuffix(req.Domain, ".")),
+		Documents:   []string{},
+		Status:      entity.VerificationStatusPending,
+		SubmittedBy: actor.MemberID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		CompanyName: company.Name,
+	}
+
+	switch req.Method {
+	case entity.VerificationMethodDNS:
+		token, err := utils.GenerateToken(16)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Failed to generate verification token")
+			return auth.CompanyVerificationResponse{}, err
+		}
+		verification.Token = token
+
+	case entity.VerificationMethodEmailDomain:
+		if isFreeEmailDomain(verification.Domain) {
+			return auth.CompanyVerificationResponse{}, auth.ErrorVerificationFreeEmailDomain
+		}
+		if emailDomain(actor.Email) != verification.Domain && emailDomain(company.Email) != verification.Domain {
+			return auth.CompanyVerificationResponse{}, auth.ErrorVerificationDomainMismatch
+		}
+		verification.DomainVerifiedAt = &now
+
+	case entity.VerificationMethodDocument:
+		verification.Domain = ""
+		if len(documents) == 0 || len(documents) > maxVerificationDocuments {
+			return auth.CompanyVerificationResponse{}, auth.ErrorVerificationDocumentsRequired
+		}
+		for _, document := range documents {
+			if document.Size > maxVerificationDocumentSize || !isVerificationDocument(document) {
+				return auth.CompanyVerificationResponse{}, auth.ErrorVerificationDocumentInvalid
+			}
+		}
+		for _, document := range documents {
+			url, err := s.s3.UploadFile(document, document.Filename)
+			if err != nil {
+				s.log.WithFields(logrus.Fields{
+					"request_id": requestID,
+					"error":      err.Error(),
+					"company_id": company.ID,
+				}).Error("Failed to upload verification document")
+				return auth.CompanyVerificationResponse{}, err
+			}
+			verification.Documents = append(verification.Documents, url)
+		}
+	}
+
+	// A new submission replaces whatever request was still waiting.
+	if err := repo.CompanyVerification.CancelPendingCompanyVerification(c, company.ID, now); err != nil {
+		return auth.CompanyVerificationResponse{}, err
+	}
+
+	if err := repo.CompanyVerification.CreateCompanyVerification(c, verification); err != nil {
+		return auth.CompanyVerificationResponse{}, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to commit company verification")
+		return auth.CompanyVerificationResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"request_id":      requestID,
+		"company_id":      company.ID,
+		"verification_id": verification.ID,
+		"method":          verification.Method,
+	}).Info("Company verification submitted")
+
+	return makeCompanyVerificationResponse(verification), nil
+}
+
+func (s *authService) GetCompanyVerificationStatus(c context.Context, actor entity.UserLoginData) (auth.CompanyVerificationStatusResponse, error) {
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.CompanyVerificationStatusResponse{}, err
+	}
+
+	company, err := repo.Company.GetCompanyByID(c, actor.ID)
+	if err != nil {
+		return auth.CompanyVerificationStatusResponse{}, err
+	}
+	if company.ID == "" {
+		return auth.CompanyVerificationStatusResponse{}, auth.ErrorCompanyNotFound
+	}
+
+	response := auth.CompanyVerificationStatusResponse{
+		IsVerified: company.IsVerified,
+		VerifiedAt: company.VerifiedAt,
+	}
+
+	latest, err := repo.CompanyVerification.GetLatestCompanyVerification(c, company.ID)
+	if err != nil {
+		return auth.CompanyVerificationStatusResponse{}, err
+	}
+	if latest.ID != "" {
+		latestResponse := makeCompanyVerificationResponse(latest)
+		response.Latest = &latestResponse
+	}
+
+	return response, nil
+}
+
+// CheckCompanyVerification looks up the pending dns_txt request's token on
+// the domain and records the proof once the TXT record is visible.
+func (s *authService) CheckCompanyVerification(c context.Context, actor entity.UserLoginData) (auth.CompanyVerificationResponse, error) {
+	requestID := contextPkg.GetRequestID(c)
+
+	if err := requireCompanyManager(actor); err != nil {
+		return auth.CompanyVerificationResponse{}, err
+	}
+
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.CompanyVerificationResponse{}, err
+	}
+
+	verification, err := repo.CompanyVerification.GetLatestCompanyVerification(c, actor.ID)
+	if err != nil {
+		return auth.CompanyVerificationResponse{}, err
+	}
+	if verification.ID == "" {
+		return auth.CompanyVerificationResponse{}, auth.ErrorVerificationNotFound
+	}
+	if verification.Status != entity.VerificationStatusPending {
+		return auth.CompanyVerificationResponse{}, auth.ErrorVerificationNotPending
+	}
+	if verification.Method != entity.VerificationMethodDNS {
+		return auth.CompanyVerificationResponse{}, auth.ErrorVerificationNotDNS
+	}
+	if verification.DomainVerifiedAt != nil {
+		return makeCompanyVerificationResponse(verification), nil
+	}
+
+	records, err := net.DefaultResolver.LookupTXT(c, verification.Domain)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id":      requestID,
+			"error":           err.Error(),
+			"verification_id": verification.ID,
+			"domain":          verification.Domain,
+		}).Warn("Failed to look up verification TXT record")
+		return auth.CompanyVerificationResponse{}, auth.ErrorVerificationRecordMissing
+	}
+
+	expected := verificationRecordValue(verification.Token)
+	found := false
+	for _, record := range records {
+		if strings.TrimSpace(record) == expected {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return auth.CompanyVerificationResponse{}, auth.ErrorVerificationRecordMissing
+	}
+
+	now := time.Now()
+	if err := repo.CompanyVerification.MarkCompanyDomainVerified(c, verification.ID, now); err != nil {
+		return auth.CompanyVerificationResponse{}, err
+	}
+	verification.DomainVerifiedAt = &now
+
+	s.log.WithFields(logrus.Fields{
+		"request_id":      requestID,
+		"company_id":      actor.ID,
+		"verification_id": verification.ID,
+		"domain":          verification.Domain,
+	}).Info("Company domain verified via DNS")
+
+	return makeCompanyVerificationResponse(verification), nil
+}
+
+func (s *authService) GetCompanyVerifications(c context.Context, req auth.GetCompanyVerifications) (auth.PaginatedCompanyVerificationsResponse, error) {
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.PaginatedCompanyVerificationsResponse{}, err
+	}
+
+	status := req.Status
+	if status == "" {
+		status = entity.VerificationStatusPending
+	}
+
+	verifications, total, err := repo.CompanyVerification.GetCompanyVerificationsByStatus(c, status, req.PageSize, (req.Page-1)*req.PageSize)
+	if err != nil {
+		return auth.PaginatedCompanyVerificationsResponse{}, err
+	}
+
+	responses := make([]auth.CompanyVerificationResponse, 0, len(verifications))
+	for _, verification := range verifications {
+		response := makeCompanyVerificationResponse(verification)
+		response.CompanyEmail = verification.CompanyEmail
+		responses = append(responses, response)
+	}
+
+	totalPages := total / req.PageSize
+	if total%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return auth.PaginatedCompanyVerificationsResponse{
+		Verifications: responses,
+		TotalCount:    total,
+		TotalPages:    totalPages,
+		CurrentPage:   req.Page,
+		PageSize:      req.PageSize,
+	}, nil
+}
+
+func (s *authService) ApproveCompanyVerification(c context.Context, adminID string, id string, req auth.ReviewCompanyVerification) error {
+	return s.reviewCompanyVerification(c, adminID, id, entity.VerificationStatusApproved, req.Note)
+}
+
+func (s *authService) RejectCompanyVerification(c context.Context, adminID string, id string, req auth.RejectCompanyVerification) error {
+	return s.reviewCompanyVerification(c, adminID, id, entity.VerificationStatusRejected, req.Note)
+}
+
+func (s *authService) reviewCompanyVerification(c context.Context, adminID string, id string, status entity.VerificationStatus, note string) error {
+	requestID := contextPkg.GetRequestID(c)
+
+	repo, err := s.authrepository.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+	defer repo.Rollback()
+
+	verification, err := repo.CompanyVerification.GetCompanyVerificationByID(c, id)
+	if err != nil {
+		return err
+	}
+	if verification.ID == "" {
+		return auth.ErrorVerificationNotFound
+	}
+	if verification.Status != entity.VerificationStatusPending {
+		return auth.ErrorVerificationNotPending
+	}
+	if status == entity.VerificationStatusApproved &&
+		verification.Method != entity.VerificationMethodDocument && verification.DomainVerifiedAt == nil {
+		return auth.ErrorVerificationDomainNotProven
+	}
+
+	now := time.Now()
+	verification.Status = status
+	verification.ReviewedBy = adminID
+	verification.ReviewNote = note
+	verification.ReviewedAt = &now
+	verification.UpdatedAt = now
+
+	reviewed, err := repo.CompanyVerification.ReviewCompanyVerification(c, verification)
+	if err != nil {
+		return err
+	}
+	if !reviewed {
+		return auth.ErrorVerificationNotPending
+	}
+
+	if status == entity.VerificationStatusApproved {
+		if err := repo.Company.SetCompanyVerified(c, verification.CompanyID, now); err != nil {
+			return err
+		}
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to commit company verification review")
+		return err
+	}
+
+	s.notifyVerificationDecision(c, verification)
+
+	s.log.WithFields(logrus.Fields{
+		"request_id":      requestID,
+		"company_id":      verification.CompanyID,
+		"verification_id": verification.ID,
+		"status":          status,
+		"admin_id":        adminID,
+	}).Info("Company verification reviewed")
+
+	return nil
+}
+
+func (s *authService) GetCompany(c context.Context, id string) (auth.CompanyResponse, error) {
+	repo, err := s.authrepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(c),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return auth.CompanyResponse{}, err
+	}
+
+	company, err := repo.Company.GetCompanyByID(c, id)
+	if err != nil {
+		return auth.CompanyResponse{}, err
+	}
+	if company.ID == "" {
+		return auth.CompanyResponse{}, auth.ErrorCompanyNotFound
+	}
+
+	slug, err := repo.ProfileSlug.GetCurrentProfileSlug(c, entity.ProfileOwnerCompany, company.ID)
+	if err != nil {
+		return auth.CompanyResponse{}, err
+	}
+
+	return auth.CompanyResponse{
+		ID:              company.ID,
+		Slug:            slug.Slug,
+		Name:            company.Name,
+		ProfilePicture:  company.ProfilePicture,
+		BannerPicture:   company.BannerPicture,
+		Location:        company.Location,
+		AboutUs:         company.AboutUs,
+		IndustryTypes:   company.IndustryTypes,
+		NumberEmployees: company.NumberEmployees,
+		EstablishedDate: company.EstablishedDate,
+		CompanyURL:      company.CompanyURL,
+		IsVerified:      company.IsVerified,
+		VerifiedAt:      company.VerifiedAt,
+	}, nil
+}
+
+func (s *authService) notifyVerificationDecision(c context.Context, verification entity.CompanyVerification) {
+	mail := smtp.Mail{
+		To:      verification.CompanyEmail,
+		Subject: "Your company is now verified",
+		Body: fmt.Sprintf("<p><b>%s</b> has been verified. Verified companies are marked on their vacancies and can publish without limits.</p>",
+			html.EscapeString(verification.CompanyName)),
+	}
+	body := fmt.Sprintf("%s has been verified.", verification.CompanyName)
+	if verification.Status == entity.VerificationStatusRejected {
+		mail.Subject = "Your company verification was rejected"
+		mail.Body = fmt.Sprintf("<p>The verification request for <b>%s</b> was rejected.</p><blockquote>%s</blockquote><p>You can submit a new request at any time.</p>",
+			html.EscapeString(verification.CompanyName), html.EscapeString(verification.ReviewNote))
+		body = verification.ReviewNote
+	}
+
+	s.notifier.Notify(c, notification.Event{
+		RecipientID: verification.CompanyID,
+		Type:        entity.NotificationVerification,
+		Title:       mail.Subject,
+		Body:        body,
+		Data: map[string]string{
+			"verification_id": verification.ID,
+			"status":          string(verification.Status),
+		},
+		Mail: &mail,
+	})
+}
@@ -0,0 +1,105 @@
+package authService
+
+import (
+	"ProjectGolang/internal/entity"
+	"mime/multipart"
+	"testing"
+)
+
+func TestEmailDomain(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{email: "hr@Acme.Example.com", want: "acme.example.com"},
+		{email: "odd@name@acme.com", want: "acme.com"},
+		{email: "no-at-sign", want: ""},
+		{email: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			if got := emailDomain(tt.email); got != tt.want {
+				t.Errorf("emailDomain(%q) = %q, want %q", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsFreeEmailDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   bool
+	}{
+		{domain: "gmail.com", want: true},
+		{domain: "GMAIL.com", want: true},
+		{domain: "yahoo.com", want: true},
+		{domain: "acme.example.com", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			if got := isFreeEmailDomain(tt.domain); got != tt.want {
+				t.Errorf("isFreeEmailDomain(%q) = %v, want %v", tt.domain, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsVerificationDocument(t *testing.T) {
+	tests := []struct {
+		filename string
+		want     bool
+	}{
+		{filename: "deed.pdf", want: true},
+		{filename: "license.JPG", want: true},
+		{filename: "scan.jpeg", want: true},
+		{filename: "scan.png", want: true},
+		{filename: "deed.docx", want: false},
+		{filename: "script.pdf.exe", want: false},
+		{filename: "pdf", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			if got := isVerificationDocument(&multipart.FileHeader{Filename: tt.filename}); got != tt.want {
+				t.Errorf("isVerificationDocument(%q) = %v, want %v", tt.filename, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeCompanyVerificationResponseDNSRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  entity.VerificationMethod
+		status  entity.VerificationStatus
+		wantDNS bool
+	}{
+		{name: "pending DNS shows the record", method: entity.VerificationMethodDNS, status: entity.VerificationStatusPending, wantDNS: true},
+		{name: "approved DNS hides it", method: entity.VerificationMethodDNS, status: entity.VerificationStatusApproved},
+		{name: "email domain has none", method: entity.VerificationMethodEmailDomain, status: entity.VerificationStatusPending},
+		{name: "documents have none", method: entity.VerificationMethodDocument, status: entity.VerificationStatusPending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := makeCompanyVerificationResponse(entity.CompanyVerification{
+				Method: tt.method,
+				Status: tt.status,
+				Domain: "acme.example.com",
+				Token:  "abc123",
+			})
+
+			if (got.DNSRecord != nil) != tt.wantDNS {
+				t.Fatalf("DNSRecord = %+v, want present %v", got.DNSRecord, tt.wantDNS)
+			}
+			if !tt.wantDNS {
+				return
+			}
+			if got.DNSRecord.Type != "TXT" || got.DNSRecord.Name != "acme.example.com" || got.DNSRecord.Value != verificationRecordPrefix+"abc123" {
+				t.Errorf("DNSRecord = %+v", got.DNSRecord)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package bio
+
+import "fmt"
+
+// ProfileCacheKey is shared with the auth service so user updates can drop a
+// candidate's cached profile alongside the bio writes.
+func ProfileCacheKey(userID string) string {
+	return fmt.Sprintf("profile:%s", userID)
+}
+
+// ResumeStorageRoot holds every generated résumé. Keys are content-hashed, so
+// a profile change simply stops using the old files and the scheduler removes
+// them once they have aged out.
+const ResumeStorageRoot = "resumes/"
+
+// ResumeStoragePrefix is where a user's generated résumé PDFs are stored.
+func ResumeStoragePrefix(userID string) string {
+	return fmt.Sprintf("%s%s/", ResumeStorageRoot, userID)
+}
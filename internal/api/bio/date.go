@@ -0,0 +1,15 @@
+package bio
+
+import "time"
+
+// YearMonthLayout is the format bio start and end dates are sent and returned
+// in; they are stored as the first day of the month.
+const YearMonthLayout = "2006-01"
+
+// FormatYearMonth renders an optional bio date, nil being empty.
+func FormatYearMonth(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(YearMonthLayout)
+}
@@ -1,6 +1,11 @@
 package bio
 
-import "database/sql"
+import (
+	"ProjectGolang/internal/api/skill"
+	"ProjectGolang/internal/entity"
+	"database/sql"
+	"time"
+)
 
 type CreateExperience struct {
 	ImageURL    string `form:"image"`
@@ -9,7 +14,9 @@ type CreateExperience struct {
 	SkillUsed   string `form:"skill_used"`
 	StartDate   string `form:"start_date" validate:"required"`
 	EndDate     string `form:"end_date"`
+	IsCurrent   bool   `form:"is_current"`
 	Description string `form:"description"`
+	IsHidden    bool   `form:"is_hidden"`
 }
 
 type ExperienceDB struct {
@@ -19,9 +26,11 @@ type ExperienceDB struct {
 	JobTitle    sql.NullString `db:"job_title"`
 	JobLocation sql.NullString `db:"job_location"`
 	SkillUsed   sql.NullString `db:"skill_used"`
-	StartDate   sql.NullString `db:"start_date"`
-	EndDate     sql.NullString `db:"end_date"`
+	StartDate   sql.NullTime   `db:"start_date"`
+	EndDate     sql.NullTime   `db:"end_date"`
+	IsCurrent   sql.NullBool   `db:"is_current"`
 	Description sql.NullString `db:"description"`
+	IsHidden    sql.NullBool   `db:"is_hidden"`
 	CreatedAt   sql.NullTime   `db:"created_at"`
 	UpdatedAt   sql.NullTime   `db:"updated_at"`
 }
@@ -33,7 +42,9 @@ type UpdateExperience struct {
 	SkillUsed   string `form:"skill_used"`
 	StartDate   string `form:"start_date"`
 	EndDate     string `form:"end_date"`
+	IsCurrent   *bool  `form:"is_current"`
 	Description string `form:"description"`
+	IsHidden    *bool  `form:"is_hidden"`
 }
 
 type CreateEducation struct {
@@ -42,7 +53,9 @@ type CreateEducation struct {
 	InstitutionalName string `form:"institutional_name" validate:"required"`
 	StartDate         string `form:"start_date" validate:"required"`
 	EndDate           string `form:"end_date"`
+	IsCurrent         bool   `form:"is_current"`
 	Description       string `form:"description"`
+	IsHidden          bool   `form:"is_hidden"`
 }
 
 type EducationDB struct {
@@ -51,9 +64,11 @@ type EducationDB struct {
 	UserID            sql.NullString `db:"user_id"`
 	TitleDegree       sql.NullString `db:"title_degree"`
 	InstitutionalName sql.NullString `db:"institutional_name"`
-	StartDate         sql.NullString `db:"start_date"`
-	EndDate           sql.NullString `db:"end_date"`
+	StartDate         sql.NullTime   `db:"start_date"`
+	EndDate           sql.NullTime   `db:"end_date"`
+	IsCurrent         sql.NullBool   `db:"is_current"`
 	Description       sql.NullString `db:"description"`
+	IsHidden          sql.NullBool   `db:"is_hidden"`
 	CreatedAt         sql.NullTime   `db:"created_at"`
 	UpdatedAt         sql.NullTime   `db:"updated_at"`
 }
@@ -64,7 +79,9 @@ type UpdateEducation struct {
 	InstitutionalName string `form:"institutional_name"`
 	StartDate         string `form:"start_date"`
 	EndDate           string `form:"end_date"`
+	IsCurrent         *bool  `form:"is_current"`
 	Description       string `form:"description"`
+	IsHidden          *bool  `form:"is_hidden"`
 }
 
 type CreatePortfolio struct {
@@ -75,7 +92,11 @@ type CreatePortfolio struct {
 	ProjectLink      string `form:"project_link"`
 	StartDate        string `form:"start_date" validate:"required"`
 	EndDate          string `form:"end_date"`
+	IsCurrent        bool   `form:"is_current"`
 	Description      string `form:"description"`
+	IsHidden         bool   `form:"is_hidden"`
+	// Skills is a comma-separated list linked through the skill catalogue.
+	Skills string `form:"skills"`
 }
 
 type PortfolioDB struct {
@@ -86,9 +107,11 @@ type PortfolioDB struct {
 	ProjectLocation  sql.NullString `db:"project_location"`
 	DescriptionImage sql.NullString `db:"description_image"`
 	ProjectLink      sql.NullString `db:"project_link"`
-	StartDate        sql.NullString `db:"start_date"`
-	EndDate          sql.NullString `db:"end_date"`
+	StartDate        sql.NullTime   `db:"start_date"`
+	EndDate          sql.NullTime   `db:"end_date"`
+	IsCurrent        sql.NullBool   `db:"is_current"`
 	Description      sql.NullString `db:"description"`
+	IsHidden         sql.NullBool   `db:"is_hidden"`
 	CreatedAt        sql.NullTime   `db:"created_at"`
 	UpdatedAt        sql.NullTime   `db:"updated_at"`
 }
@@ -101,5 +124,331 @@ type UpdatePortfolio struct {
 	ProjectLink      string `form:"project_link"`
 	StartDate        string `form:"start_date"`
 	EndDate          string `form:"end_date"`
+	IsCurrent        *bool  `form:"is_current"`
 	Description      string `form:"description"`
+	IsHidden         *bool  `form:"is_hidden"`
+	Skills           string `form:"skills"`
+}
+
+type CreateCertification struct {
+	Image         string `form:"image"`
+	Name          string `form:"name" validate:"required,max=255"`
+	Issuer        string `form:"issuer" validate:"required,max=255"`
+	IssueDate     string `form:"issue_date" validate:"required"`
+	ExpiryDate    string `form:"expiry_date"`
+	CredentialID  string `form:"credential_id" validate:"max=255"`
+	CredentialURL string `form:"credential_url" validate:"omitempty,url,max=255"`
+	IsHidden      bool   `form:"is_hidden"`
+}
+
+type CertificationDB struct {
+	ID                   sql.NullString `db:"id"`
+	UserID               sql.NullString `db:"user_id"`
+	Name                 sql.NullString `db:"name"`
+	Issuer               sql.NullString `db:"issuer"`
+	IssueDate            sql.NullTime   `db:"issue_date"`
+	ExpiryDate           sql.NullTime   `db:"expiry_date"`
+	CredentialID         sql.NullString `db:"credential_id"`
+	CredentialURL        sql.NullString `db:"credential_url"`
+	Image                sql.NullString `db:"image"`
+	IsHidden             sql.NullBool   `db:"is_hidden"`
+	ExpiryReminderSentAt sql.NullTime   `db:"expiry_reminder_sent_at"`
+	CreatedAt            sql.NullTime   `db:"created_at"`
+	UpdatedAt            sql.NullTime   `db:"updated_at"`
+}
+
+type UpdateCertification struct {
+	Image      string `form:"image"`
+	Name       string `form:"name" validate:"max=255"`
+	Issuer     string `form:"issuer" validate:"max=255"`
+	IssueDate  string `form:"issue_date"`
+	ExpiryDate string `form:"expiry_date"`
+	// NoExpiry clears the expiry date of a certification that no longer
+	// expires.
+	NoExpiry      *bool  `form:"no_expiry"`
+	CredentialID  string `form:"credential_id" validate:"max=255"`
+	CredentialURL string `form:"credential_url" validate:"omitempty,url,max=255"`
+	IsHidden      *bool  `form:"is_hidden"`
+}
+
+type ExperienceResponse struct {
+	ID          string    `json:"id"`
+	ImageURL    string    `json:"image_url"`
+	JobTitle    string    `json:"job_title"`
+	JobLocation string    `json:"job_location"`
+	SkillUsed   string    `json:"skill_used"`
+	StartDate   string    `json:"start_date"`
+	EndDate     string    `json:"end_date"`
+	IsCurrent   bool      `json:"is_current"`
+	Description string    `json:"description"`
+	IsHidden    bool      `json:"is_hidden,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	Skills []skill.SkillResponse `json:"skills"`
+}
+
+type EducationResponse struct {
+	ID                string    `json:"id"`
+	Image             string    `json:"image"`
+	TitleDegree       string    `json:"title_degree"`
+	InstitutionalName string    `json:"institutional_name"`
+	StartDate         string    `json:"start_date"`
+	EndDate           string    `json:"end_date"`
+	IsCurrent         bool      `json:"is_current"`
+	Description       string    `json:"description"`
+	IsHidden          bool      `json:"is_hidden,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type PortfolioResponse struct {
+	ID               string    `json:"id"`
+	Image            string    `json:"image"`
+	ProjectName      string    `json:"project_name"`
+	ProjectLocation  string    `json:"project_location"`
+	DescriptionImage string    `json:"description_image"`
+	ProjectLink      string    `json:"project_link"`
+	StartDate        string    `json:"start_date"`
+	EndDate          string    `json:"end_date"`
+	IsCurrent        bool      `json:"is_current"`
+	Description      string    `json:"description"`
+	IsHidden         bool      `json:"is_hidden,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+
+	Skills []skill.SkillResponse `json:"skills"`
+}
+
+type CertificationResponse struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Issuer        string    `json:"issuer"`
+	IssueDate     string    `json:"issue_date"`
+	ExpiryDate    string    `json:"expiry_date"`
+	IsExpired     bool      `json:"is_expired"`
+	CredentialID  string    `json:"credential_id"`
+	CredentialURL string    `json:"credential_url"`
+	Image         string    `json:"image"`
+	IsHidden      bool      `json:"is_hidden,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type ProfileResponse struct {
+	ID                string                  `json:"id"`
+	Slug              string                  `json:"slug,omitempty"`
+	Name              string                  `json:"name"`
+	Email             string                  `json:"email"`
+	Role              string                  `json:"role"`
+	ProfilePicture    string                  `json:"profile_picture"`
+	BannerPicture     string                  `json:"banner_picture"`
+	PhoneNumber       string                  `json:"phone_number"`
+	Headline          string                  `json:"headline"`
+	Location          string                  `json:"location"`
+	IsPremium         bool                    `json:"is_premium"`
+	CreatedAt         time.Time               `json:"created_at"`
+	Privacy           *ProfilePrivacy         `json:"privacy,omitempty"`
+	YearsOfExperience float64                 `json:"years_of_experience"`
+	Skills            []ProfileSkillResponse  `json:"skills"`
+	Languages         []LanguageResponse      `json:"languages"`
+	Experiences       []ExperienceResponse    `json:"experiences"`
+	Educations        []EducationResponse     `json:"educations"`
+	Portfolios        []PortfolioResponse     `json:"portfolios"`
+	Certifications    []CertificationResponse `json:"certifications"`
+}
+
+type UpdateUserSkills struct {
+	Skills []string `json:"skills" validate:"max=30,dive,max=100"`
+}
+
+// ProfileSkillResponse is a profile skill with its endorsements. Endorsers
+// holds the most recent few; EndorsedByViewer is filled per request and never
+// cached.
+type ProfileSkillResponse struct {
+	skill.SkillResponse
+	EndorsementCount int                `json:"endorsement_count"`
+	Endorsers        []EndorserResponse `json:"endorsers"`
+	EndorsedByViewer bool               `json:"endorsed_by_viewer"`
+}
+
+type EndorserResponse struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Headline       string    `json:"headline,omitempty"`
+	ProfilePicture string    `json:"profile_picture,omitempty"`
+	EndorsedAt     time.Time `json:"endorsed_at"`
+}
+
+type GetEndorsers struct {
+	Page     int `query:"page" validate:"min=1"`
+	PageSize int `query:"page_size" validate:"min=1,max=100"`
+}
+
+type PaginatedEndorsersResponse struct {
+	Endorsers   []EndorserResponse `json:"endorsers"`
+	TotalCount  int                `json:"total_count"`
+	TotalPages  int                `json:"total_pages"`
+	CurrentPage int                `json:"current_page"`
+	PageSize    int                `json:"page_size"`
+}
+
+type UserLanguageRequest struct {
+	Language string `json:"language" validate:"required,min=2,max=3"`
+	Level    string `json:"level" validate:"required,oneof=A1 A2 B1 B2 C1 C2 NATIVE"`
+	// TestName and TestScore are given together, e.g. IELTS and 7.5.
+	TestName  string `json:"test_name" validate:"required_with=TestScore,max=50"`
+	TestScore string `json:"test_score" validate:"required_with=TestName,max=20"`
+}
+
+type UpdateUserLanguages struct {
+	Languages []UserLanguageRequest `json:"languages" validate:"max=20,dive"`
+}
+
+type LanguageResponse struct {
+	Language  string `json:"language"`
+	Name      string `json:"name"`
+	Level     string `json:"level"`
+	TestName  string `json:"test_name,omitempty"`
+	TestScore string `json:"test_score,omitempty"`
+}
+
+// ProfilePrivacy echoes the owner's visibility settings; it is only included
+// when the owner reads their own profile.
+type ProfilePrivacy struct {
+	Visibility      entity.ProfileVisibility `json:"visibility"`
+	HideEmail       bool                     `json:"hide_email"`
+	HidePhoneNumber bool                     `json:"hide_phone_number"`
+}
+
+// Résumé templates offered by the PDF export.
+const (
+	ResumeTemplateClassic = "classic"
+	ResumeTemplateModern  = "modern"
+	ResumeTemplateCompact = "compact"
+)
+
+// JSONResume is the subset of the jsonresume.org schema (v1.0.0) that maps
+// onto a profile. Unknown fields are ignored on import.
+type JSONResume struct {
+	Schema    string                `json:"$schema,omitempty"`
+	Basics    JSONResumeBasics      `json:"basics"`
+	Work      []JSONResumeWork      `json:"work"`
+	Education []JSONResumeEducation `json:"education"`
+	Projects  []JSONResumeProject   `json:"projects"`
+	Skills    []JSONResumeSkill     `json:"skills,omitempty"`
+
+	Certificates []JSONResumeCertificate `json:"certificates,omitempty"`
+	Languages    []JSONResumeLanguage    `json:"languages,omitempty"`
+}
+
+type JSONResumeBasics struct {
+	Name     string              `json:"name"`
+	Label    string              `json:"label,omitempty"`
+	Image    string              `json:"image,omitempty"`
+	Email    string              `json:"email,omitempty"`
+	Phone    string              `json:"phone,omitempty"`
+	URL      string              `json:"url,omitempty"`
+	Summary  string              `json:"summary,omitempty"`
+	Location *JSONResumeLocation `json:"location,omitempty"`
+}
+
+type JSONResumeLocation struct {
+	Address     string `json:"address,omitempty"`
+	PostalCode  string `json:"postalCode,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+type JSONResumeWork struct {
+	Name       string   `json:"name,omitempty"`
+	Position   string   `json:"position"`
+	Location   string   `json:"location,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	StartDate  string   `json:"startDate"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+type JSONResumeEducation struct {
+	Institution string   `json:"institution"`
+	URL         string   `json:"url,omitempty"`
+	Area        string   `json:"area,omitempty"`
+	StudyType   string   `json:"studyType"`
+	StartDate   string   `json:"startDate"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Score       string   `json:"score,omitempty"`
+	Courses     []string `json:"courses,omitempty"`
+}
+
+type JSONResumeProject struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	StartDate   string   `json:"startDate"`
+	EndDate     string   `json:"endDate,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Entity      string   `json:"entity,omitempty"`
+}
+
+type JSONResumeSkill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+type JSONResumeCertificate struct {
+	Name   string `json:"name"`
+	Date   string `json:"date,omitempty"`
+	Issuer string `json:"issuer,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+type JSONResumeLanguage struct {
+	Language string `json:"language"`
+	Fluency  string `json:"fluency,omitempty"`
+}
+
+// Résumé import modes: merge adds entries that are not on the profile yet,
+// replace swaps the whole bio for the document's.
+const (
+	JSONResumeImportMerge   = "merge"
+	JSONResumeImportReplace = "replace"
+)
+
+type JSONResumeFieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+type JSONResumeSkippedEntry struct {
+	Section string `json:"section"`
+	Index   int    `json:"index"`
+	Reason  string `json:"reason"`
+}
+
+type JSONResumeRemoved struct {
+	Experiences int `json:"experiences"`
+	Educations  int `json:"educations"`
+	Portfolios  int `json:"portfolios"`
+}
+
+// JSONResumeImportResult describes what an import changes; a dry run returns
+// the same result without writing anything.
+type JSONResumeImportResult struct {
+	DryRun bool                    `json:"dry_run"`
+	Mode   string                  `json:"mode"`
+	Basics []JSONResumeFieldChange `json:"basics"`
+	// Skills are the names added to the profile's skill list.
+	Skills      []string                 `json:"skills"`
+	Experiences []ExperienceResponse     `json:"experiences"`
+	Educations  []EducationResponse      `json:"educations"`
+	Portfolios  []PortfolioResponse      `json:"portfolios"`
+	Removed     JSONResumeRemoved        `json:"removed"`
+	Skipped     []JSONResumeSkippedEntry `json:"skipped"`
+	Warnings    []string                 `json:"warnings"`
 }
@@ -1 +1,34 @@
 package bio
+
+import (
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrorUserNotFound          = response.New(fiber.StatusNotFound, "user not found")
+	ErrorExperienceNotFound    = response.New(fiber.StatusNotFound, "experience not found")
+	ErrorEducationNotFound     = response.New(fiber.StatusNotFound, "education not found")
+	ErrorPortfolioNotFound     = response.New(fiber.StatusNotFound, "portfolio not found")
+	ErrorCertificationNotFound = response.New(fiber.StatusNotFound, "certification not found")
+	ErrorNotBioOwner           = response.New(fiber.StatusForbidden, "you can only change your own bio")
+	ErrorProfileNotVisible     = response.New(fiber.StatusForbidden, "this profile is not visible to you")
+	ErrorCandidateOnly         = response.New(fiber.StatusForbidden, "only candidates can list profile skills and languages")
+	ErrorResumeTemplate        = response.New(fiber.StatusBadRequest, "unknown resume template")
+	ErrorResumeImportMode      = response.New(fiber.StatusBadRequest, "import mode must be merge or replace")
+	ErrorResumeTooLarge        = response.New(fiber.StatusRequestEntityTooLarge, "resume has too many entries")
+	ErrorDateFormat            = response.New(fiber.StatusBadRequest, "dates must be in YYYY-MM format")
+	ErrorDateInFuture          = response.New(fiber.StatusBadRequest, "dates must not be in the future")
+	ErrorEndDateRequired       = response.New(fiber.StatusBadRequest, "end_date is required unless is_current is set")
+	ErrorEndDateOnCurrent      = response.New(fiber.StatusBadRequest, "an entry with is_current set cannot have an end_date")
+	ErrorEndDateBeforeStart    = response.New(fiber.StatusBadRequest, "end_date must not be before start_date")
+	ErrorExpiryBeforeIssue     = response.New(fiber.StatusBadRequest, "expiry_date must not be before issue_date")
+	ErrorExpiryOnNoExpiry      = response.New(fiber.StatusBadRequest, "a certification with no_expiry set cannot have an expiry_date")
+	ErrorUnknownLanguage       = response.New(fiber.StatusBadRequest, "languages must be ISO 639 codes such as en or id")
+	ErrorLanguageLevel         = response.New(fiber.StatusBadRequest, "language levels must be one of A1, A2, B1, B2, C1, C2 or NATIVE")
+	ErrorDuplicateLanguage     = response.New(fiber.StatusBadRequest, "each language can only be listed once")
+	ErrorSelfEndorsement       = response.New(fiber.StatusBadRequest, "you cannot endorse your own skills")
+	ErrorEndorserNotUser       = response.New(fiber.StatusForbidden, "skills are endorsed from a user account, not a company account")
+	ErrorSkillNotOnProfile     = response.New(fiber.StatusNotFound, "skill is not listed on this profile")
+	ErrorEndorsementLimit      = response.New(fiber.StatusTooManyRequests, "daily endorsement limit reached, try again tomorrow")
+)
@@ -0,0 +1,229 @@
+package bioHandler
+
+import (
+	"ProjectGolang/internal/api/bio"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/response"
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"net/http"
+	"time"
+)
+
+func (h *BioHandler) CreateCertification(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	h.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing certification creation request")
+
+	userID := ctx.Params("userId")
+	if userID == "" {
+		h.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing user ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "User ID is required")
+	}
+
+	req := bio.CreateCertification{
+		Name:          ctx.FormValue("name"),
+		Issuer:        ctx.FormValue("issuer"),
+		IssueDate:     ctx.FormValue("issue_date"),
+		ExpiryDate:    ctx.FormValue("expiry_date"),
+		CredentialID:  ctx.FormValue("credential_id"),
+		CredentialURL: ctx.FormValue("credential_url"),
+	}
+
+	isHidden, err := formBool(ctx, "is_hidden")
+	if err != nil {
+		return response.WriteError(ctx, err, "Certification creation failed")
+	}
+	req.IsHidden = isHidden != nil && *isHidden
+
+	imageFile, err := ctx.FormFile("image")
+	if err != nil && !errors.Is(err, http.ErrMissingFile) {
+		h.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse certification image")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Warn("Validation failed for certification creation")
+		return err
+	}
+
+	if err := h.bioService.CreateCertification(c, h.viewer(ctx), req, userID, imageFile); err != nil {
+		return response.WriteError(ctx, err, "Certification creation failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusCreated)
+	}
+}
+
+func (h *BioHandler) GetCertificationByID(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	id := ctx.Params("id")
+	if id == "" {
+		h.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing certification ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "Certification ID is required")
+	}
+
+	certification, err := h.bioService.GetCertificationByID(c, h.viewer(ctx), id)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get certification")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(certification)
+	}
+}
+
+func (h *BioHandler) GetCertificationsByUserID(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Params("userId")
+	if userID == "" {
+		h.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing user ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "User ID is required")
+	}
+
+	certifications, err := h.bioService.GetCertificationsByUserID(c, h.viewer(ctx), userID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get certifications")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(certifications)
+	}
+}
+
+func (h *BioHandler) UpdateCertification(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	h.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing certification update request")
+
+	id := ctx.Params("id")
+	if id == "" {
+		h.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing certification ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "Certification ID is required")
+	}
+
+	req := bio.UpdateCertification{
+		Name:          ctx.FormValue("name"),
+		Issuer:        ctx.FormValue("issuer"),
+		IssueDate:     ctx.FormValue("issue_date"),
+		ExpiryDate:    ctx.FormValue("expiry_date"),
+		CredentialID:  ctx.FormValue("credential_id"),
+		CredentialURL: ctx.FormValue("credential_url"),
+	}
+
+	var err error
+	if req.IsHidden, err = formBool(ctx, "is_hidden"); err != nil {
+		return response.WriteError(ctx, err, "Certification update failed")
+	}
+	if req.NoExpiry, err = formBool(ctx, "no_expiry"); err != nil {
+		return response.WriteError(ctx, err, "Certification update failed")
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"id":         id,
+		}).Warn("Validation failed for certification update")
+		return err
+	}
+
+	imageFile, err := ctx.FormFile("image")
+	if err != nil && !errors.Is(err, http.ErrMissingFile) {
+		h.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse certification image")
+		return err
+	}
+
+	if err := h.bioService.UpdateCertification(c, h.viewer(ctx), req, id, imageFile); err != nil {
+		return response.WriteError(ctx, err, "Certification update failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
+
+func (h *BioHandler) DeleteCertification(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	id := ctx.Params("id")
+	if id == "" {
+		h.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing certification ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "Certification ID is required")
+	}
+
+	if err := h.bioService.DeleteCertification(c, h.viewer(ctx), id); err != nil {
+		return response.WriteError(ctx, err, "Certification deletion failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
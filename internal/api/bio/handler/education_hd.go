@@ -4,6 +4,7 @@ import (
 	"ProjectGolang/internal/api/bio"
 	contextPkg "ProjectGolang/pkg/context"
 	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
 	"errors"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/utils"
@@ -40,6 +41,18 @@ func (h *BioHandler) CreateEducation(ctx *fiber.Ctx) error {
 		Description:       ctx.FormValue("description"),
 	}
 
+	isHidden, err := formBool(ctx, "is_hidden")
+	if err != nil {
+		return response.WriteError(ctx, err, "Education creation failed")
+	}
+	req.IsHidden = isHidden != nil && *isHidden
+
+	isCurrent, err := formBool(ctx, "is_current")
+	if err != nil {
+		return response.WriteError(ctx, err, "Education creation failed")
+	}
+	req.IsCurrent = isCurrent != nil && *isCurrent
+
 	imageFile, err := ctx.FormFile("image")
 	if err != nil && !errors.Is(err, http.ErrMissingFile) {
 		h.log.WithFields(log.Fields{
@@ -58,11 +71,8 @@ func (h *BioHandler) CreateEducation(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	if err := h.bioService.CreateEducation(c, req, userID, imageFile); err != nil {
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Education creation failed", "err": err.Error()},
-		})
+	if err := h.bioService.CreateEducation(c, h.viewer(ctx), req, userID, imageFile); err != nil {
+		return response.WriteError(ctx, err, "Education creation failed")
 	}
 
 	select {
@@ -93,12 +103,9 @@ func (h *BioHandler) GetEducationByID(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Education ID is required")
 	}
 
-	education, err := h.bioService.GetEducationByID(c, id)
+	education, err := h.bioService.GetEducationByID(c, h.viewer(ctx), id)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Failed to get education", "err": err.Error()},
-		})
+		return response.WriteError(ctx, err, "Failed to get education")
 	}
 
 	if education.ID == "" {
@@ -135,12 +142,9 @@ func (h *BioHandler) GetEducationsByUserID(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "User ID is required")
 	}
 
-	educations, err := h.bioService.GetEducationsByUserID(c, userID)
+	educations, err := h.bioService.GetEducationsByUserID(c, h.viewer(ctx), userID)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Failed to get educations", "err": err.Error()},
-		})
+		return response.WriteError(ctx, err, "Failed to get educations")
 	}
 
 	select {
@@ -179,6 +183,17 @@ func (h *BioHandler) UpdateEducation(ctx *fiber.Ctx) error {
 		Description:       ctx.FormValue("description"),
 	}
 
+	isHidden, err := formBool(ctx, "is_hidden")
+	if err != nil {
+		return response.WriteError(ctx, err, "Education update failed")
+	}
+	req.IsHidden = isHidden
+
+	req.IsCurrent, err = formBool(ctx, "is_current")
+	if err != nil {
+		return response.WriteError(ctx, err, "Education update failed")
+	}
+
 	if err := h.validator.Struct(&req); err != nil {
 		h.log.WithFields(log.Fields{
 			"request_id": requestID,
@@ -189,7 +204,7 @@ func (h *BioHandler) UpdateEducation(ctx *fiber.Ctx) error {
 	}
 
 	var imageFile *multipart.FileHeader
-	imageFile, err := ctx.FormFile("image")
+	imageFile, err = ctx.FormFile("image")
 	if err != nil && !errors.Is(err, http.ErrMissingFile) {
 		h.log.WithFields(log.Fields{
 			"request_id": requestID,
@@ -198,16 +213,13 @@ func (h *BioHandler) UpdateEducation(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	if err := h.bioService.UpdateEducation(c, req, id, imageFile); err != nil {
+	if err := h.bioService.UpdateEducation(c, h.viewer(ctx), req, id, imageFile); err != nil {
 		if errors.Is(err, errors.New("education not found")) {
 			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"errors": fiber.Map{"message": "Education not found"},
 			})
 		}
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Education update failed", "err": err.Error()},
-		})
+		return response.WriteError(ctx, err, "Education update failed")
 	}
 
 	select {
@@ -238,7 +250,7 @@ func (h *BioHandler) DeleteEducation(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Education ID is required")
 	}
 
-	if err := h.bioService.DeleteEducation(c, id); err != nil {
+	if err := h.bioService.DeleteEducation(c, h.viewer(ctx), id); err != nil {
 		if errors.Is(err, errors.New("education not found")) {
 			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"errors": fiber.Map{"message": "Education not found"},
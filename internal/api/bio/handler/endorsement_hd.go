@@ -0,0 +1,132 @@
+package bioHandler
+
+import (
+	"ProjectGolang/internal/api/bio"
+	contextPkg "ProjectGolang/pkg/context"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *BioHandler) EndorseSkill(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing skill endorsement request")
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to endorse skill")
+	}
+
+	userID, skillID, err := h.endorsementParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := h.bioService.EndorseSkill(c, user, userID, skillID); err != nil {
+		return response.WriteError(ctx, err, "Failed to endorse skill")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusCreated)
+	}
+}
+
+func (h *BioHandler) RevokeEndorsement(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing endorsement revoke request")
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to revoke endorsement")
+	}
+
+	userID, skillID, err := h.endorsementParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := h.bioService.RevokeEndorsement(c, user, userID, skillID); err != nil {
+		return response.WriteError(ctx, err, "Failed to revoke endorsement")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *BioHandler) GetEndorsers(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	userID, skillID, err := h.endorsementParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := bio.GetEndorsers{Page: 1, PageSize: 20}
+	if err := ctx.QueryParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse endorser query parameters")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for endorser list")
+		return err
+	}
+
+	endorsers, err := h.bioService.GetEndorsers(c, h.viewer(ctx), userID, skillID, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get endorsers")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(endorsers)
+	}
+}
+
+func (h *BioHandler) endorsementParams(ctx *fiber.Ctx) (string, string, error) {
+	userID, skillID := ctx.Params("id"), ctx.Params("skillId")
+	if userID == "" || skillID == "" {
+		h.log.WithFields(log.Fields{
+			"request_id": h.middleware.GetRequestID(ctx),
+			"path":       ctx.Path(),
+		}).Warn("Missing user or skill ID in URL")
+		return "", "", fiber.NewError(fiber.StatusBadRequest, "User ID and skill ID are required")
+	}
+	return userID, skillID, nil
+}
@@ -4,6 +4,7 @@ import (
 	"ProjectGolang/internal/api/bio"
 	contextPkg "ProjectGolang/pkg/context"
 	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
 	"errors"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/utils"
@@ -41,6 +42,18 @@ func (h *BioHandler) CreateExperience(ctx *fiber.Ctx) error {
 		Description: ctx.FormValue("description"),
 	}
 
+	isHidden, err := formBool(ctx, "is_hidden")
+	if err != nil {
+		return response.WriteError(ctx, err, "Experience creation failed")
+	}
+	req.IsHidden = isHidden != nil && *isHidden
+
+	isCurrent, err := formBool(ctx, "is_current")
+	if err != nil {
+		return response.WriteError(ctx, err, "Experience creation failed")
+	}
+	req.IsCurrent = isCurrent != nil && *isCurrent
+
 	imageFile, err := ctx.FormFile("image")
 	if err != nil && !errors.Is(err, http.ErrMissingFile) {
 		h.log.WithFields(log.Fields{
@@ -59,11 +72,8 @@ func (h *BioHandler) CreateExperience(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	if err := h.bioService.CreateExperience(c, req, userID, imageFile); err != nil {
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Experience creation failed", "err": err.Error()},
-		})
+	if err := h.bioService.CreateExperience(c, h.viewer(ctx), req, userID, imageFile); err != nil {
+		return response.WriteError(ctx, err, "Experience creation failed")
 	}
 
 	select {
@@ -94,12 +104,9 @@ func (h *BioHandler) GetExperienceByID(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Experience ID is required")
 	}
 
-	experience, err := h.bioService.GetExperienceByID(c, id)
+	experience, err := h.bioService.GetExperienceByID(c, h.viewer(ctx), id)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Failed to get experience", "err": err.Error()},
-		})
+		return response.WriteError(ctx, err, "Failed to get experience")
 	}
 
 	if experience.ID == "" {
@@ -136,12 +143,9 @@ func (h *BioHandler) GetExperiencesByUserID(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "User ID is required")
 	}
 
-	experiences, err := h.bioService.GetExperiencesByUserID(c, userID)
+	experiences, err := h.bioService.GetExperiencesByUserID(c, h.viewer(ctx), userID)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Failed to get experiences", "err": err.Error()},
-		})
+		return response.WriteError(ctx, err, "Failed to get experiences")
 	}
 
 	select {
@@ -174,12 +178,24 @@ func (h *BioHandler) UpdateExperience(ctx *fiber.Ctx) error {
 
 	req := bio.UpdateExperience{
 		JobTitle:    ctx.FormValue("job_title"),
+		JobLocation: ctx.FormValue("job_location"),
 		SkillUsed:   ctx.FormValue("skill_used"),
 		StartDate:   ctx.FormValue("start_date"),
 		EndDate:     ctx.FormValue("end_date"),
 		Description: ctx.FormValue("description"),
 	}
 
+	isHidden, err := formBool(ctx, "is_hidden")
+	if err != nil {
+		return response.WriteError(ctx, err, "Experience update failed")
+	}
+	req.IsHidden = isHidden
+
+	req.IsCurrent, err = formBool(ctx, "is_current")
+	if err != nil {
+		return response.WriteError(ctx, err, "Experience update failed")
+	}
+
 	if err := h.validator.Struct(&req); err != nil {
 		h.log.WithFields(log.Fields{
 			"request_id": requestID,
@@ -190,7 +206,7 @@ func (h *BioHandler) UpdateExperience(ctx *fiber.Ctx) error {
 	}
 
 	var imageFile *multipart.FileHeader
-	imageFile, err := ctx.FormFile("image")
+	imageFile, err = ctx.FormFile("image")
 	if err != nil && !errors.Is(err, http.ErrMissingFile) {
 		h.log.WithFields(log.Fields{
 			"request_id": requestID,
@@ -199,16 +215,13 @@ func (h *BioHandler) UpdateExperience(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	if err := h.bioService.UpdateExperience(c, req, id, imageFile); err != nil {
+	if err := h.bioService.UpdateExperience(c, h.viewer(ctx), req, id, imageFile); err != nil {
 		if errors.Is(err, errors.New("experience not found")) {
 			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"errors": fiber.Map{"message": "Experience not found"},
 			})
 		}
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Experience update failed", "err": err.Error()},
-		})
+		return response.WriteError(ctx, err, "Experience update failed")
 	}
 
 	select {
@@ -239,7 +252,7 @@ func (h *BioHandler) DeleteExperience(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Experience ID is required")
 	}
 
-	if err := h.bioService.DeleteExperience(c, id); err != nil {
+	if err := h.bioService.DeleteExperience(c, h.viewer(ctx), id); err != nil {
 		if errors.Is(err, errors.New("experience not found")) {
 			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"errors": fiber.Map{"message": "Experience not found"},
@@ -0,0 +1,30 @@
+package bioHandler
+
+import (
+	"ProjectGolang/internal/entity"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// viewer returns who is reading a public bio route; it is empty for anonymous
+// requests, which the optional token middleware lets through.
+func (h *BioHandler) viewer(ctx *fiber.Ctx) entity.UserLoginData {
+	user, _ := jwtPkg.GetUserLoginData(ctx)
+	return user
+}
+
+// formBool reads an optional boolean form field; nil means it was not sent.
+func formBool(ctx *fiber.Ctx, key string) (*bool, error) {
+	value := ctx.FormValue(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("%s must be true or false", key))
+	}
+	return &parsed, nil
+}
@@ -25,29 +25,52 @@ func New(bs bioService.BioService, validate *validator.Validate, middleware midd
 }
 func (h *BioHandler) Start(srv fiber.Router) {
 	experiences := srv.Group("/experiences")
-	experiences.Get("/:id", h.GetExperienceByID)
+	experiences.Get("/:id", h.middleware.NewOptionalTokenMiddleware, h.GetExperienceByID)
 	experiences.Put("/:id", h.middleware.NewTokenMiddleware, h.UpdateExperience)
 	experiences.Delete("/:id", h.middleware.NewTokenMiddleware, h.DeleteExperience)
 
 	userExperiences := srv.Group("/users/:userId/experiences")
 	userExperiences.Post("/", h.middleware.NewTokenMiddleware, h.CreateExperience)
-	userExperiences.Get("/", h.GetExperiencesByUserID)
+	userExperiences.Get("/", h.middleware.NewOptionalTokenMiddleware, h.GetExperiencesByUserID)
 
 	educations := srv.Group("/educations")
-	educations.Get("/:id", h.GetEducationByID)
+	educations.Get("/:id", h.middleware.NewOptionalTokenMiddleware, h.GetEducationByID)
 	educations.Put("/:id", h.middleware.NewTokenMiddleware, h.UpdateEducation)
 	educations.Delete("/:id", h.middleware.NewTokenMiddleware, h.DeleteEducation)
 
 	userEducations := srv.Group("/users/:userId/educations")
 	userEducations.Post("/", h.middleware.NewTokenMiddleware, h.CreateEducation)
-	userEducations.Get("/", h.GetEducationsByUserID)
+	userEducations.Get("/", h.middleware.NewOptionalTokenMiddleware, h.GetEducationsByUserID)
 
 	portfolios := srv.Group("/portfolios")
-	portfolios.Get("/:id", h.GetPortfolioByID)
+	portfolios.Get("/:id", h.middleware.NewOptionalTokenMiddleware, h.GetPortfolioByID)
 	portfolios.Put("/:id", h.middleware.NewTokenMiddleware, h.UpdatePortfolio)
 	portfolios.Delete("/:id", h.middleware.NewTokenMiddleware, h.DeletePortfolio)
 
 	userPortfolios := srv.Group("/users/:userId/portfolios")
 	userPortfolios.Post("/", h.middleware.NewTokenMiddleware, h.CreatePortfolio)
-	userPortfolios.Get("/", h.GetPortfoliosByUserID)
+	userPortfolios.Get("/", h.middleware.NewOptionalTokenMiddleware, h.GetPortfoliosByUserID)
+
+	certifications := srv.Group("/certifications")
+	certifications.Get("/:id", h.middleware.NewOptionalTokenMiddleware, h.GetCertificationByID)
+	certifications.Put("/:id", h.middleware.NewTokenMiddleware, h.UpdateCertification)
+	certifications.Delete("/:id", h.middleware.NewTokenMiddleware, h.DeleteCertification)
+
+	userCertifications := srv.Group("/users/:userId/certifications")
+	userCertifications.Post("/", h.middleware.NewTokenMiddleware, h.CreateCertification)
+	userCertifications.Get("/", h.middleware.NewOptionalTokenMiddleware, h.GetCertificationsByUserID)
+
+	profiles := srv.Group("/users")
+	profiles.Get("/me/profile", h.middleware.NewTokenMiddleware, h.GetMyProfile)
+	profiles.Get("/:id/profile", h.middleware.NewOptionalTokenMiddleware, h.GetProfile)
+	profiles.Get("/me/resume.pdf", h.middleware.NewTokenMiddleware, h.GetMyResume)
+	profiles.Get("/:id/resume.pdf", h.middleware.NewOptionalTokenMiddleware, h.GetResume)
+	profiles.Get("/me/json_resume", h.middleware.NewTokenMiddleware, h.ExportMyJSONResume)
+	profiles.Post("/me/json_resume", h.middleware.NewTokenMiddleware, h.ImportJSONResume)
+	profiles.Get("/:id/json_resume", h.middleware.NewOptionalTokenMiddleware, h.ExportJSONResume)
+	profiles.Put("/me/skills", h.middleware.NewTokenMiddleware, h.UpdateMySkills)
+	profiles.Put("/me/languages", h.middleware.NewTokenMiddleware, h.UpdateMyLanguages)
+	profiles.Get("/:id/skills/:skillId/endorsements", h.middleware.NewOptionalTokenMiddleware, h.GetEndorsers)
+	profiles.Post("/:id/skills/:skillId/endorsements", h.middleware.NewTokenMiddleware, h.EndorseSkill)
+	profiles.Delete("/:id/skills/:skillId/endorsements", h.middleware.NewTokenMiddleware, h.RevokeEndorsement)
 }
@@ -0,0 +1,93 @@
+package bioHandler
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"strconv"
+	"time"
+)
+
+func (h *BioHandler) ExportJSONResume(ctx *fiber.Ctx) error {
+	userID := ctx.Params("id")
+	if userID == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "User ID is required")
+	}
+
+	return h.sendJSONResume(ctx, h.viewer(ctx), userID)
+}
+
+func (h *BioHandler) ExportMyJSONResume(ctx *fiber.Ctx) error {
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to export resume")
+	}
+
+	return h.sendJSONResume(ctx, user, user.ID)
+}
+
+func (h *BioHandler) sendJSONResume(ctx *fiber.Ctx, viewer entity.UserLoginData, userID string) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	resume, err := h.bioService.ExportJSONResume(c, viewer, userID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to export resume")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(resume)
+	}
+}
+
+// ImportJSONResume takes a JSON Resume document as the body; ?dry_run=true
+// previews the import and ?mode=replace swaps out the existing bio.
+func (h *BioHandler) ImportJSONResume(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Resume import failed")
+	}
+
+	dryRun := false
+	if value := ctx.Query("dry_run"); value != "" {
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			return response.WriteError(ctx, fiber.NewError(fiber.StatusBadRequest, "dry_run must be true or false"), "Resume import failed")
+		}
+	}
+
+	var resume bio.JSONResume
+	if err := ctx.BodyParser(&resume); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse JSON resume")
+		return response.WriteError(ctx, fiber.NewError(fiber.StatusBadRequest, "body must be a JSON Resume document"), "Resume import failed")
+	}
+
+	result, err := h.bioService.ImportJSONResume(c, user.ID, resume, ctx.Query("mode"), dryRun)
+	if err != nil {
+		return response.WriteError(ctx, err, "Resume import failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(result)
+	}
+}
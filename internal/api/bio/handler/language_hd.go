@@ -0,0 +1,61 @@
+package bioHandler
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// UpdateMyLanguages replaces the languages on the caller's profile; codes are
+// ISO 639 in either their two or three letter form.
+func (h *BioHandler) UpdateMyLanguages(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update languages")
+	}
+
+	if user.Role == entity.RoleRecruiter {
+		return response.WriteError(ctx, bio.ErrorCandidateOnly, "Failed to update languages")
+	}
+
+	var req bio.UpdateUserLanguages
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse languages request")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for languages request")
+		return err
+	}
+
+	languages, err := h.bioService.UpdateUserLanguages(c, user.ID, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update languages")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(languages)
+	}
+}
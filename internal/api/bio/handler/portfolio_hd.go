@@ -3,6 +3,7 @@ package bioHandler
 import (
 	"ProjectGolang/internal/api/bio"
 	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/response"
 	"errors"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/utils"
@@ -39,8 +40,21 @@ func (h *BioHandler) CreatePortfolio(ctx *fiber.Ctx) error {
 		StartDate:       ctx.FormValue("start_date"),
 		EndDate:         ctx.FormValue("end_date"),
 		Description:     ctx.FormValue("description"),
+		Skills:          ctx.FormValue("skills"),
 	}
 
+	isHidden, err := formBool(ctx, "is_hidden")
+	if err != nil {
+		return response.WriteError(ctx, err, "Portfolio creation failed")
+	}
+	req.IsHidden = isHidden != nil && *isHidden
+
+	isCurrent, err := formBool(ctx, "is_current")
+	if err != nil {
+		return response.WriteError(ctx, err, "Portfolio creation failed")
+	}
+	req.IsCurrent = isCurrent != nil && *isCurrent
+
 	imageFile, err := ctx.FormFile("image")
 	if err != nil && !errors.Is(err, http.ErrMissingFile) {
 		h.log.WithFields(logrus.Fields{
@@ -68,11 +82,8 @@ func (h *BioHandler) CreatePortfolio(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	if err := h.bioService.CreatePortfolio(c, req, userID, imageFile, descriptionImage); err != nil {
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Portfolio creation failed", "err": err.Error()},
-		})
+	if err := h.bioService.CreatePortfolio(c, h.viewer(ctx), req, userID, imageFile, descriptionImage); err != nil {
+		return response.WriteError(ctx, err, "Portfolio creation failed")
 	}
 
 	select {
@@ -103,12 +114,9 @@ func (h *BioHandler) GetPortfolioByID(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Portfolio ID is required")
 	}
 
-	portfolio, err := h.bioService.GetPortfolioByID(c, id)
+	portfolio, err := h.bioService.GetPortfolioByID(c, h.viewer(ctx), id)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Failed to get portfolio", "err": err.Error()},
-		})
+		return response.WriteError(ctx, err, "Failed to get portfolio")
 	}
 
 	if portfolio.ID == "" {
@@ -145,12 +153,9 @@ func (h *BioHandler) GetPortfoliosByUserID(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "User ID is required")
 	}
 
-	portfolios, err := h.bioService.GetPortfoliosByUserID(c, userID)
+	portfolios, err := h.bioService.GetPortfoliosByUserID(c, h.viewer(ctx), userID)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Failed to get portfolios", "err": err.Error()},
-		})
+		return response.WriteError(ctx, err, "Failed to get portfolios")
 	}
 
 	select {
@@ -189,6 +194,18 @@ func (h *BioHandler) UpdatePortfolio(ctx *fiber.Ctx) error {
 		StartDate:        ctx.FormValue("start_date"),
 		EndDate:          ctx.FormValue("end_date"),
 		Description:      ctx.FormValue("description"),
+		Skills:           ctx.FormValue("skills"),
+	}
+
+	isHidden, err := formBool(ctx, "is_hidden")
+	if err != nil {
+		return response.WriteError(ctx, err, "Portfolio update failed")
+	}
+	req.IsHidden = isHidden
+
+	req.IsCurrent, err = formBool(ctx, "is_current")
+	if err != nil {
+		return response.WriteError(ctx, err, "Portfolio update failed")
 	}
 
 	if err := h.validator.Struct(&req); err != nil {
@@ -201,7 +218,7 @@ func (h *BioHandler) UpdatePortfolio(ctx *fiber.Ctx) error {
 	}
 
 	var imageFile *multipart.FileHeader
-	imageFile, err := ctx.FormFile("image")
+	imageFile, err = ctx.FormFile("image")
 	if err != nil && !errors.Is(err, http.ErrMissingFile) {
 		h.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -220,16 +237,13 @@ func (h *BioHandler) UpdatePortfolio(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	if err := h.bioService.UpdatePortfolio(c, req, id, imageFile, descriptionFile); err != nil {
+	if err := h.bioService.UpdatePortfolio(c, h.viewer(ctx), req, id, imageFile, descriptionFile); err != nil {
 		if errors.Is(err, errors.New("portfolio not found")) {
 			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"errors": fiber.Map{"message": "Portfolio not found"},
 			})
 		}
-		status := fiber.StatusInternalServerError
-		return ctx.Status(status).JSON(fiber.Map{
-			"errors": fiber.Map{"message": "Portfolio update failed", "err": err.Error()},
-		})
+		return response.WriteError(ctx, err, "Portfolio update failed")
 	}
 
 	select {
@@ -260,7 +274,7 @@ func (h *BioHandler) DeletePortfolio(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Portfolio ID is required")
 	}
 
-	if err := h.bioService.DeletePortfolio(c, id); err != nil {
+	if err := h.bioService.DeletePortfolio(c, h.viewer(ctx), id); err != nil {
 		if errors.Is(err, errors.New("portfolio not found")) {
 			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"errors": fiber.Map{"message": "Portfolio not found"},
@@ -0,0 +1,67 @@
+package bioHandler
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *BioHandler) GetProfile(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing get profile request")
+
+	userID := ctx.Params("id")
+	if userID == "" {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing user ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "User ID is required")
+	}
+
+	return h.sendProfile(ctx, h.viewer(ctx), userID)
+}
+
+func (h *BioHandler) GetMyProfile(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing get own profile request")
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get profile")
+	}
+
+	return h.sendProfile(ctx, user, user.ID)
+}
+
+func (h *BioHandler) sendProfile(ctx *fiber.Ctx, viewer entity.UserLoginData, userID string) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	profile, err := h.bioService.GetProfile(c, viewer, userID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get profile")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(profile)
+	}
+}
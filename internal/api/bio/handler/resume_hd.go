@@ -0,0 +1,63 @@
+package bioHandler
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *BioHandler) GetResume(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing get resume request")
+
+	userID := ctx.Params("id")
+	if userID == "" {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing user ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "User ID is required")
+	}
+
+	return h.sendResume(ctx, h.viewer(ctx), userID)
+}
+
+func (h *BioHandler) GetMyResume(ctx *fiber.Ctx) error {
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get resume")
+	}
+
+	return h.sendResume(ctx, user, user.ID)
+}
+
+func (h *BioHandler) sendResume(ctx *fiber.Ctx, viewer entity.UserLoginData, userID string) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 10*time.Second)
+	defer cancel()
+
+	document, err := h.bioService.GetResumePDF(c, viewer, userID, ctx.Query("template"))
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get resume")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		ctx.Set(fiber.HeaderContentType, "application/pdf")
+		ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("inline; filename=\"resume-%s.pdf\"", userID))
+		return ctx.Status(fiber.StatusOK).Send(document)
+	}
+}
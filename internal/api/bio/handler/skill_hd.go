@@ -0,0 +1,61 @@
+package bioHandler
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// UpdateMySkills replaces the skills on the caller's profile; names are
+// matched against the skill catalogue, aliases included.
+func (h *BioHandler) UpdateMySkills(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update skills")
+	}
+
+	if user.Role == entity.RoleRecruiter {
+		return response.WriteError(ctx, bio.ErrorCandidateOnly, "Failed to update skills")
+	}
+
+	var req bio.UpdateUserSkills
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse skills request")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for skills request")
+		return err
+	}
+
+	skills, err := h.bioService.UpdateUserSkills(c, user.ID, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update skills")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(skills)
+	}
+}
@@ -0,0 +1,70 @@
+package bio
+
+import (
+	"ProjectGolang/internal/entity"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+	"strings"
+)
+
+// NormalizeLanguage maps an ISO 639 code, in either its two or three letter
+// form, onto the canonical code stored for it.
+func NormalizeLanguage(code string) (string, error) {
+	base, err := language.ParseBase(strings.TrimSpace(code))
+	if err != nil || base.String() == "und" {
+		return "", ErrorUnknownLanguage
+	}
+	return base.String(), nil
+}
+
+// LanguageName is the English name of a stored language code.
+func LanguageName(code string) string {
+	base, err := language.ParseBase(code)
+	if err != nil {
+		return code
+	}
+	return display.English.Languages().Name(base)
+}
+
+// LevelsAtLeast returns min and every level above it, for matching a
+// requirement against stored levels.
+func LevelsAtLeast(min entity.LanguageLevel) []string {
+	for i, level := range entity.LanguageLevels {
+		if level == min {
+			levels := make([]string, 0, len(entity.LanguageLevels)-i)
+			for _, above := range entity.LanguageLevels[i:] {
+				levels = append(levels, string(above))
+			}
+			return levels
+		}
+	}
+	return nil
+}
+
+// ParseLanguageRequirements reads a comma-separated filter such as
+// "en:B2,id", where a language without a level accepts any level.
+func ParseLanguageRequirements(raw string) ([]entity.LanguageRequirement, error) {
+	var requirements []entity.LanguageRequirement
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		code, level, _ := strings.Cut(part, ":")
+		normalized, err := NormalizeLanguage(code)
+		if err != nil {
+			return nil, err
+		}
+
+		requirement := entity.LanguageRequirement{Language: normalized, MinLevel: entity.LanguageLevelA1}
+		if level = strings.ToUpper(strings.TrimSpace(level)); level != "" {
+			requirement.MinLevel = entity.LanguageLevel(level)
+			if LevelsAtLeast(requirement.MinLevel) == nil {
+				return nil, ErrorLanguageLevel
+			}
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
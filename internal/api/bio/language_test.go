@@ -0,0 +1,102 @@
+package bio
+
+import (
+	"ProjectGolang/internal/entity"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeLanguage(t *testing.T) {
+	tests := []struct {
+		code    string
+		want    string
+		wantErr error
+	}{
+		{code: "en", want: "en"},
+		{code: "EN", want: "en"},
+		{code: " id ", want: "id"},
+		{code: "eng", want: "en"},
+		{code: "ind", want: "id"},
+		{code: "und", wantErr: ErrorUnknownLanguage},
+		{code: "english", wantErr: ErrorUnknownLanguage},
+		{code: "", wantErr: ErrorUnknownLanguage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, err := NormalizeLanguage(tt.code)
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("NormalizeLanguage(%q) = %q, %v, want %q, %v", tt.code, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLevelsAtLeast(t *testing.T) {
+	tests := []struct {
+		min  entity.LanguageLevel
+		want []string
+	}{
+		{min: entity.LanguageLevelA1, want: []string{"A1", "A2", "B1", "B2", "C1", "C2", "NATIVE"}},
+		{min: entity.LanguageLevelB2, want: []string{"B2", "C1", "C2", "NATIVE"}},
+		{min: entity.LanguageLevelC2, want: []string{"C2", "NATIVE"}},
+		{min: entity.LanguageLevelNative, want: []string{"NATIVE"}},
+		{min: "b2", want: nil},
+		{min: "D1", want: nil},
+		{min: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.min), func(t *testing.T) {
+			if got := LevelsAtLeast(tt.min); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LevelsAtLeast(%q) = %v, want %v", tt.min, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLanguageRequirements(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    []entity.LanguageRequirement
+		wantErr error
+	}{
+		{name: "empty", raw: "", want: nil},
+		{
+			name: "language with level",
+			raw:  "en:B2",
+			want: []entity.LanguageRequirement{{Language: "en", MinLevel: entity.LanguageLevelB2}},
+		},
+		{
+			name: "language without level accepts any",
+			raw:  "id",
+			want: []entity.LanguageRequirement{{Language: "id", MinLevel: entity.LanguageLevelA1}},
+		},
+		{
+			name: "several, normalized",
+			raw:  " EN:c1 , ind ,,jpn:native",
+			want: []entity.LanguageRequirement{
+				{Language: "en", MinLevel: entity.LanguageLevelC1},
+				{Language: "id", MinLevel: entity.LanguageLevelA1},
+				{Language: "ja", MinLevel: entity.LanguageLevelNative},
+			},
+		},
+		{name: "empty level accepts any", raw: "en:", want: []entity.LanguageRequirement{{Language: "en", MinLevel: entity.LanguageLevelA1}}},
+		{name: "unknown language", raw: "en:B2,xx-yy", wantErr: ErrorUnknownLanguage},
+		{name: "unknown level", raw: "en:D1", wantErr: ErrorLanguageLevel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLanguageRequirements(tt.raw)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseLanguageRequirements(%q) error = %v, want %v", tt.raw, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLanguageRequirements(%q) = %v, want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
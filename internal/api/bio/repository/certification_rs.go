@@ -0,0 +1,251 @@
+package bioRepository
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (r *certificationRepository) CreateCertification(ctx context.Context, certification entity.Certification) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	query, args, err := sqlx.Named(queryCreateCertification, certification)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to build SQL query for CreateCertification")
+		return err
+	}
+
+	if _, err := r.q.ExecContext(ctx, r.q.Rebind(query), args...); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Database error when creating certification")
+		return err
+	}
+
+	return nil
+}
+
+func (r *certificationRepository) GetCertificationByID(ctx context.Context, id string) (entity.Certification, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+	r.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"id":         id,
+	}).Debug("Getting certification by ID")
+
+	var cert bio.CertificationDB
+	err := r.q.QueryRowxContext(ctx, r.q.Rebind(queryGetCertificationByID), id).Scan(certificationColumns(&cert)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"id":         id,
+			}).Warn("Certification not found")
+			return entity.Certification{}, nil
+		}
+
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Database error when getting certification by ID")
+		return entity.Certification{}, err
+	}
+
+	return makeCertification(cert), nil
+}
+
+func (r *certificationRepository) GetCertificationsByUserID(ctx context.Context, userID string) ([]entity.Certification, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+	r.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"user_id":    userID,
+	}).Debug("Getting certifications by user ID")
+
+	return r.getCertifications(ctx, queryGetCertificationsByUserID, userID)
+}
+
+func (r *certificationRepository) UpdateCertification(ctx context.Context, certification entity.Certification) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	query, args, err := sqlx.Named(queryUpdateCertification, certification)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to build SQL query for UpdateCertification")
+		return err
+	}
+
+	result, err := r.q.ExecContext(ctx, r.q.Rebind(query), args...)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Database error when updating certification")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to get rows affected after update")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         certification.ID,
+		}).Warn("No certification was updated")
+		return fmt.Errorf("certification with ID %s not found", certification.ID)
+	}
+
+	return nil
+}
+
+func (r *certificationRepository) DeleteCertification(ctx context.Context, id string) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	result, err := r.q.ExecContext(ctx, r.q.Rebind(queryDeleteCertification), id)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Database error when deleting certification")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Failed to get rows affected after delete")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+		}).Warn("No certification was deleted")
+		return fmt.Errorf("certification with ID %s not found", id)
+	}
+
+	return nil
+}
+
+// GetCertificationsDueForReminder returns the certifications expiring between
+// from and to, inclusive, whose owner has not been reminded yet.
+func (r *certificationRepository) GetCertificationsDueForReminder(ctx context.Context, from time.Time, to time.Time) ([]entity.Certification, error) {
+	return r.getCertifications(ctx, queryGetCertificationsDueForReminder, from, to)
+}
+
+func (r *certificationRepository) MarkCertificationReminderSent(ctx context.Context, id string, sentAt time.Time) error {
+	if _, err := r.q.ExecContext(ctx, r.q.Rebind(queryMarkCertificationReminderSent), sentAt, id); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Database error when marking certification reminder sent")
+		return err
+	}
+
+	return nil
+}
+
+func (r *certificationRepository) getCertifications(ctx context.Context, query string, args ...interface{}) ([]entity.Certification, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	rows, err := r.q.QueryxContext(ctx, r.q.Rebind(query), args...)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Database error when getting certifications")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var certifications []entity.Certification
+	for rows.Next() {
+		var cert bio.CertificationDB
+		if err := rows.Scan(certificationColumns(&cert)...); err != nil {
+			r.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Error scanning certification row")
+			return nil, err
+		}
+		certifications = append(certifications, makeCertification(cert))
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Error iterating certification rows")
+		return nil, err
+	}
+
+	return certifications, nil
+}
+
+// certificationColumns lists scan targets in the column order of the
+// certification SELECT queries.
+func certificationColumns(cert *bio.CertificationDB) []interface{} {
+	return []interface{}{
+		&cert.ID,
+		&cert.UserID,
+		&cert.Name,
+		&cert.Issuer,
+		&cert.IssueDate,
+		&cert.ExpiryDate,
+		&cert.CredentialID,
+		&cert.CredentialURL,
+		&cert.Image,
+		&cert.IsHidden,
+		&cert.ExpiryReminderSentAt,
+		&cert.CreatedAt,
+		&cert.UpdatedAt,
+	}
+}
+
+func makeCertification(cert bio.CertificationDB) entity.Certification {
+	certification := entity.Certification{
+		ID:            cert.ID.String,
+		UserID:        cert.UserID.String,
+		Name:          cert.Name.String,
+		Issuer:        cert.Issuer.String,
+		IssueDate:     cert.IssueDate.Time,
+		CredentialID:  cert.CredentialID.String,
+		CredentialURL: cert.CredentialURL.String,
+		Image:         cert.Image.String,
+		IsHidden:      cert.IsHidden.Bool,
+		CreatedAt:     cert.CreatedAt.Time,
+		UpdatedAt:     cert.UpdatedAt.Time,
+	}
+	if cert.ExpiryDate.Valid {
+		certification.ExpiryDate = &cert.ExpiryDate.Time
+	}
+	if cert.ExpiryReminderSentAt.Valid {
+		certification.ExpiryReminderSentAt = &cert.ExpiryReminderSentAt.Time
+	}
+
+	return certification
+}
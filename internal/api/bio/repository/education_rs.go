@@ -57,7 +57,9 @@ func (r *educationRepository) GetEducationByID(ctx context.Context, id string) (
 		&edu.InstitutionalName,
 		&edu.StartDate,
 		&edu.EndDate,
+		&edu.IsCurrent,
 		&edu.Description,
+		&edu.IsHidden,
 		&edu.CreatedAt,
 		&edu.UpdatedAt,
 	)
@@ -121,7 +123,9 @@ func (r *educationRepository) GetEducationsByUserID(ctx context.Context, userID
 			&edu.InstitutionalName,
 			&edu.StartDate,
 			&edu.EndDate,
+			&edu.IsCurrent,
 			&edu.Description,
+			&edu.IsHidden,
 			&edu.CreatedAt,
 			&edu.UpdatedAt,
 		)
@@ -295,15 +299,22 @@ func (r *educationRepository) DeleteEducationsByUserID(ctx context.Context, user
 }
 
 func (r *educationRepository) makeEducation(edu bio.EducationDB) entity.Education {
-	return entity.Education{
+	education := entity.Education{
 		ID:                edu.ID.String,
+		UserID:            edu.UserID.String,
 		Image:             edu.Image.String,
 		TitleDegree:       edu.TitleDegree.String,
 		InstitutionalName: edu.InstitutionalName.String,
-		StartDate:         edu.StartDate.String,
-		EndDate:           edu.EndDate.String,
+		StartDate:         edu.StartDate.Time,
+		IsCurrent:         edu.IsCurrent.Bool,
 		Description:       edu.Description.String,
+		IsHidden:          edu.IsHidden.Bool,
 		CreatedAt:         edu.CreatedAt.Time,
 		UpdatedAt:         edu.UpdatedAt.Time,
 	}
+	if edu.EndDate.Valid {
+		education.EndDate = &edu.EndDate.Time
+	}
+
+	return education
 }
@@ -0,0 +1,259 @@
+package bioRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"database/sql"
+	"errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (r *endorsementRepository) GetEndorsement(ctx context.Context, userID string, skillID string, endorserID string) (entity.SkillEndorsement, error) {
+	var (
+		endorsement entity.SkillEndorsement
+		revokedAt   sql.NullTime
+	)
+	err := r.q.QueryRowxContext(ctx, r.q.Rebind(queryGetSkillEndorsement), userID, skillID, endorserID).
+		Scan(&endorsement.UserID, &endorsement.SkillID, &endorsement.EndorserID, &endorsement.CreatedAt, &revokedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.SkillEndorsement{}, nil
+		}
+
+		r.log.WithFields(logrus.Fields{
+			"request_id":  contextPkg.GetRequestID(ctx),
+			"error":       err.Error(),
+			"user_id":     userID,
+			"skill_id":    skillID,
+			"endorser_id": endorserID,
+		}).Error("Database error when getting skill endorsement")
+		return entity.SkillEndorsement{}, err
+	}
+
+	if revokedAt.Valid {
+		endorsement.RevokedAt = &revokedAt.Time
+	}
+	return endorsement, nil
+}
+
+// EndorseSkill records an endorsement, or restores a revoked one with a new
+// date.
+func (r *endorsementRepository) EndorseSkill(ctx context.Context, endorsement entity.SkillEndorsement) error {
+	_, err := r.q.ExecContext(ctx, r.q.Rebind(queryEndorseSkill),
+		endorsement.UserID, endorsement.SkillID, endorsement.EndorserID, endorsement.CreatedAt)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id":  contextPkg.GetRequestID(ctx),
+			"error":       err.Error(),
+			"user_id":     endorsement.UserID,
+			"skill_id":    endorsement.SkillID,
+			"endorser_id": endorsement.EndorserID,
+		}).Error("Database error when endorsing skill")
+		return err
+	}
+
+	return nil
+}
+
+// RevokeEndorsement reports whether there was an active endorsement to revoke.
+func (r *endorsementRepository) RevokeEndorsement(ctx context.Context, userID string, skillID string, endorserID string, revokedAt time.Time) (bool, error) {
+	result, err := r.q.ExecContext(ctx, r.q.Rebind(queryRevokeSkillEndorsement), revokedAt, userID, skillID, endorserID)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id":  contextPkg.GetRequestID(ctx),
+			"error":       err.Error(),
+			"user_id":     userID,
+			"skill_id":    skillID,
+			"endorser_id": endorserID,
+		}).Error("Database error when revoking skill endorsement")
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+		}).Error("Failed to get rows affected after revoke")
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// LockEndorser serializes an endorser's endorsements until the transaction
+// ends, so the daily limit cannot be raced past. It only holds inside a
+// transaction client.
+func (r *endorsementRepository) LockEndorser(ctx context.Context, endorserID string) error {
+	if _, err := r.q.ExecContext(ctx, r.q.Rebind(queryLockEndorser), endorserID); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id":  contextPkg.GetRequestID(ctx),
+			"error":       err.Error(),
+			"endorser_id": endorserID,
+		}).Error("Database error when locking endorser")
+		return err
+	}
+
+	return nil
+}
+
+// CountEndorsementsSince counts the endorsements an endorser gave or renewed
+// since the given time, revoked ones included.
+func (r *endorsementRepository) CountEndorsementsSince(ctx context.Context, endorserID string, since time.Time) (int, error) {
+	var count int
+	if err := r.q.QueryRowxContext(ctx, r.q.Rebind(queryCountEndorsementsSince), endorserID, since).Scan(&count); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id":  contextPkg.GetRequestID(ctx),
+			"error":       err.Error(),
+			"endorser_id": endorserID,
+		}).Error("Database error when counting endorsements")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetEndorsementSummaries counts the active endorsements of each skill on the
+// profile, keyed by skill ID, with up to sampleSize of the latest endorsers.
+func (r *endorsementRepository) GetEndorsementSummaries(ctx context.Context, userID string, sampleSize int) (map[string]entity.SkillEndorsementSummary, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	rows, err := r.q.QueryContext(ctx, r.q.Rebind(queryGetEndorsementSummaries), userID, sampleSize)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Database error when getting endorsement summaries")
+		return nil, err
+	}
+	defer rows.Close()
+
+	summaries := make(map[string]entity.SkillEndorsementSummary)
+	for rows.Next() {
+		var (
+			skillID   string
+			total     int
+			endorser  entity.Endorser
+			isPrivate bool
+		)
+		if err := rows.Scan(&skillID, &total, &endorser.ID, &endorser.Name, &endorser.Headline, &endorser.ProfilePicture,
+			&endorser.EndorsedAt, &isPrivate); err != nil {
+			r.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Error scanning endorsement summary row")
+			return nil, err
+		}
+
+		summary := summaries[skillID]
+		summary.SkillID = skillID
+		summary.Count = total
+		if !isPrivate {
+			summary.Endorsers = append(summary.Endorsers, endorser)
+		}
+		summaries[skillID] = summary
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Error iterating endorsement summary rows")
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+func (r *endorsementRepository) GetEndorsers(ctx context.Context, userID string, skillID string, page int, pageSize int) ([]entity.Endorser, int, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+	offset := (page - 1) * pageSize
+
+	var totalCount int
+	if err := r.q.QueryRowxContext(ctx, r.q.Rebind(queryCountEndorsers), userID, skillID).Scan(&totalCount); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+			"skill_id":   skillID,
+		}).Error("Database error when counting endorsers")
+		return nil, 0, err
+	}
+
+	rows, err := r.q.QueryContext(ctx, r.q.Rebind(queryGetEndorsers), userID, skillID, pageSize, offset)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+			"skill_id":   skillID,
+		}).Error("Database error when getting endorsers")
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var endorsers []entity.Endorser
+	for rows.Next() {
+		var endorser entity.Endorser
+		if err := rows.Scan(&endorser.ID, &endorser.Name, &endorser.Headline, &endorser.ProfilePicture, &endorser.EndorsedAt); err != nil {
+			r.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Error scanning endorser row")
+			return nil, 0, err
+		}
+		endorsers = append(endorsers, endorser)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Error iterating endorser rows")
+		return nil, 0, err
+	}
+
+	return endorsers, totalCount, nil
+}
+
+func (r *endorsementRepository) GetEndorsedSkillIDs(ctx context.Context, userID string, endorserID string) ([]string, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	rows, err := r.q.QueryContext(ctx, r.q.Rebind(queryGetEndorsedSkillIDs), userID, endorserID)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id":  requestID,
+			"error":       err.Error(),
+			"user_id":     userID,
+			"endorser_id": endorserID,
+		}).Error("Database error when getting endorsed skills")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var skillIDs []string
+	for rows.Next() {
+		var skillID string
+		if err := rows.Scan(&skillID); err != nil {
+			r.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Error scanning endorsed skill row")
+			return nil, err
+		}
+		skillIDs = append(skillIDs, skillID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Error iterating endorsed skill rows")
+		return nil, err
+	}
+
+	return skillIDs, nil
+}
@@ -54,10 +54,13 @@ func (r *experienceRepository) GetExperienceByID(ctx context.Context, id string)
 		&exp.UserID,
 		&exp.ImageURL,
 		&exp.JobTitle,
+		&exp.JobLocation,
 		&exp.SkillUsed,
 		&exp.StartDate,
 		&exp.EndDate,
+		&exp.IsCurrent,
 		&exp.Description,
+		&exp.IsHidden,
 		&exp.CreatedAt,
 		&exp.UpdatedAt,
 	)
@@ -118,10 +121,13 @@ func (r *experienceRepository) GetExperiencesByUserID(ctx context.Context, userI
 			&exp.UserID,
 			&exp.ImageURL,
 			&exp.JobTitle,
+			&exp.JobLocation,
 			&exp.SkillUsed,
 			&exp.StartDate,
 			&exp.EndDate,
+			&exp.IsCurrent,
 			&exp.Description,
+			&exp.IsHidden,
 			&exp.CreatedAt,
 			&exp.UpdatedAt,
 		)
@@ -295,16 +301,23 @@ func (r *experienceRepository) DeleteExperiencesByUserID(ctx context.Context, us
 }
 
 func (r *experienceRepository) makeExperience(exp bio.ExperienceDB) entity.Experience {
-	return entity.Experience{
+	experience := entity.Experience{
 		ID:          exp.ID.String,
 		UserID:      exp.UserID.String,
 		ImageURL:    exp.ImageURL.String,
 		JobTitle:    exp.JobTitle.String,
+		JobLocation: exp.JobLocation.String,
 		SkillUsed:   exp.SkillUsed.String,
-		StartDate:   exp.StartDate.String,
-		EndDate:     exp.EndDate.String,
+		StartDate:   exp.StartDate.Time,
+		IsCurrent:   exp.IsCurrent.Bool,
 		Description: exp.Description.String,
+		IsHidden:    exp.IsHidden.Bool,
 		CreatedAt:   exp.CreatedAt.Time,
 		UpdatedAt:   exp.UpdatedAt.Time,
 	}
+	if exp.EndDate.Valid {
+		experience.EndDate = &exp.EndDate.Time
+	}
+
+	return experience
 }
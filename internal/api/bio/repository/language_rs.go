@@ -0,0 +1,97 @@
+package bioRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"database/sql"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// ReplaceUserLanguages swaps the profile's languages for the given list; the
+// caller runs it in a transaction so readers never see an empty list.
+func (r *languageRepository) ReplaceUserLanguages(ctx context.Context, userID string, languages []entity.UserLanguage, now time.Time) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	if _, err := r.q.ExecContext(ctx, r.q.Rebind(queryDeleteUserLanguages), userID); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Database error when deleting user languages")
+		return err
+	}
+
+	if len(languages) == 0 {
+		return nil
+	}
+
+	codes := make([]string, len(languages))
+	levels := make([]string, len(languages))
+	testNames := make([]string, len(languages))
+	testScores := make([]string, len(languages))
+	for i, language := range languages {
+		codes[i] = language.Language
+		levels[i] = string(language.Level)
+		testNames[i] = language.TestName
+		testScores[i] = language.TestScore
+	}
+
+	_, err := r.q.ExecContext(ctx, r.q.Rebind(queryCreateUserLanguages), userID, now, now,
+		pq.Array(codes), pq.Array(levels), pq.Array(testNames), pq.Array(testScores))
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Database error when creating user languages")
+		return err
+	}
+
+	return nil
+}
+
+func (r *languageRepository) GetLanguagesByUserID(ctx context.Context, userID string) ([]entity.UserLanguage, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	rows, err := r.q.QueryContext(ctx, r.q.Rebind(queryGetLanguagesByUserID), userID)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Database error when getting user languages")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var languages []entity.UserLanguage
+	for rows.Next() {
+		var (
+			language  entity.UserLanguage
+			updatedAt sql.NullTime
+		)
+		if err := rows.Scan(&language.UserID, &language.Language, &language.Level, &language.TestName, &language.TestScore,
+			&language.CreatedAt, &updatedAt); err != nil {
+			r.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Error scanning user language row")
+			return nil, err
+		}
+		language.UpdatedAt = updatedAt.Time
+		languages = append(languages, language)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Error iterating user language rows")
+		return nil, err
+	}
+
+	return languages, nil
+}
@@ -59,7 +59,9 @@ func (r *portfolioRepository) GetPortfolioByID(ctx context.Context, id string) (
 		&port.ProjectLink,
 		&port.StartDate,
 		&port.EndDate,
+		&port.IsCurrent,
 		&port.Description,
+		&port.IsHidden,
 		&port.CreatedAt,
 		&port.UpdatedAt,
 	)
@@ -125,7 +127,9 @@ func (r *portfolioRepository) GetPortfoliosByUserID(ctx context.Context, userID
 			&port.ProjectLink,
 			&port.StartDate,
 			&port.EndDate,
+			&port.IsCurrent,
 			&port.Description,
+			&port.IsHidden,
 			&port.CreatedAt,
 			&port.UpdatedAt,
 		)
@@ -299,7 +303,7 @@ func (r *portfolioRepository) DeletePortfoliosByUserID(ctx context.Context, user
 }
 
 func (r *portfolioRepository) makePortfolio(port bio.PortfolioDB) entity.Portfolio {
-	return entity.Portfolio{
+	portfolio := entity.Portfolio{
 		ID:               port.ID.String,
 		UserID:           port.UserID.String,
 		Image:            port.Image.String,
@@ -307,10 +311,16 @@ func (r *portfolioRepository) makePortfolio(port bio.PortfolioDB) entity.Portfol
 		ProjectLocation:  port.ProjectLocation.String,
 		DescriptionImage: port.DescriptionImage.String,
 		ProjectLink:      port.ProjectLink.String,
-		StartDate:        port.StartDate.String,
-		EndDate:          port.EndDate.String,
+		StartDate:        port.StartDate.Time,
+		IsCurrent:        port.IsCurrent.Bool,
 		Description:      port.Description.String,
+		IsHidden:         port.IsHidden.Bool,
 		CreatedAt:        port.CreatedAt.Time,
 		UpdatedAt:        port.UpdatedAt.Time,
 	}
+	if port.EndDate.Valid {
+		portfolio.EndDate = &port.EndDate.Time
+	}
+
+	return portfolio
 }
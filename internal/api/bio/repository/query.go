@@ -3,32 +3,35 @@ package bioRepository
 const (
 	queryCreateExperience = `
     INSERT INTO experiences (
-        id, user_id, image_url, job_title, job_location, skill_used, start_date, end_date, description, created_at, updated_at
+        id, user_id, image_url, job_title, job_location, skill_used, start_date, end_date, is_current, description, is_hidden, created_at, updated_at
     ) VALUES (
-        :id, :user_id, :image_url, :job_title, :job_location, :skill_used, :start_date, :end_date, :description, :created_at, :updated_at
+        :id, :user_id, :image_url, :job_title, :job_location, :skill_used, :start_date, :end_date, :is_current, :description, :is_hidden, :created_at, :updated_at
     )`
 
 	queryGetExperienceByID = `
-    SELECT id, user_id, image_url, job_title, skill_used, start_date, end_date, description, created_at, updated_at
+    SELECT id, user_id, image_url, job_title, job_location, skill_used, start_date, end_date, is_current, description, is_hidden, created_at, updated_at
     FROM experiences
     WHERE id = ?
     `
 
 	queryGetExperiencesByUserID = `
-    SELECT id, user_id, image_url, job_title, skill_used, start_date, end_date, description, created_at, updated_at
+    SELECT id, user_id, image_url, job_title, job_location, skill_used, start_date, end_date, is_current, description, is_hidden, created_at, updated_at
     FROM experiences
     WHERE user_id = ?
-    ORDER BY start_date DESC
+    ORDER BY is_current DESC, end_date DESC NULLS LAST, start_date DESC, created_at DESC
     `
 
 	queryUpdateExperience = `
     UPDATE experiences
     SET image_url = :image_url,
         job_title = :job_title,
+        job_location = :job_location,
         skill_used = :skill_used,
         start_date = :start_date,
         end_date = :end_date,
+        is_current = :is_current,
         description = :description,
+        is_hidden = :is_hidden,
         updated_at = :updated_at
     WHERE id = :id
     `
@@ -45,22 +48,22 @@ const (
 
 	queryCreateEducation = `
     INSERT INTO educations (
-        id, image, user_id, title_degree, institutional_name, start_date, end_date, description, created_at, updated_at
+        id, image, user_id, title_degree, institutional_name, start_date, end_date, is_current, description, is_hidden, created_at, updated_at
     ) VALUES (
-        :id, :image, :user_id, :title_degree, :institutional_name, :start_date, :end_date, :description, :created_at, :updated_at
+        :id, :image, :user_id, :title_degree, :institutional_name, :start_date, :end_date, :is_current, :description, :is_hidden, :created_at, :updated_at
     )`
 
 	queryGetEducationByID = `
-    SELECT id, user_id, image, title_degree, institutional_name, start_date, end_date, description, created_at, updated_at
+    SELECT id, user_id, image, title_degree, institutional_name, start_date, end_date, is_current, description, is_hidden, created_at, updated_at
     FROM educations
     WHERE id = ?
     `
 
 	queryGetEducationsByUserID = `
-    SELECT id, user_id, image, title_degree, institutional_name, start_date, end_date, description, created_at, updated_at
+    SELECT id, user_id, image, title_degree, institutional_name, start_date, end_date, is_current, description, is_hidden, created_at, updated_at
     FROM educations
     WHERE user_id = ?
-    ORDER BY start_date DESC
+    ORDER BY is_current DESC, end_date DESC NULLS LAST, start_date DESC, created_at DESC
     `
 
 	queryUpdateEducation = `
@@ -71,7 +74,9 @@ const (
         institutional_name = :institutional_name,
         start_date = :start_date,
         end_date = :end_date,
+        is_current = :is_current,
         description = :description,
+        is_hidden = :is_hidden,
         updated_at = :updated_at
     WHERE id = :id
     `
@@ -88,22 +93,22 @@ const (
 
 	queryCreatePortfolio = `
    INSERT INTO portfolios (
-       id, user_id, image, project_name, project_location, description_image, project_link, start_date, end_date, description, created_at, updated_at
+       id, user_id, image, project_name, project_location, description_image, project_link, start_date, end_date, is_current, description, is_hidden, created_at, updated_at
    ) VALUES (
-       :id, :user_id, :image, :project_name, :project_location, :description_image, :project_link, :start_date, :end_date, :description, :created_at, :updated_at
+       :id, :user_id, :image, :project_name, :project_location, :description_image, :project_link, :start_date, :end_date, :is_current, :description, :is_hidden, :created_at, :updated_at
    )`
 
 	queryGetPortfolioByID = `
-   SELECT id, user_id, image, project_name, project_location, description_image, project_link, start_date, end_date, description, created_at, updated_at
+   SELECT id, user_id, image, project_name, project_location, description_image, project_link, start_date, end_date, is_current, description, is_hidden, created_at, updated_at
    FROM portfolios
    WHERE id = ?
    `
 
 	queryGetPortfoliosByUserID = `
-   SELECT id, user_id, image, project_name, project_location, description_image, project_link, start_date, end_date, description, created_at, updated_at
+   SELECT id, user_id, image, project_name, project_location, description_image, project_link, start_date, end_date, is_current, description, is_hidden, created_at, updated_at
    FROM portfolios
    WHERE user_id = ?
-   ORDER BY start_date DESC
+   ORDER BY is_current DESC, end_date DESC NULLS LAST, start_date DESC, created_at DESC
    `
 
 	queryUpdatePortfolio = `
@@ -116,7 +121,9 @@ const (
        project_link = :project_link,
        start_date = :start_date,
        end_date = :end_date,
+       is_current = :is_current,
        description = :description,
+       is_hidden = :is_hidden,
        updated_at = :updated_at
    WHERE id = :id
    `
@@ -129,5 +136,232 @@ const (
 	queryDeletePortfoliosByUserID = `
    DELETE FROM portfolios
    WHERE user_id = ?
+   `
+
+	queryCreateCertification = `
+   INSERT INTO certifications (
+       id, user_id, name, issuer, issue_date, expiry_date, credential_id, credential_url, image, is_hidden, created_at, updated_at
+   ) VALUES (
+       :id, :user_id, :name, :issuer, :issue_date, :expiry_date, :credential_id, :credential_url, :image, :is_hidden, :created_at, :updated_at
+   )`
+
+	queryGetCertificationByID = `
+   SELECT id, user_id, name, issuer, issue_date, expiry_date, credential_id, credential_url, image, is_hidden, expiry_reminder_sent_at, created_at, updated_at
+   FROM certifications
+   WHERE id = ?
+   `
+
+	queryGetCertificationsByUserID = `
+   SELECT id, user_id, name, issuer, issue_date, expiry_date, credential_id, credential_url, image, is_hidden, expiry_reminder_sent_at, created_at, updated_at
+   FROM certifications
+   WHERE user_id = ?
+   ORDER BY issue_date DESC, created_at DESC
+   `
+
+	queryUpdateCertification = `
+   UPDATE certifications
+   SET name = :name,
+       issuer = :issuer,
+       issue_date = :issue_date,
+       expiry_date = :expiry_date,
+       credential_id = :credential_id,
+       credential_url = :credential_url,
+       image = :image,
+       is_hidden = :is_hidden,
+       expiry_reminder_sent_at = :expiry_reminder_sent_at,
+       updated_at = :updated_at
+   WHERE id = :id
+   `
+
+	queryDeleteCertification = `
+   DELETE FROM certifications
+   WHERE id = ?
+   `
+
+	// queryGetCertificationsDueForReminder finds certifications that expire
+	// within the window and whose owner has not been reminded yet.
+	queryGetCertificationsDueForReminder = `
+   SELECT id, user_id, name, issuer, issue_date, expiry_date, credential_id, credential_url, image, is_hidden, expiry_reminder_sent_at, created_at, updated_at
+   FROM certifications
+   WHERE expiry_reminder_sent_at IS NULL
+     AND expiry_date >= ? AND expiry_date <= ?
+   ORDER BY expiry_date, id
+   `
+
+	queryMarkCertificationReminderSent = `
+   UPDATE certifications
+   SET expiry_reminder_sent_at = ?
+   WHERE id = ?
+   `
+
+	queryGetUserSkill = `
+   SELECT s.id, s.name, s.normalized_name, COALESCE(s.category, ''), s.created_at
+   FROM user_skills l
+   JOIN skills s ON s.id = l.skill_id
+   WHERE l.user_id = ? AND l.skill_id = ?
+   `
+
+	queryGetSkillEndorsement = `
+   SELECT user_id, skill_id, endorser_id, created_at, revoked_at
+   FROM skill_endorsements
+   WHERE user_id = ? AND skill_id = ? AND endorser_id = ?
+   `
+
+	queryEndorseSkill = `
+   INSERT INTO skill_endorsements (user_id, skill_id, endorser_id, created_at)
+   VALUES (?, ?, ?, ?)
+   ON CONFLICT (user_id, skill_id, endorser_id) DO UPDATE
+   SET created_at = EXCLUDED.created_at, revoked_at = NULL
+   WHERE skill_endorsements.revoked_at IS NOT NULL
+   `
+
+	queryRevokeSkillEndorsement = `
+   UPDATE skill_endorsements
+   SET revoked_at = ?
+   WHERE user_id = ? AND skill_id = ? AND endorser_id = ? AND revoked_at IS NULL
+   `
+
+	queryLockEndorser = `SELECT pg_advisory_xact_lock(hashtext('skill_endorsements:' || ?))`
+
+	queryCountEndorsementsSince = `
+   SELECT COUNT(*)
+   FROM skill_endorsements
+   WHERE endorser_id = ? AND created_at >= ?
+   `
+
+	queryGetEndorsementSummaries = `
+   SELECT skill_id, total, id, name, headline, profile_picture, created_at, is_private
+   FROM (
+       SELECT e.skill_id, e.created_at, u.id, u.name,
+              COALESCE(u.headline, '') AS headline,
+              COALESCE(u.profile_picture, '') AS profile_picture,
+              u.profile_visibility = 'private' AS is_private,
+              COUNT(*) OVER (PARTITION BY e.skill_id) AS total,
+              ROW_NUMBER() OVER (PARTITION BY e.skill_id ORDER BY u.profile_visibility = 'private', e.created_at DESC) AS rank
+       FROM skill_endorsements e
+       JOIN users u ON u.id = e.endorser_id
+       WHERE e.user_id = ? AND e.revoked_at IS NULL
+   ) ranked
+   WHERE rank <= ?
+   ORDER BY skill_id, rank
+   `
+
+	queryListedEndorsersBase = `
+   FROM skill_endorsements e
+   JOIN users u ON u.id = e.endorser_id
+   WHERE e.user_id = ? AND e.skill_id = ? AND e.revoked_at IS NULL AND u.profile_visibility <> 'private'
+   `
+
+	queryCountEndorsers = `
+   SELECT COUNT(*)
+   ` + queryListedEndorsersBase
+
+	queryGetEndorsers = `
+   SELECT u.id, u.name, COALESCE(u.headline, ''), COALESCE(u.profile_picture, ''), e.created_at
+   ` + queryListedEndorsersBase + `
+   ORDER BY e.created_at DESC
+   LIMIT ? OFFSET ?
+   `
+
+	queryGetEndorsedSkillIDs = `
+   SELECT skill_id
+   FROM skill_endorsements
+   WHERE user_id = ? AND endorser_id = ? AND revoked_at IS NULL
+   `
+
+	queryDeleteUserLanguages = `
+   DELETE FROM user_languages
+   WHERE user_id = ?
+   `
+
+	queryCreateUserLanguages = `
+   INSERT INTO user_languages (user_id, language, level, test_name, test_score, position, created_at, updated_at)
+   SELECT ?, l.language, l.level, NULLIF(l.test_name, ''), NULLIF(l.test_score, ''), l.position - 1, ?, ?
+   FROM unnest(?::VARCHAR[], ?::VARCHAR[], ?::VARCHAR[], ?::VARCHAR[]) WITH ORDINALITY AS l(language, level, test_name, test_score, position)
+   `
+
+	queryGetLanguagesByUserID = `
+   SELECT user_id, language, level, COALESCE(test_name, ''), COALESCE(test_score, ''), created_at, updated_at
+   FROM user_languages
+   WHERE user_id = ?
+   ORDER BY position
+   `
+
+	queryUpdateUserBasics = `
+   UPDATE users
+   SET name = :name,
+       headline = :headline,
+       location = :location,
+       phone_number = :phone_number,
+       updated_at = :updated_at
+   WHERE id = :id
+   `
+
+	queryDeleteExperienceSkills = `
+   DELETE FROM experience_skills
+   WHERE experience_id = ?
+   `
+
+	queryCreateExperienceSkills = `
+   INSERT INTO experience_skills (experience_id, skill_id, position)
+   SELECT ?, l.skill_id, l.position - 1
+   FROM unnest(?::VARCHAR[]) WITH ORDINALITY AS l(skill_id, position)
+   `
+
+	queryGetSkillsByExperienceIDs = `
+   SELECT l.experience_id, s.id, s.name, s.normalized_name, COALESCE(s.category, ''), s.created_at
+   FROM experience_skills l
+   JOIN skills s ON s.id = l.skill_id
+   WHERE l.experience_id = ANY(?)
+   ORDER BY l.experience_id, l.position
+   `
+
+	queryDeletePortfolioSkills = `
+   DELETE FROM portfolio_skills
+   WHERE portfolio_id = ?
+   `
+
+	queryCreatePortfolioSkills = `
+   INSERT INTO portfolio_skills (portfolio_id, skill_id, position)
+   SELECT ?, l.skill_id, l.position - 1
+   FROM unnest(?::VARCHAR[]) WITH ORDINALITY AS l(skill_id, position)
+   `
+
+	queryGetSkillsByPortfolioIDs = `
+   SELECT l.portfolio_id, s.id, s.name, s.normalized_name, COALESCE(s.category, ''), s.created_at
+   FROM portfolio_skills l
+   JOIN skills s ON s.id = l.skill_id
+   WHERE l.portfolio_id = ANY(?)
+   ORDER BY l.portfolio_id, l.position
+   `
+
+	queryDeleteUnlistedUserSkills = `
+   DELETE FROM user_skills
+   WHERE user_id = ? AND NOT (skill_id = ANY(?))
+   `
+
+	// queryUpsertUserSkills keeps rows for skills the user already had, so
+	// only their position changes.
+	queryUpsertUserSkills = `
+   INSERT INTO user_skills (user_id, skill_id, position, created_at)
+   SELECT ?, l.skill_id, l.position - 1, ?
+   FROM unnest(?::VARCHAR[]) WITH ORDINALITY AS l(skill_id, position)
+   ON CONFLICT (user_id, skill_id) DO UPDATE SET position = EXCLUDED.position
+   `
+
+	queryAddUserSkills = `
+   INSERT INTO user_skills (user_id, skill_id, position, created_at)
+   SELECT ?, l.skill_id,
+          COALESCE((SELECT MAX(position) + 1 FROM user_skills WHERE user_id = ?), 0) + l.position - 1, ?
+   FROM unnest(?::VARCHAR[]) WITH ORDINALITY AS l(skill_id, position)
+   ON CONFLICT (user_id, skill_id) DO NOTHING
+   `
+
+	queryGetSkillsByUserID = `
+   SELECT l.user_id, s.id, s.name, s.normalized_name, COALESCE(s.category, ''), s.created_at
+   FROM user_skills l
+   JOIN skills s ON s.id = l.skill_id
+   WHERE l.user_id = ?
+   ORDER BY l.position, l.created_at
    `
 )
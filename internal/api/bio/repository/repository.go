@@ -5,6 +5,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"time"
 )
 
 func New(db *sqlx.DB, log *logrus.Logger) Repository {
@@ -49,9 +50,14 @@ func (r *repository) NewClient(tx bool) (Client, error) {
 	}
 
 	client := Client{
-		Experience: &experienceRepository{q: db, log: r.log},
-		Education:  &educationRepository{q: db, log: r.log},
-		Portfolio:  &portfolioRepository{q: db, log: r.log},
+		Experience:    &experienceRepository{q: db, log: r.log},
+		Education:     &educationRepository{q: db, log: r.log},
+		Portfolio:     &portfolioRepository{q: db, log: r.log},
+		Certification: &certificationRepository{q: db, log: r.log},
+		Language:      &languageRepository{q: db, log: r.log},
+		User:          &userRepository{q: db, log: r.log},
+		Skill:         &skillRepository{q: db, log: r.log},
+		Endorsement:   &endorsementRepository{q: db, log: r.log},
 
 		Commit: func() error {
 			if tx {
@@ -97,6 +103,54 @@ type Client struct {
 		DeletePortfoliosByUserID(ctx context.Context, userID string) error
 	}
 
+	Certification interface {
+		CreateCertification(ctx context.Context, certification entity.Certification) error
+		GetCertificationByID(ctx context.Context, id string) (entity.Certification, error)
+		GetCertificationsByUserID(ctx context.Context, userID string) ([]entity.Certification, error)
+		UpdateCertification(ctx context.Context, certification entity.Certification) error
+		DeleteCertification(ctx context.Context, id string) error
+		GetCertificationsDueForReminder(ctx context.Context, from time.Time, to time.Time) ([]entity.Certification, error)
+		MarkCertificationReminderSent(ctx context.Context, id string, sentAt time.Time) error
+	}
+
+	// Language keeps a profile's languages in the order they were given.
+	Language interface {
+		ReplaceUserLanguages(ctx context.Context, userID string, languages []entity.UserLanguage, now time.Time) error
+		GetLanguagesByUserID(ctx context.Context, userID string) ([]entity.UserLanguage, error)
+	}
+
+	// User covers the profile fields a résumé import writes, so they commit in
+	// the same transaction as the bio rows.
+	User interface {
+		UpdateUserBasics(ctx context.Context, user entity.User) error
+	}
+
+	// Skill links catalogue skills to bio entries and profiles; lists are
+	// kept in the order they were given.
+	Skill interface {
+		ReplaceExperienceSkills(ctx context.Context, experienceID string, skillIDs []string) error
+		ReplacePortfolioSkills(ctx context.Context, portfolioID string, skillIDs []string) error
+		ReplaceUserSkills(ctx context.Context, userID string, skillIDs []string, now time.Time) error
+		AddUserSkills(ctx context.Context, userID string, skillIDs []string, now time.Time) error
+		GetSkillsByExperienceIDs(ctx context.Context, experienceIDs []string) (map[string][]entity.Skill, error)
+		GetSkillsByPortfolioIDs(ctx context.Context, portfolioIDs []string) (map[string][]entity.Skill, error)
+		GetSkillsByUserID(ctx context.Context, userID string) ([]entity.Skill, error)
+		GetUserSkill(ctx context.Context, userID string, skillID string) (entity.Skill, error)
+	}
+
+	// Endorsement records users vouching for skills on other profiles.
+	// Endorsers with a private profile are counted but never listed.
+	Endorsement interface {
+		GetEndorsement(ctx context.Context, userID string, skillID string, endorserID string) (entity.SkillEndorsement, error)
+		EndorseSkill(ctx context.Context, endorsement entity.SkillEndorsement) error
+		RevokeEndorsement(ctx context.Context, userID string, skillID string, endorserID string, revokedAt time.Time) (bool, error)
+		LockEndorser(ctx context.Context, endorserID string) error
+		CountEndorsementsSince(ctx context.Context, endorserID string, since time.Time) (int, error)
+		GetEndorsementSummaries(ctx context.Context, userID string, sampleSize int) (map[string]entity.SkillEndorsementSummary, error)
+		GetEndorsers(ctx context.Context, userID string, skillID string, page int, pageSize int) ([]entity.Endorser, int, error)
+		GetEndorsedSkillIDs(ctx context.Context, userID string, endorserID string) ([]string, error)
+	}
+
 	Commit   func() error
 	Rollback func() error
 }
@@ -115,3 +169,28 @@ type portfolioRepository struct {
 	q   sqlx.ExtContext
 	log *logrus.Logger
 }
+
+type certificationRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type languageRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type endorsementRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type userRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type skillRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
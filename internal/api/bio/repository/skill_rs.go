@@ -0,0 +1,179 @@
+package bioRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"database/sql"
+	"errors"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (r *skillRepository) ReplaceExperienceSkills(ctx context.Context, experienceID string, skillIDs []string) error {
+	return r.replaceLinks(ctx, queryDeleteExperienceSkills, queryCreateExperienceSkills, experienceID, skillIDs)
+}
+
+func (r *skillRepository) ReplacePortfolioSkills(ctx context.Context, portfolioID string, skillIDs []string) error {
+	return r.replaceLinks(ctx, queryDeletePortfolioSkills, queryCreatePortfolioSkills, portfolioID, skillIDs)
+}
+
+func (r *skillRepository) replaceLinks(ctx context.Context, deleteQuery, createQuery string, ownerID string, skillIDs []string) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	if _, err := r.q.ExecContext(ctx, r.q.Rebind(deleteQuery), ownerID); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"owner_id":   ownerID,
+		}).Error("Database error when deleting skill links")
+		return err
+	}
+
+	if len(skillIDs) == 0 {
+		return nil
+	}
+
+	if _, err := r.q.ExecContext(ctx, r.q.Rebind(createQuery), ownerID, pq.Array(skillIDs)); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"owner_id":   ownerID,
+		}).Error("Database error when creating skill links")
+		return err
+	}
+
+	return nil
+}
+
+// ReplaceUserSkills sets the profile's skill list. Skills the user keeps
+// retain their original row.
+func (r *skillRepository) ReplaceUserSkills(ctx context.Context, userID string, skillIDs []string, now time.Time) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	if _, err := r.q.ExecContext(ctx, r.q.Rebind(queryDeleteUnlistedUserSkills), userID, pq.Array(skillIDs)); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Database error when deleting user skills")
+		return err
+	}
+
+	if len(skillIDs) == 0 {
+		return nil
+	}
+
+	if _, err := r.q.ExecContext(ctx, r.q.Rebind(queryUpsertUserSkills), userID, now, pq.Array(skillIDs)); err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Database error when saving user skills")
+		return err
+	}
+
+	return nil
+}
+
+// AddUserSkills appends skills the profile does not list yet.
+func (r *skillRepository) AddUserSkills(ctx context.Context, userID string, skillIDs []string, now time.Time) error {
+	if len(skillIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.q.ExecContext(ctx, r.q.Rebind(queryAddUserSkills), userID, userID, now, pq.Array(skillIDs))
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Database error when adding user skills")
+		return err
+	}
+
+	return nil
+}
+
+func (r *skillRepository) GetSkillsByExperienceIDs(ctx context.Context, experienceIDs []string) (map[string][]entity.Skill, error) {
+	return r.getLinkedSkills(ctx, queryGetSkillsByExperienceIDs, pq.Array(experienceIDs))
+}
+
+func (r *skillRepository) GetSkillsByPortfolioIDs(ctx context.Context, portfolioIDs []string) (map[string][]entity.Skill, error) {
+	return r.getLinkedSkills(ctx, queryGetSkillsByPortfolioIDs, pq.Array(portfolioIDs))
+}
+
+func (r *skillRepository) GetSkillsByUserID(ctx context.Context, userID string) ([]entity.Skill, error) {
+	skills, err := r.getLinkedSkills(ctx, queryGetSkillsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return skills[userID], nil
+}
+
+// getLinkedSkills reads skills keyed by the ID of the row they are linked to.
+func (r *skillRepository) getLinkedSkills(ctx context.Context, query string, arg interface{}) (map[string][]entity.Skill, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	rows, err := r.q.QueryContext(ctx, r.q.Rebind(query), arg)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Database error when getting linked skills")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanLinkedSkills(rows, r.log)
+}
+
+func scanLinkedSkills(rows *sql.Rows, log *logrus.Logger) (map[string][]entity.Skill, error) {
+	skills := make(map[string][]entity.Skill)
+	for rows.Next() {
+		var (
+			ownerID string
+			skill   entity.Skill
+		)
+		if err := rows.Scan(&ownerID, &skill.ID, &skill.Name, &skill.NormalizedName, &skill.Category, &skill.CreatedAt); err != nil {
+			log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Error scanning linked skill row")
+			return nil, err
+		}
+		skills[ownerID] = append(skills[ownerID], skill)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Error iterating through linked skill rows")
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+// GetUserSkill returns the skill if the profile lists it, and a zero skill
+// otherwise.
+func (r *skillRepository) GetUserSkill(ctx context.Context, userID string, skillID string) (entity.Skill, error) {
+	var skill entity.Skill
+	err := r.q.QueryRowxContext(ctx, r.q.Rebind(queryGetUserSkill), userID, skillID).
+		Scan(&skill.ID, &skill.Name, &skill.NormalizedName, &skill.Category, &skill.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Skill{}, nil
+		}
+
+		r.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+			"user_id":    userID,
+			"skill_id":   skillID,
+		}).Error("Database error when getting user skill")
+		return entity.Skill{}, err
+	}
+
+	return skill, nil
+}
@@ -0,0 +1,34 @@
+package bioRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func (r *userRepository) UpdateUserBasics(ctx context.Context, user entity.User) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	query, args, err := sqlx.Named(queryUpdateUserBasics, user)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to build SQL query for UpdateUserBasics")
+		return err
+	}
+
+	_, err = r.q.ExecContext(ctx, r.q.Rebind(query), args...)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    user.ID,
+		}).Error("Database error when updating user basics")
+		return err
+	}
+
+	return nil
+}
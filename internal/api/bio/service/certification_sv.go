@@ -0,0 +1,410 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	bioRepository "ProjectGolang/internal/api/bio/repository"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/utils"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"mime/multipart"
+	"strings"
+	"time"
+)
+
+func (s *bioService) CreateCertification(ctx context.Context, viewer entity.UserLoginData, req bio.CreateCertification, userID string, image *multipart.FileHeader) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	if err := requireBioOwner(viewer, userID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"user_id":    userID,
+			"viewer_id":  viewer.ID,
+		}).Warn("Certification creation for another user")
+		return err
+	}
+
+	now := time.Now()
+	issueDate, err := parseYearMonth(req.IssueDate)
+	if err != nil {
+		return err
+	}
+
+	var expiryDate *time.Time
+	if strings.TrimSpace(req.ExpiryDate) != "" {
+		parsed, err := parseYearMonth(req.ExpiryDate)
+		if err != nil {
+			return err
+		}
+		expiryDate = &parsed
+	}
+
+	if err := validateCertificationDates(issueDate, expiryDate, now); err != nil {
+		return err
+	}
+
+	repo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	var imageURL string
+	if image != nil {
+		uploadedURL, err := s.s3.UploadFile(image, image.Filename)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+				"user_id":    userID,
+			}).Error("Failed to upload certification image")
+			return err
+		}
+		imageURL = uploadedURL
+	}
+
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to generate ULID")
+		return err
+	}
+
+	certification := entity.Certification{
+		ID:            id,
+		UserID:        userID,
+		Name:          req.Name,
+		Issuer:        req.Issuer,
+		IssueDate:     issueDate,
+		ExpiryDate:    expiryDate,
+		CredentialID:  req.CredentialID,
+		CredentialURL: req.CredentialURL,
+		Image:         imageURL,
+		IsHidden:      req.IsHidden,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := repo.Certification.CreateCertification(ctx, certification); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Failed to create certification")
+		return err
+	}
+
+	s.invalidateProfile(ctx, userID)
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"id":         certification.ID,
+		"user_id":    userID,
+		"name":       certification.Name,
+		"issuer":     certification.Issuer,
+	}).Info("Certification created successfully")
+
+	return nil
+}
+
+func (s *bioService) GetCertificationByID(ctx context.Context, viewer entity.UserLoginData, id string) (bio.CertificationResponse, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	repo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return bio.CertificationResponse{}, err
+	}
+
+	certification, err := s.getCertification(ctx, repo, id)
+	if err != nil {
+		return bio.CertificationResponse{}, err
+	}
+
+	owner, err := s.getVisibleOwner(ctx, certification.UserID, viewer)
+	if err != nil {
+		return bio.CertificationResponse{}, err
+	}
+
+	if certification.IsHidden && !bio.IsProfileOwner(owner, viewer) {
+		return bio.CertificationResponse{}, bio.ErrorCertificationNotFound
+	}
+
+	return makeCertificationResponse(certification, time.Now()), nil
+}
+
+func (s *bioService) GetCertificationsByUserID(ctx context.Context, viewer entity.UserLoginData, userID string) ([]bio.CertificationResponse, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	repo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	owner, err := s.getVisibleOwner(ctx, userID, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	certifications, err := repo.Certification.GetCertificationsByUserID(ctx, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Failed to get certifications by user ID")
+		return nil, err
+	}
+
+	isOwner := bio.IsProfileOwner(owner, viewer)
+	now := time.Now()
+	responses := make([]bio.CertificationResponse, 0, len(certifications))
+	for _, certification := range certifications {
+		if certification.IsHidden && !isOwner {
+			continue
+		}
+		responses = append(responses, makeCertificationResponse(certification, now))
+	}
+
+	return responses, nil
+}
+
+func (s *bioService) UpdateCertification(ctx context.Context, viewer entity.UserLoginData, req bio.UpdateCertification, id string, image *multipart.FileHeader) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	repo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	existing, err := s.getCertification(ctx, repo, id)
+	if err != nil {
+		return err
+	}
+
+	if err := requireBioOwner(viewer, existing.UserID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+			"viewer_id":  viewer.ID,
+		}).Warn("Certification update by another user")
+		return err
+	}
+
+	updated, err := updateCertificationChanges(existing, req, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if image != nil {
+		imageURL, err := s.s3.UploadFile(image, image.Filename)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+				"id":         id,
+			}).Error("Failed to upload certification image")
+			return err
+		}
+		updated.Image = imageURL
+	}
+
+	if err := repo.Certification.UpdateCertification(ctx, updated); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Failed to update certification")
+		return err
+	}
+
+	// The old image is only dropped once the row points at the new one.
+	if image != nil && existing.Image != "" {
+		if err := s.s3.DeleteFile(existing.Image); err != nil {
+			s.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+				"id":         id,
+			}).Error("Failed to delete existing certification image")
+		}
+	}
+
+	s.invalidateProfile(ctx, existing.UserID)
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"id":         id,
+		"name":       updated.Name,
+		"issuer":     updated.Issuer,
+	}).Info("Certification updated successfully")
+
+	return nil
+}
+
+// updateCertificationChanges applies the non-empty fields of an update. A new
+// expiry date re-arms the expiry reminder.
+func updateCertificationChanges(certification entity.Certification, req bio.UpdateCertification, now time.Time) (entity.Certification, error) {
+	updated := certification
+	updated.UpdatedAt = now
+
+	if req.Name != "" {
+		updated.Name = req.Name
+	}
+	if req.Issuer != "" {
+		updated.Issuer = req.Issuer
+	}
+	if req.CredentialID != "" {
+		updated.CredentialID = req.CredentialID
+	}
+	if req.CredentialURL != "" {
+		updated.CredentialURL = req.CredentialURL
+	}
+	if req.IsHidden != nil {
+		updated.IsHidden = *req.IsHidden
+	}
+
+	if strings.TrimSpace(req.IssueDate) != "" {
+		parsed, err := parseYearMonth(req.IssueDate)
+		if err != nil {
+			return entity.Certification{}, err
+		}
+		updated.IssueDate = parsed
+	}
+
+	if strings.TrimSpace(req.ExpiryDate) != "" {
+		if req.NoExpiry != nil && *req.NoExpiry {
+			return entity.Certification{}, bio.ErrorExpiryOnNoExpiry
+		}
+		parsed, err := parseYearMonth(req.ExpiryDate)
+		if err != nil {
+			return entity.Certification{}, err
+		}
+		updated.ExpiryDate = &parsed
+	} else if req.NoExpiry != nil && *req.NoExpiry {
+		updated.ExpiryDate = nil
+	}
+
+	if !sameMonth(certification.ExpiryDate, updated.ExpiryDate) {
+		updated.ExpiryReminderSentAt = nil
+	}
+
+	if err := validateCertificationDates(updated.IssueDate, updated.ExpiryDate, now); err != nil {
+		return entity.Certification{}, err
+	}
+	return updated, nil
+}
+
+func (s *bioService) DeleteCertification(ctx context.Context, viewer entity.UserLoginData, id string) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	repo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	existing, err := s.getCertification(ctx, repo, id)
+	if err != nil {
+		return err
+	}
+
+	if err := requireBioOwner(viewer, existing.UserID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+			"viewer_id":  viewer.ID,
+		}).Warn("Certification deletion by another user")
+		return err
+	}
+
+	if err := repo.Certification.DeleteCertification(ctx, id); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Failed to delete certification")
+		return err
+	}
+
+	if existing.Image != "" {
+		if err := s.s3.DeleteFile(existing.Image); err != nil {
+			s.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+				"id":         id,
+			}).Error("Failed to delete certification image from S3")
+		}
+	}
+
+	s.invalidateProfile(ctx, existing.UserID)
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"id":         id,
+	}).Info("Certification deleted successfully")
+
+	return nil
+}
+
+func (s *bioService) getCertification(ctx context.Context, repo bioRepository.Client, id string) (entity.Certification, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	certification, err := repo.Certification.GetCertificationByID(ctx, id)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Failed to get certification by ID")
+		return entity.Certification{}, err
+	}
+
+	if certification.ID == "" {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+		}).Warn("Certification not found")
+		return entity.Certification{}, bio.ErrorCertificationNotFound
+	}
+
+	return certification, nil
+}
+
+// validateCertificationDates checks that a certification was issued in the
+// past and does not expire before it was issued; expiry may lie ahead.
+func validateCertificationDates(issue time.Time, expiry *time.Time, now time.Time) error {
+	if issue.After(currentMonth(now)) {
+		return bio.ErrorDateInFuture
+	}
+	if expiry != nil && expiry.Before(issue) {
+		return bio.ErrorExpiryBeforeIssue
+	}
+	return nil
+}
+
+func sameMonth(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,158 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRequireBioOwner(t *testing.T) {
+	tests := []struct {
+		name   string
+		viewer entity.UserLoginData
+		want   error
+	}{
+		{name: "owner", viewer: entity.UserLoginData{ID: "user", Role: entity.RoleCandidate}},
+		{name: "another candidate", viewer: entity.UserLoginData{ID: "other", Role: entity.RoleCandidate}, want: bio.ErrorNotBioOwner},
+		{name: "recruiter with a matching id", viewer: entity.UserLoginData{ID: "user", Role: entity.RoleRecruiter}, want: bio.ErrorNotBioOwner},
+		{name: "admin", viewer: entity.UserLoginData{ID: "admin", Role: entity.RoleAdmin}, want: bio.ErrorNotBioOwner},
+		{name: "anonymous", viewer: entity.UserLoginData{}, want: bio.ErrorNotBioOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := requireBioOwner(tt.viewer, "user"); !errors.Is(err, tt.want) {
+				t.Errorf("requireBioOwner() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCertificationExpired(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		expiry *time.Time
+		want   bool
+	}{
+		{name: "no expiry", expiry: nil, want: false},
+		{name: "expires next month", expiry: ptr(month(2026, time.November)), want: false},
+		{name: "valid through the expiry month", expiry: ptr(month(2026, time.October)), want: false},
+		{name: "expired last month", expiry: ptr(month(2026, time.September)), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certification := entity.Certification{ExpiryDate: tt.expiry}
+			if got := certificationExpired(certification, now); got != tt.want {
+				t.Errorf("certificationExpired() = %v, want %v", got, tt.want)
+			}
+			if got := makeCertificationResponse(certification, now).IsExpired; got != tt.want {
+				t.Errorf("makeCertificationResponse().IsExpired = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCertificationDates(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		issue  time.Time
+		expiry *time.Time
+		want   error
+	}{
+		{name: "issued this month", issue: month(2026, time.October)},
+		{name: "issued in the future", issue: month(2026, time.November), want: bio.ErrorDateInFuture},
+		{name: "expiry in the future is fine", issue: month(2024, time.March), expiry: ptr(month(2029, time.March))},
+		{name: "expiry in the issue month", issue: month(2024, time.March), expiry: ptr(month(2024, time.March))},
+		{name: "expiry before issue", issue: month(2024, time.March), expiry: ptr(month(2024, time.February)), want: bio.ErrorExpiryBeforeIssue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateCertificationDates(tt.issue, tt.expiry, now); !errors.Is(err, tt.want) {
+				t.Errorf("validateCertificationDates() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateCertificationChanges(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+	sentAt := now.Add(-24 * time.Hour)
+	yes := true
+
+	existing := entity.Certification{
+		Name:                 "CKA",
+		Issuer:               "CNCF",
+		IssueDate:            month(2024, time.March),
+		ExpiryDate:           ptr(month(2026, time.November)),
+		ExpiryReminderSentAt: &sentAt,
+	}
+
+	tests := []struct {
+		name         string
+		req          bio.UpdateCertification
+		wantErr      error
+		wantExpiry   *time.Time
+		wantReminder bool
+	}{
+		{
+			name:         "blank fields keep the dates and the reminder",
+			req:          bio.UpdateCertification{Name: "CKAD"},
+			wantExpiry:   ptr(month(2026, time.November)),
+			wantReminder: true,
+		},
+		{
+			name:         "same expiry keeps the reminder",
+			req:          bio.UpdateCertification{ExpiryDate: "2026-11"},
+			wantExpiry:   ptr(month(2026, time.November)),
+			wantReminder: true,
+		},
+		{
+			name:       "new expiry resets the reminder",
+			req:        bio.UpdateCertification{ExpiryDate: "2029-11"},
+			wantExpiry: ptr(month(2029, time.November)),
+		},
+		{
+			name: "no_expiry clears the expiry",
+			req:  bio.UpdateCertification{NoExpiry: &yes},
+		},
+		{
+			name:    "no_expiry with an expiry date",
+			req:     bio.UpdateCertification{ExpiryDate: "2029-11", NoExpiry: &yes},
+			wantErr: bio.ErrorExpiryOnNoExpiry,
+		},
+		{
+			name:    "issue moved past the expiry",
+			req:     bio.UpdateCertification{IssueDate: "2026-10", ExpiryDate: "2025-01"},
+			wantErr: bio.ErrorExpiryBeforeIssue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := updateCertificationChanges(existing, tt.req, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("updateCertificationChanges() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !equalMonth(got.ExpiryDate, tt.wantExpiry) {
+				t.Errorf("ExpiryDate = %v, want %v", got.ExpiryDate, tt.wantExpiry)
+			}
+			if (got.ExpiryReminderSentAt != nil) != tt.wantReminder {
+				t.Errorf("ExpiryReminderSentAt = %v, want kept %v", got.ExpiryReminderSentAt, tt.wantReminder)
+			}
+			if !got.UpdatedAt.Equal(now) {
+				t.Errorf("UpdatedAt = %v, want %v", got.UpdatedAt, now)
+			}
+		})
+	}
+}
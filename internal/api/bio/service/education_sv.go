@@ -12,9 +12,24 @@ import (
 	"time"
 )
 
-func (s *bioService) CreateEducation(ctx context.Context, req bio.CreateEducation, userID string, image *multipart.FileHeader) error {
+func (s *bioService) CreateEducation(ctx context.Context, viewer entity.UserLoginData, req bio.CreateEducation, userID string, image *multipart.FileHeader) error {
 	requestID := contextPkg.GetRequestID(ctx)
 
+	if err := requireBioOwner(viewer, userID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"user_id":    userID,
+			"viewer_id":  viewer.ID,
+		}).Warn("Education creation for another user")
+		return err
+	}
+
+	now := time.Now()
+	startDate, endDate, err := newBioPeriod(req.StartDate, req.EndDate, req.IsCurrent, now)
+	if err != nil {
+		return err
+	}
+
 	bioRepo, err := s.bioRepository.NewClient(false)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -65,7 +80,6 @@ func (s *bioService) CreateEducation(ctx context.Context, req bio.CreateEducatio
 		imageURL = uploadedURL
 	}
 
-	now := time.Now()
 	id, err := utils.NewUlidFromTimestamp(now)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -81,9 +95,11 @@ func (s *bioService) CreateEducation(ctx context.Context, req bio.CreateEducatio
 		UserID:            userID,
 		TitleDegree:       req.TitleDegree,
 		InstitutionalName: req.InstitutionalName,
-		StartDate:         req.StartDate,
-		EndDate:           req.EndDate,
+		StartDate:         startDate,
+		EndDate:           endDate,
+		IsCurrent:         req.IsCurrent,
 		Description:       req.Description,
+		IsHidden:          req.IsHidden,
 		CreatedAt:         now,
 		UpdatedAt:         now,
 	}
@@ -97,6 +113,8 @@ func (s *bioService) CreateEducation(ctx context.Context, req bio.CreateEducatio
 		return err
 	}
 
+	s.invalidateProfile(ctx, userID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id":         requestID,
 		"id":                 newEducation.ID,
@@ -108,7 +126,7 @@ func (s *bioService) CreateEducation(ctx context.Context, req bio.CreateEducatio
 	return nil
 }
 
-func (s *bioService) GetEducationByID(ctx context.Context, id string) (entity.Education, error) {
+func (s *bioService) GetEducationByID(ctx context.Context, viewer entity.UserLoginData, id string) (entity.Education, error) {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	repo, err := s.bioRepository.NewClient(false)
@@ -135,7 +153,16 @@ func (s *bioService) GetEducationByID(ctx context.Context, id string) (entity.Ed
 			"request_id": requestID,
 			"id":         id,
 		}).Warn("Education not found")
-		return entity.Education{}, fmt.Errorf("education not found")
+		return entity.Education{}, bio.ErrorEducationNotFound
+	}
+
+	owner, err := s.getVisibleOwner(ctx, education.UserID, viewer)
+	if err != nil {
+		return entity.Education{}, err
+	}
+
+	if education.IsHidden && !bio.IsProfileOwner(owner, viewer) {
+		return entity.Education{}, bio.ErrorEducationNotFound
 	}
 
 	s.log.WithFields(logrus.Fields{
@@ -146,7 +173,7 @@ func (s *bioService) GetEducationByID(ctx context.Context, id string) (entity.Ed
 	return education, nil
 }
 
-func (s *bioService) GetEducationsByUserID(ctx context.Context, userID string) ([]entity.Education, error) {
+func (s *bioService) GetEducationsByUserID(ctx context.Context, viewer entity.UserLoginData, userID string) ([]entity.Education, error) {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	bioRepo, err := s.bioRepository.NewClient(false)
@@ -158,34 +185,11 @@ func (s *bioService) GetEducationsByUserID(ctx context.Context, userID string) (
 		return nil, err
 	}
 
-	authRepo, err := s.authRepository.NewClient(false)
+	owner, err := s.getVisibleOwner(ctx, userID, viewer)
 	if err != nil {
-		s.log.WithFields(logrus.Fields{
-			"request_id": requestID,
-			"error":      err.Error(),
-		}).Error("Failed to create repository")
-		return nil, err
-	}
-
-	// Check if user exists
-	user, err := authRepo.User.GetUserByID(ctx, userID)
-	if err != nil {
-		s.log.WithFields(logrus.Fields{
-			"request_id": requestID,
-			"error":      err.Error(),
-			"user_id":    userID,
-		}).Error("Failed to get user by ID")
 		return nil, err
 	}
 
-	if user.ID == "" {
-		s.log.WithFields(logrus.Fields{
-			"request_id": requestID,
-			"user_id":    userID,
-		}).Warn("User not found")
-		return nil, fmt.Errorf("user not found")
-	}
-
 	educations, err := bioRepo.Education.GetEducationsByUserID(ctx, userID)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -196,6 +200,10 @@ func (s *bioService) GetEducationsByUserID(ctx context.Context, userID string) (
 		return nil, err
 	}
 
+	if !bio.IsProfileOwner(owner, viewer) {
+		educations = visibleEducations(educations)
+	}
+
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"user_id":    userID,
@@ -205,7 +213,7 @@ func (s *bioService) GetEducationsByUserID(ctx context.Context, userID string) (
 	return educations, nil
 }
 
-func (s *bioService) UpdateEducation(ctx context.Context, req bio.UpdateEducation, id string, image *multipart.FileHeader) error {
+func (s *bioService) UpdateEducation(ctx context.Context, viewer entity.UserLoginData, req bio.UpdateEducation, id string, image *multipart.FileHeader) error {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	repo, err := s.bioRepository.NewClient(false)
@@ -235,6 +243,21 @@ func (s *bioService) UpdateEducation(ctx context.Context, req bio.UpdateEducatio
 		return fmt.Errorf("education not found")
 	}
 
+	if err := requireBioOwner(viewer, existingEducation.UserID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+			"viewer_id":  viewer.ID,
+		}).Warn("Education update by another user")
+		return err
+	}
+
+	startDate, endDate, isCurrent, err := updateBioPeriod(existingEducation.StartDate, existingEducation.EndDate, existingEducation.IsCurrent,
+		req.StartDate, req.EndDate, req.IsCurrent, time.Now())
+	if err != nil {
+		return err
+	}
+
 	if image != nil {
 		err := s.s3.DeleteFile(existingEducation.Image)
 		if err != nil {
@@ -258,6 +281,7 @@ func (s *bioService) UpdateEducation(ctx context.Context, req bio.UpdateEducatio
 	}
 
 	updatedEducation := s.updateEducationChanges(existingEducation, req)
+	updatedEducation.StartDate, updatedEducation.EndDate, updatedEducation.IsCurrent = startDate, endDate, isCurrent
 
 	if err := repo.Education.UpdateEducation(ctx, updatedEducation); err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -268,6 +292,8 @@ func (s *bioService) UpdateEducation(ctx context.Context, req bio.UpdateEducatio
 		return err
 	}
 
+	s.invalidateProfile(ctx, updatedEducation.UserID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id":         requestID,
 		"id":                 updatedEducation.ID,
@@ -290,14 +316,6 @@ func (s *bioService) updateEducationChanges(education entity.Education, req bio.
 		updatedEducation.InstitutionalName = req.InstitutionalName
 	}
 
-	if req.StartDate != "" {
-		updatedEducation.StartDate = req.StartDate
-	}
-
-	if req.EndDate != "" {
-		updatedEducation.EndDate = req.EndDate
-	}
-
 	if req.Description != "" {
 		updatedEducation.Description = req.Description
 	}
@@ -306,10 +324,14 @@ func (s *bioService) updateEducationChanges(education entity.Education, req bio.
 		updatedEducation.Image = req.Image
 	}
 
+	if req.IsHidden != nil {
+		updatedEducation.IsHidden = *req.IsHidden
+	}
+
 	return updatedEducation
 }
 
-func (s *bioService) DeleteEducation(ctx context.Context, id string) error {
+func (s *bioService) DeleteEducation(ctx context.Context, viewer entity.UserLoginData, id string) error {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	repo, err := s.bioRepository.NewClient(false)
@@ -339,6 +361,15 @@ func (s *bioService) DeleteEducation(ctx context.Context, id string) error {
 		return fmt.Errorf("education not found")
 	}
 
+	if err := requireBioOwner(viewer, existingEducation.UserID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+			"viewer_id":  viewer.ID,
+		}).Warn("Education deletion by another user")
+		return err
+	}
+
 	if err := repo.Education.DeleteEducation(ctx, id); err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -348,6 +379,8 @@ func (s *bioService) DeleteEducation(ctx context.Context, id string) error {
 		return err
 	}
 
+	s.invalidateProfile(ctx, existingEducation.UserID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"id":         id,
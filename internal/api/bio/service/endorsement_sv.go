@@ -0,0 +1,290 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	bioRepository "ProjectGolang/internal/api/bio/repository"
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/smtp"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"html"
+	"time"
+)
+
+const (
+	// endorsementDailyLimit caps how many endorsements a user can give or
+	// renew in a rolling day.
+	endorsementDailyLimit = 50
+	// endorserSampleSize is how many endorsers each profile skill lists.
+	endorserSampleSize = 3
+)
+
+// EndorseSkill vouches for a skill on another candidate's profile. Any user
+// account can endorse; company tokens cannot, since an endorser is a person
+// shown on the profile. Endorsing twice is a no-op, and the owner is only
+// notified the first time: renewing a revoked endorsement does not notify
+// them again.
+func (s *bioService) EndorseSkill(ctx context.Context, viewer entity.UserLoginData, userID string, skillID string) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	if !canEndorse(viewer) {
+		return bio.ErrorEndorserNotUser
+	}
+	if viewer.ID == userID {
+		return bio.ErrorSelfEndorsement
+	}
+
+	owner, err := s.getVisibleOwner(ctx, userID, viewer)
+	if err != nil {
+		return err
+	}
+
+	repo, err := s.bioRepository.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+	defer repo.Rollback()
+
+	endorsedSkill, err := s.getProfileSkill(ctx, repo, userID, skillID)
+	if err != nil {
+		return err
+	}
+
+	// Holding the endorser's lock until commit makes the existing check, the
+	// daily count and the insert one step for concurrent requests.
+	if err := repo.Endorsement.LockEndorser(ctx, viewer.ID); err != nil {
+		return err
+	}
+
+	existing, err := repo.Endorsement.GetEndorsement(ctx, userID, skillID, viewer.ID)
+	if err != nil {
+		return err
+	}
+	if existing.UserID != "" && existing.RevokedAt == nil {
+		return nil
+	}
+
+	now := time.Now()
+	given, err := repo.Endorsement.CountEndorsementsSince(ctx, viewer.ID, now.Add(-24*time.Hour))
+	if err != nil {
+		return err
+	}
+	if given >= endorsementDailyLimit {
+		s.log.WithFields(logrus.Fields{
+			"request_id":  requestID,
+			"endorser_id": viewer.ID,
+			"given":       given,
+		}).Warn("Endorser reached daily endorsement limit")
+		return bio.ErrorEndorsementLimit
+	}
+
+	endorsement := entity.SkillEndorsement{
+		UserID:     userID,
+		SkillID:    skillID,
+		EndorserID: viewer.ID,
+		CreatedAt:  now,
+	}
+	if err := repo.Endorsement.EndorseSkill(ctx, endorsement); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+			"skill_id":   skillID,
+		}).Error("Failed to endorse skill")
+		return err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to commit transaction")
+		return err
+	}
+
+	s.invalidateProfile(ctx, userID)
+
+	if existing.UserID == "" {
+		s.notifyEndorsement(ctx, owner, viewer, endorsedSkill)
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"request_id":  requestID,
+		"user_id":     userID,
+		"skill_id":    skillID,
+		"endorser_id": viewer.ID,
+	}).Info("Skill endorsed successfully")
+
+	return nil
+}
+
+// RevokeEndorsement withdraws the viewer's endorsement; revoking one that is
+// not there is a no-op.
+func (s *bioService) RevokeEndorsement(ctx context.Context, viewer entity.UserLoginData, userID string, skillID string) error {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	repo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	revoked, err := repo.Endorsement.RevokeEndorsement(ctx, userID, skillID, viewer.ID, time.Now())
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+			"skill_id":   skillID,
+		}).Error("Failed to revoke endorsement")
+		return err
+	}
+
+	if !revoked {
+		return nil
+	}
+
+	s.invalidateProfile(ctx, userID)
+
+	s.log.WithFields(logrus.Fields{
+		"request_id":  requestID,
+		"user_id":     userID,
+		"skill_id":    skillID,
+		"endorser_id": viewer.ID,
+	}).Info("Endorsement revoked successfully")
+
+	return nil
+}
+
+func (s *bioService) GetEndorsers(ctx context.Context, viewer entity.UserLoginData, userID string, skillID string, req bio.GetEndorsers) (bio.PaginatedEndorsersResponse, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	if _, err := s.getVisibleOwner(ctx, userID, viewer); err != nil {
+		return bio.PaginatedEndorsersResponse{}, err
+	}
+
+	repo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return bio.PaginatedEndorsersResponse{}, err
+	}
+
+	if _, err := s.getProfileSkill(ctx, repo, userID, skillID); err != nil {
+		return bio.PaginatedEndorsersResponse{}, err
+	}
+
+	endorsers, totalCount, err := repo.Endorsement.GetEndorsers(ctx, userID, skillID, req.Page, req.PageSize)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+			"skill_id":   skillID,
+		}).Error("Failed to get endorsers")
+		return bio.PaginatedEndorsersResponse{}, err
+	}
+
+	return bio.PaginatedEndorsersResponse{
+		Endorsers:   makeEndorserResponses(endorsers),
+		TotalCount:  totalCount,
+		TotalPages:  (totalCount + req.PageSize - 1) / req.PageSize,
+		CurrentPage: req.Page,
+		PageSize:    req.PageSize,
+	}, nil
+}
+
+// markViewerEndorsements flags the profile skills the viewer has endorsed.
+// It runs after the cache, since the flags differ for every viewer.
+func (s *bioService) markViewerEndorsements(ctx context.Context, profile bio.ProfileResponse, viewer entity.UserLoginData) (bio.ProfileResponse, error) {
+	if !canEndorse(viewer) || viewer.ID == profile.ID || len(profile.Skills) == 0 {
+		return profile, nil
+	}
+
+	repo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return bio.ProfileResponse{}, err
+	}
+
+	skillIDs, err := repo.Endorsement.GetEndorsedSkillIDs(ctx, profile.ID, viewer.ID)
+	if err != nil {
+		return bio.ProfileResponse{}, err
+	}
+
+	endorsed := make(map[string]bool, len(skillIDs))
+	for _, id := range skillIDs {
+		endorsed[id] = true
+	}
+
+	// The skills slice is shared with the caller's copy, so mark a new one.
+	skills := make([]bio.ProfileSkillResponse, len(profile.Skills))
+	for i, item := range profile.Skills {
+		item.EndorsedByViewer = endorsed[item.ID]
+		skills[i] = item
+	}
+	profile.Skills = skills
+
+	return profile, nil
+}
+
+// canEndorse reports whether the viewer is a user account. Recruiter and API
+// key tokens act for a company and carry its ID, which is not a user.
+func canEndorse(viewer entity.UserLoginData) bool {
+	return viewer.ID != "" && viewer.Role != entity.RoleRecruiter
+}
+
+func (s *bioService) getProfileSkill(ctx context.Context, repo bioRepository.Client, userID string, skillID string) (entity.Skill, error) {
+	listed, err := repo.Skill.GetUserSkill(ctx, userID, skillID)
+	if err != nil {
+		return entity.Skill{}, err
+	}
+
+	if listed.ID == "" {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"user_id":    userID,
+			"skill_id":   skillID,
+		}).Warn("Skill not on profile")
+		return entity.Skill{}, bio.ErrorSkillNotOnProfile
+	}
+
+	return listed, nil
+}
+
+func (s *bioService) notifyEndorsement(ctx context.Context, owner entity.User, endorser entity.UserLoginData, endorsed entity.Skill) {
+	mail := smtp.Mail{
+		To:      owner.Email,
+		Subject: fmt.Sprintf("%s endorsed your %s skill", endorser.Name, endorsed.Name),
+		Body: fmt.Sprintf("<p>Hello %s,</p><p><b>%s</b> endorsed your <b>%s</b> skill. "+
+			"Endorsements show recruiters that others vouch for what is on your profile.</p>",
+			html.EscapeString(owner.Name), html.EscapeString(endorser.Name), html.EscapeString(endorsed.Name)),
+	}
+
+	s.notifier.Notify(ctx, notification.Event{
+		RecipientID: owner.ID,
+		Type:        entity.NotificationEndorsement,
+		Title:       mail.Subject,
+		Body:        fmt.Sprintf("%s vouched for your %s skill.", endorser.Name, endorsed.Name),
+		Data: map[string]string{
+			"skill_id":    endorsed.ID,
+			"endorser_id": endorser.ID,
+		},
+		Mail: &mail,
+	})
+}
@@ -0,0 +1,117 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	"errors"
+	"golang.org/x/net/context"
+	"testing"
+	"time"
+)
+
+func TestCanEndorse(t *testing.T) {
+	tests := []struct {
+		name   string
+		viewer entity.UserLoginData
+		want   bool
+	}{
+		{name: "candidate", viewer: entity.UserLoginData{ID: "user", Role: entity.RoleCandidate}, want: true},
+		{name: "admin", viewer: entity.UserLoginData{ID: "admin", Role: entity.RoleAdmin}, want: true},
+		{name: "recruiter", viewer: entity.UserLoginData{ID: "company", Role: entity.RoleRecruiter, MemberID: "member"}, want: false},
+		{name: "anonymous", viewer: entity.UserLoginData{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canEndorse(tt.viewer); got != tt.want {
+				t.Errorf("canEndorse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// The rejected endorsers are turned away before any repository is touched,
+// so a bare service is enough.
+func TestEndorseSkillRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		viewer entity.UserLoginData
+		want   error
+	}{
+		{name: "recruiter", viewer: entity.UserLoginData{ID: "company", Role: entity.RoleRecruiter}, want: bio.ErrorEndorserNotUser},
+		{name: "anonymous", viewer: entity.UserLoginData{}, want: bio.ErrorEndorserNotUser},
+		{name: "self", viewer: entity.UserLoginData{ID: "owner", Role: entity.RoleCandidate}, want: bio.ErrorSelfEndorsement},
+		{name: "admin endorsing themselves", viewer: entity.UserLoginData{ID: "owner", Role: entity.RoleAdmin}, want: bio.ErrorSelfEndorsement},
+	}
+
+	s := &bioService{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.EndorseSkill(context.Background(), tt.viewer, "owner", "skill"); !errors.Is(err, tt.want) {
+				t.Errorf("EndorseSkill() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkViewerEndorsementsSkips(t *testing.T) {
+	profile := bio.ProfileResponse{ID: "owner", Skills: []bio.ProfileSkillResponse{{EndorsementCount: 2}}}
+
+	tests := []struct {
+		name    string
+		viewer  entity.UserLoginData
+		profile bio.ProfileResponse
+	}{
+		{name: "anonymous", viewer: entity.UserLoginData{}, profile: profile},
+		{name: "recruiter", viewer: entity.UserLoginData{ID: "company", Role: entity.RoleRecruiter}, profile: profile},
+		{name: "owner", viewer: entity.UserLoginData{ID: "owner", Role: entity.RoleCandidate}, profile: profile},
+		{name: "no skills", viewer: entity.UserLoginData{ID: "user", Role: entity.RoleCandidate}, profile: bio.ProfileResponse{ID: "owner"}},
+	}
+
+	s := &bioService{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.markViewerEndorsements(context.Background(), tt.profile, tt.viewer)
+			if err != nil {
+				t.Fatalf("markViewerEndorsements() error = %v", err)
+			}
+			for _, item := range got.Skills {
+				if item.EndorsedByViewer {
+					t.Errorf("skill %q marked as endorsed by the viewer", item.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestMakeProfileSkillResponses(t *testing.T) {
+	endorsedAt := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	skills := []entity.Skill{{ID: "go", Name: "Go"}, {ID: "sql", Name: "SQL"}}
+	endorsements := map[string]entity.SkillEndorsementSummary{
+		"go": {
+			SkillID:   "go",
+			Count:     5,
+			Endorsers: []entity.Endorser{{ID: "a", Name: "Ann", EndorsedAt: endorsedAt}, {ID: "b", Name: "Ben"}},
+		},
+	}
+
+	got := makeProfileSkillResponses(skills, endorsements)
+	if len(got) != 2 {
+		t.Fatalf("got %d skills, want 2", len(got))
+	}
+
+	if got[0].ID != "go" || got[0].EndorsementCount != 5 {
+		t.Errorf("go = %+v, want 5 endorsements", got[0])
+	}
+	if len(got[0].Endorsers) != 2 || got[0].Endorsers[0].Name != "Ann" || !got[0].Endorsers[0].EndorsedAt.Equal(endorsedAt) {
+		t.Errorf("go endorsers = %+v, want Ann then Ben", got[0].Endorsers)
+	}
+
+	if got[1].ID != "sql" || got[1].EndorsementCount != 0 {
+		t.Errorf("sql = %+v, want no endorsements", got[1])
+	}
+	// An empty list rather than null keeps the JSON shape stable.
+	if got[1].Endorsers == nil || len(got[1].Endorsers) != 0 {
+		t.Errorf("sql endorsers = %#v, want an empty list", got[1].Endorsers)
+	}
+}
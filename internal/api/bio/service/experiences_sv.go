@@ -2,6 +2,7 @@ package bioService
 
 import (
 	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/api/skill"
 	"ProjectGolang/internal/entity"
 	contextPkg "ProjectGolang/pkg/context"
 	"ProjectGolang/pkg/utils"
@@ -12,10 +13,30 @@ import (
 	"time"
 )
 
-func (s *bioService) CreateExperience(ctx context.Context, req bio.CreateExperience, userID string, image *multipart.FileHeader) error {
+func (s *bioService) CreateExperience(ctx context.Context, viewer entity.UserLoginData, req bio.CreateExperience, userID string, image *multipart.FileHeader) error {
 	requestID := contextPkg.GetRequestID(ctx)
 
-	bioRepo, err := s.bioRepository.NewClient(false)
+	if err := requireBioOwner(viewer, userID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"user_id":    userID,
+			"viewer_id":  viewer.ID,
+		}).Warn("Experience creation for another user")
+		return err
+	}
+
+	now := time.Now()
+	startDate, endDate, err := newBioPeriod(req.StartDate, req.EndDate, req.IsCurrent, now)
+	if err != nil {
+		return err
+	}
+
+	skills, err := s.resolveSkills(ctx, skill.SplitNames(req.SkillUsed))
+	if err != nil {
+		return err
+	}
+
+	bioRepo, err := s.bioRepository.NewClient(true)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -23,6 +44,7 @@ func (s *bioService) CreateExperience(ctx context.Context, req bio.CreateExperie
 		}).Error("Failed to create repository client")
 		return err
 	}
+	defer bioRepo.Rollback()
 
 	authRepo, err := s.authRepository.NewClient(false)
 	if err != nil {
@@ -65,7 +87,6 @@ func (s *bioService) CreateExperience(ctx context.Context, req bio.CreateExperie
 		imageURL = uploadedURL
 	}
 
-	now := time.Now()
 	id, err := utils.NewUlidFromTimestamp(now)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -82,9 +103,11 @@ func (s *bioService) CreateExperience(ctx context.Context, req bio.CreateExperie
 		JobTitle:    req.JobTitle,
 		JobLocation: req.JobLocation,
 		SkillUsed:   req.SkillUsed,
-		StartDate:   req.StartDate,
-		EndDate:     req.EndDate,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		IsCurrent:   req.IsCurrent,
 		Description: req.Description,
+		IsHidden:    req.IsHidden,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
@@ -98,6 +121,24 @@ func (s *bioService) CreateExperience(ctx context.Context, req bio.CreateExperie
 		return err
 	}
 
+	if len(skills) > 0 {
+		if err := s.linkEntrySkills(ctx, bioRepo, userID, id, skills, bioRepo.Skill.ReplaceExperienceSkills); err != nil {
+			return err
+		}
+	}
+
+	if err := bioRepo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Failed to commit experience creation")
+		return err
+	}
+
+	s.invalidateRecommendedJobs(ctx, userID)
+	s.invalidateProfile(ctx, userID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"id":         newExperience.ID,
@@ -108,7 +149,7 @@ func (s *bioService) CreateExperience(ctx context.Context, req bio.CreateExperie
 	return nil
 }
 
-func (s *bioService) GetExperienceByID(ctx context.Context, id string) (entity.Experience, error) {
+func (s *bioService) GetExperienceByID(ctx context.Context, viewer entity.UserLoginData, id string) (entity.Experience, error) {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	repo, err := s.bioRepository.NewClient(false)
@@ -135,9 +176,24 @@ func (s *bioService) GetExperienceByID(ctx context.Context, id string) (entity.E
 			"request_id": requestID,
 			"id":         id,
 		}).Warn("Experience not found")
-		return entity.Experience{}, fmt.Errorf("experience not found")
+		return entity.Experience{}, bio.ErrorExperienceNotFound
+	}
+
+	owner, err := s.getVisibleOwner(ctx, experience.UserID, viewer)
+	if err != nil {
+		return entity.Experience{}, err
 	}
 
+	if experience.IsHidden && !bio.IsProfileOwner(owner, viewer) {
+		return entity.Experience{}, bio.ErrorExperienceNotFound
+	}
+
+	experiences := []entity.Experience{experience}
+	if err := s.attachExperienceSkills(ctx, repo, experiences); err != nil {
+		return entity.Experience{}, err
+	}
+	experience = experiences[0]
+
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"id":         id,
@@ -147,7 +203,7 @@ func (s *bioService) GetExperienceByID(ctx context.Context, id string) (entity.E
 	return experience, nil
 }
 
-func (s *bioService) GetExperiencesByUserID(ctx context.Context, userID string) ([]entity.Experience, error) {
+func (s *bioService) GetExperiencesByUserID(ctx context.Context, viewer entity.UserLoginData, userID string) ([]entity.Experience, error) {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	bioRepo, err := s.bioRepository.NewClient(false)
@@ -159,41 +215,26 @@ func (s *bioService) GetExperiencesByUserID(ctx context.Context, userID string)
 		return nil, err
 	}
 
-	authRepo, err := s.authRepository.NewClient(false)
+	owner, err := s.getVisibleOwner(ctx, userID, viewer)
 	if err != nil {
-		s.log.WithFields(logrus.Fields{
-			"request_id": requestID,
-			"error":      err.Error(),
-		}).Error("Failed to create repository")
 		return nil, err
 	}
 
-	// Check if user exists
-	user, err := authRepo.User.GetUserByID(ctx, userID)
+	experiences, err := bioRepo.Experience.GetExperiencesByUserID(ctx, userID)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
 			"error":      err.Error(),
 			"user_id":    userID,
-		}).Error("Failed to get user by ID")
+		}).Error("Failed to get experiences by user ID")
 		return nil, err
 	}
 
-	if user.ID == "" {
-		s.log.WithFields(logrus.Fields{
-			"request_id": requestID,
-			"user_id":    userID,
-		}).Warn("User not found")
-		return nil, fmt.Errorf("user not found")
+	if !bio.IsProfileOwner(owner, viewer) {
+		experiences = visibleExperiences(experiences)
 	}
 
-	experiences, err := bioRepo.Experience.GetExperiencesByUserID(ctx, userID)
-	if err != nil {
-		s.log.WithFields(logrus.Fields{
-			"request_id": requestID,
-			"error":      err.Error(),
-			"user_id":    userID,
-		}).Error("Failed to get experiences by user ID")
+	if err := s.attachExperienceSkills(ctx, bioRepo, experiences); err != nil {
 		return nil, err
 	}
 
@@ -206,10 +247,19 @@ func (s *bioService) GetExperiencesByUserID(ctx context.Context, userID string)
 	return experiences, nil
 }
 
-func (s *bioService) UpdateExperience(ctx context.Context, req bio.UpdateExperience, id string, image *multipart.FileHeader) error {
+func (s *bioService) UpdateExperience(ctx context.Context, viewer entity.UserLoginData, req bio.UpdateExperience, id string, image *multipart.FileHeader) error {
 	requestID := contextPkg.GetRequestID(ctx)
 
-	repo, err := s.bioRepository.NewClient(false)
+	// An empty skill_used leaves the linked skills as they are.
+	var skills []entity.Skill
+	if req.SkillUsed != "" {
+		var err error
+		if skills, err = s.resolveSkills(ctx, skill.SplitNames(req.SkillUsed)); err != nil {
+			return err
+		}
+	}
+
+	repo, err := s.bioRepository.NewClient(true)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -217,6 +267,7 @@ func (s *bioService) UpdateExperience(ctx context.Context, req bio.UpdateExperie
 		}).Error("Failed to create repository client")
 		return err
 	}
+	defer repo.Rollback()
 
 	existingExperience, err := repo.Experience.GetExperienceByID(ctx, id)
 	if err != nil {
@@ -236,6 +287,21 @@ func (s *bioService) UpdateExperience(ctx context.Context, req bio.UpdateExperie
 		return fmt.Errorf("experience not found")
 	}
 
+	if err := requireBioOwner(viewer, existingExperience.UserID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+			"viewer_id":  viewer.ID,
+		}).Warn("Experience update by another user")
+		return err
+	}
+
+	startDate, endDate, isCurrent, err := updateBioPeriod(existingExperience.StartDate, existingExperience.EndDate, existingExperience.IsCurrent,
+		req.StartDate, req.EndDate, req.IsCurrent, time.Now())
+	if err != nil {
+		return err
+	}
+
 	if image != nil {
 		err := s.s3.DeleteFile(existingExperience.ImageURL)
 		if err != nil {
@@ -260,6 +326,7 @@ func (s *bioService) UpdateExperience(ctx context.Context, req bio.UpdateExperie
 	}
 
 	updatedExperience := s.updateExperienceChanges(existingExperience, req)
+	updatedExperience.StartDate, updatedExperience.EndDate, updatedExperience.IsCurrent = startDate, endDate, isCurrent
 
 	if err := repo.Experience.UpdateExperience(ctx, updatedExperience); err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -270,6 +337,24 @@ func (s *bioService) UpdateExperience(ctx context.Context, req bio.UpdateExperie
 		return err
 	}
 
+	if req.SkillUsed != "" {
+		if err := s.linkEntrySkills(ctx, repo, updatedExperience.UserID, id, skills, repo.Skill.ReplaceExperienceSkills); err != nil {
+			return err
+		}
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Failed to commit experience update")
+		return err
+	}
+
+	s.invalidateRecommendedJobs(ctx, updatedExperience.UserID)
+	s.invalidateProfile(ctx, updatedExperience.UserID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"id":         updatedExperience.ID,
@@ -288,16 +373,12 @@ func (s *bioService) updateExperienceChanges(experience entity.Experience, req b
 		updatedExperience.JobTitle = req.JobTitle
 	}
 
-	if req.SkillUsed != "" {
-		updatedExperience.SkillUsed = req.SkillUsed
+	if req.JobLocation != "" {
+		updatedExperience.JobLocation = req.JobLocation
 	}
 
-	if req.StartDate != "" {
-		updatedExperience.StartDate = req.StartDate
-	}
-
-	if req.EndDate != "" {
-		updatedExperience.EndDate = req.EndDate
+	if req.SkillUsed != "" {
+		updatedExperience.SkillUsed = req.SkillUsed
 	}
 
 	if req.Description != "" {
@@ -308,10 +389,14 @@ func (s *bioService) updateExperienceChanges(experience entity.Experience, req b
 		updatedExperience.ImageURL = req.ImageURL
 	}
 
+	if req.IsHidden != nil {
+		updatedExperience.IsHidden = *req.IsHidden
+	}
+
 	return updatedExperience
 }
 
-func (s *bioService) DeleteExperience(ctx context.Context, id string) error {
+func (s *bioService) DeleteExperience(ctx context.Context, viewer entity.UserLoginData, id string) error {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	repo, err := s.bioRepository.NewClient(false)
@@ -341,6 +426,15 @@ func (s *bioService) DeleteExperience(ctx context.Context, id string) error {
 		return fmt.Errorf("experience not found")
 	}
 
+	if err := requireBioOwner(viewer, existingExperience.UserID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+			"viewer_id":  viewer.ID,
+		}).Warn("Experience deletion by another user")
+		return err
+	}
+
 	if err := repo.Experience.DeleteExperience(ctx, id); err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -350,6 +444,9 @@ func (s *bioService) DeleteExperience(ctx context.Context, id string) error {
 		return err
 	}
 
+	s.invalidateRecommendedJobs(ctx, existingExperience.UserID)
+	s.invalidateProfile(ctx, existingExperience.UserID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"id":         id,
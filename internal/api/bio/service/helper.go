@@ -0,0 +1,458 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/api/skill"
+	"ProjectGolang/internal/entity"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// requireBioOwner lets only the candidate themselves change their bio.
+func requireBioOwner(viewer entity.UserLoginData, userID string) error {
+	if !bio.IsProfileOwner(entity.User{ID: userID}, viewer) {
+		return bio.ErrorNotBioOwner
+	}
+	return nil
+}
+
+// parseYearMonth reads a YYYY-MM bio date as the first day of that month.
+func parseYearMonth(value string) (time.Time, error) {
+	t, err := time.Parse(bio.YearMonthLayout, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, bio.ErrorDateFormat
+	}
+	return t, nil
+}
+
+func currentMonth(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// validateBioPeriod checks a bio entry's dates: ongoing entries have no end
+// date, finished ones end no earlier than they start, and nothing lies in the
+// future.
+func validateBioPeriod(start time.Time, end *time.Time, isCurrent bool, now time.Time) error {
+	month := currentMonth(now)
+	if start.After(month) {
+		return bio.ErrorDateInFuture
+	}
+
+	if isCurrent {
+		if end != nil {
+			return bio.ErrorEndDateOnCurrent
+		}
+		return nil
+	}
+
+	if end == nil {
+		return bio.ErrorEndDateRequired
+	}
+	if end.Before(start) {
+		return bio.ErrorEndDateBeforeStart
+	}
+	if end.After(month) {
+		return bio.ErrorDateInFuture
+	}
+	return nil
+}
+
+// newBioPeriod reads the dates of a new bio entry.
+func newBioPeriod(startValue, endValue string, isCurrent bool, now time.Time) (time.Time, *time.Time, error) {
+	start, err := parseYearMonth(startValue)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	var end *time.Time
+	if strings.TrimSpace(endValue) != "" {
+		parsed, err := parseYearMonth(endValue)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		end = &parsed
+	}
+
+	if err := validateBioPeriod(start, end, isCurrent, now); err != nil {
+		return time.Time{}, nil, err
+	}
+	return start, end, nil
+}
+
+// updateBioPeriod applies the date fields of an update onto an entry's current
+// period. Sending an end date finishes an ongoing entry, and setting
+// is_current clears the end date.
+func updateBioPeriod(start time.Time, end *time.Time, isCurrent bool, startValue, endValue string, current *bool, now time.Time) (time.Time, *time.Time, bool, error) {
+	if strings.TrimSpace(startValue) != "" {
+		parsed, err := parseYearMonth(startValue)
+		if err != nil {
+			return time.Time{}, nil, false, err
+		}
+		start = parsed
+	}
+
+	if strings.TrimSpace(endValue) != "" {
+		if current != nil && *current {
+			return time.Time{}, nil, false, bio.ErrorEndDateOnCurrent
+		}
+		parsed, err := parseYearMonth(endValue)
+		if err != nil {
+			return time.Time{}, nil, false, err
+		}
+		end, isCurrent = &parsed, false
+	}
+
+	if current != nil {
+		isCurrent = *current
+		if isCurrent {
+			end = nil
+		}
+	}
+
+	if err := validateBioPeriod(start, end, isCurrent, now); err != nil {
+		return time.Time{}, nil, false, err
+	}
+	return start, end, isCurrent, nil
+}
+
+// bioEntryBefore orders bio entries most recent first: ongoing entries lead,
+// then the latest end date, then the latest start, matching the ORDER BY of
+// the list queries.
+func bioEntryBefore(startA time.Time, endA *time.Time, currentA bool, createdA time.Time,
+	startB time.Time, endB *time.Time, currentB bool, createdB time.Time) bool {
+	if currentA != currentB {
+		return currentA
+	}
+	if (endA == nil) != (endB == nil) {
+		return endA != nil
+	}
+	if endA != nil && !endA.Equal(*endB) {
+		return endA.After(*endB)
+	}
+	if !startA.Equal(startB) {
+		return startA.After(startB)
+	}
+	return createdA.After(createdB)
+}
+
+func sortExperiences(experiences []entity.Experience) {
+	sort.SliceStable(experiences, func(i, j int) bool {
+		a, b := experiences[i], experiences[j]
+		return bioEntryBefore(a.StartDate, a.EndDate, a.IsCurrent, a.CreatedAt, b.StartDate, b.EndDate, b.IsCurrent, b.CreatedAt)
+	})
+}
+
+func sortEducations(educations []entity.Education) {
+	sort.SliceStable(educations, func(i, j int) bool {
+		a, b := educations[i], educations[j]
+		return bioEntryBefore(a.StartDate, a.EndDate, a.IsCurrent, a.CreatedAt, b.StartDate, b.EndDate, b.IsCurrent, b.CreatedAt)
+	})
+}
+
+func sortPortfolios(portfolios []entity.Portfolio) {
+	sort.SliceStable(portfolios, func(i, j int) bool {
+		a, b := portfolios[i], portfolios[j]
+		return bioEntryBefore(a.StartDate, a.EndDate, a.IsCurrent, a.CreatedAt, b.StartDate, b.EndDate, b.IsCurrent, b.CreatedAt)
+	})
+}
+
+// yearsOfExperience totals the months covered by the experiences, counting
+// overlapping jobs once, as years rounded to one decimal. Both the start and
+// end month count, and ongoing jobs run to the current month.
+func yearsOfExperience(experiences []bio.ExperienceResponse, now time.Time) float64 {
+	type span struct{ from, to int }
+	monthIndex := func(t time.Time) int { return t.Year()*12 + int(t.Month()) - 1 }
+
+	spans := make([]span, 0, len(experiences))
+	for _, experience := range experiences {
+		start, err := parseYearMonth(experience.StartDate)
+		if err != nil {
+			continue
+		}
+		end := start
+		if experience.IsCurrent {
+			end = currentMonth(now)
+		} else if parsed, err := parseYearMonth(experience.EndDate); err == nil {
+			end = parsed
+		}
+		if end.Before(start) {
+			continue
+		}
+		spans = append(spans, span{from: monthIndex(start), to: monthIndex(end) + 1})
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].from < spans[j].from })
+
+	months, reached := 0, 0
+	for i, s := range spans {
+		if i > 0 && s.from < reached {
+			s.from = reached
+		}
+		if s.to > s.from {
+			months += s.to - s.from
+		}
+		if s.to > reached {
+			reached = s.to
+		}
+	}
+
+	return math.Round(float64(months)/12*10) / 10
+}
+
+func makeExperienceResponse(experience entity.Experience) bio.ExperienceResponse {
+	return bio.ExperienceResponse{
+		ID:          experience.ID,
+		ImageURL:    experience.ImageURL,
+		JobTitle:    experience.JobTitle,
+		JobLocation: experience.JobLocation,
+		SkillUsed:   experience.SkillUsed,
+		StartDate:   experience.StartDate.Format(bio.YearMonthLayout),
+		EndDate:     bio.FormatYearMonth(experience.EndDate),
+		IsCurrent:   experience.IsCurrent,
+		Description: experience.Description,
+		IsHidden:    experience.IsHidden,
+		CreatedAt:   experience.CreatedAt,
+		UpdatedAt:   experience.UpdatedAt,
+		Skills:      skill.MakeSkillResponses(experience.Skills),
+	}
+}
+
+func makeEducationResponse(education entity.Education) bio.EducationResponse {
+	return bio.EducationResponse{
+		ID:                education.ID,
+		Image:             education.Image,
+		TitleDegree:       education.TitleDegree,
+		InstitutionalName: education.InstitutionalName,
+		StartDate:         education.StartDate.Format(bio.YearMonthLayout),
+		EndDate:           bio.FormatYearMonth(education.EndDate),
+		IsCurrent:         education.IsCurrent,
+		Description:       education.Description,
+		IsHidden:          education.IsHidden,
+		CreatedAt:         education.CreatedAt,
+		UpdatedAt:         education.UpdatedAt,
+	}
+}
+
+func makePortfolioResponse(portfolio entity.Portfolio) bio.PortfolioResponse {
+	return bio.PortfolioResponse{
+		ID:               portfolio.ID,
+		Image:            portfolio.Image,
+		ProjectName:      portfolio.ProjectName,
+		ProjectLocation:  portfolio.ProjectLocation,
+		DescriptionImage: portfolio.DescriptionImage,
+		ProjectLink:      portfolio.ProjectLink,
+		StartDate:        portfolio.StartDate.Format(bio.YearMonthLayout),
+		EndDate:          bio.FormatYearMonth(portfolio.EndDate),
+		IsCurrent:        portfolio.IsCurrent,
+		Description:      portfolio.Description,
+		IsHidden:         portfolio.IsHidden,
+		CreatedAt:        portfolio.CreatedAt,
+		UpdatedAt:        portfolio.UpdatedAt,
+		Skills:           skill.MakeSkillResponses(portfolio.Skills),
+	}
+}
+
+// certificationExpired reports whether a certification's expiry month has
+// passed; it stays valid through the month itself.
+func certificationExpired(certification entity.Certification, now time.Time) bool {
+	return certification.ExpiryDate != nil && certification.ExpiryDate.Before(currentMonth(now))
+}
+
+func makeCertificationResponse(certification entity.Certification, now time.Time) bio.CertificationResponse {
+	return bio.CertificationResponse{
+		ID:            certification.ID,
+		Name:          certification.Name,
+		Issuer:        certification.Issuer,
+		IssueDate:     certification.IssueDate.Format(bio.YearMonthLayout),
+		ExpiryDate:    bio.FormatYearMonth(certification.ExpiryDate),
+		IsExpired:     certificationExpired(certification, now),
+		CredentialID:  certification.CredentialID,
+		CredentialURL: certification.CredentialURL,
+		Image:         certification.Image,
+		IsHidden:      certification.IsHidden,
+		CreatedAt:     certification.CreatedAt,
+		UpdatedAt:     certification.UpdatedAt,
+	}
+}
+
+func makeLanguageResponses(languages []entity.UserLanguage) []bio.LanguageResponse {
+	responses := make([]bio.LanguageResponse, len(languages))
+	for i, language := range languages {
+		responses[i] = bio.LanguageResponse{
+			Language:  language.Language,
+			Name:      bio.LanguageName(language.Language),
+			Level:     string(language.Level),
+			TestName:  language.TestName,
+			TestScore: language.TestScore,
+		}
+	}
+	return responses
+}
+
+func makeEndorserResponses(endorsers []entity.Endorser) []bio.EndorserResponse {
+	responses := make([]bio.EndorserResponse, len(endorsers))
+	for i, endorser := range endorsers {
+		responses[i] = bio.EndorserResponse{
+			ID:             endorser.ID,
+			Name:           endorser.Name,
+			Headline:       endorser.Headline,
+			ProfilePicture: endorser.ProfilePicture,
+			EndorsedAt:     endorser.EndorsedAt,
+		}
+	}
+	return responses
+}
+
+func makeProfileSkillResponses(skills []entity.Skill, endorsements map[string]entity.SkillEndorsementSummary) []bio.ProfileSkillResponse {
+	responses := make([]bio.ProfileSkillResponse, 0, len(skills))
+	for _, item := range skill.MakeSkillResponses(skills) {
+		summary := endorsements[item.ID]
+		responses = append(responses, bio.ProfileSkillResponse{
+			SkillResponse:    item,
+			EndorsementCount: summary.Count,
+			Endorsers:        makeEndorserResponses(summary.Endorsers),
+		})
+	}
+	return responses
+}
+
+func makeProfileResponse(user entity.User, skills []entity.Skill, endorsements map[string]entity.SkillEndorsementSummary, languages []entity.UserLanguage, experiences []entity.Experience, educations []entity.Education,
+	portfolios []entity.Portfolio, certifications []entity.Certification) bio.ProfileResponse {
+	profile := bio.ProfileResponse{
+		ID:             user.ID,
+		Name:           user.Name,
+		Email:          user.Email,
+		Role:           string(user.Role),
+		ProfilePicture: user.ProfilePicture,
+		BannerPicture:  user.BannerPicture,
+		PhoneNumber:    user.PhoneNumber,
+		Headline:       user.Headline,
+		Location:       user.Location,
+		IsPremium:      user.IsPremium,
+		CreatedAt:      user.CreatedAt,
+		Privacy: &bio.ProfilePrivacy{
+			Visibility:      user.ProfileVisibility,
+			HideEmail:       user.HideEmail,
+			HidePhoneNumber: user.HidePhoneNumber,
+		},
+		Skills:      makeProfileSkillResponses(skills, endorsements),
+		Languages:   makeLanguageResponses(languages),
+		Experiences: make([]bio.ExperienceResponse, 0, len(experiences)),
+		Educations:  make([]bio.EducationResponse, 0, len(educations)),
+		Portfolios:  make([]bio.PortfolioResponse, 0, len(portfolios)),
+
+		Certifications: make([]bio.CertificationResponse, 0, len(certifications)),
+	}
+
+	for _, experience := range experiences {
+		profile.Experiences = append(profile.Experiences, makeExperienceResponse(experience))
+	}
+	profile.YearsOfExperience = yearsOfExperience(profile.Experiences, time.Now())
+	for _, education := range educations {
+		profile.Educations = append(profile.Educations, makeEducationResponse(education))
+	}
+	for _, portfolio := range portfolios {
+		profile.Portfolios = append(profile.Portfolios, makePortfolioResponse(portfolio))
+	}
+	now := time.Now()
+	for _, certification := range certifications {
+		profile.Certifications = append(profile.Certifications, makeCertificationResponse(certification, now))
+	}
+
+	return profile
+}
+
+func visibleExperiences(experiences []entity.Experience) []entity.Experience {
+	visible := make([]entity.Experience, 0, len(experiences))
+	for _, experience := range experiences {
+		if !experience.IsHidden {
+			visible = append(visible, experience)
+		}
+	}
+	return visible
+}
+
+func visibleEducations(educations []entity.Education) []entity.Education {
+	visible := make([]entity.Education, 0, len(educations))
+	for _, education := range educations {
+		if !education.IsHidden {
+			visible = append(visible, education)
+		}
+	}
+	return visible
+}
+
+func visiblePortfolios(portfolios []entity.Portfolio) []entity.Portfolio {
+	visible := make([]entity.Portfolio, 0, len(portfolios))
+	for _, portfolio := range portfolios {
+		if !portfolio.IsHidden {
+			visible = append(visible, portfolio)
+		}
+	}
+	return visible
+}
+
+// profileOwner rebuilds the parts of the owner that visibility checks need
+// from a cached profile.
+func profileOwner(profile bio.ProfileResponse) entity.User {
+	owner := entity.User{ID: profile.ID, Role: entity.UserRole(profile.Role)}
+	if profile.Privacy != nil {
+		owner.ProfileVisibility = profile.Privacy.Visibility
+		owner.HideEmail = profile.Privacy.HideEmail
+		owner.HidePhoneNumber = profile.Privacy.HidePhoneNumber
+	}
+	return owner
+}
+
+// viewProfile trims a full profile down to what the viewer may see: hidden
+// items, hidden contact fields and the privacy settings themselves are only
+// for the owner.
+func viewProfile(profile bio.ProfileResponse, viewer entity.UserLoginData) (bio.ProfileResponse, error) {
+	owner := profileOwner(profile)
+	if !bio.CanViewProfile(owner, viewer) {
+		return bio.ProfileResponse{}, bio.ErrorProfileNotVisible
+	}
+	if bio.IsProfileOwner(owner, viewer) {
+		return profile, nil
+	}
+
+	view := profile
+	view.Privacy = nil
+	if owner.HideEmail {
+		view.Email = ""
+	}
+	if owner.HidePhoneNumber {
+		view.PhoneNumber = ""
+	}
+
+	view.Experiences = make([]bio.ExperienceResponse, 0, len(profile.Experiences))
+	for _, experience := range profile.Experiences {
+		if !experience.IsHidden {
+			view.Experiences = append(view.Experiences, experience)
+		}
+	}
+	view.YearsOfExperience = yearsOfExperience(view.Experiences, time.Now())
+
+	view.Educations = make([]bio.EducationResponse, 0, len(profile.Educations))
+	for _, education := range profile.Educations {
+		if !education.IsHidden {
+			view.Educations = append(view.Educations, education)
+		}
+	}
+	view.Portfolios = make([]bio.PortfolioResponse, 0, len(profile.Portfolios))
+	for _, portfolio := range profile.Portfolios {
+		if !portfolio.IsHidden {
+			view.Portfolios = append(view.Portfolios, portfolio)
+		}
+	}
+	view.Certifications = make([]bio.CertificationResponse, 0, len(profile.Certifications))
+	for _, certification := range profile.Certifications {
+		if !certification.IsHidden {
+			view.Certifications = append(view.Certifications, certification)
+		}
+	}
+
+	return view, nil
+}
@@ -0,0 +1,280 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestViewProfile(t *testing.T) {
+	profile := func(privacy bio.ProfilePrivacy) bio.ProfileResponse {
+		return bio.ProfileResponse{
+			ID:          "owner",
+			Email:       "jane@example.com",
+			PhoneNumber: "+62800000000",
+			Privacy:     &privacy,
+			Experiences: []bio.ExperienceResponse{
+				{ID: "shown", StartDate: "2020-01", EndDate: "2020-12"},
+				{ID: "hidden", StartDate: "2010-01", EndDate: "2015-12", IsHidden: true},
+			},
+			Educations:     []bio.EducationResponse{{ID: "shown"}, {ID: "hidden", IsHidden: true}},
+			Portfolios:     []bio.PortfolioResponse{{ID: "shown"}, {ID: "hidden", IsHidden: true}},
+			Certifications: []bio.CertificationResponse{{ID: "shown"}, {ID: "hidden", IsHidden: true}},
+		}
+	}
+
+	var (
+		owner     = entity.UserLoginData{ID: "owner", Role: entity.RoleCandidate}
+		recruiter = entity.UserLoginData{ID: "company", Role: entity.RoleRecruiter}
+		anonymous = entity.UserLoginData{}
+	)
+
+	tests := []struct {
+		name        string
+		privacy     bio.ProfilePrivacy
+		viewer      entity.UserLoginData
+		wantErr     error
+		wantEmail   string
+		wantPhone   string
+		wantItems   int
+		wantPrivacy bool
+	}{
+		{
+			name:        "owner sees everything",
+			privacy:     bio.ProfilePrivacy{Visibility: entity.ProfileVisibilityPrivate, HideEmail: true, HidePhoneNumber: true},
+			viewer:      owner,
+			wantEmail:   "jane@example.com",
+			wantPhone:   "+62800000000",
+			wantItems:   2,
+			wantPrivacy: true,
+		},
+		{
+			name:      "others lose hidden items and settings",
+			privacy:   bio.ProfilePrivacy{Visibility: entity.ProfileVisibilityPublic},
+			viewer:    anonymous,
+			wantEmail: "jane@example.com",
+			wantPhone: "+62800000000",
+			wantItems: 1,
+		},
+		{
+			name:      "hidden contact fields",
+			privacy:   bio.ProfilePrivacy{Visibility: entity.ProfileVisibilityRecruiters, HideEmail: true, HidePhoneNumber: true},
+			viewer:    recruiter,
+			wantItems: 1,
+		},
+		{
+			name:    "not visible",
+			privacy: bio.ProfilePrivacy{Visibility: entity.ProfileVisibilityRecruiters},
+			viewer:  anonymous,
+			wantErr: bio.ErrorProfileNotVisible,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view, err := viewProfile(profile(tt.privacy), tt.viewer)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("viewProfile() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if view.Email != tt.wantEmail || view.PhoneNumber != tt.wantPhone {
+				t.Errorf("contact = %q %q, want %q %q", view.Email, view.PhoneNumber, tt.wantEmail, tt.wantPhone)
+			}
+			if (view.Privacy != nil) != tt.wantPrivacy {
+				t.Errorf("privacy included = %v, want %v", view.Privacy != nil, tt.wantPrivacy)
+			}
+			for section, count := range map[string]int{
+				"experiences":    len(view.Experiences),
+				"educations":     len(view.Educations),
+				"portfolios":     len(view.Portfolios),
+				"certifications": len(view.Certifications),
+			} {
+				if count != tt.wantItems {
+					t.Errorf("%s = %d, want %d", section, count, tt.wantItems)
+				}
+			}
+		})
+	}
+}
+
+func TestViewProfileRecountsExperience(t *testing.T) {
+	profile := bio.ProfileResponse{
+		ID:      "owner",
+		Privacy: &bio.ProfilePrivacy{Visibility: entity.ProfileVisibilityPublic},
+		Experiences: []bio.ExperienceResponse{
+			{ID: "shown", StartDate: "2020-01", EndDate: "2020-12"},
+			{ID: "hidden", StartDate: "2010-01", EndDate: "2015-12", IsHidden: true},
+		},
+	}
+
+	view, err := viewProfile(profile, entity.UserLoginData{})
+	if err != nil {
+		t.Fatalf("viewProfile() error = %v", err)
+	}
+	if view.YearsOfExperience != 1 {
+		t.Errorf("YearsOfExperience = %v, want 1", view.YearsOfExperience)
+	}
+}
+
+func TestParseYearMonth(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr error
+	}{
+		{value: "2021-03", want: month(2021, time.March)},
+		{value: " 2021-12 ", want: month(2021, time.December)},
+		{value: "2021-3", wantErr: bio.ErrorDateFormat},
+		{value: "2021-13", wantErr: bio.ErrorDateFormat},
+		{value: "2021-03-01", wantErr: bio.ErrorDateFormat},
+		{value: "2021", wantErr: bio.ErrorDateFormat},
+		{value: "", wantErr: bio.ErrorDateFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseYearMonth(tt.value)
+			if !errors.Is(err, tt.wantErr) || !got.Equal(tt.want) {
+				t.Errorf("parseYearMonth(%q) = %v, %v, want %v, %v", tt.value, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateBioPeriod(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		start     time.Time
+		end       *time.Time
+		isCurrent bool
+		want      error
+	}{
+		{name: "finished", start: month(2020, time.January), end: ptr(month(2021, time.June))},
+		{name: "single month", start: month(2020, time.January), end: ptr(month(2020, time.January))},
+		{name: "ongoing", start: month(2020, time.January), isCurrent: true},
+		{name: "ends this month", start: month(2020, time.January), end: ptr(month(2026, time.October))},
+		{name: "starts next month", start: month(2026, time.November), isCurrent: true, want: bio.ErrorDateInFuture},
+		{name: "ends next month", start: month(2020, time.January), end: ptr(month(2026, time.November)), want: bio.ErrorDateInFuture},
+		{name: "ongoing with end date", start: month(2020, time.January), end: ptr(month(2021, time.June)), isCurrent: true, want: bio.ErrorEndDateOnCurrent},
+		{name: "finished without end date", start: month(2020, time.January), want: bio.ErrorEndDateRequired},
+		{name: "ends before start", start: month(2021, time.June), end: ptr(month(2020, time.January)), want: bio.ErrorEndDateBeforeStart},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateBioPeriod(tt.start, tt.end, tt.isCurrent, now); !errors.Is(err, tt.want) {
+				t.Errorf("validateBioPeriod() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateBioPeriod(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+	yes, no := true, false
+
+	type period struct {
+		start     time.Time
+		end       *time.Time
+		isCurrent bool
+	}
+	ongoing := period{start: month(2022, time.March), isCurrent: true}
+	finished := period{start: month(2020, time.January), end: ptr(month(2021, time.June))}
+
+	tests := []struct {
+		name     string
+		existing period
+		start    string
+		end      string
+		current  *bool
+		want     period
+		wantErr  error
+	}{
+		{name: "nothing sent keeps the period", existing: finished, want: finished},
+		{name: "new start date", existing: finished, start: "2019-05", want: period{start: month(2019, time.May), end: finished.end}},
+		{name: "end date finishes an ongoing entry", existing: ongoing, end: "2025-01", want: period{start: ongoing.start, end: ptr(month(2025, time.January))}},
+		{name: "is_current clears the end date", existing: finished, current: &yes, want: period{start: finished.start, isCurrent: true}},
+		{name: "is_current false needs an end date", existing: ongoing, current: &no, wantErr: bio.ErrorEndDateRequired},
+		{name: "is_current with an end date", existing: ongoing, end: "2025-01", current: &yes, wantErr: bio.ErrorEndDateOnCurrent},
+		{name: "start moved past the end", existing: finished, start: "2022-01", wantErr: bio.ErrorEndDateBeforeStart},
+		{name: "bad date format", existing: finished, end: "June 2021", wantErr: bio.ErrorDateFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, isCurrent, err := updateBioPeriod(tt.existing.start, tt.existing.end, tt.existing.isCurrent, tt.start, tt.end, tt.current, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("updateBioPeriod() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if !start.Equal(tt.want.start) || !equalMonth(end, tt.want.end) || isCurrent != tt.want.isCurrent {
+				t.Errorf("updateBioPeriod() = %v, %v, %v, want %v, %v, %v", start, end, isCurrent, tt.want.start, tt.want.end, tt.want.isCurrent)
+			}
+		})
+	}
+}
+
+func TestYearsOfExperience(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		experiences []bio.ExperienceResponse
+		want        float64
+	}{
+		{name: "none", want: 0},
+		{
+			name:        "inclusive months",
+			experiences: []bio.ExperienceResponse{{StartDate: "2020-01", EndDate: "2020-12"}},
+			want:        1,
+		},
+		{
+			name: "overlaps counted once",
+			experiences: []bio.ExperienceResponse{
+				{StartDate: "2020-01", EndDate: "2021-12"},
+				{StartDate: "2021-01", EndDate: "2022-12"},
+			},
+			want: 3,
+		},
+		{
+			name: "gaps are not counted",
+			experiences: []bio.ExperienceResponse{
+				{StartDate: "2018-01", EndDate: "2018-06"},
+				{StartDate: "2020-01", EndDate: "2020-06"},
+			},
+			want: 1,
+		},
+		{
+			name:        "ongoing runs to this month",
+			experiences: []bio.ExperienceResponse{{StartDate: "2025-11", IsCurrent: true}},
+			want:        1,
+		},
+		{
+			name: "unreadable and inverted entries skipped",
+			experiences: []bio.ExperienceResponse{
+				{StartDate: "someday", EndDate: "2020-12"},
+				{StartDate: "2021-06", EndDate: "2020-01"},
+				{StartDate: "2020-01", EndDate: "2020-06"},
+			},
+			want: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := yearsOfExperience(tt.experiences, now); got != tt.want {
+				t.Errorf("yearsOfExperience() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,580 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/api/skill"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/utils"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	jsonResumeSchemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+	// maxJSONResumeEntries caps each section of an imported document.
+	maxJSONResumeEntries = 100
+
+	// Column size of the bio tables' text fields.
+	maxBioTextLength = 255
+)
+
+// ExportJSONResume writes the viewer's slice of a profile as a JSON Resume
+// document.
+func (s *bioService) ExportJSONResume(ctx context.Context, viewer entity.UserLoginData, userID string) (bio.JSONResume, error) {
+	profile, err := s.getViewedProfile(ctx, viewer, userID)
+	if err != nil {
+		return bio.JSONResume{}, err
+	}
+
+	resume := bio.JSONResume{
+		Schema: jsonResumeSchemaURL,
+		Basics: bio.JSONResumeBasics{
+			Name:  profile.Name,
+			Label: profile.Headline,
+			Image: profile.ProfilePicture,
+			Email: profile.Email,
+			Phone: profile.PhoneNumber,
+		},
+		Work:      make([]bio.JSONResumeWork, 0, len(profile.Experiences)),
+		Education: make([]bio.JSONResumeEducation, 0, len(profile.Educations)),
+		Projects:  make([]bio.JSONResumeProject, 0, len(profile.Portfolios)),
+	}
+	if profile.Slug != "" {
+		resume.Basics.URL = fmt.Sprintf("%s/api/v1/profiles/%s", strings.TrimRight(os.Getenv("APP_URL"), "/"), profile.Slug)
+	}
+	if profile.Location != "" {
+		resume.Basics.Location = &bio.JSONResumeLocation{Address: profile.Location}
+	}
+
+	for _, experience := range profile.Experiences {
+		resume.Work = append(resume.Work, bio.JSONResumeWork{
+			Position:  experience.JobTitle,
+			Location:  experience.JobLocation,
+			StartDate: experience.StartDate,
+			EndDate:   experience.EndDate,
+			Summary:   experience.Description,
+		})
+	}
+
+	for _, item := range profile.Skills {
+		resume.Skills = append(resume.Skills, bio.JSONResumeSkill{Name: item.Name})
+	}
+
+	for _, language := range profile.Languages {
+		resume.Languages = append(resume.Languages, bio.JSONResumeLanguage{
+			Language: language.Name,
+			Fluency:  resumeLanguageLevel(language.Level),
+		})
+	}
+
+	for _, education := range profile.Educations {
+		resume.Education = append(resume.Education, bio.JSONResumeEducation{
+			Institution: education.InstitutionalName,
+			StudyType:   education.TitleDegree,
+			StartDate:   education.StartDate,
+			EndDate:     education.EndDate,
+		})
+	}
+
+	for _, portfolio := range profile.Portfolios {
+		resume.Projects = append(resume.Projects, bio.JSONResumeProject{
+			Name:        portfolio.ProjectName,
+			Description: portfolio.Description,
+			StartDate:   portfolio.StartDate,
+			EndDate:     portfolio.EndDate,
+			URL:         portfolio.ProjectLink,
+			Entity:      portfolio.ProjectLocation,
+		})
+	}
+
+	for _, certification := range profile.Certifications {
+		resume.Certificates = append(resume.Certificates, bio.JSONResumeCertificate{
+			Name:   certification.Name,
+			Date:   certification.IssueDate,
+			Issuer: certification.Issuer,
+			URL:    certification.CredentialURL,
+		})
+	}
+
+	return resume, nil
+}
+
+// ImportJSONResume maps a JSON Resume document onto the user's profile. Entries
+// that cannot be stored are reported as skipped rather than failing the whole
+// import; everything else is written in one transaction unless dryRun is set.
+func (s *bioService) ImportJSONResume(ctx context.Context, userID string, resume bio.JSONResume, mode string, dryRun bool) (bio.JSONResumeImportResult, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	if mode == "" {
+		mode = bio.JSONResumeImportMerge
+	}
+	if mode != bio.JSONResumeImportMerge && mode != bio.JSONResumeImportReplace {
+		return bio.JSONResumeImportResult{}, bio.ErrorResumeImportMode
+	}
+	if len(resume.Work) > maxJSONResumeEntries || len(resume.Education) > maxJSONResumeEntries ||
+		len(resume.Projects) > maxJSONResumeEntries {
+		return bio.JSONResumeImportResult{}, bio.ErrorResumeTooLarge
+	}
+
+	authRepo, err := s.authRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository")
+		return bio.JSONResumeImportResult{}, err
+	}
+
+	user, err := authRepo.User.GetUserByID(ctx, userID)
+	if err != nil {
+		return bio.JSONResumeImportResult{}, err
+	}
+	if user.ID == "" {
+		return bio.JSONResumeImportResult{}, bio.ErrorUserNotFound
+	}
+
+	bioRepo, err := s.bioRepository.NewClient(!dryRun)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return bio.JSONResumeImportResult{}, err
+	}
+	defer bioRepo.Rollback()
+
+	existingExperiences, err := bioRepo.Experience.GetExperiencesByUserID(ctx, userID)
+	if err != nil {
+		return bio.JSONResumeImportResult{}, err
+	}
+	existingEducations, err := bioRepo.Education.GetEducationsByUserID(ctx, userID)
+	if err != nil {
+		return bio.JSONResumeImportResult{}, err
+	}
+	existingPortfolios, err := bioRepo.Portfolio.GetPortfoliosByUserID(ctx, userID)
+	if err != nil {
+		return bio.JSONResumeImportResult{}, err
+	}
+
+	result := bio.JSONResumeImportResult{
+		DryRun:   dryRun,
+		Mode:     mode,
+		Basics:   []bio.JSONResumeFieldChange{},
+		Skills:   []string{},
+		Skipped:  []bio.JSONResumeSkippedEntry{},
+		Warnings: []string{},
+	}
+
+	// In merge mode entries already on the profile are left alone; replace
+	// starts from an empty bio.
+	seen := make(map[string]bool)
+	if mode == bio.JSONResumeImportMerge {
+		for _, experience := range existingExperiences {
+			seen[bioEntryKey("work", experience.JobTitle, experience.JobLocation, experience.StartDate)] = true
+		}
+		for _, education := range existingEducations {
+			seen[bioEntryKey("education", education.TitleDegree, education.InstitutionalName, education.StartDate)] = true
+		}
+		for _, portfolio := range existingPortfolios {
+			seen[bioEntryKey("projects", portfolio.ProjectName, portfolio.ProjectLocation, portfolio.StartDate)] = true
+		}
+	} else {
+		result.Removed = bio.JSONResumeRemoved{
+			Experiences: len(existingExperiences),
+			Educations:  len(existingEducations),
+			Portfolios:  len(existingPortfolios),
+		}
+	}
+
+	skip := func(section string, index int, reason string) {
+		result.Skipped = append(result.Skipped, bio.JSONResumeSkippedEntry{Section: section, Index: index, Reason: reason})
+	}
+
+	now := time.Now()
+	newID := func() (string, error) {
+		id, err := utils.NewUlidFromTimestamp(now)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Failed to generate ULID")
+		}
+		return id, err
+	}
+
+	var experiences []entity.Experience
+	for i, work := range resume.Work {
+		experience := entity.Experience{
+			UserID:      userID,
+			JobTitle:    strings.TrimSpace(work.Position),
+			JobLocation: strings.TrimSpace(work.Location),
+			Description: withHighlights(work.Summary, work.Highlights),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if experience.JobLocation == "" {
+			experience.JobLocation = strings.TrimSpace(work.Name)
+		}
+
+		if reason := checkBioEntry(experience.JobTitle, "position", experience.JobLocation); reason != "" {
+			skip("work", i, reason)
+			continue
+		}
+		if experience.StartDate, experience.EndDate, experience.IsCurrent, err = jsonResumePeriod(work.StartDate, work.EndDate, now); err != nil {
+			skip("work", i, err.Error())
+			continue
+		}
+		key := bioEntryKey("work", experience.JobTitle, experience.JobLocation, experience.StartDate)
+		if seen[key] {
+			skip("work", i, "already on the profile")
+			continue
+		}
+		seen[key] = true
+
+		if experience.ID, err = newID(); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+		experiences = append(experiences, experience)
+	}
+
+	var educations []entity.Education
+	for i, item := range resume.Education {
+		education := entity.Education{
+			UserID:            userID,
+			TitleDegree:       joinNonEmpty(" in ", item.StudyType, item.Area),
+			InstitutionalName: strings.TrimSpace(item.Institution),
+			Description:       educationDescription(item),
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		}
+
+		if education.InstitutionalName == "" {
+			skip("education", i, "institution is required")
+			continue
+		}
+		if reason := checkBioEntry(education.TitleDegree, "studyType or area", education.InstitutionalName); reason != "" {
+			skip("education", i, reason)
+			continue
+		}
+		if education.StartDate, education.EndDate, education.IsCurrent, err = jsonResumePeriod(item.StartDate, item.EndDate, now); err != nil {
+			skip("education", i, err.Error())
+			continue
+		}
+		key := bioEntryKey("education", education.TitleDegree, education.InstitutionalName, education.StartDate)
+		if seen[key] {
+			skip("education", i, "already on the profile")
+			continue
+		}
+		seen[key] = true
+
+		if education.ID, err = newID(); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+		educations = append(educations, education)
+	}
+
+	var portfolios []entity.Portfolio
+	for i, project := range resume.Projects {
+		portfolio := entity.Portfolio{
+			UserID:          userID,
+			ProjectName:     strings.TrimSpace(project.Name),
+			ProjectLocation: strings.TrimSpace(project.Entity),
+			ProjectLink:     strings.TrimSpace(project.URL),
+			Description:     withHighlights(project.Description, project.Highlights),
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		}
+
+		if reason := checkBioEntry(portfolio.ProjectName, "name", portfolio.ProjectLocation, portfolio.ProjectLink); reason != "" {
+			skip("projects", i, reason)
+			continue
+		}
+		if portfolio.StartDate, portfolio.EndDate, portfolio.IsCurrent, err = jsonResumePeriod(project.StartDate, project.EndDate, now); err != nil {
+			skip("projects", i, err.Error())
+			continue
+		}
+		key := bioEntryKey("projects", portfolio.ProjectName, portfolio.ProjectLocation, portfolio.StartDate)
+		if seen[key] {
+			skip("projects", i, "already on the profile")
+			continue
+		}
+		seen[key] = true
+
+		// Keywords become the project's skills. Until the import is written
+		// they are only names, so a preview shows them without IDs.
+		keywords, dropped := importSkillNames(project.Keywords)
+		if dropped {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("some keywords of projects[%d] were too long or too many to import as skills", i))
+		}
+		for _, name := range keywords {
+			portfolio.Skills = append(portfolio.Skills, entity.Skill{Name: name})
+		}
+
+		if portfolio.ID, err = newID(); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+		portfolios = append(portfolios, portfolio)
+	}
+
+	updatedUser := user
+	basics := resume.Basics
+	for _, change := range []struct {
+		field string
+		value string
+		dest  *string
+	}{
+		{"name", basics.Name, &updatedUser.Name},
+		{"headline", basics.Label, &updatedUser.Headline},
+		{"phone_number", basics.Phone, &updatedUser.PhoneNumber},
+		{"location", jsonResumeLocation(basics.Location), &updatedUser.Location},
+	} {
+		value := strings.TrimSpace(change.value)
+		if value == "" || value == *change.dest {
+			continue
+		}
+		if utf8.RuneCountInString(value) > maxBioTextLength {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("basics %s is too long and was not imported", change.field))
+			continue
+		}
+		result.Basics = append(result.Basics, bio.JSONResumeFieldChange{Field: change.field, From: *change.dest, To: value})
+		*change.dest = value
+	}
+
+	if email := strings.TrimSpace(basics.Email); email != "" && !strings.EqualFold(email, user.Email) {
+		result.Warnings = append(result.Warnings, "basics email is not imported; change it from your account settings")
+	}
+	if strings.TrimSpace(basics.Image) != "" && basics.Image != user.ProfilePicture {
+		result.Warnings = append(result.Warnings, "basics image is not imported; upload a profile picture instead")
+	}
+	skillNames, dropped := importSkillNames(jsonResumeSkillNames(resume.Skills))
+	if dropped {
+		result.Warnings = append(result.Warnings, "some skills were too long or too many to import")
+	}
+	result.Skills = append(result.Skills, skillNames...)
+
+	result.Experiences = make([]bio.ExperienceResponse, 0, len(experiences))
+	for _, experience := range experiences {
+		result.Experiences = append(result.Experiences, makeExperienceResponse(experience))
+	}
+	result.Educations = make([]bio.EducationResponse, 0, len(educations))
+	for _, education := range educations {
+		result.Educations = append(result.Educations, makeEducationResponse(education))
+	}
+	result.Portfolios = make([]bio.PortfolioResponse, 0, len(portfolios))
+	for _, portfolio := range portfolios {
+		result.Portfolios = append(result.Portfolios, makePortfolioResponse(portfolio))
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	if mode == bio.JSONResumeImportReplace {
+		if err := bioRepo.Experience.DeleteExperiencesByUserID(ctx, userID); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+		if err := bioRepo.Education.DeleteEducationsByUserID(ctx, userID); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+		if err := bioRepo.Portfolio.DeletePortfoliosByUserID(ctx, userID); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+	}
+
+	for _, experience := range experiences {
+		if err := bioRepo.Experience.CreateExperience(ctx, experience); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+	}
+	for _, education := range educations {
+		if err := bioRepo.Education.CreateEducation(ctx, education); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+	}
+	for _, portfolio := range portfolios {
+		if err := bioRepo.Portfolio.CreatePortfolio(ctx, portfolio); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+
+		if len(portfolio.Skills) > 0 {
+			names := make([]string, len(portfolio.Skills))
+			for i, item := range portfolio.Skills {
+				names[i] = item.Name
+			}
+			skills, err := s.resolveSkills(ctx, names)
+			if err != nil {
+				return bio.JSONResumeImportResult{}, err
+			}
+			if err := s.linkEntrySkills(ctx, bioRepo, userID, portfolio.ID, skills, bioRepo.Skill.ReplacePortfolioSkills); err != nil {
+				return bio.JSONResumeImportResult{}, err
+			}
+		}
+	}
+
+	// Imported skills are added to the profile's list, even when replacing
+	// the bio, since the list is not part of any one entry.
+	if len(skillNames) > 0 {
+		skills, err := s.resolveSkills(ctx, skillNames)
+		if err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+		if err := bioRepo.Skill.AddUserSkills(ctx, userID, skillIDs(skills), now); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+	}
+
+	if len(result.Basics) > 0 {
+		updatedUser.UpdatedAt = now
+		if err := bioRepo.User.UpdateUserBasics(ctx, updatedUser); err != nil {
+			return bio.JSONResumeImportResult{}, err
+		}
+	}
+
+	if err := bioRepo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Failed to commit resume import")
+		return bio.JSONResumeImportResult{}, err
+	}
+
+	s.invalidateRecommendedJobs(ctx, userID)
+	s.invalidateProfile(ctx, userID)
+
+	s.log.WithFields(logrus.Fields{
+		"request_id":  requestID,
+		"user_id":     userID,
+		"mode":        mode,
+		"experiences": len(experiences),
+		"educations":  len(educations),
+		"portfolios":  len(portfolios),
+		"skipped":     len(result.Skipped),
+	}).Info("JSON resume imported")
+
+	return result, nil
+}
+
+// checkBioEntry returns why an imported entry's text cannot be stored, or ""
+// when it can.
+func checkBioEntry(title, titleField string, texts ...string) string {
+	if title == "" {
+		return fmt.Sprintf("%s is required", titleField)
+	}
+	for _, text := range append(texts, title) {
+		if utf8.RuneCountInString(text) > maxBioTextLength {
+			return fmt.Sprintf("values are limited to %d characters", maxBioTextLength)
+		}
+	}
+	return ""
+}
+
+func bioEntryKey(section, title, place string, startDate time.Time) string {
+	return strings.ToLower(strings.Join([]string{section, strings.TrimSpace(title), strings.TrimSpace(place),
+		startDate.Format(bio.YearMonthLayout)}, "|"))
+}
+
+// jsonResumeDateLayouts are the ISO 8601 precisions the schema allows; days
+// are dropped since bio dates are stored by month.
+var jsonResumeDateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+
+func parseJSONResumeDate(value string) (time.Time, bool) {
+	for _, layout := range jsonResumeDateLayouts {
+		if t, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+			return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// jsonResumePeriod reads an imported entry's dates; a missing end date means
+// the entry is ongoing, as in the schema.
+func jsonResumePeriod(startValue, endValue string, now time.Time) (time.Time, *time.Time, bool, error) {
+	if strings.TrimSpace(startValue) == "" {
+		return time.Time{}, nil, false, fmt.Errorf("startDate is required")
+	}
+	start, ok := parseJSONResumeDate(startValue)
+	if !ok {
+		return time.Time{}, nil, false, fmt.Errorf("startDate is not a valid date")
+	}
+
+	var end *time.Time
+	if strings.TrimSpace(endValue) != "" {
+		parsed, ok := parseJSONResumeDate(endValue)
+		if !ok {
+			return time.Time{}, nil, false, fmt.Errorf("endDate is not a valid date")
+		}
+		end = &parsed
+	}
+
+	isCurrent := end == nil
+	if err := validateBioPeriod(start, end, isCurrent, now); err != nil {
+		return time.Time{}, nil, false, err
+	}
+	return start, end, isCurrent, nil
+}
+
+// jsonResumeSkillNames reads the schema's skill groups: the keywords are the
+// skills themselves, and a group without keywords is taken as one skill.
+func jsonResumeSkillNames(items []bio.JSONResumeSkill) []string {
+	var names []string
+	for _, item := range items {
+		if len(item.Keywords) > 0 {
+			names = append(names, item.Keywords...)
+		} else {
+			names = append(names, item.Name)
+		}
+	}
+	return names
+}
+
+// importSkillNames keeps the names that fit the skill catalogue's limits and
+// reports whether any had to be dropped.
+func importSkillNames(names []string) ([]string, bool) {
+	cleaned := skill.CleanNames(names)
+	kept := make([]string, 0, len(cleaned))
+	for _, name := range cleaned {
+		if utf8.RuneCountInString(name) <= skill.MaxNameLength && len(kept) < skill.MaxSkillsPerEntry {
+			kept = append(kept, name)
+		}
+	}
+	return kept, len(kept) < len(cleaned)
+}
+
+func jsonResumeLocation(location *bio.JSONResumeLocation) string {
+	if location == nil {
+		return ""
+	}
+	if address := strings.TrimSpace(location.Address); address != "" {
+		return address
+	}
+	return joinNonEmpty(", ", location.City, location.Region, location.CountryCode)
+}
+
+func withHighlights(text string, highlights []string) string {
+	lines := []string{strings.TrimSpace(text)}
+	for _, highlight := range highlights {
+		if highlight = strings.TrimSpace(highlight); highlight != "" {
+			lines = append(lines, "- "+highlight)
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func educationDescription(education bio.JSONResumeEducation) string {
+	var lines []string
+	if score := strings.TrimSpace(education.Score); score != "" {
+		lines = append(lines, "Score: "+score)
+	}
+	if courses := joinNonEmpty(", ", education.Courses...); courses != "" {
+		lines = append(lines, "Courses: "+courses)
+	}
+	return strings.Join(lines, "\n")
+}
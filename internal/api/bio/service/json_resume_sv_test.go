@@ -0,0 +1,104 @@
+package bioService
+
+import (
+	"testing"
+	"time"
+)
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseJSONResumeDate(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Time
+		wantOK bool
+	}{
+		{value: "2021-03-17", want: month(2021, time.March), wantOK: true},
+		{value: "2021-03", want: month(2021, time.March), wantOK: true},
+		{value: "2021", want: month(2021, time.January), wantOK: true},
+		{value: " 2021-03 ", want: month(2021, time.March), wantOK: true},
+		{value: "", wantOK: false},
+		{value: "March 2021", wantOK: false},
+		{value: "2021-13", wantOK: false},
+		{value: "03/2021", wantOK: false},
+		{value: "2021-03-17T10:00:00Z", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseJSONResumeDate(tt.value)
+			if ok != tt.wantOK || !got.Equal(tt.want) {
+				t.Errorf("parseJSONResumeDate(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestJSONResumePeriod(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		start       string
+		end         string
+		wantStart   time.Time
+		wantEnd     *time.Time
+		wantCurrent bool
+		wantErr     bool
+	}{
+		{
+			name:      "finished entry",
+			start:     "2019-02-01",
+			end:       "2021-06",
+			wantStart: month(2019, time.February),
+			wantEnd:   ptr(month(2021, time.June)),
+		},
+		{
+			name:        "missing end date is ongoing",
+			start:       "2024",
+			wantStart:   month(2024, time.January),
+			wantCurrent: true,
+		},
+		{
+			name:        "this month is not the future",
+			start:       "2026-10-31",
+			wantStart:   month(2026, time.October),
+			wantCurrent: true,
+		},
+		{name: "missing start date", start: " ", wantErr: true},
+		{name: "invalid start date", start: "last year", wantErr: true},
+		{name: "invalid end date", start: "2020", end: "now", wantErr: true},
+		{name: "end before start", start: "2021-06", end: "2020-01", wantErr: true},
+		{name: "start in the future", start: "2026-11", wantErr: true},
+		{name: "end in the future", start: "2020", end: "2027", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, current, err := jsonResumePeriod(tt.start, tt.end, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("jsonResumePeriod() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if !start.Equal(tt.wantStart) || current != tt.wantCurrent || !equalMonth(end, tt.wantEnd) {
+				t.Errorf("jsonResumePeriod() = %v, %v, %v, want %v, %v, %v", start, end, current, tt.wantStart, tt.wantEnd, tt.wantCurrent)
+			}
+		})
+	}
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
+
+func equalMonth(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
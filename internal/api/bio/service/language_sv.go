@@ -0,0 +1,91 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"strings"
+	"time"
+)
+
+// UpdateUserLanguages replaces the languages listed on the user's profile, in
+// the order given.
+func (s *bioService) UpdateUserLanguages(ctx context.Context, userID string, req bio.UpdateUserLanguages) ([]bio.LanguageResponse, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	languages, err := makeUserLanguages(userID, req.Languages)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Warn("Invalid user languages")
+		return nil, err
+	}
+
+	repo, err := s.bioRepository.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+	defer repo.Rollback()
+
+	if err := repo.Language.ReplaceUserLanguages(ctx, userID, languages, time.Now()); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Failed to update user languages")
+		return nil, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to commit transaction")
+		return nil, err
+	}
+
+	s.invalidateRecommendedJobs(ctx, userID)
+	s.invalidateProfile(ctx, userID)
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"user_id":    userID,
+		"count":      len(languages),
+	}).Info("User languages updated successfully")
+
+	return makeLanguageResponses(languages), nil
+}
+
+// makeUserLanguages normalizes the requested language codes; listing the
+// same language twice, even as "en" and "eng", is rejected.
+func makeUserLanguages(userID string, requests []bio.UserLanguageRequest) ([]entity.UserLanguage, error) {
+	languages := make([]entity.UserLanguage, 0, len(requests))
+	seen := make(map[string]bool, len(requests))
+	for _, req := range requests {
+		code, err := bio.NormalizeLanguage(req.Language)
+		if err != nil {
+			return nil, err
+		}
+		if seen[code] {
+			return nil, bio.ErrorDuplicateLanguage
+		}
+		seen[code] = true
+
+		languages = append(languages, entity.UserLanguage{
+			UserID:    userID,
+			Language:  code,
+			Level:     entity.LanguageLevel(req.Level),
+			TestName:  strings.TrimSpace(req.TestName),
+			TestScore: strings.TrimSpace(req.TestScore),
+		})
+	}
+	return languages, nil
+}
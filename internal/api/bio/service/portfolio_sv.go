@@ -2,6 +2,7 @@ package bioService
 
 import (
 	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/api/skill"
 	"ProjectGolang/internal/entity"
 	contextPkg "ProjectGolang/pkg/context"
 	"ProjectGolang/pkg/utils"
@@ -12,10 +13,30 @@ import (
 	"time"
 )
 
-func (s *bioService) CreatePortfolio(ctx context.Context, req bio.CreatePortfolio, userID string, image *multipart.FileHeader, descriptionImage *multipart.FileHeader) error {
+func (s *bioService) CreatePortfolio(ctx context.Context, viewer entity.UserLoginData, req bio.CreatePortfolio, userID string, image *multipart.FileHeader, descriptionImage *multipart.FileHeader) error {
 	requestID := contextPkg.GetRequestID(ctx)
 
-	bioRepo, err := s.bioRepository.NewClient(false)
+	if err := requireBioOwner(viewer, userID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"user_id":    userID,
+			"viewer_id":  viewer.ID,
+		}).Warn("Portfolio creation for another user")
+		return err
+	}
+
+	now := time.Now()
+	startDate, endDate, err := newBioPeriod(req.StartDate, req.EndDate, req.IsCurrent, now)
+	if err != nil {
+		return err
+	}
+
+	skills, err := s.resolveSkills(ctx, skill.SplitNames(req.Skills))
+	if err != nil {
+		return err
+	}
+
+	bioRepo, err := s.bioRepository.NewClient(true)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -23,6 +44,7 @@ func (s *bioService) CreatePortfolio(ctx context.Context, req bio.CreatePortfoli
 		}).Error("Failed to create repository client")
 		return err
 	}
+	defer bioRepo.Rollback()
 
 	authRepo, err := s.authRepository.NewClient(false)
 	if err != nil {
@@ -79,7 +101,6 @@ func (s *bioService) CreatePortfolio(ctx context.Context, req bio.CreatePortfoli
 		descriptionImageURL = uploadedURL
 	}
 
-	now := time.Now()
 	id, err := utils.NewUlidFromTimestamp(now)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -97,9 +118,11 @@ func (s *bioService) CreatePortfolio(ctx context.Context, req bio.CreatePortfoli
 		ProjectLocation:  req.ProjectLocation,
 		DescriptionImage: descriptionImageURL,
 		ProjectLink:      req.ProjectLink,
-		StartDate:        req.StartDate,
-		EndDate:          req.EndDate,
+		StartDate:        startDate,
+		EndDate:          endDate,
+		IsCurrent:        req.IsCurrent,
 		Description:      req.Description,
+		IsHidden:         req.IsHidden,
 		CreatedAt:        now,
 		UpdatedAt:        now,
 	}
@@ -113,6 +136,23 @@ func (s *bioService) CreatePortfolio(ctx context.Context, req bio.CreatePortfoli
 		return err
 	}
 
+	if len(skills) > 0 {
+		if err := s.linkEntrySkills(ctx, bioRepo, userID, newPortfolio.ID, skills, bioRepo.Skill.ReplacePortfolioSkills); err != nil {
+			return err
+		}
+	}
+
+	if err := bioRepo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Failed to commit portfolio creation")
+		return err
+	}
+
+	s.invalidateProfile(ctx, userID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id":       requestID,
 		"id":               newPortfolio.ID,
@@ -124,7 +164,7 @@ func (s *bioService) CreatePortfolio(ctx context.Context, req bio.CreatePortfoli
 	return nil
 }
 
-func (s *bioService) GetPortfolioByID(ctx context.Context, id string) (entity.Portfolio, error) {
+func (s *bioService) GetPortfolioByID(ctx context.Context, viewer entity.UserLoginData, id string) (entity.Portfolio, error) {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	repo, err := s.bioRepository.NewClient(false)
@@ -151,8 +191,23 @@ func (s *bioService) GetPortfolioByID(ctx context.Context, id string) (entity.Po
 			"request_id": requestID,
 			"id":         id,
 		}).Warn("Portfolio not found")
-		return entity.Portfolio{}, fmt.Errorf("portfolio not found")
+		return entity.Portfolio{}, bio.ErrorPortfolioNotFound
+	}
+
+	owner, err := s.getVisibleOwner(ctx, portfolio.UserID, viewer)
+	if err != nil {
+		return entity.Portfolio{}, err
+	}
+
+	if portfolio.IsHidden && !bio.IsProfileOwner(owner, viewer) {
+		return entity.Portfolio{}, bio.ErrorPortfolioNotFound
+	}
+
+	portfolios := []entity.Portfolio{portfolio}
+	if err := s.attachPortfolioSkills(ctx, repo, portfolios); err != nil {
+		return entity.Portfolio{}, err
 	}
+	portfolio = portfolios[0]
 
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
@@ -162,7 +217,7 @@ func (s *bioService) GetPortfolioByID(ctx context.Context, id string) (entity.Po
 	return portfolio, nil
 }
 
-func (s *bioService) GetPortfoliosByUserID(ctx context.Context, userID string) ([]entity.Portfolio, error) {
+func (s *bioService) GetPortfoliosByUserID(ctx context.Context, viewer entity.UserLoginData, userID string) ([]entity.Portfolio, error) {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	bioRepo, err := s.bioRepository.NewClient(false)
@@ -174,41 +229,26 @@ func (s *bioService) GetPortfoliosByUserID(ctx context.Context, userID string) (
 		return nil, err
 	}
 
-	authRepo, err := s.authRepository.NewClient(false)
+	owner, err := s.getVisibleOwner(ctx, userID, viewer)
 	if err != nil {
-		s.log.WithFields(logrus.Fields{
-			"request_id": requestID,
-			"error":      err.Error(),
-		}).Error("Failed to create repository")
 		return nil, err
 	}
 
-	// Check if user exists
-	user, err := authRepo.User.GetUserByID(ctx, userID)
+	portfolios, err := bioRepo.Portfolio.GetPortfoliosByUserID(ctx, userID)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
 			"error":      err.Error(),
 			"user_id":    userID,
-		}).Error("Failed to get user by ID")
+		}).Error("Failed to get portfolios by user ID")
 		return nil, err
 	}
 
-	if user.ID == "" {
-		s.log.WithFields(logrus.Fields{
-			"request_id": requestID,
-			"user_id":    userID,
-		}).Warn("User not found")
-		return nil, fmt.Errorf("user not found")
+	if !bio.IsProfileOwner(owner, viewer) {
+		portfolios = visiblePortfolios(portfolios)
 	}
 
-	portfolios, err := bioRepo.Portfolio.GetPortfoliosByUserID(ctx, userID)
-	if err != nil {
-		s.log.WithFields(logrus.Fields{
-			"request_id": requestID,
-			"error":      err.Error(),
-			"user_id":    userID,
-		}).Error("Failed to get portfolios by user ID")
+	if err := s.attachPortfolioSkills(ctx, bioRepo, portfolios); err != nil {
 		return nil, err
 	}
 
@@ -221,10 +261,19 @@ func (s *bioService) GetPortfoliosByUserID(ctx context.Context, userID string) (
 	return portfolios, nil
 }
 
-func (s *bioService) UpdatePortfolio(ctx context.Context, req bio.UpdatePortfolio, id string, image *multipart.FileHeader, descriptionImage *multipart.FileHeader) error {
+func (s *bioService) UpdatePortfolio(ctx context.Context, viewer entity.UserLoginData, req bio.UpdatePortfolio, id string, image *multipart.FileHeader, descriptionImage *multipart.FileHeader) error {
 	requestID := contextPkg.GetRequestID(ctx)
 
-	repo, err := s.bioRepository.NewClient(false)
+	// An empty skills field leaves the linked skills as they are.
+	var skills []entity.Skill
+	if req.Skills != "" {
+		var err error
+		if skills, err = s.resolveSkills(ctx, skill.SplitNames(req.Skills)); err != nil {
+			return err
+		}
+	}
+
+	repo, err := s.bioRepository.NewClient(true)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"request_id": requestID,
@@ -232,6 +281,7 @@ func (s *bioService) UpdatePortfolio(ctx context.Context, req bio.UpdatePortfoli
 		}).Error("Failed to create repository client")
 		return err
 	}
+	defer repo.Rollback()
 
 	existingPortfolio, err := repo.Portfolio.GetPortfolioByID(ctx, id)
 	if err != nil {
@@ -251,6 +301,21 @@ func (s *bioService) UpdatePortfolio(ctx context.Context, req bio.UpdatePortfoli
 		return fmt.Errorf("portfolio not found")
 	}
 
+	if err := requireBioOwner(viewer, existingPortfolio.UserID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+			"viewer_id":  viewer.ID,
+		}).Warn("Portfolio update by another user")
+		return err
+	}
+
+	startDate, endDate, isCurrent, err := updateBioPeriod(existingPortfolio.StartDate, existingPortfolio.EndDate, existingPortfolio.IsCurrent,
+		req.StartDate, req.EndDate, req.IsCurrent, time.Now())
+	if err != nil {
+		return err
+	}
+
 	if image != nil {
 		err := s.s3.DeleteFile(existingPortfolio.Image)
 		if err != nil {
@@ -296,6 +361,7 @@ func (s *bioService) UpdatePortfolio(ctx context.Context, req bio.UpdatePortfoli
 	}
 
 	updatedPortfolio := s.updatePortfolioChanges(existingPortfolio, req)
+	updatedPortfolio.StartDate, updatedPortfolio.EndDate, updatedPortfolio.IsCurrent = startDate, endDate, isCurrent
 
 	if err := repo.Portfolio.UpdatePortfolio(ctx, updatedPortfolio); err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -306,6 +372,23 @@ func (s *bioService) UpdatePortfolio(ctx context.Context, req bio.UpdatePortfoli
 		return err
 	}
 
+	if req.Skills != "" {
+		if err := s.linkEntrySkills(ctx, repo, updatedPortfolio.UserID, id, skills, repo.Skill.ReplacePortfolioSkills); err != nil {
+			return err
+		}
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"id":         id,
+		}).Error("Failed to commit portfolio update")
+		return err
+	}
+
+	s.invalidateProfile(ctx, updatedPortfolio.UserID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id":       requestID,
 		"id":               updatedPortfolio.ID,
@@ -336,14 +419,6 @@ func (s *bioService) updatePortfolioChanges(portfolio entity.Portfolio, req bio.
 		updatedPortfolio.ProjectLink = req.ProjectLink
 	}
 
-	if req.StartDate != "" {
-		updatedPortfolio.StartDate = req.StartDate
-	}
-
-	if req.EndDate != "" {
-		updatedPortfolio.EndDate = req.EndDate
-	}
-
 	if req.Description != "" {
 		updatedPortfolio.Description = req.Description
 	}
@@ -352,10 +427,14 @@ func (s *bioService) updatePortfolioChanges(portfolio entity.Portfolio, req bio.
 		updatedPortfolio.Image = req.Image
 	}
 
+	if req.IsHidden != nil {
+		updatedPortfolio.IsHidden = *req.IsHidden
+	}
+
 	return updatedPortfolio
 }
 
-func (s *bioService) DeletePortfolio(ctx context.Context, id string) error {
+func (s *bioService) DeletePortfolio(ctx context.Context, viewer entity.UserLoginData, id string) error {
 	requestID := contextPkg.GetRequestID(ctx)
 
 	repo, err := s.bioRepository.NewClient(false)
@@ -385,6 +464,15 @@ func (s *bioService) DeletePortfolio(ctx context.Context, id string) error {
 		return fmt.Errorf("portfolio not found")
 	}
 
+	if err := requireBioOwner(viewer, existingPortfolio.UserID); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"id":         id,
+			"viewer_id":  viewer.ID,
+		}).Warn("Portfolio deletion by another user")
+		return err
+	}
+
 	if existingPortfolio.Image != "" {
 		err := s.s3.DeleteFile(existingPortfolio.Image)
 		if err != nil {
@@ -405,6 +493,8 @@ func (s *bioService) DeletePortfolio(ctx context.Context, id string) error {
 		return err
 	}
 
+	s.invalidateProfile(ctx, existingPortfolio.UserID)
+
 	s.log.WithFields(logrus.Fields{
 		"request_id": requestID,
 		"id":         id,
@@ -0,0 +1,255 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"sync"
+	"time"
+)
+
+const profileCacheTTL = 30 * time.Minute
+
+// GetProfile serves the viewer's slice of the profile, with the skills they
+// have endorsed marked.
+func (s *bioService) GetProfile(ctx context.Context, viewer entity.UserLoginData, userID string) (bio.ProfileResponse, error) {
+	profile, err := s.getViewedProfile(ctx, viewer, userID)
+	if err != nil {
+		return bio.ProfileResponse{}, err
+	}
+
+	return s.markViewerEndorsements(ctx, profile, viewer)
+}
+
+// getViewedProfile serves the viewer's slice of the profile. The cache holds
+// the owner's full view so one entry serves every viewer.
+func (s *bioService) getViewedProfile(ctx context.Context, viewer entity.UserLoginData, userID string) (bio.ProfileResponse, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+	cacheKey := bio.ProfileCacheKey(userID)
+
+	cached, err := s.redis.GetCache(ctx, cacheKey)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Warn("Failed to read profile cache")
+	}
+
+	if cached != "" {
+		var profile bio.ProfileResponse
+		if err := json.Unmarshal([]byte(cached), &profile); err == nil {
+			return viewProfile(profile, viewer)
+		}
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"user_id":    userID,
+		}).Warn("Discarding unreadable profile cache entry")
+	}
+
+	profile, err := s.loadProfile(ctx, userID)
+	if err != nil {
+		return bio.ProfileResponse{}, err
+	}
+
+	payload, err := json.Marshal(profile)
+	if err == nil {
+		err = s.redis.SetCache(ctx, cacheKey, string(payload), profileCacheTTL)
+	}
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Warn("Failed to cache profile")
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"user_id":    userID,
+	}).Debug("Profile retrieved successfully")
+
+	return viewProfile(profile, viewer)
+}
+
+// loadProfile reads the user, their slug, skills, languages and bio lists in parallel;
+// they are independent queries, so the page waits on the slowest one rather
+// than all.
+func (s *bioService) loadProfile(ctx context.Context, userID string) (bio.ProfileResponse, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	bioRepo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return bio.ProfileResponse{}, err
+	}
+
+	authRepo, err := s.authRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository")
+		return bio.ProfileResponse{}, err
+	}
+
+	var (
+		wg          sync.WaitGroup
+		mu          sync.Mutex
+		firstErr    error
+		user        entity.User
+		slug        entity.ProfileSlug
+		skills      []entity.Skill
+		experiences []entity.Experience
+		educations  []entity.Education
+		portfolios  []entity.Portfolio
+
+		certifications []entity.Certification
+		languages      []entity.UserLanguage
+		endorsements   map[string]entity.SkillEndorsementSummary
+	)
+
+	fetch := func(name string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				s.log.WithFields(logrus.Fields{
+					"request_id": requestID,
+					"error":      err.Error(),
+					"user_id":    userID,
+				}).Errorf("Failed to get %s for profile", name)
+
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	fetch("user", func() (err error) {
+		user, err = authRepo.User.GetUserByID(ctx, userID)
+		return err
+	})
+	fetch("slug", func() (err error) {
+		slug, err = authRepo.ProfileSlug.GetCurrentProfileSlug(ctx, entity.ProfileOwnerUser, userID)
+		return err
+	})
+	fetch("skills", func() (err error) {
+		skills, err = bioRepo.Skill.GetSkillsByUserID(ctx, userID)
+		return err
+	})
+	fetch("endorsements", func() (err error) {
+		endorsements, err = bioRepo.Endorsement.GetEndorsementSummaries(ctx, userID, endorserSampleSize)
+		return err
+	})
+	fetch("languages", func() (err error) {
+		languages, err = bioRepo.Language.GetLanguagesByUserID(ctx, userID)
+		return err
+	})
+	fetch("experiences", func() (err error) {
+		if experiences, err = bioRepo.Experience.GetExperiencesByUserID(ctx, userID); err != nil {
+			return err
+		}
+		return s.attachExperienceSkills(ctx, bioRepo, experiences)
+	})
+	fetch("educations", func() (err error) {
+		educations, err = bioRepo.Education.GetEducationsByUserID(ctx, userID)
+		return err
+	})
+	fetch("portfolios", func() (err error) {
+		if portfolios, err = bioRepo.Portfolio.GetPortfoliosByUserID(ctx, userID); err != nil {
+			return err
+		}
+		return s.attachPortfolioSkills(ctx, bioRepo, portfolios)
+	})
+	fetch("certifications", func() (err error) {
+		certifications, err = bioRepo.Certification.GetCertificationsByUserID(ctx, userID)
+		return err
+	})
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return bio.ProfileResponse{}, firstErr
+	}
+
+	if user.ID == "" {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"user_id":    userID,
+		}).Warn("User not found")
+		return bio.ProfileResponse{}, bio.ErrorUserNotFound
+	}
+
+	sortExperiences(experiences)
+	sortEducations(educations)
+	sortPortfolios(portfolios)
+
+	profile := makeProfileResponse(user, skills, endorsements, languages, experiences, educations, portfolios, certifications)
+	profile.Slug = slug.Slug
+
+	return profile, nil
+}
+
+// getVisibleOwner loads the owner of a bio and checks the viewer may read it.
+func (s *bioService) getVisibleOwner(ctx context.Context, userID string, viewer entity.UserLoginData) (entity.User, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	authRepo, err := s.authRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository")
+		return entity.User{}, err
+	}
+
+	owner, err := authRepo.User.GetUserByID(ctx, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Failed to get user by ID")
+		return entity.User{}, err
+	}
+
+	if owner.ID == "" {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"user_id":    userID,
+		}).Warn("User not found")
+		return entity.User{}, bio.ErrorUserNotFound
+	}
+
+	if !bio.CanViewProfile(owner, viewer) {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"user_id":    userID,
+			"viewer_id":  viewer.ID,
+		}).Warn("Profile not visible to viewer")
+		return entity.User{}, bio.ErrorProfileNotVisible
+	}
+
+	return owner, nil
+}
+
+// invalidateProfile drops the user's cached profile after any bio write.
+// Stored résumés are keyed by content, so the next download misses on its own.
+func (s *bioService) invalidateProfile(ctx context.Context, userID string) {
+	if err := s.redis.DeleteCache(ctx, bio.ProfileCacheKey(userID)); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Warn("Failed to invalidate profile cache")
+	}
+}
@@ -0,0 +1,70 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/entity"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSortExperiences(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	experiences := []entity.Experience{
+		{ID: "old", StartDate: month(2015, 1), EndDate: ptr(month(2017, 6)), CreatedAt: created},
+		{ID: "recent", StartDate: month(2018, 1), EndDate: ptr(month(2022, 3)), CreatedAt: created},
+		{ID: "current", StartDate: month(2022, 4), IsCurrent: true, CreatedAt: created},
+		{ID: "same end, later start", StartDate: month(2016, 1), EndDate: ptr(month(2017, 6)), CreatedAt: created},
+		{ID: "same dates, added later", StartDate: month(2015, 1), EndDate: ptr(month(2017, 6)), CreatedAt: created.Add(time.Hour)},
+		{ID: "no end date", StartDate: month(2019, 1), CreatedAt: created},
+	}
+
+	sortExperiences(experiences)
+
+	got := make([]string, len(experiences))
+	for i, experience := range experiences {
+		got[i] = experience.ID
+	}
+
+	want := []string{"current", "recent", "same end, later start", "same dates, added later", "old", "no end date"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("order = %q, want %q", got, want)
+	}
+}
+
+func TestVisibleEntries(t *testing.T) {
+	experiences := visibleExperiences([]entity.Experience{{ID: "a"}, {ID: "b", IsHidden: true}})
+	if len(experiences) != 1 || experiences[0].ID != "a" {
+		t.Errorf("visibleExperiences() = %+v", experiences)
+	}
+
+	educations := visibleEducations([]entity.Education{{ID: "a", IsHidden: true}, {ID: "b"}})
+	if len(educations) != 1 || educations[0].ID != "b" {
+		t.Errorf("visibleEducations() = %+v", educations)
+	}
+
+	portfolios := visiblePortfolios([]entity.Portfolio{{ID: "a", IsHidden: true}})
+	if portfolios == nil || len(portfolios) != 0 {
+		t.Errorf("visiblePortfolios() = %#v, want an empty list", portfolios)
+	}
+}
+
+func TestMakeProfileResponseKeepsPrivacyForCache(t *testing.T) {
+	user := entity.User{
+		ID:                "owner",
+		Role:              entity.RoleCandidate,
+		ProfileVisibility: entity.ProfileVisibilityRecruiters,
+		HideEmail:         true,
+	}
+
+	profile := makeProfileResponse(user, nil, nil, nil, nil, nil, nil, nil)
+
+	if profile.Experiences == nil || profile.Educations == nil || profile.Portfolios == nil || profile.Certifications == nil {
+		t.Errorf("empty sections should be empty lists, got %+v", profile)
+	}
+
+	owner := profileOwner(profile)
+	if owner.ID != user.ID || owner.Role != user.Role || owner.ProfileVisibility != user.ProfileVisibility || owner.HideEmail != user.HideEmail {
+		t.Errorf("profileOwner() = %+v, want the privacy settings of %+v", owner, user)
+	}
+}
@@ -0,0 +1,281 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/pdf"
+	"ProjectGolang/pkg/s3"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"strings"
+)
+
+// resumeLayoutVersion is part of every stored résumé's name; bump it when a
+// template changes so files rendered with the old layout are not served.
+const resumeLayoutVersion = "3"
+
+type resumeLayout struct {
+	photoSize    float64
+	nameSize     float64
+	headingSize  float64
+	bodySize     float64
+	descriptions bool
+	headingRule  bool
+}
+
+var resumeLayouts = map[string]resumeLayout{
+	bio.ResumeTemplateClassic: {photoSize: 80, nameSize: 20, headingSize: 13, bodySize: 11, descriptions: true},
+	bio.ResumeTemplateModern:  {photoSize: 110, nameSize: 24, headingSize: 12, bodySize: 10, descriptions: true, headingRule: true},
+	bio.ResumeTemplateCompact: {nameSize: 16, headingSize: 11, bodySize: 9},
+}
+
+type resumeEntry struct {
+	title       string
+	subtitle    string
+	period      string
+	description string
+}
+
+// GetResumePDF renders the viewer's slice of a profile as a PDF. Rendered
+// files are kept in storage under a hash of the profile they were built from,
+// so an unchanged profile is served without rendering it again.
+func (s *bioService) GetResumePDF(ctx context.Context, viewer entity.UserLoginData, userID string, template string) ([]byte, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	if template == "" {
+		template = bio.ResumeTemplateClassic
+	}
+	layout, ok := resumeLayouts[template]
+	if !ok {
+		return nil, bio.ErrorResumeTemplate
+	}
+
+	profile, err := s.getViewedProfile(ctx, viewer, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := resumeStorageKey(profile, template)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.s3.GetFile(key)
+	if err == nil {
+		return stored, nil
+	}
+	if !errors.Is(err, s3.ErrNotFound) {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Warn("Failed to read stored resume")
+	}
+
+	var picture []byte
+	if layout.photoSize > 0 && profile.ProfilePicture != "" {
+		picture, err = s.s3.GetFile(profile.ProfilePicture)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+				"user_id":    userID,
+			}).Warn("Failed to get profile picture for resume")
+		}
+	}
+
+	document := buildResumePDF(profile, picture, layout)
+
+	if _, err := s.s3.UploadBytes(document, key, "application/pdf"); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Warn("Failed to store resume")
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"user_id":    userID,
+		"template":   template,
+	}).Info("Resume generated")
+
+	return document, nil
+}
+
+// resumeStorageKey names a résumé after the exact profile view it shows, so
+// viewers who see different parts of a profile never share a file.
+func resumeStorageKey(profile bio.ProfileResponse, template string) (string, error) {
+	payload, err := json.Marshal(profile)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(resumeLayoutVersion))
+	hash.Write([]byte(template))
+	hash.Write(payload)
+
+	return fmt.Sprintf("%s%s-%s.pdf", bio.ResumeStoragePrefix(profile.ID), template,
+		hex.EncodeToString(hash.Sum(nil))[:16]), nil
+}
+
+func buildResumePDF(profile bio.ProfileResponse, picture []byte, layout resumeLayout) []byte {
+	doc := pdf.New()
+
+	if len(picture) > 0 {
+		// A picture that cannot be decoded is left out rather than failing
+		// the whole résumé.
+		if err := doc.Image(picture, layout.photoSize, layout.photoSize); err == nil {
+			doc.Space(10)
+		}
+	}
+
+	doc.SetFont(pdf.HelveticaBold, layout.nameSize)
+	doc.Paragraph(profile.Name)
+	if profile.Headline != "" {
+		doc.SetFont(pdf.Helvetica, layout.bodySize+1)
+		doc.Paragraph(profile.Headline)
+	}
+
+	doc.SetFont(pdf.Helvetica, layout.bodySize)
+	if contact := joinNonEmpty(" · ", profile.Email, profile.PhoneNumber, profile.Location); contact != "" {
+		doc.Paragraph(contact)
+	}
+	doc.Rule()
+
+	experiences := make([]resumeEntry, 0, len(profile.Experiences))
+	for _, experience := range profile.Experiences {
+		experiences = append(experiences, resumeEntry{
+			title:       experience.JobTitle,
+			subtitle:    joinNonEmpty(" · ", experience.JobLocation, experience.SkillUsed),
+			period:      resumePeriod(experience.StartDate, experience.EndDate, experience.IsCurrent),
+			description: experience.Description,
+		})
+	}
+	writeResumeSection(doc, layout, "Experience", experiences)
+
+	educations := make([]resumeEntry, 0, len(profile.Educations))
+	for _, education := range profile.Educations {
+		educations = append(educations, resumeEntry{
+			title:       education.TitleDegree,
+			subtitle:    education.InstitutionalName,
+			period:      resumePeriod(education.StartDate, education.EndDate, education.IsCurrent),
+			description: education.Description,
+		})
+	}
+	writeResumeSection(doc, layout, "Education", educations)
+
+	portfolios := make([]resumeEntry, 0, len(profile.Portfolios))
+	for _, portfolio := range profile.Portfolios {
+		portfolios = append(portfolios, resumeEntry{
+			title:       portfolio.ProjectName,
+			subtitle:    joinNonEmpty(" · ", portfolio.ProjectLocation, portfolio.ProjectLink),
+			period:      resumePeriod(portfolio.StartDate, portfolio.EndDate, portfolio.IsCurrent),
+			description: portfolio.Description,
+		})
+	}
+	writeResumeSection(doc, layout, "Projects", portfolios)
+
+	certifications := make([]resumeEntry, 0, len(profile.Certifications))
+	for _, certification := range profile.Certifications {
+		period := resumeMonth(certification.IssueDate)
+		if certification.ExpiryDate != "" {
+			period = fmt.Sprintf("%s - %s", period, resumeMonth(certification.ExpiryDate))
+		}
+		certifications = append(certifications, resumeEntry{
+			title:    certification.Name,
+			subtitle: joinNonEmpty(" · ", certification.Issuer, certification.CredentialID),
+			period:   period,
+		})
+	}
+	writeResumeSection(doc, layout, "Certifications", certifications)
+
+	languages := make([]resumeEntry, 0, len(profile.Languages))
+	for _, language := range profile.Languages {
+		languages = append(languages, resumeEntry{
+			title:    language.Name,
+			subtitle: joinNonEmpty(" ", language.TestName, language.TestScore),
+			period:   resumeLanguageLevel(language.Level),
+		})
+	}
+	writeResumeSection(doc, layout, "Languages", languages)
+
+	return doc.Bytes()
+}
+
+func writeResumeSection(doc *pdf.Document, layout resumeLayout, heading string, entries []resumeEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	doc.Space(layout.bodySize)
+	doc.SetFont(pdf.HelveticaBold, layout.headingSize)
+	if layout.headingRule {
+		doc.Paragraph(strings.ToUpper(heading))
+		doc.Rule()
+	} else {
+		doc.Paragraph(heading)
+	}
+
+	const periodWidth = 130
+	for _, entry := range entries {
+		doc.Space(layout.bodySize / 3)
+		doc.SetFont(pdf.HelveticaBold, layout.bodySize)
+		doc.Cell(doc.Width()-periodWidth, entry.title)
+		doc.SetFont(pdf.Helvetica, layout.bodySize)
+		doc.Cell(periodWidth, entry.period)
+		doc.Ln()
+
+		if entry.subtitle != "" {
+			doc.Paragraph(entry.subtitle)
+		}
+		if layout.descriptions && entry.description != "" {
+			doc.Paragraph(entry.description)
+		}
+	}
+}
+
+func resumePeriod(start, end string, isCurrent bool) string {
+	from := resumeMonth(start)
+	to := resumeMonth(end)
+	if isCurrent {
+		to = "Present"
+	}
+	if to == "" {
+		return from
+	}
+	return fmt.Sprintf("%s - %s", from, to)
+}
+
+func resumeLanguageLevel(level string) string {
+	if level == string(entity.LanguageLevelNative) {
+		return "Native"
+	}
+	return level
+}
+
+// resumeMonth prints a YYYY-MM bio date as e.g. "Mar 2021".
+func resumeMonth(value string) string {
+	t, err := parseYearMonth(value)
+	if err != nil {
+		return value
+	}
+	return t.Format("Jan 2006")
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
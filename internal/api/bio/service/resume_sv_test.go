@@ -0,0 +1,48 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"strings"
+	"testing"
+)
+
+func TestResumeStorageKey(t *testing.T) {
+	base := bio.ProfileResponse{ID: "user-1", Name: "Jane Doe", Headline: "Backend Engineer"}
+	key, err := resumeStorageKey(base, bio.ResumeTemplateClassic)
+	if err != nil {
+		t.Fatalf("resumeStorageKey() error = %v", err)
+	}
+
+	if !strings.HasPrefix(key, bio.ResumeStoragePrefix("user-1")+bio.ResumeTemplateClassic+"-") || !strings.HasSuffix(key, ".pdf") {
+		t.Errorf("resumeStorageKey() = %q, not under the user's prefix", key)
+	}
+
+	renamed := base
+	renamed.Name = "Jane Smith"
+	trimmed := base
+	trimmed.Headline = ""
+
+	tests := []struct {
+		name     string
+		profile  bio.ProfileResponse
+		template string
+		wantSame bool
+	}{
+		{name: "same view", profile: base, template: bio.ResumeTemplateClassic, wantSame: true},
+		{name: "other template", profile: base, template: bio.ResumeTemplateModern, wantSame: false},
+		{name: "profile changed", profile: renamed, template: bio.ResumeTemplateClassic, wantSame: false},
+		{name: "viewer sees less", profile: trimmed, template: bio.ResumeTemplateClassic, wantSame: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resumeStorageKey(tt.profile, tt.template)
+			if err != nil {
+				t.Fatalf("resumeStorageKey() error = %v", err)
+			}
+			if (got == key) != tt.wantSame {
+				t.Errorf("resumeStorageKey() = %q, base %q, want same %v", got, key, tt.wantSame)
+			}
+		})
+	}
+}
@@ -4,6 +4,10 @@ import (
 	authRepository "ProjectGolang/internal/api/auth/repository"
 	"ProjectGolang/internal/api/bio"
 	bioRepository "ProjectGolang/internal/api/bio/repository"
+	notificationService "ProjectGolang/internal/api/notification/service"
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/api/skill"
+	skillService "ProjectGolang/internal/api/skill/service"
 	"ProjectGolang/internal/entity"
 	"ProjectGolang/pkg/redis"
 	"ProjectGolang/pkg/s3"
@@ -20,33 +24,54 @@ type bioService struct {
 	smtp           smtp.ItfSmtp
 	redis          redis.ItfRedis
 	s3             s3.ItfS3
+	skills         skillService.Resolver
+	notifier       notificationService.Notifier
 }
 
 type BioService interface {
-	CreateExperience(ctx context.Context, req bio.CreateExperience, userID string, image *multipart.FileHeader) error
-	GetExperienceByID(ctx context.Context, id string) (entity.Experience, error)
-	GetExperiencesByUserID(ctx context.Context, userID string) ([]entity.Experience, error)
-	UpdateExperience(ctx context.Context, req bio.UpdateExperience, id string, image *multipart.FileHeader) error
-	DeleteExperience(ctx context.Context, id string) error
-
-	CreateEducation(ctx context.Context, req bio.CreateEducation, userID string, image *multipart.FileHeader) error
-	GetEducationByID(ctx context.Context, id string) (entity.Education, error)
-	GetEducationsByUserID(ctx context.Context, userID string) ([]entity.Education, error)
-	UpdateEducation(ctx context.Context, req bio.UpdateEducation, id string, image *multipart.FileHeader) error
-	DeleteEducation(ctx context.Context, id string) error
-
-	CreatePortfolio(ctx context.Context, req bio.CreatePortfolio, userID string, image *multipart.FileHeader, descriptionImage *multipart.FileHeader) error
-	GetPortfolioByID(ctx context.Context, id string) (entity.Portfolio, error)
-	GetPortfoliosByUserID(ctx context.Context, userID string) ([]entity.Portfolio, error)
-	UpdatePortfolio(ctx context.Context, req bio.UpdatePortfolio, id string, image *multipart.FileHeader, descriptionImage *multipart.FileHeader) error
-	DeletePortfolio(ctx context.Context, id string) error
+	CreateExperience(ctx context.Context, viewer entity.UserLoginData, req bio.CreateExperience, userID string, image *multipart.FileHeader) error
+	GetExperienceByID(ctx context.Context, viewer entity.UserLoginData, id string) (entity.Experience, error)
+	GetExperiencesByUserID(ctx context.Context, viewer entity.UserLoginData, userID string) ([]entity.Experience, error)
+	UpdateExperience(ctx context.Context, viewer entity.UserLoginData, req bio.UpdateExperience, id string, image *multipart.FileHeader) error
+	DeleteExperience(ctx context.Context, viewer entity.UserLoginData, id string) error
+
+	CreateEducation(ctx context.Context, viewer entity.UserLoginData, req bio.CreateEducation, userID string, image *multipart.FileHeader) error
+	GetEducationByID(ctx context.Context, viewer entity.UserLoginData, id string) (entity.Education, error)
+	GetEducationsByUserID(ctx context.Context, viewer entity.UserLoginData, userID string) ([]entity.Education, error)
+	UpdateEducation(ctx context.Context, viewer entity.UserLoginData, req bio.UpdateEducation, id string, image *multipart.FileHeader) error
+	DeleteEducation(ctx context.Context, viewer entity.UserLoginData, id string) error
+
+	CreatePortfolio(ctx context.Context, viewer entity.UserLoginData, req bio.CreatePortfolio, userID string, image *multipart.FileHeader, descriptionImage *multipart.FileHeader) error
+	GetPortfolioByID(ctx context.Context, viewer entity.UserLoginData, id string) (entity.Portfolio, error)
+	GetPortfoliosByUserID(ctx context.Context, viewer entity.UserLoginData, userID string) ([]entity.Portfolio, error)
+	UpdatePortfolio(ctx context.Context, viewer entity.UserLoginData, req bio.UpdatePortfolio, id string, image *multipart.FileHeader, descriptionImage *multipart.FileHeader) error
+	DeletePortfolio(ctx context.Context, viewer entity.UserLoginData, id string) error
+
+	CreateCertification(ctx context.Context, viewer entity.UserLoginData, req bio.CreateCertification, userID string, image *multipart.FileHeader) error
+	GetCertificationByID(ctx context.Context, viewer entity.UserLoginData, id string) (bio.CertificationResponse, error)
+	GetCertificationsByUserID(ctx context.Context, viewer entity.UserLoginData, userID string) ([]bio.CertificationResponse, error)
+	UpdateCertification(ctx context.Context, viewer entity.UserLoginData, req bio.UpdateCertification, id string, image *multipart.FileHeader) error
+	DeleteCertification(ctx context.Context, viewer entity.UserLoginData, id string) error
+
+	GetProfile(ctx context.Context, viewer entity.UserLoginData, userID string) (bio.ProfileResponse, error)
+	UpdateUserSkills(ctx context.Context, userID string, req bio.UpdateUserSkills) ([]skill.SkillResponse, error)
+	UpdateUserLanguages(ctx context.Context, userID string, req bio.UpdateUserLanguages) ([]bio.LanguageResponse, error)
+
+	EndorseSkill(ctx context.Context, viewer entity.UserLoginData, userID string, skillID string) error
+	RevokeEndorsement(ctx context.Context, viewer entity.UserLoginData, userID string, skillID string) error
+	GetEndorsers(ctx context.Context, viewer entity.UserLoginData, userID string, skillID string, req bio.GetEndorsers) (bio.PaginatedEndorsersResponse, error)
+	GetResumePDF(ctx context.Context, viewer entity.UserLoginData, userID string, template string) ([]byte, error)
+	ExportJSONResume(ctx context.Context, viewer entity.UserLoginData, userID string) (bio.JSONResume, error)
+	ImportJSONResume(ctx context.Context, userID string, resume bio.JSONResume, mode string, dryRun bool) (bio.JSONResumeImportResult, error)
 }
 
 func New(authRepo authRepository.Repository, bioRepo bioRepository.Repository,
 	log *logrus.Logger,
 	smtp smtp.ItfSmtp,
 	redis redis.ItfRedis,
-	s3 s3.ItfS3) BioService {
+	s3 s3.ItfS3,
+	skills skillService.Resolver,
+	notifier notificationService.Notifier) BioService {
 	return &bioService{
 		authRepository: authRepo,
 		bioRepository:  bioRepo,
@@ -54,5 +79,18 @@ func New(authRepo authRepository.Repository, bioRepo bioRepository.Repository,
 		smtp:           smtp,
 		redis:          redis,
 		s3:             s3,
+		skills:         skills,
+		notifier:       notifier,
+	}
+}
+
+// invalidateRecommendedJobs drops the user's cached job feed; experiences carry
+// the skills and job titles the recommendation ranking is built from.
+func (s *bioService) invalidateRecommendedJobs(ctx context.Context, userID string) {
+	if err := s.redis.DeleteCache(ctx, recruitment.RecommendedJobsCacheKey(userID)); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Warn("Failed to invalidate recommended jobs cache")
 	}
 }
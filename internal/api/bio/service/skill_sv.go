@@ -0,0 +1,157 @@
+package bioService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	bioRepository "ProjectGolang/internal/api/bio/repository"
+	"ProjectGolang/internal/api/skill"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// UpdateUserSkills replaces the skills listed on the user's profile, in the
+// order given.
+func (s *bioService) UpdateUserSkills(ctx context.Context, userID string, req bio.UpdateUserSkills) ([]skill.SkillResponse, error) {
+	requestID := contextPkg.GetRequestID(ctx)
+
+	skills, err := s.resolveSkills(ctx, req.Skills)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := s.bioRepository.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	if err := repo.Skill.ReplaceUserSkills(ctx, userID, skillIDs(skills), time.Now()); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Failed to update user skills")
+		return nil, err
+	}
+
+	s.invalidateRecommendedJobs(ctx, userID)
+	s.invalidateProfile(ctx, userID)
+
+	s.log.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"user_id":    userID,
+		"count":      len(skills),
+	}).Info("User skills updated successfully")
+
+	return skill.MakeSkillResponses(skills), nil
+}
+
+// resolveSkills tidies free-text skill names and maps them onto the
+// catalogue.
+func (s *bioService) resolveSkills(ctx context.Context, names []string) ([]entity.Skill, error) {
+	names = skill.CleanNames(names)
+	if err := skill.ValidateNames(names); err != nil {
+		return nil, err
+	}
+
+	skills, err := s.skills.Resolve(ctx, names)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+		}).Error("Failed to resolve skills")
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+// linkEntrySkills points a bio entry at its skills and adds any the profile
+// does not list yet, so skills used on the job show up on the profile too.
+func (s *bioService) linkEntrySkills(ctx context.Context, repo bioRepository.Client, userID string, entryID string, skills []entity.Skill,
+	replace func(ctx context.Context, id string, skillIDs []string) error) error {
+	ids := skillIDs(skills)
+
+	if err := replace(ctx, entryID, ids); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+			"id":         entryID,
+		}).Error("Failed to link skills")
+		return err
+	}
+
+	if err := repo.Skill.AddUserSkills(ctx, userID, ids, time.Now()); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+			"user_id":    userID,
+		}).Error("Failed to add skills to profile")
+		return err
+	}
+
+	return nil
+}
+
+func (s *bioService) attachExperienceSkills(ctx context.Context, repo bioRepository.Client, experiences []entity.Experience) error {
+	if len(experiences) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(experiences))
+	for i, experience := range experiences {
+		ids[i] = experience.ID
+	}
+
+	skills, err := repo.Skill.GetSkillsByExperienceIDs(ctx, ids)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+		}).Error("Failed to get experience skills")
+		return err
+	}
+
+	for i := range experiences {
+		experiences[i].Skills = skills[experiences[i].ID]
+	}
+	return nil
+}
+
+func (s *bioService) attachPortfolioSkills(ctx context.Context, repo bioRepository.Client, portfolios []entity.Portfolio) error {
+	if len(portfolios) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(portfolios))
+	for i, portfolio := range portfolios {
+		ids[i] = portfolio.ID
+	}
+
+	skills, err := repo.Skill.GetSkillsByPortfolioIDs(ctx, ids)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": contextPkg.GetRequestID(ctx),
+			"error":      err.Error(),
+		}).Error("Failed to get portfolio skills")
+		return err
+	}
+
+	for i := range portfolios {
+		portfolios[i].Skills = skills[portfolios[i].ID]
+	}
+	return nil
+}
+
+func skillIDs(skills []entity.Skill) []string {
+	ids := make([]string, len(skills))
+	for i, s := range skills {
+		ids[i] = s.ID
+	}
+	return ids
+}
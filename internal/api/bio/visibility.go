@@ -0,0 +1,28 @@
+package bio
+
+import "ProjectGolang/internal/entity"
+
+// IsProfileOwner reports whether the viewer is the candidate themselves.
+// Recruiter tokens carry the company ID, so they never match a user.
+func IsProfileOwner(owner entity.User, viewer entity.UserLoginData) bool {
+	return viewer.ID != "" && viewer.ID == owner.ID && viewer.Role != entity.RoleRecruiter
+}
+
+// CanViewProfile applies the owner's visibility setting to a viewer. An empty
+// viewer is an anonymous request; admins and the owner always see the profile.
+func CanViewProfile(owner entity.User, viewer entity.UserLoginData) bool {
+	if IsProfileOwner(owner, viewer) || viewer.Role == entity.RoleAdmin {
+		return true
+	}
+
+	switch owner.ProfileVisibility {
+	case entity.ProfileVisibilityPrivate:
+		return false
+	case entity.ProfileVisibilityRecruiters:
+		return viewer.Role == entity.RoleRecruiter
+	case entity.ProfileVisibilityLoggedIn:
+		return viewer.ID != ""
+	default:
+		return true
+	}
+}
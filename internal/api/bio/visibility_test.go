@@ -0,0 +1,47 @@
+package bio
+
+import (
+	"ProjectGolang/internal/entity"
+	"testing"
+)
+
+func TestCanViewProfile(t *testing.T) {
+	var (
+		anonymous = entity.UserLoginData{}
+		candidate = entity.UserLoginData{ID: "other", Role: entity.RoleCandidate}
+		recruiter = entity.UserLoginData{ID: "company", Role: entity.RoleRecruiter}
+		admin     = entity.UserLoginData{ID: "admin", Role: entity.RoleAdmin}
+		self      = entity.UserLoginData{ID: "owner", Role: entity.RoleCandidate}
+		// Recruiter tokens carry the company ID, which must never be taken
+		// for the candidate with the same ID.
+		lookalike = entity.UserLoginData{ID: "owner", Role: entity.RoleRecruiter}
+	)
+
+	tests := []struct {
+		name       string
+		visibility entity.ProfileVisibility
+		viewer     entity.UserLoginData
+		want       bool
+	}{
+		{name: "public to anonymous", visibility: entity.ProfileVisibilityPublic, viewer: anonymous, want: true},
+		{name: "unset is public", visibility: "", viewer: anonymous, want: true},
+		{name: "logged in to anonymous", visibility: entity.ProfileVisibilityLoggedIn, viewer: anonymous, want: false},
+		{name: "logged in to candidate", visibility: entity.ProfileVisibilityLoggedIn, viewer: candidate, want: true},
+		{name: "logged in to recruiter", visibility: entity.ProfileVisibilityLoggedIn, viewer: recruiter, want: true},
+		{name: "recruiters to candidate", visibility: entity.ProfileVisibilityRecruiters, viewer: candidate, want: false},
+		{name: "recruiters to recruiter", visibility: entity.ProfileVisibilityRecruiters, viewer: recruiter, want: true},
+		{name: "private to recruiter", visibility: entity.ProfileVisibilityPrivate, viewer: recruiter, want: false},
+		{name: "private to lookalike recruiter", visibility: entity.ProfileVisibilityPrivate, viewer: lookalike, want: false},
+		{name: "private to owner", visibility: entity.ProfileVisibilityPrivate, viewer: self, want: true},
+		{name: "private to admin", visibility: entity.ProfileVisibilityPrivate, viewer: admin, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := entity.User{ID: "owner", ProfileVisibility: tt.visibility}
+			if got := CanViewProfile(owner, tt.viewer); got != tt.want {
+				t.Errorf("CanViewProfile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package messaging
+
+import (
+	"fmt"
+	"time"
+)
+
+// PresenceTTL is how long a participant counts as online after their last
+// messaging activity.
+const PresenceTTL = 2 * time.Minute
+
+// NotificationCooldown keeps an offline recipient from getting an email for
+// every message of a burst.
+const NotificationCooldown = 15 * time.Minute
+
+func PresenceCacheKey(participantID string) string {
+	return fmt.Sprintf("messaging_presence:%s", participantID)
+}
+
+func NotificationCacheKey(conversationID string, recipientID string) string {
+	return fmt.Sprintf("messaging_notified:%s:%s", conversationID, recipientID)
+}
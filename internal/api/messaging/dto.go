@@ -0,0 +1,70 @@
+package messaging
+
+import (
+	"ProjectGolang/internal/entity"
+	"mime/multipart"
+	"time"
+)
+
+type StartConversation struct {
+	ParticipantID    string `json:"-"`
+	JobApplicationID string `json:"job_application_id" validate:"required_without=JobInvitationID,excluded_with=JobInvitationID"`
+	JobInvitationID  string `json:"job_invitation_id" validate:"required_without=JobApplicationID"`
+}
+
+type ConversationResponse struct {
+	ID               string           `json:"id"`
+	CompanyID        string           `json:"company_id"`
+	CandidateID      string           `json:"candidate_id"`
+	JobApplicationID string           `json:"job_application_id,omitempty"`
+	JobInvitationID  string           `json:"job_invitation_id,omitempty"`
+	UnreadCount      int              `json:"unread_count"`
+	Blocked          bool             `json:"blocked"`
+	LastMessage      *MessageResponse `json:"last_message"`
+	LastMessageAt    *time.Time       `json:"last_message_at"`
+	CreatedAt        time.Time        `json:"created_at"`
+}
+
+type GetMessages struct {
+	ConversationID string `json:"-"`
+	ParticipantID  string `json:"-"`
+	Before         string `query:"before"`
+	Limit          int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type SendMessage struct {
+	ConversationID string                `json:"-"`
+	SenderID       string                `json:"-"`
+	Body           string                `json:"body" validate:"max=5000"`
+	Attachment     *multipart.FileHeader `json:"-"`
+}
+
+type MessageResponse struct {
+	ID             string `json:"id"`
+	ConversationID string `json:"conversation_id"`
+	SenderID       string `json:"sender_id"`
+	Body           string `json:"body"`
+	AttachmentURL  string `json:"attachment_url,omitempty"`
+	AttachmentName string `json:"attachment_name,omitempty"`
+	// Read reports whether the recipient has seen the message.
+	Read      bool      `json:"read"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MessagesResponse struct {
+	Messages []MessageResponse `json:"messages"`
+	// NextBefore is the cursor for the next, older page.
+	NextBefore string `json:"next_before,omitempty"`
+}
+
+type UnreadCountResponse struct {
+	UnreadCount int `json:"unread_count"`
+}
+
+type ReportConversation struct {
+	ConversationID string              `json:"-"`
+	ReporterID     string              `json:"-"`
+	MessageID      string              `json:"message_id"`
+	Reason         entity.ReportReason `json:"reason" validate:"required,oneof=spam harassment scam inappropriate other"`
+	Details        string              `json:"details" validate:"max=2000"`
+}
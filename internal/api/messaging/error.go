@@ -0,0 +1,17 @@
+package messaging
+
+import (
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrorConversationNotFound = response.New(fiber.StatusNotFound, "conversation not found")
+	ErrorSubjectNotFound      = response.New(fiber.StatusNotFound, "job application or invitation not found")
+	ErrorConversationBlocked  = response.New(fiber.StatusForbidden, "messaging is blocked between these participants")
+	ErrorMessageEmpty         = response.New(fiber.StatusBadRequest, "message needs a body or an attachment")
+	ErrorMessageNotFound      = response.New(fiber.StatusNotFound, "message not found")
+	ErrorAttachmentTooLarge   = response.New(fiber.StatusRequestEntityTooLarge, "attachment exceeds the size limit")
+	ErrorParticipantOnly      = response.New(fiber.StatusForbidden, "only candidates and recruiters can use messaging")
+	ErrorViewerReadOnly       = response.New(fiber.StatusForbidden, "viewers cannot send messages for the company")
+)
@@ -0,0 +1,302 @@
+package messagingHandler
+
+import (
+	"ProjectGolang/internal/api/messaging"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"strings"
+	"time"
+)
+
+func (h *MessagingHandler) StartConversation(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireParticipant(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to start conversation")
+	}
+
+	var req messaging.StartConversation
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse conversation request body")
+		return err
+	}
+	req.ParticipantID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for conversation start")
+		return err
+	}
+
+	conversation, err := h.messagingService.Conversation().StartConversation(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to start conversation")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(conversation)
+	}
+}
+
+func (h *MessagingHandler) GetConversations(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireParticipant(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get conversations")
+	}
+
+	conversations, err := h.messagingService.Conversation().GetConversations(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get conversations")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(conversations)
+	}
+}
+
+func (h *MessagingHandler) GetUnreadCount(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireParticipant(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get unread count")
+	}
+
+	count, err := h.messagingService.Conversation().GetUnreadCount(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get unread count")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(count)
+	}
+}
+
+func (h *MessagingHandler) GetMessages(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireParticipant(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get messages")
+	}
+
+	var req messaging.GetMessages
+	if err := ctx.QueryParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse messages query parameters")
+		return err
+	}
+	req.ConversationID = ctx.Params("id")
+	req.ParticipantID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for messages request")
+		return err
+	}
+
+	messages, err := h.messagingService.Conversation().GetMessages(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get messages")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(messages)
+	}
+}
+
+// SendMessage accepts JSON for plain text messages, or a multipart form with
+// a "body" field and an optional "attachment" file.
+func (h *MessagingHandler) SendMessage(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 15*time.Second)
+	defer cancel()
+
+	user, err := h.requireParticipant(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to send message")
+	}
+
+	var req messaging.SendMessage
+	if strings.HasPrefix(ctx.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		req.Body = ctx.FormValue("body")
+		if attachment, err := ctx.FormFile("attachment"); err == nil {
+			req.Attachment = attachment
+		}
+	} else if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse message request body")
+		return err
+	}
+	req.ConversationID = ctx.Params("id")
+	req.SenderID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for message")
+		return err
+	}
+
+	message, err := h.messagingService.Conversation().SendMessage(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to send message")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(message)
+	}
+}
+
+func (h *MessagingHandler) MarkConversationRead(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireParticipant(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to mark conversation read")
+	}
+
+	if err := h.messagingService.Conversation().MarkConversationRead(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Failed to mark conversation read")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *MessagingHandler) BlockParticipant(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireParticipant(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to block participant")
+	}
+
+	if err := h.messagingService.Moderation().BlockParticipant(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Failed to block participant")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *MessagingHandler) UnblockParticipant(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireParticipant(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to unblock participant")
+	}
+
+	if err := h.messagingService.Moderation().UnblockParticipant(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Failed to unblock participant")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *MessagingHandler) ReportConversation(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireParticipant(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to report conversation")
+	}
+
+	var req messaging.ReportConversation
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse report request body")
+		return err
+	}
+	req.ConversationID = ctx.Params("id")
+	req.ReporterID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for conversation report")
+		return err
+	}
+
+	if err := h.messagingService.Moderation().ReportConversation(c, req); err != nil {
+		return response.WriteError(ctx, err, "Failed to report conversation")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusCreated)
+	}
+}
@@ -0,0 +1,27 @@
+package messagingHandler
+
+import (
+	"ProjectGolang/internal/api/messaging"
+	"ProjectGolang/internal/entity"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"github.com/gofiber/fiber/v2"
+)
+
+// requireParticipant returns the authenticated user when they can take part
+// in conversations, which is candidates and recruiters only.
+func (h *MessagingHandler) requireParticipant(ctx *fiber.Ctx) (entity.UserLoginData, error) {
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return entity.UserLoginData{}, err
+	}
+
+	if user.Role != entity.RoleCandidate && user.Role != entity.RoleRecruiter {
+		return entity.UserLoginData{}, messaging.ErrorParticipantOnly
+	}
+
+	if user.CompanyRole == entity.CompanyRoleViewer && ctx.Method() != fiber.MethodGet {
+		return entity.UserLoginData{}, messaging.ErrorViewerReadOnly
+	}
+
+	return user, nil
+}
@@ -0,0 +1,40 @@
+package messagingHandler
+
+import (
+	messagingService "ProjectGolang/internal/api/messaging/service"
+	"ProjectGolang/internal/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+type MessagingHandler struct {
+	messagingService messagingService.MessagingService
+	validator        *validator.Validate
+	middleware       middleware.Middleware
+	log              *logrus.Logger
+}
+
+func New(ms messagingService.MessagingService, validate *validator.Validate, middleware middleware.Middleware, log *logrus.Logger) *MessagingHandler {
+	return &MessagingHandler{
+		messagingService: ms,
+		validator:        validate,
+		middleware:       middleware,
+		log:              log,
+	}
+}
+
+func (h *MessagingHandler) Start(srv fiber.Router) {
+	mg := srv.Group("/messaging")
+	mg.Get("/unread_count", h.middleware.NewTokenMiddleware, h.GetUnreadCount)
+
+	cv := mg.Group("/conversations")
+	cv.Post("/", h.middleware.NewTokenMiddleware, h.StartConversation)
+	cv.Get("/", h.middleware.NewTokenMiddleware, h.GetConversations)
+	cv.Get("/:id/messages", h.middleware.NewTokenMiddleware, h.GetMessages)
+	cv.Post("/:id/messages", h.middleware.NewTokenMiddleware, h.SendMessage)
+	cv.Post("/:id/read", h.middleware.NewTokenMiddleware, h.MarkConversationRead)
+	cv.Post("/:id/block", h.middleware.NewTokenMiddleware, h.BlockParticipant)
+	cv.Delete("/:id/block", h.middleware.NewTokenMiddleware, h.UnblockParticipant)
+	cv.Post("/:id/reports", h.middleware.NewTokenMiddleware, h.ReportConversation)
+}
@@ -0,0 +1,251 @@
+package messagingRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+func (r *conversationsRepository) CreateConversation(c context.Context, conversation entity.Conversation) error {
+	r.log.WithFields(map[string]interface{}{
+		"conversation_id": conversation.ID,
+		"company_id":      conversation.CompanyID,
+		"candidate_id":    conversation.CandidateID,
+	}).Debug("Creating conversation in database")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateConversation),
+		conversation.ID,
+		conversation.CompanyID,
+		conversation.CandidateID,
+		nullString(conversation.JobApplicationID),
+		nullString(conversation.JobInvitationID),
+		conversation.CreatedAt,
+		conversation.UpdatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating conversation")
+		return err
+	}
+
+	return nil
+}
+
+func (r *conversationsRepository) GetConversationByID(c context.Context, id string) (entity.Conversation, error) {
+	return r.getConversation(c, queryGetConversationByID, id)
+}
+
+func (r *conversationsRepository) GetConversationByJobApplicationID(c context.Context, jobApplicationID string) (entity.Conversation, error) {
+	return r.getConversation(c, queryGetConversationByJobApplicationID, jobApplicationID)
+}
+
+func (r *conversationsRepository) GetConversationByJobInvitationID(c context.Context, jobInvitationID string) (entity.Conversation, error) {
+	return r.getConversation(c, queryGetConversationByJobInvitationID, jobInvitationID)
+}
+
+func (r *conversationsRepository) getConversation(c context.Context, query string, arg string) (entity.Conversation, error) {
+	var (
+		conversation     entity.Conversation
+		jobApplicationID sql.NullString
+		jobInvitationID  sql.NullString
+		lastMessageAt    sql.NullTime
+		updatedAt        sql.NullTime
+	)
+	err := r.q.QueryRowxContext(c, r.q.Rebind(query), arg).Scan(
+		&conversation.ID,
+		&conversation.CompanyID,
+		&conversation.CandidateID,
+		&jobApplicationID,
+		&jobInvitationID,
+		&lastMessageAt,
+		&conversation.CreatedAt,
+		&updatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Conversation{}, nil
+		}
+
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"arg":   arg,
+		}).Error("Database error when getting conversation")
+		return entity.Conversation{}, err
+	}
+
+	conversation.JobApplicationID = jobApplicationID.String
+	conversation.JobInvitationID = jobInvitationID.String
+	conversation.LastMessageAt = nullTime(lastMessageAt)
+	conversation.UpdatedAt = updatedAt.Time
+
+	return conversation, nil
+}
+
+// GetConversationsByParticipantID lists the participant's conversations with
+// the latest message, their unread count and when the other side last read.
+func (r *conversationsRepository) GetConversationsByParticipantID(c context.Context, participantID string) ([]entity.Conversation, error) {
+	r.log.WithFields(map[string]interface{}{
+		"participant_id": participantID,
+	}).Debug("Getting conversations by participant ID")
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetConversationsByParticipantID),
+		participantID, participantID, participantID, participantID, participantID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"participant_id": participantID,
+		}).Error("Database error when getting conversations")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var conversations []entity.Conversation
+	for rows.Next() {
+		var (
+			conversation      entity.Conversation
+			jobApplicationID  sql.NullString
+			jobInvitationID   sql.NullString
+			lastMessageAt     sql.NullTime
+			updatedAt         sql.NullTime
+			lastRead          sql.NullTime
+			lastReadByOther   sql.NullTime
+			messageID         sql.NullString
+			messageSenderID   sql.NullString
+			messageBody       sql.NullString
+			messageAttachment sql.NullString
+			messageAttachName sql.NullString
+			messageCreatedAt  sql.NullTime
+		)
+		err := rows.Scan(
+			&conversation.ID,
+			&conversation.CompanyID,
+			&conversation.CandidateID,
+			&jobApplicationID,
+			&jobInvitationID,
+			&lastMessageAt,
+			&conversation.CreatedAt,
+			&updatedAt,
+			&conversation.UnreadCount,
+			&lastRead,
+			&lastReadByOther,
+			&conversation.Blocked,
+			&messageID,
+			&messageSenderID,
+			&messageBody,
+			&messageAttachment,
+			&messageAttachName,
+			&messageCreatedAt,
+		)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning conversation row")
+			return nil, err
+		}
+
+		conversation.JobApplicationID = jobApplicationID.String
+		conversation.JobInvitationID = jobInvitationID.String
+		conversation.LastMessageAt = nullTime(lastMessageAt)
+		conversation.UpdatedAt = updatedAt.Time
+		conversation.LastRead = nullTime(lastRead)
+		conversation.LastReadByOther = nullTime(lastReadByOther)
+		if messageID.Valid {
+			conversation.LastMessage = &entity.Message{
+				ID:             messageID.String,
+				ConversationID: conversation.ID,
+				SenderID:       messageSenderID.String,
+				Body:           messageBody.String,
+				AttachmentURL:  messageAttachment.String,
+				AttachmentName: messageAttachName.String,
+				CreatedAt:      messageCreatedAt.Time,
+			}
+		}
+		conversations = append(conversations, conversation)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through conversation rows")
+		return nil, err
+	}
+
+	return conversations, nil
+}
+
+func (r *conversationsRepository) TouchConversation(c context.Context, id string, lastMessageAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryTouchConversation), lastMessageAt, lastMessageAt, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when updating conversation")
+		return err
+	}
+
+	return nil
+}
+
+// MarkConversationRead moves the participant's read marker forward; it never
+// moves it back.
+func (r *conversationsRepository) MarkConversationRead(c context.Context, id string, participantID string, readAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryMarkConversationRead), id, participantID, readAt)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"id":             id,
+			"participant_id": participantID,
+		}).Error("Database error when marking conversation read")
+		return err
+	}
+
+	return nil
+}
+
+func (r *conversationsRepository) GetLastReadAt(c context.Context, id string, participantID string) (*time.Time, error) {
+	var lastReadAt time.Time
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryGetLastReadAt), id, participantID).Scan(&lastReadAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"id":             id,
+			"participant_id": participantID,
+		}).Error("Database error when getting conversation read marker")
+		return nil, err
+	}
+
+	return &lastReadAt, nil
+}
+
+func (r *conversationsRepository) CountUnreadMessages(c context.Context, participantID string) (int, error) {
+	var count int
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryCountUnreadMessages),
+		participantID, participantID, participantID, participantID).Scan(&count)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"participant_id": participantID,
+		}).Error("Database error when counting unread messages")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func nullTime(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
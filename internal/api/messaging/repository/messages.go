@@ -0,0 +1,113 @@
+package messagingRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *messagesRepository) CreateMessage(c context.Context, message entity.Message) error {
+	r.log.WithFields(map[string]interface{}{
+		"message_id":      message.ID,
+		"conversation_id": message.ConversationID,
+	}).Debug("Creating message in database")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateMessage),
+		message.ID,
+		message.ConversationID,
+		message.SenderID,
+		nullString(message.Body),
+		nullString(message.AttachmentURL),
+		nullString(message.AttachmentName),
+		message.CreatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating message")
+		return err
+	}
+
+	return nil
+}
+
+func (r *messagesRepository) GetMessageByID(c context.Context, id string) (entity.Message, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetMessageByID), id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when getting message")
+		return entity.Message{}, err
+	}
+	defer rows.Close()
+
+	messages, err := scanMessages(rows, r.log)
+	if err != nil {
+		return entity.Message{}, err
+	}
+
+	if len(messages) == 0 {
+		return entity.Message{}, nil
+	}
+
+	return messages[0], nil
+}
+
+// GetMessages pages backwards through a conversation, newest first. An empty
+// before starts from the latest message.
+func (r *messagesRepository) GetMessages(c context.Context, conversationID string, before string, limit int) ([]entity.Message, error) {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if before == "" {
+		rows, err = r.q.QueryContext(c, r.q.Rebind(queryGetMessages), conversationID, limit)
+	} else {
+		rows, err = r.q.QueryContext(c, r.q.Rebind(queryGetMessagesBefore), conversationID, before, limit)
+	}
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":           err.Error(),
+			"conversation_id": conversationID,
+		}).Error("Database error when getting messages")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanMessages(rows, r.log)
+}
+
+func scanMessages(rows *sql.Rows, log *logrus.Logger) ([]entity.Message, error) {
+	var messages []entity.Message
+	for rows.Next() {
+		var (
+			message        entity.Message
+			body           sql.NullString
+			attachmentURL  sql.NullString
+			attachmentName sql.NullString
+		)
+		err := rows.Scan(&message.ID, &message.ConversationID, &message.SenderID, &body,
+			&attachmentURL, &attachmentName, &message.CreatedAt)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning message row")
+			return nil, err
+		}
+		message.Body = body.String
+		message.AttachmentURL = attachmentURL.String
+		message.AttachmentName = attachmentName.String
+		messages = append(messages, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through message rows")
+		return nil, err
+	}
+
+	return messages, nil
+}
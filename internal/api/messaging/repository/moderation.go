@@ -0,0 +1,74 @@
+package messagingRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"time"
+)
+
+func (r *moderationRepository) CreateBlock(c context.Context, blockerID string, blockedID string, createdAt time.Time) error {
+	r.log.WithFields(map[string]interface{}{
+		"blocker_id": blockerID,
+		"blocked_id": blockedID,
+	}).Debug("Creating participant block in database")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateBlock), blockerID, blockedID, createdAt)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating participant block")
+		return err
+	}
+
+	return nil
+}
+
+func (r *moderationRepository) DeleteBlock(c context.Context, blockerID string, blockedID string) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryDeleteBlock), blockerID, blockedID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"blocker_id": blockerID,
+			"blocked_id": blockedID,
+		}).Error("Database error when deleting participant block")
+		return err
+	}
+
+	return nil
+}
+
+// IsBlocked reports whether either participant has blocked the other.
+func (r *moderationRepository) IsBlocked(c context.Context, firstID string, secondID string) (bool, error) {
+	var blocked bool
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryIsBlocked), firstID, secondID, secondID, firstID).Scan(&blocked)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when checking participant block")
+		return false, err
+	}
+
+	return blocked, nil
+}
+
+func (r *moderationRepository) CreateReport(c context.Context, report entity.MessageReport) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateMessageReport),
+		report.ID,
+		report.ConversationID,
+		nullString(report.MessageID),
+		report.ReporterID,
+		report.Reason,
+		nullString(report.Details),
+		report.Status,
+		report.CreatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":           err.Error(),
+			"conversation_id": report.ConversationID,
+		}).Error("Database error when creating message report")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package messagingRepository
+
+const (
+	queryCreateConversation = `
+    INSERT INTO conversations (
+        id, company_id, candidate_id, job_application_id, job_invitation_id, created_at, updated_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryConversationColumns = `
+    SELECT id, company_id, candidate_id, job_application_id, job_invitation_id,
+           last_message_at, created_at, updated_at
+    FROM conversations
+    `
+
+	queryGetConversationByID = queryConversationColumns + `WHERE id = ?`
+
+	queryGetConversationByJobApplicationID = queryConversationColumns + `WHERE job_application_id = ?`
+
+	queryGetConversationByJobInvitationID = queryConversationColumns + `WHERE job_invitation_id = ?`
+
+	queryGetConversationsByParticipantID = `
+    SELECT c.id, c.company_id, c.candidate_id, c.job_application_id, c.job_invitation_id,
+           c.last_message_at, c.created_at, c.updated_at,
+           (SELECT COUNT(*) FROM messages m
+            WHERE m.conversation_id = c.id AND m.sender_id <> ?
+              AND (own.last_read_at IS NULL OR m.created_at > own.last_read_at)) AS unread_count,
+           own.last_read_at, other.last_read_at,
+           EXISTS (
+               SELECT 1 FROM participant_blocks b
+               WHERE (b.blocker_id = c.company_id AND b.blocked_id = c.candidate_id)
+                  OR (b.blocker_id = c.candidate_id AND b.blocked_id = c.company_id)
+           ) AS blocked,
+           lm.id, lm.sender_id, lm.body, lm.attachment_url, lm.attachment_name, lm.created_at
+    FROM conversations c
+    LEFT JOIN conversation_reads own ON own.conversation_id = c.id AND own.participant_id = ?
+    LEFT JOIN conversation_reads other ON other.conversation_id = c.id AND other.participant_id <> ?
+    LEFT JOIN LATERAL (
+        SELECT id, sender_id, body, attachment_url, attachment_name, created_at
+        FROM messages
+        WHERE conversation_id = c.id
+        ORDER BY id DESC
+        LIMIT 1
+    ) lm ON TRUE
+    WHERE c.company_id = ? OR c.candidate_id = ?
+    ORDER BY COALESCE(c.last_message_at, c.created_at) DESC
+    `
+
+	queryTouchConversation = `
+    UPDATE conversations
+    SET last_message_at = ?, updated_at = ?
+    WHERE id = ?
+    `
+
+	queryMarkConversationRead = `
+    INSERT INTO conversation_reads (conversation_id, participant_id, last_read_at)
+    VALUES (?, ?, ?)
+    ON CONFLICT (conversation_id, participant_id)
+    DO UPDATE SET last_read_at = GREATEST(conversation_reads.last_read_at, EXCLUDED.last_read_at)
+    `
+
+	queryGetLastReadAt = `
+    SELECT last_read_at FROM conversation_reads WHERE conversation_id = ? AND participant_id = ?
+    `
+
+	queryCountUnreadMessages = `
+    SELECT COUNT(*)
+    FROM messages m
+    JOIN conversations c ON c.id = m.conversation_id
+    LEFT JOIN conversation_reads own ON own.conversation_id = c.id AND own.participant_id = ?
+    WHERE (c.company_id = ? OR c.candidate_id = ?) AND m.sender_id <> ?
+      AND (own.last_read_at IS NULL OR m.created_at > own.last_read_at)
+    `
+)
+
+const (
+	queryCreateMessage = `
+    INSERT INTO messages (id, conversation_id, sender_id, body, attachment_url, attachment_name, created_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryMessageColumns = `
+    SELECT id, conversation_id, sender_id, body, attachment_url, attachment_name, created_at
+    FROM messages
+    `
+
+	queryGetMessageByID = queryMessageColumns + `WHERE id = ?`
+
+	queryGetMessages = queryMessageColumns + `
+    WHERE conversation_id = ?
+    ORDER BY id DESC
+    LIMIT ?
+    `
+
+	queryGetMessagesBefore = queryMessageColumns + `
+    WHERE conversation_id = ? AND id < ?
+    ORDER BY id DESC
+    LIMIT ?
+    `
+)
+
+const (
+	queryCreateBlock = `
+    INSERT INTO participant_blocks (blocker_id, blocked_id, created_at)
+    VALUES (?, ?, ?)
+    ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+    `
+
+	queryDeleteBlock = `
+    DELETE FROM participant_blocks WHERE blocker_id = ? AND blocked_id = ?
+    `
+
+	queryIsBlocked = `
+    SELECT EXISTS (
+        SELECT 1 FROM participant_blocks
+        WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)
+    )
+    `
+
+	queryCreateMessageReport = `
+    INSERT INTO message_reports (id, conversation_id, message_id, reporter_id, reason, details, status, created_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `
+)
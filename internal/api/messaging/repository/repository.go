@@ -0,0 +1,118 @@
+package messagingRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func New(db *sqlx.DB, log *logrus.Logger) Repository {
+	return &repository{
+		DB:  db,
+		log: log,
+	}
+}
+
+type repository struct {
+	DB  *sqlx.DB
+	log *logrus.Logger
+}
+
+type Repository interface {
+	NewClient(tx bool) (Client, error)
+}
+
+func (r *repository) NewClient(tx bool) (Client, error) {
+	var db sqlx.ExtContext
+	var commitFunc, rollbackFunc func() error
+
+	r.log.WithFields(logrus.Fields{
+		"transaction": tx,
+	}).Debug("Creating new repository client")
+
+	db = r.DB
+
+	if tx {
+		r.log.Debug("Starting database transaction")
+		var err error
+		txx, err := r.DB.Beginx()
+		if err != nil {
+			r.log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Failed to begin transaction")
+			return Client{}, err
+		}
+
+		db = txx
+		commitFunc = txx.Commit
+		rollbackFunc = txx.Rollback
+	} else {
+		commitFunc = func() error { return nil }
+		rollbackFunc = func() error { return nil }
+	}
+
+	return Client{
+		Conversations: &conversationsRepository{q: db, log: r.log},
+		Messages:      &messagesRepository{q: db, log: r.log},
+		Moderation:    &moderationRepository{q: db, log: r.log},
+		Commit: func() error {
+			if tx {
+				r.log.Debug("Committing transaction")
+			}
+			return commitFunc()
+		},
+		Rollback: func() error {
+			if tx {
+				r.log.Debug("Rolling back transaction")
+			}
+			return rollbackFunc()
+		},
+	}, nil
+}
+
+type Client struct {
+	Conversations interface {
+		CreateConversation(c context.Context, conversation entity.Conversation) error
+		GetConversationByID(c context.Context, id string) (entity.Conversation, error)
+		GetConversationByJobApplicationID(c context.Context, jobApplicationID string) (entity.Conversation, error)
+		GetConversationByJobInvitationID(c context.Context, jobInvitationID string) (entity.Conversation, error)
+		GetConversationsByParticipantID(c context.Context, participantID string) ([]entity.Conversation, error)
+		TouchConversation(c context.Context, id string, lastMessageAt time.Time) error
+		MarkConversationRead(c context.Context, id string, participantID string, readAt time.Time) error
+		GetLastReadAt(c context.Context, id string, participantID string) (*time.Time, error)
+		CountUnreadMessages(c context.Context, participantID string) (int, error)
+	}
+
+	Messages interface {
+		CreateMessage(c context.Context, message entity.Message) error
+		GetMessageByID(c context.Context, id string) (entity.Message, error)
+		GetMessages(c context.Context, conversationID string, before string, limit int) ([]entity.Message, error)
+	}
+
+	Moderation interface {
+		CreateBlock(c context.Context, blockerID string, blockedID string, createdAt time.Time) error
+		DeleteBlock(c context.Context, blockerID string, blockedID string) error
+		IsBlocked(c context.Context, firstID string, secondID string) (bool, error)
+		CreateReport(c context.Context, report entity.MessageReport) error
+	}
+
+	Commit   func() error
+	Rollback func() error
+}
+
+type conversationsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type messagesRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type moderationRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
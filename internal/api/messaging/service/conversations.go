@@ -0,0 +1,512 @@
+package messagingService
+
+import (
+	"ProjectGolang/internal/api/messaging"
+	messagingRepository "ProjectGolang/internal/api/messaging/repository"
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/smtp"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"html"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMessagePageSize = 30
+	maxAttachmentSize      = 10 * 1024 * 1024
+)
+
+// StartConversation opens the thread for a job application or invitation, or
+// returns the existing one. Either side of the subject may start it.
+func (s *conversationImpl) StartConversation(c context.Context, req messaging.StartConversation) (messaging.ConversationResponse, error) {
+	s.touchPresence(c, req.ParticipantID)
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return messaging.ConversationResponse{}, err
+	}
+
+	conversation, err := s.resolveSubject(c, req)
+	if err != nil {
+		return messaging.ConversationResponse{}, err
+	}
+
+	var existing entity.Conversation
+	if conversation.JobApplicationID != "" {
+		existing, err = repo.Conversations.GetConversationByJobApplicationID(c, conversation.JobApplicationID)
+	} else {
+		existing, err = repo.Conversations.GetConversationByJobInvitationID(c, conversation.JobInvitationID)
+	}
+	if err != nil {
+		return messaging.ConversationResponse{}, err
+	}
+
+	if existing.ID != "" {
+		return makeConversationResponse(existing, req.ParticipantID), nil
+	}
+
+	blocked, err := repo.Moderation.IsBlocked(c, conversation.CompanyID, conversation.CandidateID)
+	if err != nil {
+		return messaging.ConversationResponse{}, err
+	}
+
+	if blocked {
+		return messaging.ConversationResponse{}, messaging.ErrorConversationBlocked
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return messaging.ConversationResponse{}, err
+	}
+
+	conversation.ID = id
+	conversation.CreatedAt = now
+	conversation.UpdatedAt = now
+
+	if err := repo.Conversations.CreateConversation(c, conversation); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create conversation")
+		return messaging.ConversationResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":                 conversation.ID,
+		"job_application_id": conversation.JobApplicationID,
+		"job_invitation_id":  conversation.JobInvitationID,
+	}).Info("Conversation started successfully")
+
+	return makeConversationResponse(conversation, req.ParticipantID), nil
+}
+
+func (s *conversationImpl) GetConversations(c context.Context, participantID string) ([]messaging.ConversationResponse, error) {
+	s.touchPresence(c, participantID)
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	conversations, err := repo.Conversations.GetConversationsByParticipantID(c, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]messaging.ConversationResponse, len(conversations))
+	for i, conversation := range conversations {
+		responses[i] = makeConversationResponse(conversation, participantID)
+	}
+
+	return responses, nil
+}
+
+func (s *conversationImpl) GetMessages(c context.Context, req messaging.GetMessages) (messaging.MessagesResponse, error) {
+	s.touchPresence(c, req.ParticipantID)
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return messaging.MessagesResponse{}, err
+	}
+
+	conversation, err := getParticipantConversation(c, repo, s.log, req.ConversationID, req.ParticipantID)
+	if err != nil {
+		return messaging.MessagesResponse{}, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultMessagePageSize
+	}
+
+	messages, err := repo.Messages.GetMessages(c, conversation.ID, req.Before, limit)
+	if err != nil {
+		return messaging.MessagesResponse{}, err
+	}
+
+	ownReadAt, err := repo.Conversations.GetLastReadAt(c, conversation.ID, req.ParticipantID)
+	if err != nil {
+		return messaging.MessagesResponse{}, err
+	}
+
+	otherReadAt, err := repo.Conversations.GetLastReadAt(c, conversation.ID, otherParticipant(conversation, req.ParticipantID))
+	if err != nil {
+		return messaging.MessagesResponse{}, err
+	}
+
+	response := messaging.MessagesResponse{
+		Messages: make([]messaging.MessageResponse, len(messages)),
+	}
+	for i, message := range messages {
+		response.Messages[i] = makeMessageResponse(message, req.ParticipantID, ownReadAt, otherReadAt)
+	}
+
+	if len(messages) == limit {
+		response.NextBefore = messages[len(messages)-1].ID
+	}
+
+	return response, nil
+}
+
+func (s *conversationImpl) SendMessage(c context.Context, req messaging.SendMessage) (messaging.MessageResponse, error) {
+	s.touchPresence(c, req.SenderID)
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" && req.Attachment == nil {
+		return messaging.MessageResponse{}, messaging.ErrorMessageEmpty
+	}
+
+	if req.Attachment != nil && req.Attachment.Size > maxAttachmentSize {
+		return messaging.MessageResponse{}, messaging.ErrorAttachmentTooLarge
+	}
+
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return messaging.MessageResponse{}, err
+	}
+	defer repo.Rollback()
+
+	conversation, err := getParticipantConversation(c, repo, s.log, req.ConversationID, req.SenderID)
+	if err != nil {
+		return messaging.MessageResponse{}, err
+	}
+
+	blocked, err := repo.Moderation.IsBlocked(c, conversation.CompanyID, conversation.CandidateID)
+	if err != nil {
+		return messaging.MessageResponse{}, err
+	}
+
+	if blocked {
+		return messaging.MessageResponse{}, messaging.ErrorConversationBlocked
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return messaging.MessageResponse{}, err
+	}
+
+	message := entity.Message{
+		ID:             id,
+		ConversationID: conversation.ID,
+		SenderID:       req.SenderID,
+		Body:           body,
+		CreatedAt:      now,
+	}
+
+	if req.Attachment != nil {
+		attachmentURL, err := s.s3.UploadFile(req.Attachment, req.Attachment.Filename)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"error":           err.Error(),
+				"conversation_id": conversation.ID,
+			}).Error("Failed to upload message attachment")
+			return messaging.MessageResponse{}, err
+		}
+		message.AttachmentURL = attachmentURL
+		message.AttachmentName = req.Attachment.Filename
+	}
+
+	if err := s.saveMessage(c, repo, message); err != nil {
+		if message.AttachmentURL != "" {
+			if err := s.s3.DeleteFile(message.AttachmentURL); err != nil {
+				s.log.WithFields(logrus.Fields{
+					"error": err.Error(),
+				}).Warn("Failed to delete orphaned message attachment")
+			}
+		}
+		return messaging.MessageResponse{}, err
+	}
+
+	recipientID := otherParticipant(conversation, req.SenderID)
+	s.publishMessage(c, message, recipientID)
+	s.notifyIfOffline(c, conversation, message, recipientID)
+
+	s.log.WithFields(logrus.Fields{
+		"id":              message.ID,
+		"conversation_id": conversation.ID,
+	}).Info("Message sent successfully")
+
+	return makeMessageResponse(message, req.SenderID, &now, nil), nil
+}
+
+// saveMessage stores the message, bumps the thread and counts it as read by
+// its sender, then commits.
+func (s *conversationImpl) saveMessage(c context.Context, repo messagingRepository.Client, message entity.Message) error {
+	if err := repo.Messages.CreateMessage(c, message); err != nil {
+		return err
+	}
+
+	if err := repo.Conversations.TouchConversation(c, message.ConversationID, message.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := repo.Conversations.MarkConversationRead(c, message.ConversationID, message.SenderID, message.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit message")
+		return err
+	}
+
+	return nil
+}
+
+func (s *conversationImpl) MarkConversationRead(c context.Context, id string, participantID string) error {
+	s.touchPresence(c, participantID)
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	conversation, err := getParticipantConversation(c, repo, s.log, id, participantID)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.Conversations.MarkConversationRead(c, conversation.ID, participantID, time.Now()); err != nil {
+		return err
+	}
+
+	// The next message after catching up deserves a fresh email again.
+	if err := s.redis.DeleteCache(c, messaging.NotificationCacheKey(conversation.ID, participantID)); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Warn("Failed to reset message notification cooldown")
+	}
+
+	return nil
+}
+
+func (s *conversationImpl) GetUnreadCount(c context.Context, participantID string) (messaging.UnreadCountResponse, error) {
+	s.touchPresence(c, participantID)
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return messaging.UnreadCountResponse{}, err
+	}
+
+	count, err := repo.Conversations.CountUnreadMessages(c, participantID)
+	if err != nil {
+		return messaging.UnreadCountResponse{}, err
+	}
+
+	return messaging.UnreadCountResponse{UnreadCount: count}, nil
+}
+
+// resolveSubject works out the company and candidate behind the application
+// or invitation and checks the caller is one of them.
+func (s *conversationImpl) resolveSubject(c context.Context, req messaging.StartConversation) (entity.Conversation, error) {
+	recruitmentRepo, err := s.recruitmentRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create recruitment repository client")
+		return entity.Conversation{}, err
+	}
+
+	var conversation entity.Conversation
+	if req.JobApplicationID != "" {
+		application, err := recruitmentRepo.JobApplications.GetJobApplicationByID(c, req.JobApplicationID)
+		if err != nil {
+			return entity.Conversation{}, err
+		}
+
+		if application.ID == "" {
+			return entity.Conversation{}, messaging.ErrorSubjectNotFound
+		}
+
+		jobVacancy, err := recruitmentRepo.JobVacancies.GetJobVacancyByID(c, application.JobVacancyID)
+		if err != nil {
+			return entity.Conversation{}, err
+		}
+
+		conversation = entity.Conversation{
+			CompanyID:        jobVacancy.RecruiterID,
+			CandidateID:      application.UserID,
+			JobApplicationID: application.ID,
+		}
+	} else {
+		invitation, err := recruitmentRepo.JobInvitations.GetJobInvitationByID(c, req.JobInvitationID)
+		if err != nil {
+			return entity.Conversation{}, err
+		}
+
+		if invitation.ID == "" {
+			return entity.Conversation{}, messaging.ErrorSubjectNotFound
+		}
+
+		conversation = entity.Conversation{
+			CompanyID:       invitation.CompanyID,
+			CandidateID:     invitation.UserID,
+			JobInvitationID: invitation.ID,
+		}
+	}
+
+	if !isParticipant(conversation, req.ParticipantID) {
+		s.log.WithFields(logrus.Fields{
+			"participant_id":     req.ParticipantID,
+			"job_application_id": req.JobApplicationID,
+			"job_invitation_id":  req.JobInvitationID,
+		}).Warn("Participant is not part of the conversation subject")
+		return entity.Conversation{}, messaging.ErrorSubjectNotFound
+	}
+
+	return conversation, nil
+}
+
+// publishMessage pushes the new message to the recipient's open realtime
+// connections.
+func (s *conversationImpl) publishMessage(c context.Context, message entity.Message, recipientID string) {
+	event := realtime.Event{
+		Type: realtime.EventMessageReceived,
+		Data: makeMessageResponse(message, recipientID, nil, nil),
+	}
+
+	if err := s.realtime.Publish(c, recipientID, event); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":           err.Error(),
+			"conversation_id": message.ConversationID,
+		}).Warn("Failed to publish new message event")
+	}
+}
+
+// touchPresence marks the participant as online for messaging.PresenceTTL.
+func (s *conversationImpl) touchPresence(c context.Context, participantID string) {
+	err := s.redis.SetCache(c, messaging.PresenceCacheKey(participantID), "1", messaging.PresenceTTL)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"participant_id": participantID,
+		}).Warn("Failed to update messaging presence")
+	}
+}
+
+// notifyIfOffline notifies the recipient about a new message unless they are
+// online or were already notified about this thread recently.
+func (s *conversationImpl) notifyIfOffline(c context.Context, conversation entity.Conversation, message entity.Message, recipientID string) {
+	online, err := s.redis.GetCache(c, messaging.PresenceCacheKey(recipientID))
+	if err != nil || online != "" {
+		return
+	}
+
+	notifiedKey := messaging.NotificationCacheKey(conversation.ID, recipientID)
+	notified, err := s.redis.GetCache(c, notifiedKey)
+	if err != nil || notified != "" {
+		return
+	}
+
+	senderName, _, err := s.participantContact(c, conversation, message.SenderID)
+	if err != nil {
+		return
+	}
+
+	_, recipientEmail, err := s.participantContact(c, conversation, recipientID)
+	if err != nil || recipientEmail == "" {
+		return
+	}
+
+	preview := message.Body
+	if len([]rune(preview)) > 200 {
+		preview = string([]rune(preview)[:200]) + "…"
+	}
+	if preview == "" {
+		preview = fmt.Sprintf("Attachment: %s", message.AttachmentName)
+	}
+
+	link := fmt.Sprintf("%s/api/v1/messaging/conversations/%s/messages",
+		strings.TrimRight(os.Getenv("APP_URL"), "/"), conversation.ID)
+
+	mail := smtp.Mail{
+		To:      recipientEmail,
+		Subject: fmt.Sprintf("New message from %s", senderName),
+		Body: fmt.Sprintf("<p><b>%s</b> sent you a message:</p><blockquote>%s</blockquote><p><a href=\"%s\">Open the conversation</a></p>",
+			html.EscapeString(senderName), html.EscapeString(preview), link),
+	}
+
+	if err := s.redis.SetCache(c, notifiedKey, message.ID, messaging.NotificationCooldown); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Warn("Failed to set message notification cooldown")
+	}
+
+	s.notifier.Notify(c, notification.Event{
+		RecipientID: recipientID,
+		Type:        entity.NotificationMessage,
+		Title:       mail.Subject,
+		Body:        preview,
+		Data: map[string]string{
+			"conversation_id": conversation.ID,
+			"message_id":      message.ID,
+		},
+		Mail: &mail,
+	})
+}
+
+// participantContact returns the display name and email of a participant,
+// who is either the conversation's company or its candidate.
+func (s *conversationImpl) participantContact(c context.Context, conversation entity.Conversation, participantID string) (string, string, error) {
+	authRepo, err := s.authRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create auth repository client")
+		return "", "", err
+	}
+
+	if participantID == conversation.CompanyID {
+		company, err := authRepo.Company.GetCompanyByID(c, participantID)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"error":      err.Error(),
+				"company_id": participantID,
+			}).Error("Failed to get company for message email")
+			return "", "", err
+		}
+		return company.Name, company.Email, nil
+	}
+
+	user, err := authRepo.User.GetUserByID(c, participantID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": participantID,
+		}).Error("Failed to get user for message email")
+		return "", "", err
+	}
+	return user.Name, user.Email, nil
+}
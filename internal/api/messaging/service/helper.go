@@ -0,0 +1,84 @@
+package messagingService
+
+import (
+	"ProjectGolang/internal/api/messaging"
+	messagingRepository "ProjectGolang/internal/api/messaging/repository"
+	"ProjectGolang/internal/entity"
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// getParticipantConversation loads a conversation the caller takes part in;
+// anybody else gets a not found.
+func getParticipantConversation(c context.Context, repo messagingRepository.Client, log *logrus.Logger, id string, participantID string) (entity.Conversation, error) {
+	conversation, err := repo.Conversations.GetConversationByID(c, id)
+	if err != nil {
+		return entity.Conversation{}, err
+	}
+
+	if conversation.ID == "" || !isParticipant(conversation, participantID) {
+		log.WithFields(logrus.Fields{
+			"id":             id,
+			"participant_id": participantID,
+		}).Warn("Conversation not found for participant")
+		return entity.Conversation{}, messaging.ErrorConversationNotFound
+	}
+
+	return conversation, nil
+}
+
+func isParticipant(conversation entity.Conversation, participantID string) bool {
+	return conversation.CompanyID == participantID || conversation.CandidateID == participantID
+}
+
+// otherParticipant returns the company for the candidate and the other way
+// round.
+func otherParticipant(conversation entity.Conversation, participantID string) string {
+	if conversation.CompanyID == participantID {
+		return conversation.CandidateID
+	}
+	return conversation.CompanyID
+}
+
+func makeConversationResponse(conversation entity.Conversation, participantID string) messaging.ConversationResponse {
+	response := messaging.ConversationResponse{
+		ID:               conversation.ID,
+		CompanyID:        conversation.CompanyID,
+		CandidateID:      conversation.CandidateID,
+		JobApplicationID: conversation.JobApplicationID,
+		JobInvitationID:  conversation.JobInvitationID,
+		UnreadCount:      conversation.UnreadCount,
+		Blocked:          conversation.Blocked,
+		LastMessageAt:    conversation.LastMessageAt,
+		CreatedAt:        conversation.CreatedAt,
+	}
+
+	if conversation.LastMessage != nil {
+		message := makeMessageResponse(*conversation.LastMessage, participantID, conversation.LastRead, conversation.LastReadByOther)
+		response.LastMessage = &message
+	}
+
+	return response
+}
+
+// makeMessageResponse marks a message read once its recipient's read marker
+// has passed it: the caller's own marker for incoming messages, the other
+// participant's for outgoing ones.
+func makeMessageResponse(message entity.Message, participantID string, ownReadAt *time.Time, otherReadAt *time.Time) messaging.MessageResponse {
+	readAt := ownReadAt
+	if message.SenderID == participantID {
+		readAt = otherReadAt
+	}
+
+	return messaging.MessageResponse{
+		ID:             message.ID,
+		ConversationID: message.ConversationID,
+		SenderID:       message.SenderID,
+		Body:           message.Body,
+		AttachmentURL:  message.AttachmentURL,
+		AttachmentName: message.AttachmentName,
+		Read:           readAt != nil && !readAt.Before(message.CreatedAt),
+		CreatedAt:      message.CreatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package messagingService
+
+import (
+	"ProjectGolang/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestParticipants(t *testing.T) {
+	conversation := entity.Conversation{CompanyID: "company", CandidateID: "candidate"}
+
+	tests := []struct {
+		participantID   string
+		wantParticipant bool
+		wantOther       string
+	}{
+		{participantID: "company", wantParticipant: true, wantOther: "candidate"},
+		{participantID: "candidate", wantParticipant: true, wantOther: "company"},
+		{participantID: "stranger", wantParticipant: false},
+		{participantID: "", wantParticipant: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.participantID, func(t *testing.T) {
+			if got := isParticipant(conversation, tt.participantID); got != tt.wantParticipant {
+				t.Errorf("isParticipant() = %v, want %v", got, tt.wantParticipant)
+			}
+			if !tt.wantParticipant {
+				return
+			}
+			if got := otherParticipant(conversation, tt.participantID); got != tt.wantOther {
+				t.Errorf("otherParticipant() = %q, want %q", got, tt.wantOther)
+			}
+		})
+	}
+}
+
+func TestMakeMessageResponseRead(t *testing.T) {
+	sentAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	before := sentAt.Add(-time.Minute)
+	after := sentAt.Add(time.Minute)
+	message := entity.Message{ID: "message", SenderID: "company", CreatedAt: sentAt}
+
+	tests := []struct {
+		name          string
+		participantID string
+		ownReadAt     *time.Time
+		otherReadAt   *time.Time
+		want          bool
+	}{
+		{name: "incoming, never read", participantID: "candidate", want: false},
+		{name: "incoming, read before it arrived", participantID: "candidate", ownReadAt: &before, want: false},
+		{name: "incoming, read after", participantID: "candidate", ownReadAt: &after, want: true},
+		{name: "incoming, read at the same instant", participantID: "candidate", ownReadAt: &sentAt, want: true},
+		{name: "incoming ignores the sender's marker", participantID: "candidate", otherReadAt: &after, want: false},
+		{name: "outgoing, recipient has not read", participantID: "company", ownReadAt: &after, want: false},
+		{name: "outgoing, recipient read it", participantID: "company", otherReadAt: &after, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := makeMessageResponse(message, tt.participantID, tt.ownReadAt, tt.otherReadAt)
+			if got.Read != tt.want {
+				t.Errorf("Read = %v, want %v", got.Read, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeConversationResponse(t *testing.T) {
+	sentAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	readAt := sentAt.Add(time.Minute)
+
+	conversation := entity.Conversation{
+		ID:          "conversation",
+		CompanyID:   "company",
+		CandidateID: "candidate",
+		UnreadCount: 2,
+		LastMessage: &entity.Message{ID: "message", SenderID: "candidate", CreatedAt: sentAt},
+		LastRead:    &readAt,
+	}
+
+	got := makeConversationResponse(conversation, "company")
+	if got.UnreadCount != 2 {
+		t.Errorf("UnreadCount = %d, want 2", got.UnreadCount)
+	}
+	if got.LastMessage == nil || !got.LastMessage.Read {
+		t.Errorf("LastMessage = %+v, want it read by the company", got.LastMessage)
+	}
+
+	if got := makeConversationResponse(entity.Conversation{ID: "empty"}, "company"); got.LastMessage != nil {
+		t.Errorf("LastMessage = %+v for a conversation without messages", got.LastMessage)
+	}
+}
@@ -0,0 +1,126 @@
+package messagingService
+
+import (
+	"ProjectGolang/internal/api/messaging"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// BlockParticipant blocks the other side of the conversation. Blocks apply
+// across all threads between the two and stop messages in both directions.
+func (s *moderationImpl) BlockParticipant(c context.Context, conversationID string, participantID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	conversation, err := getParticipantConversation(c, repo, s.log, conversationID, participantID)
+	if err != nil {
+		return err
+	}
+
+	blockedID := otherParticipant(conversation, participantID)
+	if err := repo.Moderation.CreateBlock(c, participantID, blockedID, time.Now()); err != nil {
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"blocker_id": participantID,
+		"blocked_id": blockedID,
+	}).Info("Participant blocked")
+
+	return nil
+}
+
+func (s *moderationImpl) UnblockParticipant(c context.Context, conversationID string, participantID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	conversation, err := getParticipantConversation(c, repo, s.log, conversationID, participantID)
+	if err != nil {
+		return err
+	}
+
+	blockedID := otherParticipant(conversation, participantID)
+	if err := repo.Moderation.DeleteBlock(c, participantID, blockedID); err != nil {
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"blocker_id": participantID,
+		"blocked_id": blockedID,
+	}).Info("Participant unblocked")
+
+	return nil
+}
+
+// ReportConversation files a report for moderators, optionally pointing at a
+// single message of the thread.
+func (s *moderationImpl) ReportConversation(c context.Context, req messaging.ReportConversation) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	conversation, err := getParticipantConversation(c, repo, s.log, req.ConversationID, req.ReporterID)
+	if err != nil {
+		return err
+	}
+
+	if req.MessageID != "" {
+		message, err := repo.Messages.GetMessageByID(c, req.MessageID)
+		if err != nil {
+			return err
+		}
+
+		if message.ID == "" || message.ConversationID != conversation.ID {
+			return messaging.ErrorMessageNotFound
+		}
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return err
+	}
+
+	report := entity.MessageReport{
+		ID:             id,
+		ConversationID: conversation.ID,
+		MessageID:      req.MessageID,
+		ReporterID:     req.ReporterID,
+		Reason:         req.Reason,
+		Details:        req.Details,
+		Status:         entity.ReportStatusOpen,
+		CreatedAt:      now,
+	}
+
+	if err := repo.Moderation.CreateReport(c, report); err != nil {
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":              report.ID,
+		"conversation_id": conversation.ID,
+		"reason":          report.Reason,
+	}).Warn("Conversation reported")
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package messagingService
+
+import (
+	authRepository "ProjectGolang/internal/api/auth/repository"
+	"ProjectGolang/internal/api/messaging"
+	messagingRepository "ProjectGolang/internal/api/messaging/repository"
+	notificationService "ProjectGolang/internal/api/notification/service"
+	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/redis"
+	"ProjectGolang/pkg/s3"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+type MessagingService interface {
+	Conversation() ConversationDomain
+	Moderation() ModerationDomain
+}
+
+type ConversationDomain interface {
+	StartConversation(c context.Context, req messaging.StartConversation) (messaging.ConversationResponse, error)
+	GetConversations(c context.Context, participantID string) ([]messaging.ConversationResponse, error)
+	GetMessages(c context.Context, req messaging.GetMessages) (messaging.MessagesResponse, error)
+	SendMessage(c context.Context, req messaging.SendMessage) (messaging.MessageResponse, error)
+	MarkConversationRead(c context.Context, id string, participantID string) error
+	GetUnreadCount(c context.Context, participantID string) (messaging.UnreadCountResponse, error)
+}
+
+type ModerationDomain interface {
+	BlockParticipant(c context.Context, conversationID string, participantID string) error
+	UnblockParticipant(c context.Context, conversationID string, participantID string) error
+	ReportConversation(c context.Context, req messaging.ReportConversation) error
+}
+
+type messagingService struct {
+	conversationDomain ConversationDomain
+	moderationDomain   ModerationDomain
+}
+
+func (s *messagingService) Conversation() ConversationDomain {
+	return s.conversationDomain
+}
+
+func (s *messagingService) Moderation() ModerationDomain {
+	return s.moderationDomain
+}
+
+type conversationImpl struct {
+	repo            messagingRepository.Repository
+	recruitmentRepo recruitmentRepository.Repository
+	authRepo        authRepository.Repository
+	redis           redis.ItfRedis
+	notifier        notificationService.Notifier
+	s3              s3.ItfS3
+	realtime        realtime.ItfRealtime
+	log             *logrus.Logger
+}
+
+type moderationImpl struct {
+	repo messagingRepository.Repository
+	log  *logrus.Logger
+}
+
+func New(messagingRepo messagingRepository.Repository,
+	recruitmentRepo recruitmentRepository.Repository,
+	authRepo authRepository.Repository,
+	log *logrus.Logger,
+	redis redis.ItfRedis,
+	notifier notificationService.Notifier,
+	s3 s3.ItfS3,
+	realtime realtime.ItfRealtime,
+) MessagingService {
+	return &messagingService{
+		conversationDomain: &conversationImpl{
+			repo:            messagingRepo,
+			recruitmentRepo: recruitmentRepo,
+			authRepo:        authRepo,
+			redis:           redis,
+			notifier:        notifier,
+			s3:              s3,
+			realtime:        realtime,
+			log:             log,
+		},
+		moderationDomain: &moderationImpl{repo: messagingRepo, log: log},
+	}
+}
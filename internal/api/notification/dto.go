@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/smtp"
+	"time"
+)
+
+// Event is something a recipient should hear about. It is stored in-app and
+// Mail is sent according to the recipient's preferences for Type. Leave
+// RecipientID empty for people without an account, such as external
+// interviewers, who only ever get the email.
+type Event struct {
+	RecipientID string
+	Type        entity.NotificationType
+	Title       string
+	Body        string
+	Data        map[string]string
+	Mail        *smtp.Mail
+}
+
+type GetNotifications struct {
+	RecipientID string `json:"-"`
+	Unread      bool   `query:"unread"`
+	Before      string `query:"before"`
+	Limit       int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type NotificationResponse struct {
+	ID        string                  `json:"id"`
+	Type      entity.NotificationType `json:"type"`
+	Title     string                  `json:"title"`
+	Body      string                  `json:"body"`
+	Data      map[string]string       `json:"data"`
+	Read      bool                    `json:"read"`
+	ReadAt    *time.Time              `json:"read_at"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+type NotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int                    `json:"unread_count"`
+	// NextBefore is the cursor for the next, older page.
+	NextBefore string `json:"next_before,omitempty"`
+}
+
+type PreferenceRequest struct {
+	Type  entity.NotificationType `json:"type" validate:"required,oneof=APPLICATION_STATUS MESSAGE INTERVIEW JOB_INVITATION OFFER JOB_ALERT CERTIFICATION ENDORSEMENT COMPANY_VERIFICATION"`
+	Email *bool                   `json:"email" validate:"required"`
+	InApp *bool                   `json:"in_app" validate:"required"`
+}
+
+type UpdatePreferences struct {
+	RecipientID string              `json:"-"`
+	Preferences []PreferenceRequest `json:"preferences" validate:"required,min=1,dive"`
+}
+
+type PreferenceResponse struct {
+	Type  entity.NotificationType `json:"type"`
+	Email bool                    `json:"email"`
+	InApp bool                    `json:"in_app"`
+}
+
+// StreamTicketResponse is a single-use ticket for opening the event stream,
+// passed as its ticket query parameter.
+type StreamTicketResponse struct {
+	Ticket    string    `json:"ticket"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
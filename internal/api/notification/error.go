@@ -0,0 +1,10 @@
+package notification
+
+import (
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrorNotificationNotFound = response.New(fiber.StatusNotFound, "notification not found")
+)
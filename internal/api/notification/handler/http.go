@@ -0,0 +1,41 @@
+package notificationHandler
+
+import (
+	notificationService "ProjectGolang/internal/api/notification/service"
+	"ProjectGolang/internal/middleware"
+	"ProjectGolang/pkg/realtime"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+type NotificationHandler struct {
+	notificationService notificationService.NotificationService
+	realtime            realtime.ItfRealtime
+	validator           *validator.Validate
+	middleware          middleware.Middleware
+	log                 *logrus.Logger
+}
+
+func New(ns notificationService.NotificationService, rt realtime.ItfRealtime, validate *validator.Validate, middleware middleware.Middleware, log *logrus.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: ns,
+		realtime:            rt,
+		validator:           validate,
+		middleware:          middleware,
+		log:                 log,
+	}
+}
+
+func (h *NotificationHandler) Start(srv fiber.Router) {
+	nt := srv.Group("/notifications")
+	nt.Post("/stream/tickets", h.middleware.NewTokenMiddleware, h.CreateStreamTicket)
+	nt.Get("/stream", h.middleware.NewEventStreamTokenMiddleware, h.Stream)
+
+	me := srv.Group("/users/me")
+	me.Get("/notifications", h.middleware.NewTokenMiddleware, h.GetNotifications)
+	me.Post("/notifications/read", h.middleware.NewTokenMiddleware, h.MarkAllNotificationsRead)
+	me.Post("/notifications/:id/read", h.middleware.NewTokenMiddleware, h.MarkNotificationRead)
+	me.Get("/notification_preferences", h.middleware.NewTokenMiddleware, h.GetPreferences)
+	me.Put("/notification_preferences", h.middleware.NewTokenMiddleware, h.UpdatePreferences)
+}
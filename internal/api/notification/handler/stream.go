@@ -0,0 +1,134 @@
+package notificationHandler
+
+import (
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/middleware"
+	contextPkg "ProjectGolang/pkg/context"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"bufio"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// heartbeatInterval keeps idle connections from being closed by proxies.
+const heartbeatInterval = 25 * time.Second
+
+// CreateStreamTicket issues the single-use ticket a browser opens the event
+// stream with, instead of putting its access token in the URL.
+func (h *NotificationHandler) CreateStreamTicket(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to create stream ticket")
+	}
+
+	expiresAt, ok := ctx.Locals(middleware.TokenExpiresAtKey).(time.Time)
+	if !ok {
+		return response.WriteError(ctx, fiber.ErrUnauthorized, "Failed to create stream ticket")
+	}
+
+	ticket, ticketExpiresAt, err := h.realtime.IssueTicket(c, user, expiresAt)
+	if err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"user_id":    user.ID,
+		}).Error("Failed to issue stream ticket")
+		return response.WriteError(ctx, err, "Failed to create stream ticket")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(notification.StreamTicketResponse{
+			Ticket:    ticket,
+			ExpiresAt: ticketExpiresAt,
+		})
+	}
+}
+
+// Stream pushes the user's realtime events as server-sent events until the
+// client disconnects or its access token expires, which ends the stream with
+// an expired event. Each other event is a JSON realtime.Event in the data
+// field.
+func (h *NotificationHandler) Stream(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return err
+	}
+
+	expiresAt, ok := ctx.Locals(middleware.TokenExpiresAtKey).(time.Time)
+	if !ok {
+		return fiber.ErrUnauthorized
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	events, unsubscribe := h.realtime.Subscribe(user.ID)
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"user_id":    user.ID,
+	}).Info("Realtime stream opened")
+
+	ctx.Context().SetBodyStreamWriter(fasthttp.StreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		expiry := time.NewTimer(time.Until(expiresAt))
+		defer expiry.Stop()
+
+		fmt.Fprint(w, "retry: 5000\n\n")
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		for {
+			select {
+			case payload, ok := <-events:
+				if !ok {
+					return
+				}
+				fmt.Fprintf(w, "data: %s\n\n", payload)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": ping\n\n")
+			case <-expiry.C:
+				fmt.Fprint(w, "event: expired\ndata: {}\n\n")
+				w.Flush()
+				h.log.WithFields(log.Fields{
+					"request_id": requestID,
+					"user_id":    user.ID,
+				}).Info("Realtime stream closed on token expiry")
+				return
+			}
+
+			if err := w.Flush(); err != nil {
+				h.log.WithFields(log.Fields{
+					"request_id": requestID,
+					"user_id":    user.ID,
+				}).Info("Realtime stream closed")
+				return
+			}
+		}
+	}))
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package notificationRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+func (r *notificationsRepository) CreateNotification(c context.Context, notification entity.Notification) error {
+	r.log.WithFields(map[string]interface{}{
+		"notification_id": notification.ID,
+		"recipient_id":    notification.RecipientID,
+		"type":            notification.Type,
+	}).Debug("Creating notification in database")
+
+	data, err := json.Marshal(notification.Data)
+	if err != nil {
+		return err
+	}
+	if notification.Data == nil {
+		data = []byte("{}")
+	}
+
+	_, err = r.q.ExecContext(c, r.q.Rebind(queryCreateNotification),
+		notification.ID,
+		notification.RecipientID,
+		notification.Type,
+		notification.Title,
+		notification.Body,
+		data,
+		notification.CreatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating notification")
+		return err
+	}
+
+	return nil
+}
+
+// GetNotifications returns the newest notifications first, older than the
+// before cursor when it is set.
+func (r *notificationsRepository) GetNotifications(c context.Context, recipientID string, unreadOnly bool, before string, limit int) ([]entity.Notification, error) {
+	var query strings.Builder
+	query.WriteString(queryNotificationColumns)
+	args := []interface{}{recipientID}
+
+	if unreadOnly {
+		query.WriteString(" AND read_at IS NULL")
+	}
+	if before != "" {
+		query.WriteString(" AND id < ?")
+		args = append(args, before)
+	}
+	query.WriteString(" ORDER BY id DESC LIMIT ?")
+	args = append(args, limit)
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(query.String()), args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":        err.Error(),
+			"recipient_id": recipientID,
+		}).Error("Database error when getting notifications")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanNotifications(rows, r.log)
+}
+
+func (r *notificationsRepository) CountUnreadNotifications(c context.Context, recipientID string) (int, error) {
+	var count int
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryCountUnreadNotifications), recipientID).Scan(&count)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":        err.Error(),
+			"recipient_id": recipientID,
+		}).Error("Database error when counting unread notifications")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// MarkNotificationRead keeps the first read time and reports whether the
+// recipient owns a notification with the given ID.
+func (r *notificationsRepository) MarkNotificationRead(c context.Context, id string, recipientID string, readAt time.Time) (bool, error) {
+	result, err := r.q.ExecContext(c, r.q.Rebind(queryMarkNotificationRead), readAt, id, recipientID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":           err.Error(),
+			"notification_id": id,
+		}).Error("Database error when marking notification read")
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *notificationsRepository) MarkAllNotificationsRead(c context.Context, recipientID string, readAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryMarkAllNotificationsRead), readAt, recipientID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":        err.Error(),
+			"recipient_id": recipientID,
+		}).Error("Database error when marking all notifications read")
+		return err
+	}
+
+	return nil
+}
+
+func scanNotifications(rows *sql.Rows, log *logrus.Logger) ([]entity.Notification, error) {
+	var notifications []entity.Notification
+	for rows.Next() {
+		var (
+			notification entity.Notification
+			data         []byte
+			readAt       sql.NullTime
+		)
+		err := rows.Scan(&notification.ID, &notification.RecipientID, &notification.Type, &notification.Title,
+			&notification.Body, &data, &readAt, &notification.CreatedAt)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning notification row")
+			return nil, err
+		}
+
+		if err := json.Unmarshal(data, &notification.Data); err != nil {
+			log.WithFields(map[string]interface{}{
+				"error":           err.Error(),
+				"notification_id": notification.ID,
+			}).Error("Error decoding notification data")
+			return nil, err
+		}
+		if readAt.Valid {
+			notification.ReadAt = &readAt.Time
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through notification rows")
+		return nil, err
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,88 @@
+package notificationRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"errors"
+)
+
+func (r *preferencesRepository) GetPreferences(c context.Context, recipientID string) ([]entity.NotificationPreference, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryPreferenceColumns), recipientID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":        err.Error(),
+			"recipient_id": recipientID,
+		}).Error("Database error when getting notification preferences")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var preferences []entity.NotificationPreference
+	for rows.Next() {
+		var preference entity.NotificationPreference
+		err := rows.Scan(&preference.RecipientID, &preference.Type, &preference.Email,
+			&preference.InApp, &preference.UpdatedAt)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning notification preference row")
+			return nil, err
+		}
+		preferences = append(preferences, preference)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through notification preference rows")
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+func (r *preferencesRepository) GetPreference(c context.Context, recipientID string, notificationType entity.NotificationType) (entity.NotificationPreference, error) {
+	var preference entity.NotificationPreference
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryGetPreference), recipientID, notificationType).Scan(
+		&preference.RecipientID,
+		&preference.Type,
+		&preference.Email,
+		&preference.InApp,
+		&preference.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.NotificationPreference{}, nil
+		}
+
+		r.log.WithFields(map[string]interface{}{
+			"error":        err.Error(),
+			"recipient_id": recipientID,
+			"type":         notificationType,
+		}).Error("Database error when getting notification preference")
+		return entity.NotificationPreference{}, err
+	}
+
+	return preference, nil
+}
+
+func (r *preferencesRepository) UpsertPreference(c context.Context, preference entity.NotificationPreference) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryUpsertPreference),
+		preference.RecipientID,
+		preference.Type,
+		preference.Email,
+		preference.InApp,
+		preference.UpdatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":        err.Error(),
+			"recipient_id": preference.RecipientID,
+			"type":         preference.Type,
+		}).Error("Database error when saving notification preference")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package notificationRepository
+
+const (
+	queryCreateNotification = `
+    INSERT INTO notifications (id, recipient_id, type, title, body, data, created_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryNotificationColumns = `
+    SELECT id, recipient_id, type, title, body, data, read_at, created_at
+    FROM notifications
+    WHERE recipient_id = ?
+    `
+
+	queryCountUnreadNotifications = `
+    SELECT COUNT(*) FROM notifications WHERE recipient_id = ? AND read_at IS NULL
+    `
+
+	queryMarkNotificationRead = `
+    UPDATE notifications SET read_at = COALESCE(read_at, ?)
+    WHERE id = ? AND recipient_id = ?
+    `
+
+	queryMarkAllNotificationsRead = `
+    UPDATE notifications SET read_at = ?
+    WHERE recipient_id = ? AND read_at IS NULL
+    `
+)
+
+const (
+	queryPreferenceColumns = `
+    SELECT recipient_id, type, email, in_app, updated_at
+    FROM notification_preferences
+    WHERE recipient_id = ?
+    `
+
+	queryGetPreference = queryPreferenceColumns + `AND type = ?`
+
+	queryUpsertPreference = `
+    INSERT INTO notification_preferences (recipient_id, type, email, in_app, updated_at)
+    VALUES (?, ?, ?, ?, ?)
+    ON CONFLICT (recipient_id, type)
+    DO UPDATE SET email = EXCLUDED.email, in_app = EXCLUDED.in_app, updated_at = EXCLUDED.updated_at
+    `
+)
@@ -0,0 +1,101 @@
+package notificationRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func New(db *sqlx.DB, log *logrus.Logger) Repository {
+	return &repository{
+		DB:  db,
+		log: log,
+	}
+}
+
+type repository struct {
+	DB  *sqlx.DB
+	log *logrus.Logger
+}
+
+type Repository interface {
+	NewClient(tx bool) (Client, error)
+}
+
+func (r *repository) NewClient(tx bool) (Client, error) {
+	var db sqlx.ExtContext
+	var commitFunc, rollbackFunc func() error
+
+	r.log.WithFields(logrus.Fields{
+		"transaction": tx,
+	}).Debug("Creating new repository client")
+
+	db = r.DB
+
+	if tx {
+		r.log.Debug("Starting database transaction")
+		var err error
+		txx, err := r.DB.Beginx()
+		if err != nil {
+			r.log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Failed to begin transaction")
+			return Client{}, err
+		}
+
+		db = txx
+		commitFunc = txx.Commit
+		rollbackFunc = txx.Rollback
+	} else {
+		commitFunc = func() error { return nil }
+		rollbackFunc = func() error { return nil }
+	}
+
+	return Client{
+		Notifications: &notificationsRepository{q: db, log: r.log},
+		Preferences:   &preferencesRepository{q: db, log: r.log},
+		Commit: func() error {
+			if tx {
+				r.log.Debug("Committing transaction")
+			}
+			return commitFunc()
+		},
+		Rollback: func() error {
+			if tx {
+				r.log.Debug("Rolling back transaction")
+			}
+			return rollbackFunc()
+		},
+	}, nil
+}
+
+type Client struct {
+	Notifications interface {
+		CreateNotification(c context.Context, notification entity.Notification) error
+		GetNotifications(c context.Context, recipientID string, unreadOnly bool, before string, limit int) ([]entity.Notification, error)
+		CountUnreadNotifications(c context.Context, recipientID string) (int, error)
+		MarkNotificationRead(c context.Context, id string, recipientID string, readAt time.Time) (bool, error)
+		MarkAllNotificationsRead(c context.Context, recipientID string, readAt time.Time) error
+	}
+
+	Preferences interface {
+		GetPreferences(c context.Context, recipientID string) ([]entity.NotificationPreference, error)
+		GetPreference(c context.Context, recipientID string, notificationType entity.NotificationType) (entity.NotificationPreference, error)
+		UpsertPreference(c context.Context, preference entity.NotificationPreference) error
+	}
+
+	Commit   func() error
+	Rollback func() error
+}
+
+type notificationsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type preferencesRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
@@ -0,0 +1,33 @@
+package notificationService
+
+import (
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/entity"
+)
+
+// effectivePreference fills in the default of both channels for types the
+// recipient never configured.
+func effectivePreference(preference entity.NotificationPreference) entity.NotificationPreference {
+	if preference.Type == "" {
+		return entity.NotificationPreference{Email: true, InApp: true}
+	}
+	return preference
+}
+
+func makeNotificationResponse(n entity.Notification) notification.NotificationResponse {
+	data := n.Data
+	if data == nil {
+		data = map[string]string{}
+	}
+
+	return notification.NotificationResponse{
+		ID:        n.ID,
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      data,
+		Read:      n.ReadAt != nil,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package notificationService
+
+import (
+	"ProjectGolang/internal/api/notification"
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const defaultNotificationPageSize = 20
+
+func (s *notificationImpl) GetNotifications(c context.Context, req notification.GetNotifications) (notification.NotificationsResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return notification.NotificationsResponse{}, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultNotificationPageSize
+	}
+
+	notifications, err := repo.Notifications.GetNotifications(c, req.RecipientID, req.Unread, req.Before, limit)
+	if err != nil {
+		return notification.NotificationsResponse{}, err
+	}
+
+	unread, err := repo.Notifications.CountUnreadNotifications(c, req.RecipientID)
+	if err != nil {
+		return notification.NotificationsResponse{}, err
+	}
+
+	response := notification.NotificationsResponse{
+		Notifications: make([]notification.NotificationResponse, len(notifications)),
+		UnreadCount:   unread,
+	}
+	for i, n := range notifications {
+		response.Notifications[i] = makeNotificationResponse(n)
+	}
+
+	if len(notifications) == limit {
+		response.NextBefore = notifications[len(notifications)-1].ID
+	}
+
+	return response, nil
+}
+
+func (s *notificationImpl) MarkNotificationRead(c context.Context, id string, recipientID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	found, err := repo.Notifications.MarkNotificationRead(c, id, recipientID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return notification.ErrorNotificationNotFound
+	}
+
+	return nil
+}
+
+func (s *notificationImpl) MarkAllNotificationsRead(c context.Context, recipientID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	return repo.Notifications.MarkAllNotificationsRead(c, recipientID, time.Now())
+}
@@ -0,0 +1,104 @@
+package notificationService
+
+import (
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// Notify stores the in-app notification and sends the email on the channels
+// the recipient enabled for the event type. Failures are logged, never
+// returned, so a broken channel does not fail the action that caused it.
+func (s *notifierImpl) Notify(c context.Context, event notification.Event) {
+	preference := entity.NotificationPreference{Email: true}
+	if event.RecipientID != "" {
+		var err error
+		preference, err = s.getPreference(c, event)
+		if err != nil {
+			return
+		}
+	}
+
+	if preference.InApp {
+		s.storeNotification(c, event)
+	}
+
+	if preference.Email && event.Mail != nil && event.Mail.To != "" {
+		mail := *event.Mail
+		go func() {
+			if err := s.smtp.Send(mail); err != nil {
+				s.log.WithFields(logrus.Fields{
+					"error":        err.Error(),
+					"recipient_id": event.RecipientID,
+					"type":         event.Type,
+				}).Error("Failed to send notification email")
+			}
+		}()
+	}
+}
+
+func (s *notifierImpl) getPreference(c context.Context, event notification.Event) (entity.NotificationPreference, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return entity.NotificationPreference{}, err
+	}
+
+	preference, err := repo.Preferences.GetPreference(c, event.RecipientID, event.Type)
+	if err != nil {
+		return entity.NotificationPreference{}, err
+	}
+
+	return effectivePreference(preference), nil
+}
+
+func (s *notifierImpl) storeNotification(c context.Context, event notification.Event) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return
+	}
+
+	n := entity.Notification{
+		ID:          id,
+		RecipientID: event.RecipientID,
+		Type:        event.Type,
+		Title:       event.Title,
+		Body:        event.Body,
+		Data:        event.Data,
+		CreatedAt:   now,
+	}
+
+	if err := repo.Notifications.CreateNotification(c, n); err != nil {
+		return
+	}
+
+	err = s.realtime.Publish(c, n.RecipientID, realtime.Event{
+		Type:      realtime.EventNotificationCreated,
+		Data:      makeNotificationResponse(n),
+		CreatedAt: now,
+	})
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":           err.Error(),
+			"notification_id": n.ID,
+		}).Warn("Failed to publish notification event")
+	}
+}
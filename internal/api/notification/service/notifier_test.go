@@ -0,0 +1,180 @@
+package notificationService
+
+import (
+	"ProjectGolang/internal/api/notification"
+	notificationRepository "ProjectGolang/internal/api/notification/repository"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/smtp"
+	"context"
+	"github.com/sirupsen/logrus"
+	"io"
+	"testing"
+	"time"
+)
+
+type fakeNotificationRepo struct {
+	preferences map[entity.NotificationType]entity.NotificationPreference
+	created     []entity.Notification
+}
+
+func (r *fakeNotificationRepo) NewClient(bool) (notificationRepository.Client, error) {
+	return notificationRepository.Client{
+		Notifications: &fakeNotifications{repo: r},
+		Preferences:   &fakePreferences{repo: r},
+		Commit:        func() error { return nil },
+		Rollback:      func() error { return nil },
+	}, nil
+}
+
+type fakeNotifications struct {
+	repo *fakeNotificationRepo
+}
+
+func (n *fakeNotifications) CreateNotification(_ context.Context, created entity.Notification) error {
+	n.repo.created = append(n.repo.created, created)
+	return nil
+}
+
+func (n *fakeNotifications) GetNotifications(context.Context, string, bool, string, int) ([]entity.Notification, error) {
+	return nil, nil
+}
+
+func (n *fakeNotifications) CountUnreadNotifications(context.Context, string) (int, error) {
+	return 0, nil
+}
+
+func (n *fakeNotifications) MarkNotificationRead(context.Context, string, string, time.Time) (bool, error) {
+	return false, nil
+}
+
+func (n *fakeNotifications) MarkAllNotificationsRead(context.Context, string, time.Time) error {
+	return nil
+}
+
+type fakePreferences struct {
+	repo *fakeNotificationRepo
+}
+
+func (p *fakePreferences) GetPreferences(context.Context, string) ([]entity.NotificationPreference, error) {
+	return nil, nil
+}
+
+func (p *fakePreferences) GetPreference(_ context.Context, _ string, notificationType entity.NotificationType) (entity.NotificationPreference, error) {
+	return p.repo.preferences[notificationType], nil
+}
+
+func (p *fakePreferences) UpsertPreference(context.Context, entity.NotificationPreference) error {
+	return nil
+}
+
+type fakeSmtp struct {
+	sent chan smtp.Mail
+}
+
+func (s *fakeSmtp) CreateSmtp(string, string) error {
+	return nil
+}
+
+func (s *fakeSmtp) Send(mail smtp.Mail) error {
+	s.sent <- mail
+	return nil
+}
+
+type fakeRealtime struct {
+	realtime.ItfRealtime
+	published []realtime.Event
+}
+
+func (r *fakeRealtime) Publish(_ context.Context, _ string, event realtime.Event) error {
+	r.published = append(r.published, event)
+	return nil
+}
+
+func TestNotifyAppliesPreferences(t *testing.T) {
+	configured := map[entity.NotificationType]entity.NotificationPreference{
+		entity.NotificationMessage:   {Type: entity.NotificationMessage, Email: false, InApp: true},
+		entity.NotificationInterview: {Type: entity.NotificationInterview, Email: true, InApp: false},
+		entity.NotificationJobInvitation: {
+			Type: entity.NotificationJobInvitation, Email: false, InApp: false,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		event      notification.Event
+		wantStored bool
+		wantMail   bool
+	}{
+		{
+			name:       "unconfigured type uses both channels",
+			event:      notification.Event{RecipientID: "user-1", Type: entity.NotificationApplicationStatus},
+			wantStored: true,
+			wantMail:   true,
+		},
+		{
+			name:       "in-app only",
+			event:      notification.Event{RecipientID: "user-1", Type: entity.NotificationMessage},
+			wantStored: true,
+		},
+		{
+			name:     "email only",
+			event:    notification.Event{RecipientID: "user-1", Type: entity.NotificationInterview},
+			wantMail: true,
+		},
+		{
+			name:  "nowhere",
+			event: notification.Event{RecipientID: "user-1", Type: entity.NotificationJobInvitation},
+		},
+		{
+			name:     "address without an account only gets the email",
+			event:    notification.Event{Type: entity.NotificationJobInvitation},
+			wantMail: true,
+		},
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeNotificationRepo{preferences: configured}
+			mailer := &fakeSmtp{sent: make(chan smtp.Mail, 1)}
+			hub := &fakeRealtime{}
+			notifier := &notifierImpl{repo: repo, smtp: mailer, realtime: hub, log: logger}
+
+			tt.event.Title = "Title"
+			tt.event.Mail = &smtp.Mail{To: "user@example.com", Subject: "Title"}
+			notifier.Notify(context.Background(), tt.event)
+
+			if stored := len(repo.created) == 1; stored != tt.wantStored {
+				t.Errorf("stored = %v, want %v", stored, tt.wantStored)
+			}
+			if published := len(hub.published) == 1; published != tt.wantStored {
+				t.Errorf("published = %v, want %v", published, tt.wantStored)
+			}
+
+			select {
+			case <-mailer.sent:
+				if !tt.wantMail {
+					t.Error("mail sent, want none")
+				}
+			case <-time.After(100 * time.Millisecond):
+				if tt.wantMail {
+					t.Error("mail not sent")
+				}
+			}
+		})
+	}
+}
+
+func TestEffectivePreference(t *testing.T) {
+	if got := effectivePreference(entity.NotificationPreference{}); !got.Email || !got.InApp {
+		t.Errorf("effectivePreference(unset) = %+v, want both channels", got)
+	}
+
+	set := entity.NotificationPreference{Type: entity.NotificationMessage}
+	if got := effectivePreference(set); got != set {
+		t.Errorf("effectivePreference(set) = %+v, want %+v", got, set)
+	}
+}
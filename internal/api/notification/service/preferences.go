@@ -0,0 +1,92 @@
+package notificationService
+
+import (
+	"ProjectGolang/internal/api/notification"
+	notificationRepository "ProjectGolang/internal/api/notification/repository"
+	"ProjectGolang/internal/entity"
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// GetPreferences returns the channels for every notification type, including
+// the defaults for types the recipient never changed.
+func (s *preferenceImpl) GetPreferences(c context.Context, recipientID string) ([]notification.PreferenceResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	return s.getPreferences(c, repo, recipientID)
+}
+
+func (s *preferenceImpl) UpdatePreferences(c context.Context, req notification.UpdatePreferences) ([]notification.PreferenceResponse, error) {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+	defer repo.Rollback()
+
+	now := time.Now()
+	for _, preference := range req.Preferences {
+		err := repo.Preferences.UpsertPreference(c, entity.NotificationPreference{
+			RecipientID: req.RecipientID,
+			Type:        preference.Type,
+			Email:       *preference.Email,
+			InApp:       *preference.InApp,
+			UpdatedAt:   now,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	preferences, err := s.getPreferences(c, repo, req.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit notification preferences")
+		return nil, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"recipient_id": req.RecipientID,
+		"count":        len(req.Preferences),
+	}).Info("Notification preferences updated successfully")
+
+	return preferences, nil
+}
+
+func (s *preferenceImpl) getPreferences(c context.Context, repo notificationRepository.Client, recipientID string) ([]notification.PreferenceResponse, error) {
+	stored, err := repo.Preferences.GetPreferences(c, recipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[entity.NotificationType]entity.NotificationPreference, len(stored))
+	for _, preference := range stored {
+		byType[preference.Type] = preference
+	}
+
+	responses := make([]notification.PreferenceResponse, len(entity.NotificationTypes))
+	for i, notificationType := range entity.NotificationTypes {
+		preference := effectivePreference(byType[notificationType])
+		responses[i] = notification.PreferenceResponse{
+			Type:  notificationType,
+			Email: preference.Email,
+			InApp: preference.InApp,
+		}
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,86 @@
+package notificationService
+
+import (
+	"ProjectGolang/internal/api/notification"
+	notificationRepository "ProjectGolang/internal/api/notification/repository"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/smtp"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+type NotificationService interface {
+	Notification() NotificationDomain
+	Preference() PreferenceDomain
+	Notifier() Notifier
+}
+
+type NotificationDomain interface {
+	GetNotifications(c context.Context, req notification.GetNotifications) (notification.NotificationsResponse, error)
+	MarkNotificationRead(c context.Context, id string, recipientID string) error
+	MarkAllNotificationsRead(c context.Context, recipientID string) error
+}
+
+type PreferenceDomain interface {
+	GetPreferences(c context.Context, recipientID string) ([]notification.PreferenceResponse, error)
+	UpdatePreferences(c context.Context, req notification.UpdatePreferences) ([]notification.PreferenceResponse, error)
+}
+
+// Notifier is how every other domain tells users about events. It applies
+// the recipient's preferences, so services never call ItfSmtp directly for
+// anything but one-time passwords.
+type Notifier interface {
+	Notify(c context.Context, event notification.Event)
+}
+
+type notificationService struct {
+	notificationDomain NotificationDomain
+	preferenceDomain   PreferenceDomain
+	notifier           Notifier
+}
+
+func (s *notificationService) Notification() NotificationDomain {
+	return s.notificationDomain
+}
+
+func (s *notificationService) Preference() PreferenceDomain {
+	return s.preferenceDomain
+}
+
+func (s *notificationService) Notifier() Notifier {
+	return s.notifier
+}
+
+type notificationImpl struct {
+	repo notificationRepository.Repository
+	log  *logrus.Logger
+}
+
+type preferenceImpl struct {
+	repo notificationRepository.Repository
+	log  *logrus.Logger
+}
+
+type notifierImpl struct {
+	repo     notificationRepository.Repository
+	smtp     smtp.ItfSmtp
+	realtime realtime.ItfRealtime
+	log      *logrus.Logger
+}
+
+func New(notificationRepo notificationRepository.Repository,
+	log *logrus.Logger,
+	smtp smtp.ItfSmtp,
+	realtime realtime.ItfRealtime,
+) NotificationService {
+	return &notificationService{
+		notificationDomain: &notificationImpl{repo: notificationRepo, log: log},
+		preferenceDomain:   &preferenceImpl{repo: notificationRepo, log: log},
+		notifier: &notifierImpl{
+			repo:     notificationRepo,
+			smtp:     smtp,
+			realtime: realtime,
+			log:      log,
+		},
+	}
+}
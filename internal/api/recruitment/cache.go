@@ -0,0 +1,11 @@
+package recruitment
+
+import "fmt"
+
+const RecommendedJobsCachePattern = "recommended_jobs:*"
+
+// RecommendedJobsCacheKey is shared with the auth and bio services so profile
+// writes can drop a candidate's cached feed.
+func RecommendedJobsCacheKey(userID string) string {
+	return fmt.Sprintf("recommended_jobs:%s", userID)
+}
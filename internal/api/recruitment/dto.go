@@ -1,16 +1,28 @@
 package recruitment
 
-import "time"
+import (
+	"ProjectGolang/internal/api/skill"
+	"ProjectGolang/internal/entity"
+	"database/sql"
+	"mime/multipart"
+	"time"
+)
 
 type CreateJobVacancy struct {
-	RecruiterID  string
-	Title        string
-	Description  string
-	Requirements string
-	Location     string
-	JobType      string
-	Deadline     time.Time
-	IsActive     bool
+	RecruiterID  string    `json:"-"`
+	MemberID     string    `json:"-"`
+	Title        string    `json:"title" validate:"required,min=3,max=100"`
+	Description  string    `json:"description" validate:"required"`
+	Requirements string    `json:"requirements"`
+	Location     string    `json:"location" validate:"required"`
+	JobType      string    `json:"job_type" validate:"required,oneof=FULL_TIME PART_TIME CONTRACT REMOTE"`
+	Deadline     time.Time `json:"deadline" validate:"required"`
+	IsActive     bool      `json:"is_active"`
+	Headcount    int       `json:"headcount" validate:"omitempty,min=1,max=1000"`
+	Skills       []string  `json:"skills" validate:"omitempty,max=30,dive,max=100"`
+
+	Languages          []LanguageRequirementRequest `json:"languages" validate:"omitempty,max=10,dive"`
+	ScreeningQuestions []ScreeningQuestionRequest   `json:"screening_questions" validate:"omitempty,max=20,dive"`
 }
 
 type GetJobVacancies struct {
@@ -28,8 +40,25 @@ type JobVacancyResponse struct {
 	JobType      string    `json:"job_type"`
 	Deadline     time.Time `json:"deadline"`
 	IsActive     bool      `json:"is_active"`
+	Headcount    int       `json:"headcount"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
+
+	CompanyVerified bool                  `json:"company_verified"`
+	Skills          []skill.SkillResponse `json:"skills"`
+
+	Languages []LanguageRequirementResponse `json:"languages"`
+}
+
+type LanguageRequirementRequest struct {
+	Language string `json:"language" validate:"required,min=2,max=3"`
+	MinLevel string `json:"min_level" validate:"required,oneof=A1 A2 B1 B2 C1 C2 NATIVE"`
+}
+
+type LanguageRequirementResponse struct {
+	Language string `json:"language"`
+	Name     string `json:"name"`
+	MinLevel string `json:"min_level"`
 }
 
 type PaginatedJobVacanciesResponse struct {
@@ -42,11 +71,531 @@ type PaginatedJobVacanciesResponse struct {
 
 type UpdateJobVacancy struct {
 	ID           string    `json:"id" validate:"required"`
+	RecruiterID  string    `json:"-"`
+	MemberID     string    `json:"-"`
 	Title        string    `json:"title" validate:"required,min=3,max=100"`
 	Description  string    `json:"description" validate:"required"`
 	Requirements string    `json:"requirements" validate:"required"`
 	Location     string    `json:"location" validate:"required"`
 	JobType      string    `json:"job_type" validate:"required,oneof=FULL_TIME PART_TIME CONTRACT REMOTE"`
-	Deadline     time.Time `json:"deadline" validate:"required,gtfield=CreatedAt"`
+	Deadline     time.Time `json:"deadline" validate:"required"`
 	IsActive     bool      `json:"is_active"`
+	Headcount    int       `json:"headcount" validate:"omitempty,min=1,max=1000"`
+	// Skills replaces the required skills; leaving it out keeps them.
+	Skills []string `json:"skills" validate:"omitempty,max=30,dive,max=100"`
+	// Languages replaces the language requirements; leaving it out keeps them.
+	Languages []LanguageRequirementRequest `json:"languages" validate:"omitempty,max=10,dive"`
+}
+
+type GetRecommendedJobs struct {
+	Page     int `query:"page" validate:"min=1"`
+	PageSize int `query:"page_size" validate:"min=1,max=100"`
+}
+
+type RecommendedJobVacancyResponse struct {
+	JobVacancyResponse
+	Score         float64  `json:"score"`
+	MatchedSkills []string `json:"matched_skills"`
+}
+
+type PaginatedRecommendedJobsResponse struct {
+	JobVacancies []RecommendedJobVacancyResponse `json:"job_vacancies"`
+	TotalCount   int                             `json:"total_count"`
+	TotalPages   int                             `json:"total_pages"`
+	CurrentPage  int                             `json:"current_page"`
+	PageSize     int                             `json:"page_size"`
+}
+
+type CreateJobApplication struct {
+	JobVacancyID string `json:"-"`
+	UserID       string `json:"-"`
+	CoverLetter  string `json:"cover_letter" validate:"omitempty,max=5000"`
+
+	Answers []ScreeningAnswerRequest `json:"answers" validate:"omitempty,max=50,dive"`
+	// Files holds uploads for FILE questions, keyed by question ID.
+	Files map[string]*multipart.FileHeader `json:"-"`
+}
+
+type JobApplicationResponse struct {
+	ID           string                   `json:"id"`
+	JobVacancyID string                   `json:"job_vacancy_id"`
+	UserID       string                   `json:"user_id"`
+	Status       entity.ApplicationStatus `json:"status"`
+	CoverLetter  string                   `json:"cover_letter"`
+	CreatedAt    time.Time                `json:"created_at"`
+	UpdatedAt    time.Time                `json:"updated_at"`
+
+	// ScreeningFlagged is only reported to recruiters.
+	ScreeningFlagged bool `json:"screening_flagged,omitempty"`
+	// StatusUpdatedBy is the company member who last changed the status,
+	// only reported to recruiters.
+	StatusUpdatedBy string `json:"status_updated_by,omitempty"`
+	// Scorecards is only filled in for recruiters who already submitted
+	// their own scorecard for the application.
+	Scorecards *ScorecardSummary `json:"scorecards,omitempty"`
+}
+
+type JobVacancyDB struct {
+	ID           sql.NullString `db:"id"`
+	RecruiterID  sql.NullString `db:"recruiter_id"`
+	Title        sql.NullString `db:"title"`
+	Description  sql.NullString `db:"description"`
+	Requirements sql.NullString `db:"requirements"`
+	Location     sql.NullString `db:"location"`
+	JobType      sql.NullString `db:"job_type"`
+	Deadline     sql.NullTime   `db:"deadline"`
+	IsActive     sql.NullBool   `db:"is_active"`
+	Headcount    sql.NullInt64  `db:"headcount"`
+	CreatedAt    sql.NullTime   `db:"created_at"`
+	UpdatedAt    sql.NullTime   `db:"updated_at"`
+
+	CompanyVerified sql.NullBool `db:"company_verified"`
+}
+
+type JobApplicationDB struct {
+	ID               sql.NullString `db:"id"`
+	JobVacancyID     sql.NullString `db:"job_vacancy_id"`
+	UserID           sql.NullString `db:"user_id"`
+	Status           sql.NullString `db:"status"`
+	CoverLetter      sql.NullString `db:"cover_letter"`
+	ScreeningFlagged sql.NullBool   `db:"screening_flagged"`
+	CreatedAt        sql.NullTime   `db:"created_at"`
+	UpdatedAt        sql.NullTime   `db:"updated_at"`
+	StatusUpdatedBy  sql.NullString `db:"status_updated_by"`
+}
+
+type SavedJobResponse struct {
+	JobVacancyResponse
+	SavedAt time.Time `json:"saved_at"`
+}
+
+type CreateSavedSearch struct {
+	UserID    string                      `json:"-"`
+	Name      string                      `json:"name" validate:"required,min=3,max=100"`
+	Query     string                      `json:"query" validate:"omitempty,max=255"`
+	Location  string                      `json:"location" validate:"omitempty,max=255"`
+	JobType   string                      `json:"job_type" validate:"omitempty,oneof=FULL_TIME PART_TIME CONTRACT REMOTE"`
+	Frequency entity.SavedSearchFrequency `json:"frequency" validate:"required,oneof=DAILY WEEKLY"`
+}
+
+type SavedSearchResponse struct {
+	ID             string                      `json:"id"`
+	Name           string                      `json:"name"`
+	Query          string                      `json:"query"`
+	Location       string                      `json:"location"`
+	JobType        string                      `json:"job_type"`
+	Frequency      entity.SavedSearchFrequency `json:"frequency"`
+	IsActive       bool                        `json:"is_active"`
+	LastNotifiedAt *time.Time                  `json:"last_notified_at"`
+	CreatedAt      time.Time                   `json:"created_at"`
+	UpdatedAt      time.Time                   `json:"updated_at"`
+}
+
+type SavedSearchDB struct {
+	ID               sql.NullString `db:"id"`
+	UserID           sql.NullString `db:"user_id"`
+	Name             sql.NullString `db:"name"`
+	Query            sql.NullString `db:"query"`
+	Location         sql.NullString `db:"location"`
+	JobType          sql.NullString `db:"job_type"`
+	Frequency        sql.NullString `db:"frequency"`
+	UnsubscribeToken sql.NullString `db:"unsubscribe_token"`
+	IsActive         sql.NullBool   `db:"is_active"`
+	LastNotifiedAt   sql.NullTime   `db:"last_notified_at"`
+	CreatedAt        sql.NullTime   `db:"created_at"`
+	UpdatedAt        sql.NullTime   `db:"updated_at"`
+}
+
+type SearchCandidates struct {
+	Skills      string `query:"skills" validate:"omitempty,max=255"`
+	JobTitle    string `query:"job_title" validate:"omitempty,max=255"`
+	Institution string `query:"institution" validate:"omitempty,max=255"`
+	Degree      string `query:"degree" validate:"omitempty,max=255"`
+	Location    string `query:"location" validate:"omitempty,max=255"`
+	Headline    string `query:"headline" validate:"omitempty,max=255"`
+	// Languages filters on spoken languages, e.g. "en:B2,id" for at least
+	// B2 English and any level of Indonesian.
+	Languages string `query:"languages" validate:"omitempty,max=255"`
+	Page      int    `query:"page" validate:"min=1"`
+	PageSize  int    `query:"page_size" validate:"min=1,max=100"`
+
+	// LanguageRequirements is Languages parsed by the service.
+	LanguageRequirements []entity.LanguageRequirement `query:"-"`
+}
+
+type CandidateExperience struct {
+	ID          string `json:"id"`
+	JobTitle    string `json:"job_title"`
+	JobLocation string `json:"job_location"`
+	SkillUsed   string `json:"skill_used"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date"`
+	IsCurrent   bool   `json:"is_current"`
+}
+
+type CandidateEducation struct {
+	ID                string `json:"id"`
+	TitleDegree       string `json:"title_degree"`
+	InstitutionalName string `json:"institutional_name"`
+	StartDate         string `json:"start_date"`
+	EndDate           string `json:"end_date"`
+	IsCurrent         bool   `json:"is_current"`
+}
+
+type CandidateCardResponse struct {
+	ID               string               `json:"id"`
+	Name             string               `json:"name"`
+	Headline         string               `json:"headline"`
+	Location         string               `json:"location"`
+	ProfilePicture   string               `json:"profile_picture"`
+	LatestExperience *CandidateExperience `json:"latest_experience"`
+	LatestEducation  *CandidateEducation  `json:"latest_education"`
+}
+
+type PaginatedCandidatesResponse struct {
+	Candidates  []CandidateCardResponse `json:"candidates"`
+	TotalCount  int                     `json:"total_count"`
+	TotalPages  int                     `json:"total_pages"`
+	CurrentPage int                     `json:"current_page"`
+	PageSize    int                     `json:"page_size"`
+}
+
+type CreateTalentPool struct {
+	CompanyID   string `json:"-"`
+	Name        string `json:"name" validate:"required,min=3,max=100"`
+	Description string `json:"description" validate:"omitempty,max=1000"`
+}
+
+type UpdateTalentPool struct {
+	ID          string `json:"-"`
+	CompanyID   string `json:"-"`
+	Name        string `json:"name" validate:"required,min=3,max=100"`
+	Description string `json:"description" validate:"omitempty,max=1000"`
+}
+
+type TalentPoolResponse struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	CandidateCount int       `json:"candidate_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type AddTalentPoolCandidate struct {
+	TalentPoolID string   `json:"-"`
+	CompanyID    string   `json:"-"`
+	UserID       string   `json:"user_id" validate:"required"`
+	Tags         []string `json:"tags" validate:"omitempty,max=20,dive,required,max=50"`
+	Note         string   `json:"note" validate:"omitempty,max=5000"`
+}
+
+type UpdateTalentPoolCandidate struct {
+	TalentPoolID string   `json:"-"`
+	CompanyID    string   `json:"-"`
+	UserID       string   `json:"-"`
+	Tags         []string `json:"tags" validate:"omitempty,max=20,dive,required,max=50"`
+	Note         string   `json:"note" validate:"omitempty,max=5000"`
+}
+
+type GetTalentPoolCandidates struct {
+	Tag string `query:"tag"`
+}
+
+type TalentPoolCandidateResponse struct {
+	UserID         string    `json:"user_id"`
+	Name           string    `json:"name"`
+	Headline       string    `json:"headline"`
+	Location       string    `json:"location"`
+	ProfilePicture string    `json:"profile_picture"`
+	Tags           []string  `json:"tags"`
+	Note           string    `json:"note"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type InviteCandidate struct {
+	TalentPoolID string `json:"-"`
+	CompanyID    string `json:"-"`
+	UserID       string `json:"-"`
+	JobVacancyID string `json:"job_vacancy_id" validate:"required"`
+	Message      string `json:"message" validate:"omitempty,max=2000"`
+}
+
+type JobInvitationResponse struct {
+	ID         string                  `json:"id"`
+	CompanyID  string                  `json:"company_id"`
+	Message    string                  `json:"message"`
+	Status     entity.InvitationStatus `json:"status"`
+	JobVacancy JobVacancyResponse      `json:"job_vacancy"`
+	CreatedAt  time.Time               `json:"created_at"`
+	UpdatedAt  time.Time               `json:"updated_at"`
+}
+
+type UpdateJobApplicationStatus struct {
+	ID          string                   `json:"-"`
+	RecruiterID string                   `json:"-"`
+	MemberID    string                   `json:"-"`
+	Status      entity.ApplicationStatus `json:"status" validate:"required,oneof=reviewing interview rejected"`
+}
+
+type InterviewSlotRequest struct {
+	StartTime time.Time `json:"start_time" validate:"required"`
+}
+
+type InterviewerRequest struct {
+	Name  string `json:"name" validate:"required,max=255"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+type CreateInterview struct {
+	JobApplicationID string                 `json:"-"`
+	CompanyID        string                 `json:"-"`
+	Title            string                 `json:"title" validate:"required,min=3,max=255"`
+	Format           entity.InterviewFormat `json:"format" validate:"required,oneof=onsite video phone"`
+	Location         string                 `json:"location" validate:"required_if=Format onsite,max=255"`
+	MeetingLink      string                 `json:"meeting_link" validate:"required_if=Format video,omitempty,url,max=255"`
+	Timezone         string                 `json:"timezone" validate:"required,timezone"`
+	DurationMinutes  int                    `json:"duration_minutes" validate:"required,min=15,max=480"`
+	Slots            []InterviewSlotRequest `json:"slots" validate:"required,min=1,max=10,dive"`
+	Interviewers     []InterviewerRequest   `json:"interviewers" validate:"required,min=1,max=10,dive"`
+}
+
+type UpdateInterview struct {
+	ID              string                 `json:"-"`
+	CompanyID       string                 `json:"-"`
+	Title           string                 `json:"title" validate:"required,min=3,max=255"`
+	Format          entity.InterviewFormat `json:"format" validate:"required,oneof=onsite video phone"`
+	Location        string                 `json:"location" validate:"required_if=Format onsite,max=255"`
+	MeetingLink     string                 `json:"meeting_link" validate:"required_if=Format video,omitempty,url,max=255"`
+	Timezone        string                 `json:"timezone" validate:"required,timezone"`
+	DurationMinutes int                    `json:"duration_minutes" validate:"required,min=15,max=480"`
+	Slots           []InterviewSlotRequest `json:"slots" validate:"omitempty,max=10,dive"`
+	Interviewers    []InterviewerRequest   `json:"interviewers" validate:"required,min=1,max=10,dive"`
+}
+
+type SelectInterviewSlot struct {
+	InterviewID string `json:"-"`
+	UserID      string `json:"-"`
+	SlotID      string `json:"slot_id" validate:"required"`
+}
+
+type InterviewSlotResponse struct {
+	ID        string    `json:"id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+type InterviewerResponse struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type InterviewResponse struct {
+	ID               string                  `json:"id"`
+	JobApplicationID string                  `json:"job_application_id"`
+	CompanyID        string                  `json:"company_id"`
+	Title            string                  `json:"title"`
+	Format           entity.InterviewFormat  `json:"format"`
+	Location         string                  `json:"location"`
+	MeetingLink      string                  `json:"meeting_link"`
+	Timezone         string                  `json:"timezone"`
+	DurationMinutes  int                     `json:"duration_minutes"`
+	Status           entity.InterviewStatus  `json:"status"`
+	ScheduledStart   *time.Time              `json:"scheduled_start"`
+	ScheduledEnd     *time.Time              `json:"scheduled_end"`
+	Slots            []InterviewSlotResponse `json:"slots"`
+	Interviewers     []InterviewerResponse   `json:"interviewers"`
+	CreatedAt        time.Time               `json:"created_at"`
+	UpdatedAt        time.Time               `json:"updated_at"`
+}
+
+type InterviewDB struct {
+	ID               sql.NullString `db:"id"`
+	JobApplicationID sql.NullString `db:"job_application_id"`
+	CompanyID        sql.NullString `db:"company_id"`
+	Title            sql.NullString `db:"title"`
+	Format           sql.NullString `db:"format"`
+	Location         sql.NullString `db:"location"`
+	MeetingLink      sql.NullString `db:"meeting_link"`
+	Timezone         sql.NullString `db:"timezone"`
+	DurationMinutes  sql.NullInt64  `db:"duration_minutes"`
+	Status           sql.NullString `db:"status"`
+	ScheduledStart   sql.NullTime   `db:"scheduled_start"`
+	ScheduledEnd     sql.NullTime   `db:"scheduled_end"`
+	Sequence         sql.NullInt64  `db:"sequence"`
+	CreatedAt        sql.NullTime   `db:"created_at"`
+	UpdatedAt        sql.NullTime   `db:"updated_at"`
+}
+
+type ScorecardCompetencyRequest struct {
+	Name        string   `json:"name" validate:"required,max=100"`
+	Description string   `json:"description" validate:"omitempty,max=1000"`
+	ScaleMax    int      `json:"scale_max" validate:"required,min=2,max=10"`
+	ScaleLabels []string `json:"scale_labels" validate:"omitempty,dive,required,max=50"`
+}
+
+type SaveScorecardTemplate struct {
+	JobVacancyID string                       `json:"-"`
+	CompanyID    string                       `json:"-"`
+	Name         string                       `json:"name" validate:"required,min=3,max=100"`
+	Competencies []ScorecardCompetencyRequest `json:"competencies" validate:"required,min=1,max=20,dive"`
+}
+
+type ScorecardCompetencyResponse struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	ScaleMax    int      `json:"scale_max"`
+	ScaleLabels []string `json:"scale_labels"`
+}
+
+type ScorecardTemplateResponse struct {
+	ID           string                        `json:"id"`
+	JobVacancyID string                        `json:"job_vacancy_id"`
+	Name         string                        `json:"name"`
+	Competencies []ScorecardCompetencyResponse `json:"competencies"`
+	CreatedAt    time.Time                     `json:"created_at"`
+	UpdatedAt    time.Time                     `json:"updated_at"`
+}
+
+type ScorecardRatingRequest struct {
+	CompetencyID string `json:"competency_id" validate:"required"`
+	Rating       int    `json:"rating" validate:"required,min=1"`
+	Comment      string `json:"comment" validate:"omitempty,max=2000"`
+}
+
+type SubmitScorecard struct {
+	JobApplicationID string                         `json:"-"`
+	CompanyID        string                         `json:"-"`
+	InterviewerID    string                         `json:"-"`
+	Recommendation   entity.ScorecardRecommendation `json:"recommendation" validate:"required,oneof=STRONG_NO NO YES STRONG_YES"`
+	Notes            string                         `json:"notes" validate:"omitempty,max=5000"`
+	Ratings          []ScorecardRatingRequest       `json:"ratings" validate:"required,min=1,dive"`
+}
+
+type ScorecardRatingResponse struct {
+	CompetencyID string `json:"competency_id"`
+	Rating       int    `json:"rating"`
+	Comment      string `json:"comment"`
+}
+
+type ScorecardResponse struct {
+	ID             string                         `json:"id"`
+	InterviewerID  string                         `json:"interviewer_id"`
+	Recommendation entity.ScorecardRecommendation `json:"recommendation"`
+	Notes          string                         `json:"notes"`
+	Ratings        []ScorecardRatingResponse      `json:"ratings"`
+	SubmittedAt    time.Time                      `json:"submitted_at"`
+	UpdatedAt      time.Time                      `json:"updated_at"`
+}
+
+type CompetencyAverage struct {
+	CompetencyID string  `json:"competency_id"`
+	Name         string  `json:"name"`
+	ScaleMax     int     `json:"scale_max"`
+	Average      float64 `json:"average"`
+}
+
+type ScorecardSummary struct {
+	Recommendation entity.ScorecardRecommendation         `json:"recommendation"`
+	Score          float64                                `json:"score"`
+	Votes          map[entity.ScorecardRecommendation]int `json:"votes"`
+	Competencies   []CompetencyAverage                    `json:"competencies"`
+	SubmittedCount int                                    `json:"submitted_count"`
+}
+
+// ApplicationScorecardsResponse withholds other interviewers' feedback, and
+// the aggregate built from it, until the caller has submitted their own.
+type ApplicationScorecardsResponse struct {
+	SubmittedCount int                 `json:"submitted_count"`
+	OwnScorecard   *ScorecardResponse  `json:"own_scorecard"`
+	Summary        *ScorecardSummary   `json:"summary,omitempty"`
+	Scorecards     []ScorecardResponse `json:"scorecards,omitempty"`
+}
+
+type CreateOffer struct {
+	JobApplicationID string              `json:"-"`
+	CompanyID        string              `json:"-"`
+	MemberID         string              `json:"-"`
+	SalaryAmount     int64               `json:"salary_amount" validate:"required,min=1"`
+	SalaryCurrency   string              `json:"salary_currency" validate:"required,len=3,uppercase"`
+	SalaryPeriod     entity.SalaryPeriod `json:"salary_period" validate:"required,oneof=hourly monthly yearly"`
+	StartDate        time.Time           `json:"start_date" validate:"required"`
+	ExpiresAt        time.Time           `json:"expires_at" validate:"required"`
+	Terms            string              `json:"terms" validate:"required,max=20000"`
+}
+
+type OfferResponse struct {
+	ID               string              `json:"id"`
+	JobApplicationID string              `json:"job_application_id"`
+	JobVacancyID     string              `json:"job_vacancy_id"`
+	JobTitle         string              `json:"job_title"`
+	CompanyID        string              `json:"company_id"`
+	CompanyName      string              `json:"company_name"`
+	SalaryAmount     int64               `json:"salary_amount"`
+	SalaryCurrency   string              `json:"salary_currency"`
+	SalaryPeriod     entity.SalaryPeriod `json:"salary_period"`
+	StartDate        time.Time           `json:"start_date"`
+	ExpiresAt        time.Time           `json:"expires_at"`
+	Terms            string              `json:"terms"`
+	Status           entity.OfferStatus  `json:"status"`
+	RespondedAt      *time.Time          `json:"responded_at"`
+	CreatedAt        time.Time           `json:"created_at"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+}
+
+type ScreeningQuestionRequest struct {
+	Prompt          string                       `json:"prompt" validate:"required,max=500"`
+	Type            entity.ScreeningQuestionType `json:"type" validate:"required,oneof=YES_NO SINGLE_CHOICE MULTIPLE_CHOICE NUMBER TEXT FILE"`
+	Options         []string                     `json:"options" validate:"omitempty,max=20,dive,required,max=200"`
+	Required        bool                         `json:"required"`
+	KnockoutAnswers []string                     `json:"knockout_answers" validate:"omitempty,max=20,dive,required"`
+	KnockoutMin     *float64                     `json:"knockout_min"`
+	KnockoutMax     *float64                     `json:"knockout_max"`
+	KnockoutAction  entity.KnockoutAction        `json:"knockout_action" validate:"omitempty,oneof=REJECT FLAG"`
+}
+
+type SaveScreeningQuestions struct {
+	JobVacancyID string                     `json:"-"`
+	RecruiterID  string                     `json:"-"`
+	Questions    []ScreeningQuestionRequest `json:"questions" validate:"max=20,dive"`
+}
+
+type ScreeningKnockoutResponse struct {
+	Answers []string              `json:"answers,omitempty"`
+	Min     *float64              `json:"min,omitempty"`
+	Max     *float64              `json:"max,omitempty"`
+	Action  entity.KnockoutAction `json:"action"`
+}
+
+type ScreeningQuestionResponse struct {
+	ID       string                       `json:"id"`
+	Prompt   string                       `json:"prompt"`
+	Type     entity.ScreeningQuestionType `json:"type"`
+	Options  []string                     `json:"options,omitempty"`
+	Required bool                         `json:"required"`
+
+	// Knockout is hidden from candidates.
+	Knockout *ScreeningKnockoutResponse `json:"knockout,omitempty"`
+}
+
+// ScreeningAnswerRequest answers YES_NO, SINGLE_CHOICE and TEXT questions
+// with Value, MULTIPLE_CHOICE with Values and NUMBER with Number. FILE
+// answers are sent as multipart uploads instead.
+type ScreeningAnswerRequest struct {
+	QuestionID string   `json:"question_id" validate:"required"`
+	Value      string   `json:"value" validate:"omitempty,max=5000"`
+	Values     []string `json:"values" validate:"omitempty,max=20"`
+	Number     *float64 `json:"number"`
+}
+
+type ScreeningAnswerResponse struct {
+	QuestionID string                       `json:"question_id"`
+	Prompt     string                       `json:"prompt"`
+	Type       entity.ScreeningQuestionType `json:"type"`
+	Values     []string                     `json:"values,omitempty"`
+	Number     *float64                     `json:"number,omitempty"`
+	FileURL    string                       `json:"file_url,omitempty"`
+	KnockedOut bool                         `json:"knocked_out"`
 }
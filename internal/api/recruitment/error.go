@@ -1 +1,70 @@
 package recruitment
+
+import (
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrorJobVacancyNotFound      = response.New(fiber.StatusNotFound, "job vacancy not found")
+	ErrorJobVacancyClosed        = response.New(fiber.StatusBadRequest, "job vacancy is closed")
+	ErrorNotVacancyOwner         = response.New(fiber.StatusForbidden, "job vacancy belongs to another company")
+	ErrorUnverifiedVacancyLimit  = response.New(fiber.StatusForbidden, "unverified companies can only have 3 active job vacancies; verify your company to publish more")
+	ErrorAlreadyApplied          = response.New(fiber.StatusConflict, "already applied to this job vacancy")
+	ErrorCandidateOnly           = response.New(fiber.StatusForbidden, "only candidates can perform this action")
+	ErrorRecruiterOnly           = response.New(fiber.StatusForbidden, "only recruiters can perform this action")
+	ErrorViewerReadOnly          = response.New(fiber.StatusForbidden, "viewers cannot make changes for the company")
+	ErrorSavedJobNotFound        = response.New(fiber.StatusNotFound, "saved job not found")
+	ErrorSavedSearchNotFound     = response.New(fiber.StatusNotFound, "saved search not found")
+	ErrorInvalidUnsubscribeToken = response.New(fiber.StatusBadRequest, "invalid unsubscribe token")
+)
+
+var (
+	ErrorTalentPoolNotFound          = response.New(fiber.StatusNotFound, "talent pool not found")
+	ErrorTalentPoolNameTaken         = response.New(fiber.StatusConflict, "talent pool with this name already exists")
+	ErrorTalentPoolCandidateNotFound = response.New(fiber.StatusNotFound, "candidate is not in this talent pool")
+	ErrorTalentPoolCandidateExists   = response.New(fiber.StatusConflict, "candidate is already in this talent pool")
+	ErrorCandidateNotFound           = response.New(fiber.StatusNotFound, "candidate not found")
+	ErrorAlreadyInvited              = response.New(fiber.StatusConflict, "candidate was already invited to this job vacancy")
+	ErrorInvitationNotFound          = response.New(fiber.StatusNotFound, "invitation not found")
+)
+
+var (
+	ErrorJobApplicationNotFound  = response.New(fiber.StatusNotFound, "job application not found")
+	ErrorInvalidStatusTransition = response.New(fiber.StatusConflict, "job application cannot move to this status from its current one")
+	ErrorApplicationNotInterview = response.New(fiber.StatusBadRequest, "job application is not in the interview stage")
+	ErrorInterviewNotFound       = response.New(fiber.StatusNotFound, "interview not found")
+	ErrorInterviewSlotNotFound   = response.New(fiber.StatusNotFound, "interview slot not found")
+	ErrorInterviewSlotInPast     = response.New(fiber.StatusBadRequest, "interview slots must be in the future")
+	ErrorInterviewNotProposed    = response.New(fiber.StatusConflict, "interview is not awaiting a slot selection")
+	ErrorInterviewCancelled      = response.New(fiber.StatusConflict, "interview has been cancelled")
+)
+
+var (
+	ErrorScorecardTemplateNotFound = response.New(fiber.StatusNotFound, "job vacancy has no scorecard template")
+	ErrorScorecardTemplateInUse    = response.New(fiber.StatusConflict, "scorecard template already has submitted scorecards")
+	ErrorScorecardRatingsMismatch  = response.New(fiber.StatusBadRequest, "ratings must cover every competency of the template exactly once")
+	ErrorScorecardRatingOutOfScale = response.New(fiber.StatusBadRequest, "rating is outside the competency scale")
+	ErrorScorecardLabelsMismatch   = response.New(fiber.StatusBadRequest, "scale labels must match the scale size")
+	ErrorScorecardNotInterviewed   = response.New(fiber.StatusBadRequest, "scorecards can only be submitted once the application reached the interview stage")
+	ErrorHiringDecisionPending     = response.New(fiber.StatusConflict, "every interviewer must submit a scorecard before an offer or rejection")
+	ErrorHiringDecisionNegative    = response.New(fiber.StatusConflict, "the interviewers' aggregated recommendation does not support an offer")
+)
+
+var (
+	ErrorOfferNotFound       = response.New(fiber.StatusNotFound, "offer not found")
+	ErrorOfferPending        = response.New(fiber.StatusConflict, "job application already has an open offer")
+	ErrorOfferNotOpen        = response.New(fiber.StatusConflict, "offer is no longer open")
+	ErrorOfferExpired        = response.New(fiber.StatusGone, "offer has expired")
+	ErrorOfferInvalidDates   = response.New(fiber.StatusBadRequest, "offer must expire in the future and start no earlier than today")
+	ErrorApplicationNotOffer = response.New(fiber.StatusBadRequest, "offers can only be made to applications in the interview or offer stage")
+)
+
+var (
+	ErrorScreeningQuestionInvalid  = response.New(fiber.StatusBadRequest, "screening question options or knockout criteria do not fit its type")
+	ErrorScreeningQuestionsInUse   = response.New(fiber.StatusConflict, "screening questions cannot change once candidates have applied")
+	ErrorScreeningAnswerRequired   = response.New(fiber.StatusBadRequest, "a required screening question was not answered")
+	ErrorScreeningAnswerInvalid    = response.New(fiber.StatusBadRequest, "screening answer does not fit its question")
+	ErrorScreeningQuestionUnknown  = response.New(fiber.StatusBadRequest, "answer refers to a question that is not part of this job vacancy")
+	ErrorScreeningAnswerFileTooBig = response.New(fiber.StatusRequestEntityTooLarge, "screening answer file exceeds the size limit")
+)
@@ -0,0 +1,58 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *RecruitmentHandler) SearchCandidates(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing candidate search request")
+
+	if _, err := h.requireRole(ctx, entity.RoleRecruiter); err != nil {
+		return response.WriteError(ctx, err, "Candidate search failed")
+	}
+
+	req := recruitment.SearchCandidates{Page: 1, PageSize: 20}
+	if err := ctx.QueryParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse candidate search query parameters")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for candidate search")
+		return err
+	}
+
+	result, err := h.recruitmentService.Candidate().SearchCandidates(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Candidate search failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(result)
+	}
+}
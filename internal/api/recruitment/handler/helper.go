@@ -0,0 +1,39 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"github.com/gofiber/fiber/v2"
+)
+
+// requireRole returns the authenticated user when they hold the given role.
+func (h *RecruitmentHandler) requireRole(ctx *fiber.Ctx, role entity.UserRole) (entity.UserLoginData, error) {
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return entity.UserLoginData{}, err
+	}
+
+	if user.Role != role {
+		if role == entity.RoleCandidate {
+			return entity.UserLoginData{}, recruitment.ErrorCandidateOnly
+		}
+		return entity.UserLoginData{}, recruitment.ErrorRecruiterOnly
+	}
+
+	// Viewers can look at their company's hiring data but not change it.
+	if user.CompanyRole == entity.CompanyRoleViewer && ctx.Method() != fiber.MethodGet {
+		return entity.UserLoginData{}, recruitment.ErrorViewerReadOnly
+	}
+
+	return user, nil
+}
+
+// interviewerID names who a scorecard belongs to: the member acting for the
+// company, or the company itself for credentials that carry no member.
+func interviewerID(user entity.UserLoginData) string {
+	if user.MemberID != "" {
+		return user.MemberID
+	}
+	return user.ID
+}
@@ -0,0 +1,84 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequireRoleKeepsViewersReadOnly(t *testing.T) {
+	h := &RecruitmentHandler{}
+
+	tests := []struct {
+		name   string
+		method string
+		user   entity.UserLoginData
+		role   entity.UserRole
+		want   error
+	}{
+		{
+			name:   "recruiter writes",
+			method: fiber.MethodPost,
+			user:   entity.UserLoginData{Role: entity.RoleRecruiter, CompanyRole: entity.CompanyRoleRecruiter},
+			role:   entity.RoleRecruiter,
+		},
+		{
+			name:   "viewer reads",
+			method: fiber.MethodGet,
+			user:   entity.UserLoginData{Role: entity.RoleRecruiter, CompanyRole: entity.CompanyRoleViewer},
+			role:   entity.RoleRecruiter,
+		},
+		{
+			name:   "viewer cannot write",
+			method: fiber.MethodPatch,
+			user:   entity.UserLoginData{Role: entity.RoleRecruiter, CompanyRole: entity.CompanyRoleViewer},
+			role:   entity.RoleRecruiter,
+			want:   recruitment.ErrorViewerReadOnly,
+		},
+		{
+			name:   "candidate on a recruiter route",
+			method: fiber.MethodGet,
+			user:   entity.UserLoginData{Role: entity.RoleCandidate},
+			role:   entity.RoleRecruiter,
+			want:   recruitment.ErrorRecruiterOnly,
+		},
+		{
+			name:   "recruiter on a candidate route",
+			method: fiber.MethodPost,
+			user:   entity.UserLoginData{Role: entity.RoleRecruiter, CompanyRole: entity.CompanyRoleOwner},
+			role:   entity.RoleCandidate,
+			want:   recruitment.ErrorCandidateOnly,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got error
+			app := fiber.New()
+			app.All("/", func(ctx *fiber.Ctx) error {
+				ctx.Locals("user", tt.user)
+				_, got = h.requireRole(ctx, tt.role)
+				return nil
+			})
+
+			if _, err := app.Test(httptest.NewRequest(tt.method, "/", nil)); err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			if !errors.Is(got, tt.want) {
+				t.Errorf("requireRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterviewerID(t *testing.T) {
+	if got := interviewerID(entity.UserLoginData{ID: "company-1", MemberID: "member-1"}); got != "member-1" {
+		t.Errorf("interviewerID(member) = %q, want member-1", got)
+	}
+	if got := interviewerID(entity.UserLoginData{ID: "company-1"}); got != "company-1" {
+		t.Errorf("interviewerID(company) = %q, want company-1", got)
+	}
+}
@@ -2,6 +2,7 @@ package recruitmentHandler
 
 import (
 	recruitmentService "ProjectGolang/internal/api/recruitment/service"
+	"ProjectGolang/internal/entity"
 	"ProjectGolang/internal/middleware"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -27,8 +28,71 @@ func New(rs recruitmentService.RecruitmentService, validate *validator.Validate,
 func (h *RecruitmentHandler) Start(srv fiber.Router) {
 	rc := srv.Group("/recruitment")
 	jv := rc.Group("/job_vacancies")
-	jv.Post("/", h.CreateJobVacancy)
+	jv.Post("/", h.middleware.NewAPIKeyOrTokenMiddleware(entity.ScopeVacanciesWrite), h.CreateJobVacancy)
 	jv.Get("/", h.GetJobVacancies)
-	jv.Put("/:id", h.UpdateJobVacancy)
-	jv.Delete("/:id", h.DeleteJobVacancy)
+	jv.Put("/:id", h.middleware.NewAPIKeyOrTokenMiddleware(entity.ScopeVacanciesWrite), h.UpdateJobVacancy)
+	jv.Delete("/:id", h.middleware.NewAPIKeyOrTokenMiddleware(entity.ScopeVacanciesWrite), h.DeleteJobVacancy)
+	jv.Post("/:id/applications", h.middleware.NewTokenMiddleware, h.CreateJobApplication)
+	jv.Post("/:id/save", h.middleware.NewTokenMiddleware, h.SaveJobVacancy)
+	jv.Delete("/:id/save", h.middleware.NewTokenMiddleware, h.UnsaveJobVacancy)
+	jv.Get("/:id/applications", h.middleware.NewAPIKeyOrTokenMiddleware(entity.ScopeApplicationsRead), h.GetJobVacancyApplications)
+	jv.Put("/:id/scorecard_template", h.middleware.NewTokenMiddleware, h.SaveScorecardTemplate)
+	jv.Get("/:id/scorecard_template", h.middleware.NewTokenMiddleware, h.GetScorecardTemplate)
+	jv.Delete("/:id/scorecard_template", h.middleware.NewTokenMiddleware, h.DeleteScorecardTemplate)
+	jv.Get("/:id/screening_questions", h.GetScreeningQuestions)
+	jv.Put("/:id/screening_questions", h.middleware.NewAPIKeyOrTokenMiddleware(entity.ScopeVacanciesWrite), h.SaveScreeningQuestions)
+	jv.Get("/:id/screening_questions/config", h.middleware.NewAPIKeyOrTokenMiddleware(entity.ScopeVacanciesRead), h.GetScreeningQuestionConfig)
+
+	ja := rc.Group("/job_applications")
+	ja.Put("/:id/status", h.middleware.NewAPIKeyOrTokenMiddleware(entity.ScopeApplicationsWrite), h.UpdateJobApplicationStatus)
+	ja.Post("/:id/interviews", h.middleware.NewTokenMiddleware, h.CreateInterview)
+	ja.Get("/:id/interviews", h.middleware.NewTokenMiddleware, h.GetJobApplicationInterviews)
+	ja.Put("/:id/scorecard", h.middleware.NewTokenMiddleware, h.SubmitScorecard)
+	ja.Get("/:id/scorecards", h.middleware.NewTokenMiddleware, h.GetApplicationScorecards)
+	ja.Post("/:id/offers", h.middleware.NewTokenMiddleware, h.CreateOffer)
+	ja.Get("/:id/offers", h.middleware.NewTokenMiddleware, h.GetJobApplicationOffers)
+	ja.Get("/:id/screening_answers", h.middleware.NewAPIKeyOrTokenMiddleware(entity.ScopeApplicationsRead), h.GetScreeningAnswers)
+
+	iv := rc.Group("/interviews")
+	iv.Put("/:id", h.middleware.NewTokenMiddleware, h.UpdateInterview)
+	iv.Delete("/:id", h.middleware.NewTokenMiddleware, h.CancelInterview)
+
+	of := rc.Group("/offers")
+	of.Get("/token/:token", h.GetOfferByToken)
+	of.Get("/token/:token/pdf", h.GetOfferPDFByToken)
+	of.Delete("/:id", h.middleware.NewTokenMiddleware, h.WithdrawOffer)
+	of.Get("/:id/pdf", h.middleware.NewTokenMiddleware, h.GetCompanyOfferPDF)
+
+	rc.Get("/candidates", h.middleware.NewTokenMiddleware, h.SearchCandidates)
+
+	tp := rc.Group("/talent_pools")
+	tp.Post("/", h.middleware.NewTokenMiddleware, h.CreateTalentPool)
+	tp.Get("/", h.middleware.NewTokenMiddleware, h.GetTalentPools)
+	tp.Put("/:id", h.middleware.NewTokenMiddleware, h.UpdateTalentPool)
+	tp.Delete("/:id", h.middleware.NewTokenMiddleware, h.DeleteTalentPool)
+	tp.Post("/:id/candidates", h.middleware.NewTokenMiddleware, h.AddTalentPoolCandidate)
+	tp.Get("/:id/candidates", h.middleware.NewTokenMiddleware, h.GetTalentPoolCandidates)
+	tp.Put("/:id/candidates/:userId", h.middleware.NewTokenMiddleware, h.UpdateTalentPoolCandidate)
+	tp.Delete("/:id/candidates/:userId", h.middleware.NewTokenMiddleware, h.RemoveTalentPoolCandidate)
+	tp.Post("/:id/candidates/:userId/invitations", h.middleware.NewTokenMiddleware, h.InviteTalentPoolCandidate)
+
+	ss := rc.Group("/saved_searches")
+	ss.Get("/unsubscribe", h.ConfirmUnsubscribeSavedSearch)
+	ss.Post("/unsubscribe", h.UnsubscribeSavedSearch)
+
+	me := srv.Group("/users/me")
+	me.Get("/recommended_jobs", h.middleware.NewTokenMiddleware, h.GetRecommendedJobs)
+	me.Get("/applications", h.middleware.NewTokenMiddleware, h.GetMyJobApplications)
+	me.Get("/invitations", h.middleware.NewTokenMiddleware, h.GetMyJobInvitations)
+	me.Post("/invitations/:id/decline", h.middleware.NewTokenMiddleware, h.DeclineJobInvitation)
+	me.Get("/interviews", h.middleware.NewTokenMiddleware, h.GetMyInterviews)
+	me.Post("/interviews/:id/slot", h.middleware.NewTokenMiddleware, h.SelectInterviewSlot)
+	me.Get("/offers", h.middleware.NewTokenMiddleware, h.GetMyOffers)
+	me.Get("/offers/:id/pdf", h.middleware.NewTokenMiddleware, h.GetMyOfferPDF)
+	me.Post("/offers/:id/accept", h.middleware.NewTokenMiddleware, h.AcceptOffer)
+	me.Post("/offers/:id/decline", h.middleware.NewTokenMiddleware, h.DeclineOffer)
+	me.Get("/saved_jobs", h.middleware.NewTokenMiddleware, h.GetSavedJobs)
+	me.Post("/saved_searches", h.middleware.NewTokenMiddleware, h.CreateSavedSearch)
+	me.Get("/saved_searches", h.middleware.NewTokenMiddleware, h.GetSavedSearches)
+	me.Delete("/saved_searches/:id", h.middleware.NewTokenMiddleware, h.DeleteSavedSearch)
 }
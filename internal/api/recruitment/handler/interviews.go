@@ -0,0 +1,210 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *RecruitmentHandler) CreateInterview(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Interview creation failed")
+	}
+
+	var req recruitment.CreateInterview
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse interview request body")
+		return err
+	}
+	req.JobApplicationID = ctx.Params("id")
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for interview creation")
+		return err
+	}
+
+	interview, err := h.recruitmentService.Interview().CreateInterview(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Interview creation failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(interview)
+	}
+}
+
+func (h *RecruitmentHandler) GetJobApplicationInterviews(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get interviews")
+	}
+
+	interviews, err := h.recruitmentService.Interview().GetInterviewsByJobApplicationID(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get interviews")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(interviews)
+	}
+}
+
+func (h *RecruitmentHandler) UpdateInterview(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Interview update failed")
+	}
+
+	var req recruitment.UpdateInterview
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse interview update request body")
+		return err
+	}
+	req.ID = ctx.Params("id")
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for interview update")
+		return err
+	}
+
+	interview, err := h.recruitmentService.Interview().UpdateInterview(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Interview update failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(interview)
+	}
+}
+
+func (h *RecruitmentHandler) CancelInterview(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Interview cancellation failed")
+	}
+
+	if err := h.recruitmentService.Interview().CancelInterview(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Interview cancellation failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *RecruitmentHandler) GetMyInterviews(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get interviews")
+	}
+
+	interviews, err := h.recruitmentService.Interview().GetInterviewsByUserID(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get interviews")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(interviews)
+	}
+}
+
+func (h *RecruitmentHandler) SelectInterviewSlot(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Interview slot selection failed")
+	}
+
+	var req recruitment.SelectInterviewSlot
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse interview slot request body")
+		return err
+	}
+	req.InterviewID = ctx.Params("id")
+	req.UserID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for interview slot selection")
+		return err
+	}
+
+	interview, err := h.recruitmentService.Interview().SelectInterviewSlot(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Interview slot selection failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(interview)
+	}
+}
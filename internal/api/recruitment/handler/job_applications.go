@@ -0,0 +1,206 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"encoding/json"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"mime/multipart"
+	"strings"
+	"time"
+)
+
+func (h *RecruitmentHandler) CreateJobApplication(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing job application request")
+
+	jobVacancyID := ctx.Params("id")
+	if jobVacancyID == "" {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing job vacancy ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "Job vacancy ID is required")
+	}
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Job application failed")
+	}
+
+	var req recruitment.CreateJobApplication
+	if strings.HasPrefix(ctx.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		if err := parseMultipartJobApplication(ctx, &req); err != nil {
+			h.log.WithFields(log.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Warn("Failed to parse multipart job application")
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid job application form")
+		}
+	} else if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			h.log.WithFields(log.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Warn("Failed to parse job application request body")
+			return err
+		}
+	}
+	req.JobVacancyID = jobVacancyID
+	req.UserID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id":     requestID,
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+		}).Warn("Validation failed for job application")
+		return err
+	}
+
+	if err := h.recruitmentService.JobApplication().CreateJobApplication(c, req); err != nil {
+		return response.WriteError(ctx, err, "Job application failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusCreated)
+	}
+}
+
+func (h *RecruitmentHandler) GetMyJobApplications(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing get my job applications request")
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get job applications")
+	}
+
+	applications, err := h.recruitmentService.JobApplication().GetJobApplicationsByUserID(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get job applications")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(applications)
+	}
+}
+
+func (h *RecruitmentHandler) GetJobVacancyApplications(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get job applications")
+	}
+
+	applications, err := h.recruitmentService.JobApplication().GetJobApplicationsByJobVacancyID(c, ctx.Params("id"), user.ID, interviewerID(user))
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get job applications")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(applications)
+	}
+}
+
+func (h *RecruitmentHandler) UpdateJobApplicationStatus(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Job application status update failed")
+	}
+
+	var req recruitment.UpdateJobApplicationStatus
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse job application status request body")
+		return err
+	}
+	req.ID = ctx.Params("id")
+	req.RecruiterID = user.ID
+	req.MemberID = user.MemberID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for job application status update")
+		return err
+	}
+
+	if err := h.recruitmentService.JobApplication().UpdateJobApplicationStatus(c, req); err != nil {
+		return response.WriteError(ctx, err, "Job application status update failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
+
+// parseMultipartJobApplication reads applications that carry file answers:
+// "cover_letter" and a JSON encoded "answers" field, plus one
+// "file_<question id>" upload per FILE question.
+func parseMultipartJobApplication(ctx *fiber.Ctx, req *recruitment.CreateJobApplication) error {
+	form, err := ctx.MultipartForm()
+	if err != nil {
+		return err
+	}
+
+	req.CoverLetter = ctx.FormValue("cover_letter")
+	if answers := ctx.FormValue("answers"); answers != "" {
+		if err := json.Unmarshal([]byte(answers), &req.Answers); err != nil {
+			return err
+		}
+	}
+
+	req.Files = map[string]*multipart.FileHeader{}
+	for field, files := range form.File {
+		questionID, ok := strings.CutPrefix(field, "file_")
+		if !ok || len(files) == 0 {
+			continue
+		}
+		req.Files[questionID] = files[0]
+	}
+
+	return nil
+}
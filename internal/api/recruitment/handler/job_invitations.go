@@ -0,0 +1,56 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *RecruitmentHandler) GetMyJobInvitations(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get job invitations")
+	}
+
+	invitations, err := h.recruitmentService.JobInvitation().GetJobInvitationsByUserID(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get job invitations")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(invitations)
+	}
+}
+
+func (h *RecruitmentHandler) DeclineJobInvitation(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to decline job invitation")
+	}
+
+	if err := h.recruitmentService.JobInvitation().DeclineJobInvitation(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Failed to decline job invitation")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
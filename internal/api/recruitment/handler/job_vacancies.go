@@ -2,7 +2,9 @@ package recruitmentHandler
 
 import (
 	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
 	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/utils"
 	"golang.org/x/net/context"
@@ -15,6 +17,11 @@ func (h *RecruitmentHandler) CreateJobVacancy(ctx *fiber.Ctx) error {
 
 	h.log.WithField("path", ctx.Path()).Debug("Processing job vacancy creation request")
 
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Job vacancy creation failed")
+	}
+
 	var req recruitment.CreateJobVacancy
 	if err := ctx.BodyParser(&req); err != nil {
 		h.log.WithFields(log.Fields{
@@ -23,6 +30,8 @@ func (h *RecruitmentHandler) CreateJobVacancy(ctx *fiber.Ctx) error {
 		}).Error("Failed to parse job vacancy creation request body")
 		return err
 	}
+	req.RecruiterID = user.ID
+	req.MemberID = user.MemberID
 
 	if err := h.validator.Struct(&req); err != nil {
 		h.log.WithFields(log.Fields{
@@ -37,7 +46,7 @@ func (h *RecruitmentHandler) CreateJobVacancy(ctx *fiber.Ctx) error {
 			"error": err.Error(),
 			"title": req.Title,
 		}).Error("Job vacancy creation failed")
-		return err
+		return response.WriteError(ctx, err, "Job vacancy creation failed")
 	}
 
 	select {
@@ -107,6 +116,11 @@ func (h *RecruitmentHandler) UpdateJobVacancy(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Job vacancy ID is required")
 	}
 
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Job vacancy update failed")
+	}
+
 	var req recruitment.UpdateJobVacancy
 	if err := ctx.BodyParser(&req); err != nil {
 		h.log.WithFields(log.Fields{
@@ -117,6 +131,8 @@ func (h *RecruitmentHandler) UpdateJobVacancy(ctx *fiber.Ctx) error {
 	}
 
 	req.ID = id
+	req.RecruiterID = user.ID
+	req.MemberID = user.MemberID
 
 	if err := h.validator.Struct(&req); err != nil {
 		h.log.WithFields(log.Fields{
@@ -133,7 +149,7 @@ func (h *RecruitmentHandler) UpdateJobVacancy(ctx *fiber.Ctx) error {
 			"id":    req.ID,
 			"title": req.Title,
 		}).Error("Job vacancy update failed")
-		return err
+		return response.WriteError(ctx, err, "Job vacancy update failed")
 	}
 
 	select {
@@ -160,12 +176,17 @@ func (h *RecruitmentHandler) DeleteJobVacancy(ctx *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Job vacancy ID is required")
 	}
 
-	if err := h.recruitmentService.JobVacancy().DeleteJobVacancy(c, id); err != nil {
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Job vacancy deletion failed")
+	}
+
+	if err := h.recruitmentService.JobVacancy().DeleteJobVacancy(c, id, user.ID); err != nil {
 		h.log.WithFields(log.Fields{
 			"error": err.Error(),
 			"id":    id,
 		}).Error("Job vacancy deletion failed")
-		return err
+		return response.WriteError(ctx, err, "Job vacancy deletion failed")
 	}
 
 	select {
@@ -0,0 +1,260 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *RecruitmentHandler) CreateOffer(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Offer creation failed")
+	}
+
+	var req recruitment.CreateOffer
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse offer request body")
+		return err
+	}
+	req.JobApplicationID = ctx.Params("id")
+	req.CompanyID = user.ID
+	req.MemberID = user.MemberID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for offer creation")
+		return err
+	}
+
+	offer, err := h.recruitmentService.Offer().CreateOffer(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Offer creation failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(offer)
+	}
+}
+
+func (h *RecruitmentHandler) GetJobApplicationOffers(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offers")
+	}
+
+	offers, err := h.recruitmentService.Offer().GetOffersByJobApplicationID(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offers")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(offers)
+	}
+}
+
+func (h *RecruitmentHandler) WithdrawOffer(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Offer withdrawal failed")
+	}
+
+	if err := h.recruitmentService.Offer().WithdrawOffer(c, ctx.Params("id"), user.ID, user.MemberID); err != nil {
+		return response.WriteError(ctx, err, "Offer withdrawal failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *RecruitmentHandler) GetCompanyOfferPDF(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offer letter")
+	}
+
+	document, err := h.recruitmentService.Offer().GetCompanyOfferPDF(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offer letter")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return sendOfferPDF(ctx, ctx.Params("id"), document)
+	}
+}
+
+// GetOfferByToken serves the secure link from the offer email, so the
+// candidate can read the offer without logging in first.
+func (h *RecruitmentHandler) GetOfferByToken(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	offer, err := h.recruitmentService.Offer().GetOfferByAccessToken(c, ctx.Params("token"))
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offer")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(offer)
+	}
+}
+
+func (h *RecruitmentHandler) GetOfferPDFByToken(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	document, err := h.recruitmentService.Offer().GetOfferPDFByAccessToken(c, ctx.Params("token"))
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offer letter")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return sendOfferPDF(ctx, "letter", document)
+	}
+}
+
+func (h *RecruitmentHandler) GetMyOffers(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offers")
+	}
+
+	offers, err := h.recruitmentService.Offer().GetOffersByUserID(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offers")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(offers)
+	}
+}
+
+func (h *RecruitmentHandler) GetMyOfferPDF(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offer letter")
+	}
+
+	document, err := h.recruitmentService.Offer().GetCandidateOfferPDF(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get offer letter")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return sendOfferPDF(ctx, ctx.Params("id"), document)
+	}
+}
+
+func (h *RecruitmentHandler) AcceptOffer(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Offer acceptance failed")
+	}
+
+	if err := h.recruitmentService.Offer().AcceptOffer(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Offer acceptance failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *RecruitmentHandler) DeclineOffer(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Offer decline failed")
+	}
+
+	if err := h.recruitmentService.Offer().DeclineOffer(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Offer decline failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func sendOfferPDF(ctx *fiber.Ctx, name string, document []byte) error {
+	ctx.Set(fiber.HeaderContentType, "application/pdf")
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("inline; filename=\"offer-%s.pdf\"", name))
+	return ctx.Status(fiber.StatusOK).Send(document)
+}
@@ -0,0 +1,61 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *RecruitmentHandler) GetRecommendedJobs(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	h.log.WithFields(log.Fields{
+		"request_id": requestID,
+		"path":       ctx.Path(),
+	}).Debug("Processing recommended jobs request")
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get recommended jobs")
+	}
+
+	req := recruitment.GetRecommendedJobs{Page: 1, PageSize: 20}
+	if err := ctx.QueryParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse recommended jobs query parameters")
+		return err
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"page":       req.Page,
+			"size":       req.PageSize,
+		}).Warn("Validation failed for recommended jobs request")
+		return err
+	}
+
+	result, err := h.recruitmentService.JobVacancy().GetRecommendedJobVacancies(c, user.ID, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get recommended jobs")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(result)
+	}
+}
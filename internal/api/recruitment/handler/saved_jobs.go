@@ -0,0 +1,99 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *RecruitmentHandler) SaveJobVacancy(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	jobVacancyID := ctx.Params("id")
+	if jobVacancyID == "" {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing job vacancy ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "Job vacancy ID is required")
+	}
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to save job vacancy")
+	}
+
+	if err := h.recruitmentService.SavedJob().SaveJobVacancy(c, user.ID, jobVacancyID); err != nil {
+		return response.WriteError(ctx, err, "Failed to save job vacancy")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusCreated)
+	}
+}
+
+func (h *RecruitmentHandler) UnsaveJobVacancy(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	jobVacancyID := ctx.Params("id")
+	if jobVacancyID == "" {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing job vacancy ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "Job vacancy ID is required")
+	}
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to remove saved job")
+	}
+
+	if err := h.recruitmentService.SavedJob().UnsaveJobVacancy(c, user.ID, jobVacancyID); err != nil {
+		return response.WriteError(ctx, err, "Failed to remove saved job")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *RecruitmentHandler) GetSavedJobs(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get saved jobs")
+	}
+
+	savedJobs, err := h.recruitmentService.SavedJob().GetSavedJobsByUserID(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get saved jobs")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(savedJobs)
+	}
+}
@@ -0,0 +1,191 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"html"
+	"net/url"
+	"time"
+)
+
+func (h *RecruitmentHandler) CreateSavedSearch(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to create saved search")
+	}
+
+	var req recruitment.CreateSavedSearch
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse saved search request body")
+		return err
+	}
+	req.UserID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for saved search")
+		return err
+	}
+
+	search, err := h.recruitmentService.SavedSearch().CreateSavedSearch(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to create saved search")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(search)
+	}
+}
+
+func (h *RecruitmentHandler) GetSavedSearches(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get saved searches")
+	}
+
+	searches, err := h.recruitmentService.SavedSearch().GetSavedSearchesByUserID(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get saved searches")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(searches)
+	}
+}
+
+func (h *RecruitmentHandler) DeleteSavedSearch(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	id := ctx.Params("id")
+	if id == "" {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"path":       ctx.Path(),
+		}).Warn("Missing saved search ID in URL")
+		return fiber.NewError(fiber.StatusBadRequest, "Saved search ID is required")
+	}
+
+	user, err := h.requireRole(ctx, entity.RoleCandidate)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to delete saved search")
+	}
+
+	if err := h.recruitmentService.SavedSearch().DeleteSavedSearch(c, id, user.ID); err != nil {
+		return response.WriteError(ctx, err, "Failed to delete saved search")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+// ConfirmUnsubscribeSavedSearch is where the unsubscribe link in digest emails
+// lands. GET must not change anything, since mail scanners prefetch links, so
+// it only shows a page whose button posts back to the same URL.
+func (h *RecruitmentHandler) ConfirmUnsubscribeSavedSearch(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	token := ctx.Query("token")
+	if token == "" {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+		}).Warn("Missing unsubscribe token")
+		return response.WriteError(ctx, recruitment.ErrorInvalidUnsubscribeToken, "Failed to get saved search")
+	}
+
+	search, err := h.recruitmentService.SavedSearch().GetUnsubscribeSavedSearch(c, token)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get saved search")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		if ctx.Accepts(fiber.MIMEApplicationJSON, fiber.MIMETextHTML) == fiber.MIMEApplicationJSON {
+			return ctx.Status(fiber.StatusOK).JSON(search)
+		}
+
+		action := "?token=" + url.QueryEscape(token)
+		ctx.Type("html", "utf-8")
+		return ctx.Status(fiber.StatusOK).SendString(unsubscribePage(fmt.Sprintf(
+			"<p>Stop emails for your job alert <b>%s</b>?</p><form method=\"post\" action=\"%s\"><button type=\"submit\">Unsubscribe</button></form>",
+			html.EscapeString(search.Name), html.EscapeString(action))))
+	}
+}
+
+// UnsubscribeSavedSearch turns the alert off. Mail clients call it through
+// List-Unsubscribe-Post (RFC 8058 one-click), people through the button on
+// the confirmation page.
+func (h *RecruitmentHandler) UnsubscribeSavedSearch(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	token := ctx.Query("token")
+	if token == "" {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+		}).Warn("Missing unsubscribe token")
+		return response.WriteError(ctx, recruitment.ErrorInvalidUnsubscribeToken, "Failed to unsubscribe")
+	}
+
+	if err := h.recruitmentService.SavedSearch().Unsubscribe(c, token); err != nil {
+		return response.WriteError(ctx, err, "Failed to unsubscribe")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		if ctx.Accepts(fiber.MIMEApplicationJSON, fiber.MIMETextHTML) == fiber.MIMETextHTML {
+			ctx.Type("html", "utf-8")
+			return ctx.Status(fiber.StatusOK).SendString(unsubscribePage("<p>You have been unsubscribed from this job alert.</p>"))
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+			"message": "You have been unsubscribed from this job alert",
+		})
+	}
+}
+
+func unsubscribePage(body string) string {
+	return "<!DOCTYPE html><html><head><meta charset=\"utf-8\"><title>Job alert</title></head><body>" + body + "</body></html>"
+}
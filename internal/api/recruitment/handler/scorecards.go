@@ -0,0 +1,168 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *RecruitmentHandler) SaveScorecardTemplate(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Scorecard template save failed")
+	}
+
+	var req recruitment.SaveScorecardTemplate
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse scorecard template request body")
+		return err
+	}
+	req.JobVacancyID = ctx.Params("id")
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for scorecard template")
+		return err
+	}
+
+	template, err := h.recruitmentService.Scorecard().SaveScorecardTemplate(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Scorecard template save failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(template)
+	}
+}
+
+func (h *RecruitmentHandler) GetScorecardTemplate(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get scorecard template")
+	}
+
+	template, err := h.recruitmentService.Scorecard().GetScorecardTemplate(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get scorecard template")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(template)
+	}
+}
+
+func (h *RecruitmentHandler) DeleteScorecardTemplate(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Scorecard template deletion failed")
+	}
+
+	if err := h.recruitmentService.Scorecard().DeleteScorecardTemplate(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Scorecard template deletion failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *RecruitmentHandler) SubmitScorecard(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Scorecard submission failed")
+	}
+
+	var req recruitment.SubmitScorecard
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse scorecard request body")
+		return err
+	}
+	req.JobApplicationID = ctx.Params("id")
+	req.CompanyID = user.ID
+	req.InterviewerID = interviewerID(user)
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for scorecard submission")
+		return err
+	}
+
+	scorecard, err := h.recruitmentService.Scorecard().SubmitScorecard(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Scorecard submission failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(scorecard)
+	}
+}
+
+func (h *RecruitmentHandler) GetApplicationScorecards(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get scorecards")
+	}
+
+	scorecards, err := h.recruitmentService.Scorecard().GetApplicationScorecards(c, ctx.Params("id"), user.ID, interviewerID(user))
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get scorecards")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(scorecards)
+	}
+}
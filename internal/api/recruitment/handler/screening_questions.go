@@ -0,0 +1,120 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *RecruitmentHandler) SaveScreeningQuestions(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to save screening questions")
+	}
+
+	var req recruitment.SaveScreeningQuestions
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse screening questions request body")
+		return err
+	}
+	req.JobVacancyID = ctx.Params("id")
+	req.RecruiterID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for screening questions")
+		return err
+	}
+
+	questions, err := h.recruitmentService.ScreeningQuestion().SaveScreeningQuestions(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to save screening questions")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(questions)
+	}
+}
+
+func (h *RecruitmentHandler) GetScreeningQuestions(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	questions, err := h.recruitmentService.ScreeningQuestion().GetScreeningQuestions(c, ctx.Params("id"))
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get screening questions")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(questions)
+	}
+}
+
+func (h *RecruitmentHandler) GetScreeningQuestionConfig(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get screening questions")
+	}
+
+	questions, err := h.recruitmentService.ScreeningQuestion().GetScreeningQuestionConfig(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get screening questions")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(questions)
+	}
+}
+
+func (h *RecruitmentHandler) GetScreeningAnswers(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get screening answers")
+	}
+
+	answers, err := h.recruitmentService.ScreeningQuestion().GetScreeningAnswers(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get screening answers")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(answers)
+	}
+}
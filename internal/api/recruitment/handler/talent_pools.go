@@ -0,0 +1,326 @@
+package recruitmentHandler
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *RecruitmentHandler) CreateTalentPool(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Talent pool creation failed")
+	}
+
+	var req recruitment.CreateTalentPool
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse talent pool request body")
+		return err
+	}
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for talent pool creation")
+		return err
+	}
+
+	pool, err := h.recruitmentService.TalentPool().CreateTalentPool(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Talent pool creation failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(pool)
+	}
+}
+
+func (h *RecruitmentHandler) GetTalentPools(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get talent pools")
+	}
+
+	pools, err := h.recruitmentService.TalentPool().GetTalentPools(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get talent pools")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(pools)
+	}
+}
+
+func (h *RecruitmentHandler) UpdateTalentPool(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Talent pool update failed")
+	}
+
+	var req recruitment.UpdateTalentPool
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse talent pool request body")
+		return err
+	}
+	req.ID = ctx.Params("id")
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for talent pool update")
+		return err
+	}
+
+	if err := h.recruitmentService.TalentPool().UpdateTalentPool(c, req); err != nil {
+		return response.WriteError(ctx, err, "Talent pool update failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
+
+func (h *RecruitmentHandler) DeleteTalentPool(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Talent pool deletion failed")
+	}
+
+	if err := h.recruitmentService.TalentPool().DeleteTalentPool(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Talent pool deletion failed")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *RecruitmentHandler) AddTalentPoolCandidate(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to add candidate to talent pool")
+	}
+
+	var req recruitment.AddTalentPoolCandidate
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse talent pool candidate request body")
+		return err
+	}
+	req.TalentPoolID = ctx.Params("id")
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for talent pool candidate")
+		return err
+	}
+
+	if err := h.recruitmentService.TalentPool().AddCandidate(c, req); err != nil {
+		return response.WriteError(ctx, err, "Failed to add candidate to talent pool")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusCreated)
+	}
+}
+
+func (h *RecruitmentHandler) GetTalentPoolCandidates(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get talent pool candidates")
+	}
+
+	var req recruitment.GetTalentPoolCandidates
+	if err := ctx.QueryParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse talent pool candidate query parameters")
+		return err
+	}
+
+	candidates, err := h.recruitmentService.TalentPool().GetCandidates(c, ctx.Params("id"), user.ID, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get talent pool candidates")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(candidates)
+	}
+}
+
+func (h *RecruitmentHandler) UpdateTalentPoolCandidate(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update talent pool candidate")
+	}
+
+	var req recruitment.UpdateTalentPoolCandidate
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse talent pool candidate request body")
+		return err
+	}
+	req.TalentPoolID = ctx.Params("id")
+	req.UserID = ctx.Params("userId")
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for talent pool candidate update")
+		return err
+	}
+
+	if err := h.recruitmentService.TalentPool().UpdateCandidate(c, req); err != nil {
+		return response.WriteError(ctx, err, "Failed to update talent pool candidate")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
+
+func (h *RecruitmentHandler) RemoveTalentPoolCandidate(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to remove talent pool candidate")
+	}
+
+	err = h.recruitmentService.TalentPool().RemoveCandidate(c, ctx.Params("id"), ctx.Params("userId"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to remove talent pool candidate")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *RecruitmentHandler) InviteTalentPoolCandidate(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRole(ctx, entity.RoleRecruiter)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to invite candidate")
+	}
+
+	var req recruitment.InviteCandidate
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse invitation request body")
+		return err
+	}
+	req.TalentPoolID = ctx.Params("id")
+	req.UserID = ctx.Params("userId")
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for invitation")
+		return err
+	}
+
+	if err := h.recruitmentService.TalentPool().InviteCandidate(c, req); err != nil {
+		return response.WriteError(ctx, err, "Failed to invite candidate")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusCreated)
+	}
+}
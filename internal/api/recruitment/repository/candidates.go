@@ -0,0 +1,233 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/api/skill"
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"github.com/lib/pq"
+	"strings"
+)
+
+func (r *candidatesRepository) SearchCandidates(c context.Context, filter recruitment.SearchCandidates) ([]entity.User, int, error) {
+	offset := (filter.Page - 1) * filter.PageSize
+
+	r.log.WithFields(map[string]interface{}{
+		"skills":      filter.Skills,
+		"job_title":   filter.JobTitle,
+		"institution": filter.Institution,
+		"degree":      filter.Degree,
+		"location":    filter.Location,
+		"headline":    filter.Headline,
+		"languages":   filter.Languages,
+		"page":        filter.Page,
+		"pageSize":    filter.PageSize,
+	}).Debug("Searching candidates in database")
+
+	conditions, args := buildCandidateConditions(filter)
+
+	var totalCount int
+	countQuery := r.q.Rebind(queryCountCandidates + conditions)
+	if err := r.q.QueryRowxContext(c, countQuery, args...).Scan(&totalCount); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to count candidates")
+		return nil, 0, err
+	}
+
+	query := r.q.Rebind(querySearchCandidates + conditions + queryCandidateSearchOrder)
+	rows, err := r.q.QueryContext(c, query, append(args, filter.PageSize, offset)...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when searching candidates")
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var candidates []entity.User
+	for rows.Next() {
+		var id, name, headline, location, profilePicture sql.NullString
+		if err := rows.Scan(&id, &name, &headline, &location, &profilePicture); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning candidate row")
+			return nil, 0, err
+		}
+
+		candidates = append(candidates, entity.User{
+			ID:             id.String,
+			Name:           name.String,
+			Headline:       headline.String,
+			Location:       location.String,
+			ProfilePicture: profilePicture.String,
+			Role:           entity.RoleCandidate,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through candidate rows")
+		return nil, 0, err
+	}
+
+	return candidates, totalCount, nil
+}
+
+func (r *candidatesRepository) GetLatestExperiences(c context.Context, userIDs []string) (map[string]entity.Experience, error) {
+	latest := make(map[string]entity.Experience, len(userIDs))
+	if len(userIDs) == 0 {
+		return latest, nil
+	}
+
+	query := r.q.Rebind(queryGetLatestExperiences)
+	rows, err := r.q.QueryContext(c, query, pq.Array(userIDs))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting latest experiences")
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id, userID, jobTitle, jobLocation, skillUsed sql.NullString
+			startDate, endDate                           sql.NullTime
+			isCurrent                                    sql.NullBool
+		)
+		if err := rows.Scan(&id, &userID, &jobTitle, &jobLocation, &skillUsed, &startDate, &endDate, &isCurrent); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning latest experience row")
+			return nil, err
+		}
+
+		experience := entity.Experience{
+			ID:          id.String,
+			UserID:      userID.String,
+			JobTitle:    jobTitle.String,
+			JobLocation: jobLocation.String,
+			SkillUsed:   skillUsed.String,
+			StartDate:   startDate.Time,
+			IsCurrent:   isCurrent.Bool,
+		}
+		if endDate.Valid {
+			experience.EndDate = &endDate.Time
+		}
+		latest[userID.String] = experience
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through latest experience rows")
+		return nil, err
+	}
+
+	return latest, nil
+}
+
+func (r *candidatesRepository) GetLatestEducations(c context.Context, userIDs []string) (map[string]entity.Education, error) {
+	latest := make(map[string]entity.Education, len(userIDs))
+	if len(userIDs) == 0 {
+		return latest, nil
+	}
+
+	query := r.q.Rebind(queryGetLatestEducations)
+	rows, err := r.q.QueryContext(c, query, pq.Array(userIDs))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting latest educations")
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id, userID, titleDegree, institutionalName sql.NullString
+			startDate, endDate                         sql.NullTime
+			isCurrent                                  sql.NullBool
+		)
+		if err := rows.Scan(&id, &userID, &titleDegree, &institutionalName, &startDate, &endDate, &isCurrent); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning latest education row")
+			return nil, err
+		}
+
+		education := entity.Education{
+			ID:                id.String,
+			UserID:            userID.String,
+			TitleDegree:       titleDegree.String,
+			InstitutionalName: institutionalName.String,
+			StartDate:         startDate.Time,
+			IsCurrent:         isCurrent.Bool,
+		}
+		if endDate.Valid {
+			education.EndDate = &endDate.Time
+		}
+		latest[userID.String] = education
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through latest education rows")
+		return nil, err
+	}
+
+	return latest, nil
+}
+
+// buildCandidateConditions turns the search filter into extra AND clauses.
+// Every listed skill must be matched, aliases included; institution and
+// degree must match on the same education entry. Languages must be spoken at
+// their minimum level or above.
+func buildCandidateConditions(filter recruitment.SearchCandidates) (string, []interface{}) {
+	var conditions strings.Builder
+	var args []interface{}
+
+	for _, name := range skill.SplitNames(filter.Skills) {
+		normalized := skill.Normalize(name)
+		conditions.WriteString(" AND" + queryCandidateHasSkill)
+		args = append(args, normalized, normalized)
+	}
+
+	for _, requirement := range filter.LanguageRequirements {
+		conditions.WriteString(" AND" + queryCandidateHasLanguage)
+		args = append(args, requirement.Language, pq.Array(bio.LevelsAtLeast(requirement.MinLevel)))
+	}
+
+	if filter.JobTitle != "" {
+		conditions.WriteString(" AND" + queryCandidateHasJobTitle)
+		args = append(args, likePattern(filter.JobTitle))
+	}
+
+	if filter.Institution != "" || filter.Degree != "" {
+		conditions.WriteString(" AND" + queryCandidateHasEducation)
+		args = append(args, likePattern(filter.Institution), likePattern(filter.Degree))
+	}
+
+	if filter.Location != "" {
+		conditions.WriteString(" AND u.location ILIKE ?")
+		args = append(args, likePattern(filter.Location))
+	}
+
+	if filter.Headline != "" {
+		conditions.WriteString(" AND u.headline ILIKE ?")
+		args = append(args, likePattern(filter.Headline))
+	}
+
+	return conditions.String(), args
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func likePattern(term string) string {
+	return "%" + likeEscaper.Replace(strings.TrimSpace(term)) + "%"
+}
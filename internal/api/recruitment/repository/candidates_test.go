@@ -0,0 +1,117 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"github.com/lib/pq"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuildCandidateConditions(t *testing.T) {
+	tests := []struct {
+		name           string
+		filter         recruitment.SearchCandidates
+		wantConditions string
+		wantArgs       []interface{}
+	}{
+		{
+			name: "no filters",
+		},
+		{
+			name:           "every skill must match, normalized",
+			filter:         recruitment.SearchCandidates{Skills: " Machine  Learning, go ,GO"},
+			wantConditions: " AND" + queryCandidateHasSkill + " AND" + queryCandidateHasSkill,
+			wantArgs:       []interface{}{"machine learning", "machine learning", "go", "go"},
+		},
+		{
+			name: "languages at their minimum level or above",
+			filter: recruitment.SearchCandidates{LanguageRequirements: []entity.LanguageRequirement{
+				{Language: "en", MinLevel: entity.LanguageLevelC1},
+			}},
+			wantConditions: " AND" + queryCandidateHasLanguage,
+			wantArgs: []interface{}{"en", pq.Array([]string{
+				string(entity.LanguageLevelC1), string(entity.LanguageLevelC2), string(entity.LanguageLevelNative),
+			})},
+		},
+		{
+			name:           "institution alone still matches one education",
+			filter:         recruitment.SearchCandidates{Institution: "Universitas Indonesia"},
+			wantConditions: " AND" + queryCandidateHasEducation,
+			wantArgs:       []interface{}{"%Universitas Indonesia%", "%%"},
+		},
+		{
+			name: "text filters in order",
+			filter: recruitment.SearchCandidates{
+				JobTitle: "Backend",
+				Degree:   "Computer Science",
+				Location: "Jakarta",
+				Headline: "Engineer",
+			},
+			wantConditions: " AND" + queryCandidateHasJobTitle + " AND" + queryCandidateHasEducation +
+				" AND u.location ILIKE ? AND u.headline ILIKE ?",
+			wantArgs: []interface{}{"%Backend%", "%%", "%Computer Science%", "%Jakarta%", "%Engineer%"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, args := buildCandidateConditions(tt.filter)
+
+			if conditions != tt.wantConditions {
+				t.Errorf("conditions = %q, want %q", conditions, tt.wantConditions)
+			}
+			if strings.Count(conditions, "?") != len(args) {
+				t.Errorf("%d placeholders for %d args", strings.Count(conditions, "?"), len(args))
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestCandidateSearchHonoursPrivacy(t *testing.T) {
+	for _, clause := range []string{
+		"u.role = 'candidate'",
+		"u.deleted_at IS NULL",
+		"u.is_searchable = TRUE",
+		"u.profile_visibility <> 'private'",
+	} {
+		if !strings.Contains(queryCandidateSearchBase, clause) {
+			t.Errorf("candidate search does not filter on %s", clause)
+		}
+	}
+
+	for name, query := range map[string]string{
+		"job title": queryCandidateHasJobTitle,
+		"education": queryCandidateHasEducation,
+		"skill":     queryCandidateHasSkill,
+	} {
+		if !strings.Contains(query, "is_hidden = FALSE") {
+			t.Errorf("%s filter matches hidden bio entries", name)
+		}
+	}
+}
+
+func TestLikePattern(t *testing.T) {
+	tests := []struct {
+		term string
+		want string
+	}{
+		{term: "Jakarta", want: "%Jakarta%"},
+		{term: "  Go  ", want: "%Go%"},
+		{term: "100%", want: `%100\%%`},
+		{term: "snake_case", want: `%snake\_case%`},
+		{term: `C:\dev`, want: `%C:\\dev%`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.term, func(t *testing.T) {
+			if got := likePattern(tt.term); got != tt.want {
+				t.Errorf("likePattern(%q) = %q, want %q", tt.term, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"context"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+func (r *interviewsRepository) CreateInterview(c context.Context, interview entity.Interview) error {
+	r.log.WithFields(map[string]interface{}{
+		"interview_id":       interview.ID,
+		"job_application_id": interview.JobApplicationID,
+	}).Debug("Creating interview in database")
+
+	query, args, err := sqlx.Named(queryCreateInterview, interview)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to build SQL query for CreateInterview")
+		return err
+	}
+
+	query = r.q.Rebind(query)
+
+	_, err = r.q.ExecContext(c, query, args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating interview")
+		return err
+	}
+
+	return nil
+}
+
+func (r *interviewsRepository) UpdateInterview(c context.Context, interview entity.Interview) error {
+	r.log.WithFields(map[string]interface{}{
+		"interview_id": interview.ID,
+		"status":       interview.Status,
+		"sequence":     interview.Sequence,
+	}).Debug("Updating interview in database")
+
+	query, args, err := sqlx.Named(queryUpdateInterview, interview)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to build SQL query for UpdateInterview")
+		return err
+	}
+
+	query = r.q.Rebind(query)
+
+	_, err = r.q.ExecContext(c, query, args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    interview.ID,
+		}).Error("Database error when updating interview")
+		return err
+	}
+
+	return nil
+}
+
+func (r *interviewsRepository) GetInterviewByID(c context.Context, id string) (entity.Interview, error) {
+	r.log.WithFields(map[string]interface{}{
+		"interview_id": id,
+	}).Debug("Getting interview by ID")
+
+	interviews, err := r.queryInterviews(c, queryGetInterviewByID, id)
+	if err != nil {
+		return entity.Interview{}, err
+	}
+
+	if len(interviews) == 0 {
+		r.log.WithFields(map[string]interface{}{
+			"id": id,
+		}).Warn("Interview not found")
+		return entity.Interview{}, nil
+	}
+
+	return interviews[0], nil
+}
+
+func (r *interviewsRepository) GetInterviewsByJobApplicationID(c context.Context, jobApplicationID string) ([]entity.Interview, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_application_id": jobApplicationID,
+	}).Debug("Getting interviews by job application ID")
+
+	return r.queryInterviews(c, queryGetInterviewsByJobApplicationID, jobApplicationID)
+}
+
+func (r *interviewsRepository) GetInterviewsByUserID(c context.Context, userID string) ([]entity.Interview, error) {
+	r.log.WithFields(map[string]interface{}{
+		"user_id": userID,
+	}).Debug("Getting interviews by user ID")
+
+	return r.queryInterviews(c, queryGetInterviewsByUserID, userID)
+}
+
+func (r *interviewsRepository) ReplaceInterviewSlots(c context.Context, interviewID string, slots []entity.InterviewSlot) error {
+	r.log.WithFields(map[string]interface{}{
+		"interview_id": interviewID,
+		"count":        len(slots),
+	}).Debug("Replacing interview slots")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryDeleteInterviewSlots), interviewID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    interviewID,
+		}).Error("Database error when deleting interview slots")
+		return err
+	}
+
+	for _, slot := range slots {
+		query, args, err := sqlx.Named(queryCreateInterviewSlot, slot)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Failed to build SQL query for CreateInterviewSlot")
+			return err
+		}
+
+		if _, err := r.q.ExecContext(c, r.q.Rebind(query), args...); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+				"id":    interviewID,
+			}).Error("Database error when creating interview slot")
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *interviewsRepository) ReplaceInterviewers(c context.Context, interviewID string, interviewers []entity.Interviewer) error {
+	r.log.WithFields(map[string]interface{}{
+		"interview_id": interviewID,
+		"count":        len(interviewers),
+	}).Debug("Replacing interviewers")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryDeleteInterviewers), interviewID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    interviewID,
+		}).Error("Database error when deleting interviewers")
+		return err
+	}
+
+	for _, interviewer := range interviewers {
+		query, args, err := sqlx.Named(queryCreateInterviewer, interviewer)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Failed to build SQL query for CreateInterviewer")
+			return err
+		}
+
+		if _, err := r.q.ExecContext(c, r.q.Rebind(query), args...); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+				"id":    interviewID,
+			}).Error("Database error when creating interviewer")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryInterviews runs an interview listing query and attaches slots and
+// interviewers with one extra query each.
+func (r *interviewsRepository) queryInterviews(c context.Context, baseQuery string, args ...interface{}) ([]entity.Interview, error) {
+	rows, err := r.q.QueryxContext(c, r.q.Rebind(baseQuery), args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting interviews")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var interviews []entity.Interview
+	for rows.Next() {
+		var i recruitment.InterviewDB
+		if err := rows.StructScan(&i); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning interview row")
+			return nil, err
+		}
+		interviews = append(interviews, makeInterview(i))
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through interview rows")
+		return nil, err
+	}
+
+	if len(interviews) == 0 {
+		return interviews, nil
+	}
+
+	ids := make([]string, len(interviews))
+	index := make(map[string]int, len(interviews))
+	for i, interview := range interviews {
+		ids[i] = interview.ID
+		index[interview.ID] = i
+	}
+
+	slots, err := r.getSlots(c, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, slot := range slots {
+		i := index[slot.InterviewID]
+		interviews[i].Slots = append(interviews[i].Slots, slot)
+	}
+
+	interviewers, err := r.getInterviewers(c, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, interviewer := range interviewers {
+		i := index[interviewer.InterviewID]
+		interviews[i].Interviewers = append(interviews[i].Interviewers, interviewer)
+	}
+
+	return interviews, nil
+}
+
+func (r *interviewsRepository) getSlots(c context.Context, interviewIDs []string) ([]entity.InterviewSlot, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetInterviewSlots), pq.Array(interviewIDs))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting interview slots")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var slots []entity.InterviewSlot
+	for rows.Next() {
+		var slot entity.InterviewSlot
+		if err := rows.Scan(&slot.ID, &slot.InterviewID, &slot.StartTime, &slot.EndTime); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning interview slot row")
+			return nil, err
+		}
+		slot.StartTime = slot.StartTime.UTC()
+		slot.EndTime = slot.EndTime.UTC()
+		slots = append(slots, slot)
+	}
+
+	return slots, rows.Err()
+}
+
+func (r *interviewsRepository) getInterviewers(c context.Context, interviewIDs []string) ([]entity.Interviewer, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetInterviewers), pq.Array(interviewIDs))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting interviewers")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var interviewers []entity.Interviewer
+	for rows.Next() {
+		var interviewer entity.Interviewer
+		if err := rows.Scan(&interviewer.InterviewID, &interviewer.Name, &interviewer.Email); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning interviewer row")
+			return nil, err
+		}
+		interviewers = append(interviewers, interviewer)
+	}
+
+	return interviewers, rows.Err()
+}
+
+// GetInterviewerMemberIDs returns the company members sitting on the
+// application's interviews that were not cancelled. External interviewers
+// have no member account and are left out.
+func (r *interviewsRepository) GetInterviewerMemberIDs(c context.Context, jobApplicationID string) ([]string, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetInterviewerMemberIDs), jobApplicationID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":              err.Error(),
+			"job_application_id": jobApplicationID,
+		}).Error("Database error when getting interviewer members")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var memberIDs []string
+	for rows.Next() {
+		var memberID string
+		if err := rows.Scan(&memberID); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning interviewer member row")
+			return nil, err
+		}
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	return memberIDs, rows.Err()
+}
+
+func makeInterview(i recruitment.InterviewDB) entity.Interview {
+	interview := entity.Interview{
+		ID:               i.ID.String,
+		JobApplicationID: i.JobApplicationID.String,
+		CompanyID:        i.CompanyID.String,
+		Title:            i.Title.String,
+		Format:           entity.InterviewFormat(i.Format.String),
+		Location:         i.Location.String,
+		MeetingLink:      i.MeetingLink.String,
+		Timezone:         i.Timezone.String,
+		DurationMinutes:  int(i.DurationMinutes.Int64),
+		Status:           entity.InterviewStatus(i.Status.String),
+		Sequence:         int(i.Sequence.Int64),
+		CreatedAt:        i.CreatedAt.Time,
+		UpdatedAt:        i.UpdatedAt.Time,
+	}
+
+	if i.ScheduledStart.Valid {
+		start := i.ScheduledStart.Time.UTC()
+		interview.ScheduledStart = &start
+	}
+	if i.ScheduledEnd.Valid {
+		end := i.ScheduledEnd.Time.UTC()
+		interview.ScheduledEnd = &end
+	}
+
+	return interview
+}
@@ -0,0 +1,350 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+func (r *jobApplicationsRepository) CreateJobApplication(c context.Context, application entity.JobApplication) error {
+	r.log.WithFields(map[string]interface{}{
+		"job_application_id": application.ID,
+		"job_vacancy_id":     application.JobVacancyID,
+		"user_id":            application.UserID,
+	}).Debug("Creating job application in database")
+
+	query, args, err := sqlx.Named(queryCreateJobApplication, application)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to build SQL query for CreateJobApplication")
+		return err
+	}
+
+	query = r.q.Rebind(query)
+
+	_, err = r.q.ExecContext(c, query, args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating job application")
+		return err
+	}
+
+	return nil
+}
+
+func (r *jobApplicationsRepository) CheckJobApplicationExists(c context.Context, jobVacancyID string, userID string) (bool, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": jobVacancyID,
+		"user_id":        userID,
+	}).Debug("Checking if job application exists")
+
+	var exists bool
+	query := r.q.Rebind(queryCheckJobApplicationExists)
+	err := r.q.QueryRowxContext(c, query, jobVacancyID, userID).Scan(&exists)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+			"user_id":        userID,
+		}).Error("Database error when checking job application existence")
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *jobApplicationsRepository) GetJobApplicationsByUserID(c context.Context, userID string) ([]entity.JobApplication, error) {
+	r.log.WithFields(map[string]interface{}{
+		"user_id": userID,
+	}).Debug("Getting job applications by user ID")
+
+	query := r.q.Rebind(queryGetJobApplicationsByUserID)
+
+	rows, err := r.q.QueryContext(c, query, userID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Database error when getting job applications by user ID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applications []entity.JobApplication
+	for rows.Next() {
+		var ja recruitment.JobApplicationDB
+		err := rows.Scan(
+			&ja.ID,
+			&ja.JobVacancyID,
+			&ja.UserID,
+			&ja.Status,
+			&ja.CoverLetter,
+			&ja.ScreeningFlagged,
+			&ja.CreatedAt,
+			&ja.UpdatedAt,
+		)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning job application row")
+			return nil, err
+		}
+		applications = append(applications, makeJobApplication(ja))
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through job application rows")
+		return nil, err
+	}
+
+	return applications, nil
+}
+
+func (r *jobApplicationsRepository) GetAppliedJobVacancies(c context.Context, userID string) ([]entity.JobVacancy, error) {
+	r.log.WithFields(map[string]interface{}{
+		"user_id": userID,
+	}).Debug("Getting job vacancies a user applied to")
+
+	query := r.q.Rebind(queryGetAppliedJobVacancies)
+
+	rows, err := r.q.QueryContext(c, query, userID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Database error when getting applied job vacancies")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobVacancies []entity.JobVacancy
+	for rows.Next() {
+		var jv recruitment.JobVacancyDB
+		err := rows.Scan(
+			&jv.ID,
+			&jv.RecruiterID,
+			&jv.Title,
+			&jv.Description,
+			&jv.Requirements,
+			&jv.Location,
+			&jv.JobType,
+			&jv.Deadline,
+			&jv.IsActive,
+			&jv.Headcount,
+			&jv.CreatedAt,
+			&jv.UpdatedAt,
+			&jv.CompanyVerified,
+		)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning applied job vacancy row")
+			return nil, err
+		}
+		jobVacancies = append(jobVacancies, makeJobVacancy(jv))
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through applied job vacancy rows")
+		return nil, err
+	}
+
+	return jobVacancies, nil
+}
+
+func makeJobApplication(ja recruitment.JobApplicationDB) entity.JobApplication {
+	return entity.JobApplication{
+		ID:               ja.ID.String,
+		JobVacancyID:     ja.JobVacancyID.String,
+		UserID:           ja.UserID.String,
+		Status:           entity.ApplicationStatus(ja.Status.String),
+		CoverLetter:      ja.CoverLetter.String,
+		ScreeningFlagged: ja.ScreeningFlagged.Bool,
+		CreatedAt:        ja.CreatedAt.Time,
+		UpdatedAt:        ja.UpdatedAt.Time,
+		StatusUpdatedBy:  ja.StatusUpdatedBy.String,
+	}
+}
+
+func (r *jobApplicationsRepository) GetJobApplicationByID(c context.Context, id string) (entity.JobApplication, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_application_id": id,
+	}).Debug("Getting job application by ID")
+
+	return r.getJobApplication(c, queryGetJobApplicationByID, id)
+}
+
+// GetJobApplicationByIDForUpdate locks the application row until the
+// transaction ends, so status changes and the scorecards they depend on are
+// decided one at a time.
+func (r *jobApplicationsRepository) GetJobApplicationByIDForUpdate(c context.Context, id string) (entity.JobApplication, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_application_id": id,
+	}).Debug("Locking job application by ID")
+
+	return r.getJobApplication(c, queryGetJobApplicationByIDForUpdate, id)
+}
+
+func (r *jobApplicationsRepository) getJobApplication(c context.Context, query string, id string) (entity.JobApplication, error) {
+	var ja recruitment.JobApplicationDB
+	err := r.q.QueryRowxContext(c, r.q.Rebind(query), id).Scan(
+		&ja.ID,
+		&ja.JobVacancyID,
+		&ja.UserID,
+		&ja.Status,
+		&ja.CoverLetter,
+		&ja.ScreeningFlagged,
+		&ja.CreatedAt,
+		&ja.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.log.WithFields(map[string]interface{}{
+				"id": id,
+			}).Warn("Job application not found")
+			return entity.JobApplication{}, nil
+		}
+
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when getting job application by ID")
+		return entity.JobApplication{}, err
+	}
+
+	return makeJobApplication(ja), nil
+}
+
+func (r *jobApplicationsRepository) GetJobApplicationsByJobVacancyID(c context.Context, jobVacancyID string) ([]entity.JobApplication, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": jobVacancyID,
+	}).Debug("Getting job applications by job vacancy ID")
+
+	query := r.q.Rebind(queryGetJobApplicationsByJobVacancyID)
+
+	rows, err := r.q.QueryContext(c, query, jobVacancyID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Database error when getting job applications by job vacancy ID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applications []entity.JobApplication
+	for rows.Next() {
+		var ja recruitment.JobApplicationDB
+		err := rows.Scan(
+			&ja.ID,
+			&ja.JobVacancyID,
+			&ja.UserID,
+			&ja.Status,
+			&ja.CoverLetter,
+			&ja.ScreeningFlagged,
+			&ja.CreatedAt,
+			&ja.UpdatedAt,
+		)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning job application row")
+			return nil, err
+		}
+		applications = append(applications, makeJobApplication(ja))
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through job application rows")
+		return nil, err
+	}
+
+	return applications, nil
+}
+
+func (r *jobApplicationsRepository) UpdateJobApplicationStatus(c context.Context, id string, status entity.ApplicationStatus, updatedBy string, updatedAt time.Time) error {
+	r.log.WithFields(map[string]interface{}{
+		"job_application_id": id,
+		"status":             status,
+	}).Debug("Updating job application status")
+
+	query := r.q.Rebind(queryUpdateJobApplicationStatus)
+
+	_, err := r.q.ExecContext(c, query, status, updatedBy, updatedAt, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when updating job application status")
+		return err
+	}
+
+	return nil
+}
+
+// ReopenOfferedJobApplications moves the given applications that are still at
+// the offer stage back to interview and returns how many moved.
+func (r *jobApplicationsRepository) ReopenOfferedJobApplications(c context.Context, ids []string, updatedAt time.Time) (int64, error) {
+	r.log.WithFields(map[string]interface{}{
+		"count": len(ids),
+	}).Debug("Reopening offered job applications")
+
+	result, err := r.q.ExecContext(c, r.q.Rebind(queryReopenOfferedJobApplications), updatedAt, pq.Array(ids))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when reopening offered job applications")
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to get rows affected after reopening offered job applications")
+		return 0, err
+	}
+
+	return rowsAffected, nil
+}
+
+func (r *jobApplicationsRepository) CountHiredJobApplications(c context.Context, jobVacancyID string) (int, error) {
+	var count int
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryCountHiredJobApplications), jobVacancyID).Scan(&count)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Database error when counting hired job applications")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *jobApplicationsRepository) CountJobApplicationsByJobVacancyID(c context.Context, jobVacancyID string) (int, error) {
+	var count int
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryCountJobApplicationsByJobVacancyID), jobVacancyID).Scan(&count)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Database error when counting job applications")
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,203 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+func (r *jobInvitationsRepository) CreateJobInvitation(c context.Context, invitation entity.JobInvitation) error {
+	r.log.WithFields(map[string]interface{}{
+		"job_invitation_id": invitation.ID,
+		"job_vacancy_id":    invitation.JobVacancyID,
+		"user_id":           invitation.UserID,
+	}).Debug("Creating job invitation in database")
+
+	query := r.q.Rebind(queryCreateJobInvitation)
+
+	_, err := r.q.ExecContext(c, query,
+		invitation.ID,
+		invitation.JobVacancyID,
+		invitation.UserID,
+		invitation.CompanyID,
+		nullString(invitation.TalentPoolID),
+		invitation.Message,
+		invitation.Status,
+		invitation.CreatedAt,
+		invitation.UpdatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating job invitation")
+		return err
+	}
+
+	return nil
+}
+
+func (r *jobInvitationsRepository) CheckJobInvitationExists(c context.Context, jobVacancyID string, userID string) (bool, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": jobVacancyID,
+		"user_id":        userID,
+	}).Debug("Checking if job invitation exists")
+
+	var exists bool
+	query := r.q.Rebind(queryCheckJobInvitationExists)
+	err := r.q.QueryRowxContext(c, query, jobVacancyID, userID).Scan(&exists)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when checking job invitation existence")
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *jobInvitationsRepository) GetJobInvitationByID(c context.Context, id string) (entity.JobInvitation, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_invitation_id": id,
+	}).Debug("Getting job invitation by ID")
+
+	query := r.q.Rebind(queryGetJobInvitationByID)
+
+	rows, err := r.q.QueryContext(c, query, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when getting job invitation by ID")
+		return entity.JobInvitation{}, err
+	}
+	defer rows.Close()
+
+	invitations, err := scanJobInvitations(rows, r.log)
+	if err != nil {
+		return entity.JobInvitation{}, err
+	}
+
+	if len(invitations) == 0 {
+		return entity.JobInvitation{}, nil
+	}
+
+	return invitations[0], nil
+}
+
+func (r *jobInvitationsRepository) GetJobInvitationsByUserID(c context.Context, userID string) ([]entity.JobInvitation, error) {
+	r.log.WithFields(map[string]interface{}{
+		"user_id": userID,
+	}).Debug("Getting job invitations by user ID")
+
+	query := r.q.Rebind(queryGetJobInvitationsByUserID)
+
+	rows, err := r.q.QueryContext(c, query, userID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Database error when getting job invitations by user ID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanJobInvitations(rows, r.log)
+}
+
+func (r *jobInvitationsRepository) UpdateJobInvitationStatus(c context.Context, id string, status entity.InvitationStatus, updatedAt time.Time) error {
+	r.log.WithFields(map[string]interface{}{
+		"job_invitation_id": id,
+		"status":            status,
+	}).Debug("Updating job invitation status")
+
+	query := r.q.Rebind(queryUpdateJobInvitationStatus)
+
+	_, err := r.q.ExecContext(c, query, status, updatedAt, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when updating job invitation status")
+		return err
+	}
+
+	return nil
+}
+
+func (r *jobInvitationsRepository) AcceptJobInvitation(c context.Context, jobVacancyID string, userID string, updatedAt time.Time) error {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": jobVacancyID,
+		"user_id":        userID,
+	}).Debug("Accepting pending job invitation")
+
+	query := r.q.Rebind(queryAcceptJobInvitation)
+
+	_, err := r.q.ExecContext(c, query, updatedAt, jobVacancyID, userID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when accepting job invitation")
+		return err
+	}
+
+	return nil
+}
+
+func scanJobInvitations(rows *sql.Rows, log *logrus.Logger) ([]entity.JobInvitation, error) {
+	var invitations []entity.JobInvitation
+	for rows.Next() {
+		var (
+			id, jobVacancyID, userID, companyID, talentPoolID, message, status sql.NullString
+			createdAt, updatedAt                                               sql.NullTime
+			jv                                                                 recruitment.JobVacancyDB
+		)
+		err := rows.Scan(
+			&id, &jobVacancyID, &userID, &companyID, &talentPoolID, &message, &status,
+			&createdAt, &updatedAt,
+			&jv.ID,
+			&jv.RecruiterID,
+			&jv.Title,
+			&jv.Description,
+			&jv.Requirements,
+			&jv.Location,
+			&jv.JobType,
+			&jv.Deadline,
+			&jv.IsActive,
+			&jv.Headcount,
+			&jv.CreatedAt,
+			&jv.UpdatedAt,
+			&jv.CompanyVerified,
+		)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning job invitation row")
+			return nil, err
+		}
+
+		invitations = append(invitations, entity.JobInvitation{
+			ID:           id.String,
+			JobVacancyID: jobVacancyID.String,
+			UserID:       userID.String,
+			CompanyID:    companyID.String,
+			TalentPoolID: talentPoolID.String,
+			Message:      message.String,
+			Status:       entity.InvitationStatus(status.String),
+			CreatedAt:    createdAt.Time,
+			UpdatedAt:    updatedAt.Time,
+			JobVacancy:   makeJobVacancy(jv),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through job invitation rows")
+		return nil, err
+	}
+
+	return invitations, nil
+}
@@ -1,10 +1,15 @@
 package recruitmentRepository
 
 import (
+	"ProjectGolang/internal/api/recruitment"
 	"ProjectGolang/internal/entity"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
 	"time"
 )
 
@@ -77,35 +82,8 @@ func (r *jobVacanciesRepository) GetJobVacancies(c context.Context, page, pageSi
 	}
 	defer rows.Close()
 
-	var jobVacancies []entity.JobVacancy
-	for rows.Next() {
-		var jv entity.JobVacancy
-		err := rows.Scan(
-			&jv.ID,
-			&jv.RecruiterID,
-			&jv.Title,
-			&jv.Description,
-			&jv.Requirements,
-			&jv.Location,
-			&jv.JobType,
-			&jv.Deadline,
-			&jv.IsActive,
-			&jv.CreatedAt,
-			&jv.UpdatedAt,
-		)
-		if err != nil {
-			r.log.WithFields(map[string]interface{}{
-				"error": err.Error(),
-			}).Error("Error scanning job vacancy row")
-			return nil, 0, err
-		}
-		jobVacancies = append(jobVacancies, jv)
-	}
-
-	if err = rows.Err(); err != nil {
-		r.log.WithFields(map[string]interface{}{
-			"error": err.Error(),
-		}).Error("Error iterating through job vacancy rows")
+	jobVacancies, err := scanJobVacancies(rows, r.log)
+	if err != nil {
 		return nil, 0, err
 	}
 
@@ -221,3 +199,331 @@ func (r *jobVacanciesRepository) DeleteJobVacancy(c context.Context, id string)
 
 	return nil
 }
+
+func (r *jobVacanciesRepository) GetJobVacancyByID(c context.Context, id string) (entity.JobVacancy, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": id,
+	}).Debug("Getting job vacancy by ID")
+
+	return r.getJobVacancy(c, queryGetJobVacancyByID, id)
+}
+
+// GetJobVacancyByIDForUpdate locks the vacancy row until the transaction
+// ends, so concurrent hires are counted one after another.
+func (r *jobVacanciesRepository) GetJobVacancyByIDForUpdate(c context.Context, id string) (entity.JobVacancy, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": id,
+	}).Debug("Locking job vacancy by ID")
+
+	return r.getJobVacancy(c, queryGetJobVacancyByIDForUpdate, id)
+}
+
+func (r *jobVacanciesRepository) getJobVacancy(c context.Context, query string, id string) (entity.JobVacancy, error) {
+	var jv recruitment.JobVacancyDB
+	err := r.q.QueryRowxContext(c, r.q.Rebind(query), id).Scan(
+		&jv.ID,
+		&jv.RecruiterID,
+		&jv.Title,
+		&jv.Description,
+		&jv.Requirements,
+		&jv.Location,
+		&jv.JobType,
+		&jv.Deadline,
+		&jv.IsActive,
+		&jv.Headcount,
+		&jv.CreatedAt,
+		&jv.UpdatedAt,
+		&jv.CompanyVerified,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.log.WithFields(map[string]interface{}{
+				"id": id,
+			}).Warn("Job vacancy not found")
+			return entity.JobVacancy{}, nil
+		}
+
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when getting job vacancy by ID")
+		return entity.JobVacancy{}, err
+	}
+
+	return makeJobVacancy(jv), nil
+}
+
+func (r *jobVacanciesRepository) GetActiveJobVacancies(c context.Context, now time.Time) ([]entity.JobVacancy, error) {
+	r.log.WithFields(map[string]interface{}{
+		"now": now,
+	}).Debug("Fetching active job vacancies from database")
+
+	query := r.q.Rebind(queryGetActiveJobVacancies)
+
+	rows, err := r.q.QueryContext(c, query, now)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when fetching active job vacancies")
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobVacancies, err := scanJobVacancies(rows, r.log)
+	if err != nil {
+		return nil, err
+	}
+
+	r.log.WithFields(map[string]interface{}{
+		"count": len(jobVacancies),
+	}).Debug("Active job vacancies fetched successfully")
+
+	return jobVacancies, nil
+}
+
+func (r *jobVacanciesRepository) GetJobVacanciesCreatedSince(c context.Context, since time.Time, now time.Time) ([]entity.JobVacancy, error) {
+	r.log.WithFields(map[string]interface{}{
+		"since": since,
+		"now":   now,
+	}).Debug("Fetching job vacancies created since timestamp")
+
+	query := r.q.Rebind(queryGetJobVacanciesCreatedSince)
+
+	rows, err := r.q.QueryContext(c, query, since, now)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when fetching new job vacancies")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanJobVacancies(rows, r.log)
+}
+
+func scanJobVacancies(rows *sql.Rows, log *logrus.Logger) ([]entity.JobVacancy, error) {
+	var jobVacancies []entity.JobVacancy
+	for rows.Next() {
+		var jv recruitment.JobVacancyDB
+		err := rows.Scan(
+			&jv.ID,
+			&jv.RecruiterID,
+			&jv.Title,
+			&jv.Description,
+			&jv.Requirements,
+			&jv.Location,
+			&jv.JobType,
+			&jv.Deadline,
+			&jv.IsActive,
+			&jv.Headcount,
+			&jv.CreatedAt,
+			&jv.UpdatedAt,
+			&jv.CompanyVerified,
+		)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning job vacancy row")
+			return nil, err
+		}
+		jobVacancies = append(jobVacancies, makeJobVacancy(jv))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through job vacancy rows")
+		return nil, err
+	}
+
+	return jobVacancies, nil
+}
+
+func makeJobVacancy(jv recruitment.JobVacancyDB) entity.JobVacancy {
+	return entity.JobVacancy{
+		ID:           jv.ID.String,
+		RecruiterID:  jv.RecruiterID.String,
+		Title:        jv.Title.String,
+		Description:  jv.Description.String,
+		Requirements: jv.Requirements.String,
+		Location:     jv.Location.String,
+		JobType:      jv.JobType.String,
+		Deadline:     jv.Deadline.Time,
+		IsActive:     jv.IsActive.Bool,
+		Headcount:    int(jv.Headcount.Int64),
+		CreatedAt:    jv.CreatedAt.Time,
+		UpdatedAt:    jv.UpdatedAt.Time,
+
+		CompanyVerified: jv.CompanyVerified.Bool,
+	}
+}
+
+func (r *jobVacanciesRepository) CloseJobVacancy(c context.Context, id string, updatedAt time.Time) error {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": id,
+	}).Debug("Closing job vacancy")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCloseJobVacancy), updatedAt, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when closing job vacancy")
+		return err
+	}
+
+	return nil
+}
+
+func (r *jobVacanciesRepository) CountActiveJobVacanciesByRecruiterID(c context.Context, recruiterID string, now time.Time) (int, error) {
+	var count int
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryCountActiveJobVacanciesByRecruiterID), recruiterID, now).Scan(&count)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":        err.Error(),
+			"recruiter_id": recruiterID,
+		}).Error("Database error when counting active job vacancies")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *jobVacanciesRepository) IsCompanyVerified(c context.Context, companyID string) (bool, error) {
+	var verified bool
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryGetCompanyVerified), companyID).Scan(&verified)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when checking company verification")
+		return false, err
+	}
+
+	return verified, nil
+}
+
+func (r *jobVacanciesRepository) ReplaceJobVacancySkills(c context.Context, jobVacancyID string, skillIDs []string) error {
+	if _, err := r.q.ExecContext(c, r.q.Rebind(queryDeleteJobVacancySkills), jobVacancyID); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    jobVacancyID,
+		}).Error("Database error when deleting job vacancy skills")
+		return err
+	}
+
+	if len(skillIDs) == 0 {
+		return nil
+	}
+
+	if _, err := r.q.ExecContext(c, r.q.Rebind(queryCreateJobVacancySkills), jobVacancyID, pq.Array(skillIDs)); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    jobVacancyID,
+		}).Error("Database error when creating job vacancy skills")
+		return err
+	}
+
+	return nil
+}
+
+func (r *jobVacanciesRepository) GetSkillsByJobVacancyIDs(c context.Context, jobVacancyIDs []string) (map[string][]entity.Skill, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetSkillsByJobVacancyIDs), pq.Array(jobVacancyIDs))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting job vacancy skills")
+		return nil, err
+	}
+	defer rows.Close()
+
+	skills := make(map[string][]entity.Skill)
+	for rows.Next() {
+		var (
+			jobVacancyID string
+			skill        entity.Skill
+		)
+		if err := rows.Scan(&jobVacancyID, &skill.ID, &skill.Name, &skill.NormalizedName, &skill.Category, &skill.CreatedAt); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning job vacancy skill row")
+			return nil, err
+		}
+		skills[jobVacancyID] = append(skills[jobVacancyID], skill)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through job vacancy skill rows")
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+func (r *jobVacanciesRepository) ReplaceJobVacancyLanguages(c context.Context, jobVacancyID string, languages []entity.LanguageRequirement) error {
+	if _, err := r.q.ExecContext(c, r.q.Rebind(queryDeleteJobVacancyLanguages), jobVacancyID); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    jobVacancyID,
+		}).Error("Database error when deleting job vacancy languages")
+		return err
+	}
+
+	if len(languages) == 0 {
+		return nil
+	}
+
+	codes := make([]string, len(languages))
+	levels := make([]string, len(languages))
+	for i, language := range languages {
+		codes[i] = language.Language
+		levels[i] = string(language.MinLevel)
+	}
+
+	if _, err := r.q.ExecContext(c, r.q.Rebind(queryCreateJobVacancyLanguages), jobVacancyID, pq.Array(codes), pq.Array(levels)); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    jobVacancyID,
+		}).Error("Database error when creating job vacancy languages")
+		return err
+	}
+
+	return nil
+}
+
+func (r *jobVacanciesRepository) GetLanguagesByJobVacancyIDs(c context.Context, jobVacancyIDs []string) (map[string][]entity.LanguageRequirement, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetLanguagesByJobVacancyIDs), pq.Array(jobVacancyIDs))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting job vacancy languages")
+		return nil, err
+	}
+	defer rows.Close()
+
+	languages := make(map[string][]entity.LanguageRequirement)
+	for rows.Next() {
+		var (
+			jobVacancyID string
+			language     entity.LanguageRequirement
+		)
+		if err := rows.Scan(&jobVacancyID, &language.Language, &language.MinLevel); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning job vacancy language row")
+			return nil, err
+		}
+		languages[jobVacancyID] = append(languages[jobVacancyID], language)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through job vacancy language rows")
+		return nil, err
+	}
+
+	return languages, nil
+}
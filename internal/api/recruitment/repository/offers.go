@@ -0,0 +1,226 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+func (r *offersRepository) CreateOffer(c context.Context, offer entity.Offer) error {
+	r.log.WithFields(map[string]interface{}{
+		"offer_id":           offer.ID,
+		"job_application_id": offer.JobApplicationID,
+	}).Debug("Creating offer in database")
+
+	query, args, err := sqlx.Named(queryCreateOffer, offer)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to build SQL query for CreateOffer")
+		return err
+	}
+
+	_, err = r.q.ExecContext(c, r.q.Rebind(query), args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating offer")
+		return err
+	}
+
+	return nil
+}
+
+func (r *offersRepository) GetOfferByID(c context.Context, id string) (entity.Offer, error) {
+	r.log.WithFields(map[string]interface{}{
+		"offer_id": id,
+	}).Debug("Getting offer by ID")
+
+	return r.getOffer(c, queryGetOfferByID, id)
+}
+
+// GetOfferByIDForUpdate locks the offer row until the transaction ends.
+func (r *offersRepository) GetOfferByIDForUpdate(c context.Context, id string) (entity.Offer, error) {
+	r.log.WithFields(map[string]interface{}{
+		"offer_id": id,
+	}).Debug("Locking offer by ID")
+
+	return r.getOffer(c, queryGetOfferByIDForUpdate, id)
+}
+
+func (r *offersRepository) GetOfferByAccessToken(c context.Context, token string) (entity.Offer, error) {
+	r.log.Debug("Getting offer by access token")
+
+	return r.getOffer(c, queryGetOfferByAccessToken, token)
+}
+
+func (r *offersRepository) GetOffersByJobApplicationID(c context.Context, jobApplicationID string) ([]entity.Offer, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_application_id": jobApplicationID,
+	}).Debug("Getting offers by job application ID")
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetOffersByJobApplicationID), jobApplicationID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":              err.Error(),
+			"job_application_id": jobApplicationID,
+		}).Error("Database error when getting offers by job application ID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanOffers(rows, r.log)
+}
+
+func (r *offersRepository) GetOffersByUserID(c context.Context, userID string) ([]entity.Offer, error) {
+	r.log.WithFields(map[string]interface{}{
+		"user_id": userID,
+	}).Debug("Getting offers by user ID")
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetOffersByUserID), userID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Database error when getting offers by user ID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanOffers(rows, r.log)
+}
+
+// UpdateSentOfferStatus moves an offer out of the sent status and reports
+// whether it was still sent.
+func (r *offersRepository) UpdateSentOfferStatus(c context.Context, id string, status entity.OfferStatus, respondedAt *time.Time, updatedAt time.Time) (bool, error) {
+	r.log.WithFields(map[string]interface{}{
+		"offer_id": id,
+		"status":   status,
+	}).Debug("Updating offer status")
+
+	result, err := r.q.ExecContext(c, r.q.Rebind(queryUpdateSentOfferStatus), status, respondedAt, updatedAt, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when updating offer status")
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to get rows affected after updating offer status")
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// ExpireSentOffers marks the sent offers whose deadline passed as expired and
+// returns the job applications they were made on.
+func (r *offersRepository) ExpireSentOffers(c context.Context, now time.Time) ([]string, error) {
+	r.log.Debug("Expiring sent offers")
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryExpireSentOffers), now, now)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when expiring sent offers")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobApplicationIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Failed to scan expired offer")
+			return nil, err
+		}
+		jobApplicationIDs = append(jobApplicationIDs, id)
+	}
+
+	return jobApplicationIDs, rows.Err()
+}
+
+func (r *offersRepository) getOffer(c context.Context, query string, arg string) (entity.Offer, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(query), arg)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting offer")
+		return entity.Offer{}, err
+	}
+	defer rows.Close()
+
+	offers, err := scanOffers(rows, r.log)
+	if err != nil {
+		return entity.Offer{}, err
+	}
+
+	if len(offers) == 0 {
+		return entity.Offer{}, nil
+	}
+
+	return offers[0], nil
+}
+
+func scanOffers(rows *sql.Rows, log *logrus.Logger) ([]entity.Offer, error) {
+	var offers []entity.Offer
+	for rows.Next() {
+		var (
+			offer       entity.Offer
+			respondedAt sql.NullTime
+			updatedAt   sql.NullTime
+		)
+		err := rows.Scan(
+			&offer.ID,
+			&offer.JobApplicationID,
+			&offer.CompanyID,
+			&offer.SalaryAmount,
+			&offer.SalaryCurrency,
+			&offer.SalaryPeriod,
+			&offer.StartDate,
+			&offer.ExpiresAt,
+			&offer.Terms,
+			&offer.Status,
+			&offer.AccessToken,
+			&respondedAt,
+			&offer.CreatedAt,
+			&updatedAt,
+			&offer.UserID,
+			&offer.JobVacancyID,
+			&offer.JobTitle,
+			&offer.CompanyName,
+		)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning offer row")
+			return nil, err
+		}
+
+		if respondedAt.Valid {
+			offer.RespondedAt = &respondedAt.Time
+		}
+		offer.UpdatedAt = updatedAt.Time
+		offers = append(offers, offer)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through offer rows")
+		return nil, err
+	}
+
+	return offers, nil
+}
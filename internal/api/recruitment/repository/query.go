@@ -2,12 +2,13 @@ package recruitmentRepository
 
 const (
 	queryCreateJobVacancy = `
-INSERT INTO job_vacancies (id, recruiter_id, title, description, requirements, location, job_type, deadline, is_active, created_at, updated_at)
-VALUES (:id, :recruiter_id, :title, :description, :requirements, :location, :job_type, :deadline, :is_active, :created_at, :updated_at)`
+INSERT INTO job_vacancies (id, recruiter_id, title, description, requirements, location, job_type, deadline, is_active, headcount, created_by, updated_by, created_at, updated_at)
+VALUES (:id, :recruiter_id, :title, :description, :requirements, :location, :job_type, :deadline, :is_active, :headcount, NULLIF(:created_by, ''), NULLIF(:updated_by, ''), :created_at, :updated_at)`
 
 	queryGetJobVacancies = `
     SELECT id, recruiter_id, title, description, requirements, location, job_type, 
-           deadline, is_active, created_at, updated_at
+           deadline, is_active, headcount, created_at, updated_at,
+           COALESCE((SELECT c.is_verified FROM companies c WHERE c.id = job_vacancies.recruiter_id), FALSE)
     FROM job_vacancies
     ORDER BY created_at DESC
     LIMIT ? OFFSET ?
@@ -26,6 +27,8 @@ VALUES (:id, :recruiter_id, :title, :description, :requirements, :location, :job
         job_type = :job_type,
         deadline = :deadline,
         is_active = :is_active,
+        headcount = :headcount,
+        updated_by = NULLIF(:updated_by, ''),
         updated_at = :updated_at
     WHERE id = :id
     `
@@ -40,5 +43,648 @@ FROM job_vacancies`
 	queryDeleteJobVacancy = `
     DELETE FROM job_vacancies 
     WHERE id = ?
+    `
+
+	queryGetJobVacancyByID = `
+    SELECT id, recruiter_id, title, description, requirements, location, job_type,
+           deadline, is_active, headcount, created_at, updated_at,
+           COALESCE((SELECT c.is_verified FROM companies c WHERE c.id = job_vacancies.recruiter_id), FALSE)
+    FROM job_vacancies
+    WHERE id = ?
+    `
+
+	queryGetJobVacancyByIDForUpdate = queryGetJobVacancyByID + `FOR UPDATE`
+
+	queryGetActiveJobVacancies = `
+    SELECT id, recruiter_id, title, description, requirements, location, job_type,
+           deadline, is_active, headcount, created_at, updated_at,
+           COALESCE((SELECT c.is_verified FROM companies c WHERE c.id = job_vacancies.recruiter_id), FALSE)
+    FROM job_vacancies
+    WHERE is_active = TRUE AND (deadline IS NULL OR deadline >= ?)
+    ORDER BY created_at DESC
+    `
+
+	queryGetCompanyVerified = `
+    SELECT COALESCE((SELECT is_verified FROM companies WHERE id = ?), FALSE)
+    `
+
+	queryCountActiveJobVacanciesByRecruiterID = `
+    SELECT COUNT(*)
+    FROM job_vacancies
+    WHERE recruiter_id = ? AND is_active = TRUE AND (deadline IS NULL OR deadline >= ?)
+    `
+
+	queryGetJobVacanciesCreatedSince = `
+    SELECT id, recruiter_id, title, description, requirements, location, job_type,
+           deadline, is_active, headcount, created_at, updated_at,
+           COALESCE((SELECT c.is_verified FROM companies c WHERE c.id = job_vacancies.recruiter_id), FALSE)
+    FROM job_vacancies
+    WHERE created_at > ? AND is_active = TRUE AND (deadline IS NULL OR deadline >= ?)
+    ORDER BY created_at DESC
+    `
+)
+
+const (
+	queryCreateJobApplication = `
+    INSERT INTO job_applications (
+        id, job_vacancy_id, user_id, status, cover_letter, screening_flagged, created_at, updated_at
+    ) VALUES (
+        :id, :job_vacancy_id, :user_id, :status, :cover_letter, :screening_flagged, :created_at, :updated_at
+    )`
+
+	queryCheckJobApplicationExists = `
+    SELECT EXISTS (SELECT 1 FROM job_applications WHERE job_vacancy_id = ? AND user_id = ?)
+    `
+
+	queryGetJobApplicationsByUserID = `
+    SELECT id, job_vacancy_id, user_id, status, cover_letter, screening_flagged, created_at, updated_at
+    FROM job_applications
+    WHERE user_id = ?
+    ORDER BY created_at DESC
+    `
+
+	queryGetJobApplicationByID = `
+    SELECT id, job_vacancy_id, user_id, status, cover_letter, screening_flagged, created_at, updated_at
+    FROM job_applications
+    WHERE id = ?
+    `
+
+	queryGetJobApplicationByIDForUpdate = queryGetJobApplicationByID + `FOR UPDATE`
+
+	queryGetJobApplicationsByJobVacancyID = `
+    SELECT id, job_vacancy_id, user_id, status, cover_letter, screening_flagged, created_at, updated_at,
+           status_updated_by
+    FROM job_applications
+    WHERE job_vacancy_id = ?
+    ORDER BY created_at DESC
+    `
+
+	queryUpdateJobApplicationStatus = `
+    UPDATE job_applications
+    SET status = ?, status_updated_by = NULLIF(?, ''), updated_at = ?
+    WHERE id = ?
+    `
+
+	queryGetAppliedJobVacancies = `
+    SELECT jv.id, jv.recruiter_id, jv.title, jv.description, jv.requirements, jv.location, jv.job_type,
+           jv.deadline, jv.is_active, jv.headcount, jv.created_at, jv.updated_at,
+           COALESCE((SELECT c.is_verified FROM companies c WHERE c.id = jv.recruiter_id), FALSE)
+    FROM job_vacancies jv
+    JOIN job_applications ja ON ja.job_vacancy_id = jv.id
+    WHERE ja.user_id = ?
+    `
+)
+
+const (
+	querySaveJob = `
+    INSERT INTO saved_jobs (user_id, job_vacancy_id, created_at)
+    VALUES (?, ?, ?)
+    ON CONFLICT (user_id, job_vacancy_id) DO NOTHING
+    `
+
+	queryDeleteSavedJob = `
+    DELETE FROM saved_jobs
+    WHERE user_id = ? AND job_vacancy_id = ?
+    `
+
+	queryGetSavedJobsByUserID = `
+    SELECT sj.user_id, sj.job_vacancy_id, sj.created_at,
+           jv.id, jv.recruiter_id, jv.title, jv.description, jv.requirements, jv.location, jv.job_type,
+           jv.deadline, jv.is_active, jv.headcount, jv.created_at, jv.updated_at,
+           COALESCE((SELECT c.is_verified FROM companies c WHERE c.id = jv.recruiter_id), FALSE)
+    FROM saved_jobs sj
+    JOIN job_vacancies jv ON jv.id = sj.job_vacancy_id
+    WHERE sj.user_id = ?
+    ORDER BY sj.created_at DESC
+    `
+)
+
+const (
+	queryCreateSavedSearch = `
+    INSERT INTO saved_searches (
+        id, user_id, name, query, location, job_type, frequency,
+        unsubscribe_token, is_active, last_notified_at, created_at, updated_at
+    ) VALUES (
+        :id, :user_id, :name, :query, :location, :job_type, :frequency,
+        :unsubscribe_token, :is_active, :last_notified_at, :created_at, :updated_at
+    )`
+
+	querySavedSearchColumns = `
+    SELECT id, user_id, name, query, location, job_type, frequency,
+           unsubscribe_token, is_active, last_notified_at, created_at, updated_at
+    FROM saved_searches
+    `
+
+	queryGetSavedSearchByID = querySavedSearchColumns + `WHERE id = ?`
+
+	queryGetSavedSearchByUnsubscribeToken = querySavedSearchColumns + `WHERE unsubscribe_token = ?`
+
+	queryGetSavedSearchesByUserID = querySavedSearchColumns + `WHERE user_id = ? ORDER BY created_at DESC`
+
+	queryGetActiveSavedSearchesByFrequency = querySavedSearchColumns + `WHERE is_active = TRUE AND frequency = ?`
+
+	queryDeleteSavedSearch = `
+    DELETE FROM saved_searches
+    WHERE id = ?
+    `
+
+	queryUnsubscribeSavedSearch = `
+    UPDATE saved_searches
+    SET is_active = FALSE, updated_at = ?
+    WHERE unsubscribe_token = ?
+    `
+
+	queryUpdateSavedSearchLastNotified = `
+    UPDATE saved_searches
+    SET last_notified_at = ?
+    WHERE id = ?
+    `
+)
+
+// Candidate queries are recruiter-facing: they follow the profile privacy
+// rules, so private profiles and hidden bio entries never match or show.
+const (
+	queryCandidateSearchBase = `
+    FROM users u
+    WHERE u.role = 'candidate' AND u.deleted_at IS NULL AND u.is_searchable = TRUE
+      AND u.profile_visibility <> 'private'
+    `
+
+	queryCountCandidates = `SELECT COUNT(*) ` + queryCandidateSearchBase
+
+	querySearchCandidates = `
+    SELECT u.id, u.name, u.headline, u.location, u.profile_picture
+    ` + queryCandidateSearchBase
+
+	queryCandidateSearchOrder = `
+    ORDER BY u.updated_at DESC NULLS LAST, u.created_at DESC
+    LIMIT ? OFFSET ?
+    `
+
+	// The filter names a skill, which resolves through the catalogue and its
+	// aliases before matching profile skills or skills on visible experiences.
+	queryCandidateHasSkill = `
+    EXISTS (
+        WITH wanted AS (
+            SELECT id FROM skills WHERE normalized_name = ?
+            UNION
+            SELECT skill_id FROM skill_aliases WHERE alias = ?
+        )
+        SELECT 1 FROM user_skills us
+        WHERE us.user_id = u.id AND us.skill_id IN (SELECT id FROM wanted)
+        UNION ALL
+        SELECT 1 FROM experience_skills es
+        JOIN experiences e ON e.id = es.experience_id
+        WHERE e.user_id = u.id AND e.is_hidden = FALSE AND es.skill_id IN (SELECT id FROM wanted)
+    )`
+
+	queryCandidateHasLanguage = `
+    EXISTS (SELECT 1 FROM user_languages ul WHERE ul.user_id = u.id AND ul.language = ? AND ul.level = ANY(?))`
+
+	queryCandidateHasJobTitle = `
+    EXISTS (SELECT 1 FROM experiences e WHERE e.user_id = u.id AND e.is_hidden = FALSE AND e.job_title ILIKE ?)`
+
+	queryCandidateHasEducation = `
+    EXISTS (SELECT 1 FROM educations ed WHERE ed.user_id = u.id AND ed.is_hidden = FALSE AND ed.institutional_name ILIKE ? AND ed.title_degree ILIKE ?)`
+
+	queryGetLatestExperiences = `
+    SELECT DISTINCT ON (user_id) id, user_id, job_title, job_location, skill_used, start_date, end_date, is_current
+    FROM experiences
+    WHERE user_id = ANY(?) AND is_hidden = FALSE
+    ORDER BY user_id, is_current DESC, end_date DESC NULLS LAST, start_date DESC, created_at DESC
+    `
+
+	queryGetLatestEducations = `
+    SELECT DISTINCT ON (user_id) id, user_id, title_degree, institutional_name, start_date, end_date, is_current
+    FROM educations
+    WHERE user_id = ANY(?) AND is_hidden = FALSE
+    ORDER BY user_id, is_current DESC, end_date DESC NULLS LAST, start_date DESC, created_at DESC
+    `
+)
+
+const (
+	queryCreateTalentPool = `
+    INSERT INTO talent_pools (id, company_id, name, description, created_at, updated_at)
+    VALUES (:id, :company_id, :name, :description, :created_at, :updated_at)
+    `
+
+	queryGetTalentPoolByID = `
+    SELECT tp.id, tp.company_id, tp.name, tp.description, tp.created_at, tp.updated_at,
+           (SELECT COUNT(*) FROM talent_pool_candidates tpc WHERE tpc.talent_pool_id = tp.id)
+    FROM talent_pools tp
+    WHERE tp.id = ?
+    `
+
+	queryGetTalentPoolsByCompanyID = `
+    SELECT tp.id, tp.company_id, tp.name, tp.description, tp.created_at, tp.updated_at,
+           (SELECT COUNT(*) FROM talent_pool_candidates tpc WHERE tpc.talent_pool_id = tp.id)
+    FROM talent_pools tp
+    WHERE tp.company_id = ?
+    ORDER BY tp.name
+    `
+
+	queryCheckTalentPoolNameExists = `
+    SELECT EXISTS (SELECT 1 FROM talent_pools WHERE company_id = ? AND LOWER(name) = LOWER(?) AND id <> ?)
+    `
+
+	queryUpdateTalentPool = `
+    UPDATE talent_pools
+    SET name = :name,
+        description = :description,
+        updated_at = :updated_at
+    WHERE id = :id
+    `
+
+	queryDeleteTalentPool = `
+    DELETE FROM talent_pools
+    WHERE id = ?
+    `
+
+	queryAddTalentPoolCandidate = `
+    INSERT INTO talent_pool_candidates (talent_pool_id, user_id, tags, note, created_at, updated_at)
+    VALUES (?, ?, ?, ?, ?, ?)
+    `
+
+	queryTalentPoolCandidateColumns = `
+    SELECT tpc.talent_pool_id, tpc.user_id, tpc.tags, tpc.note, tpc.created_at, tpc.updated_at,
+           u.name, u.headline, u.location, u.profile_picture
+    FROM talent_pool_candidates tpc
+    JOIN users u ON u.id = tpc.user_id
+    `
+
+	queryGetTalentPoolCandidate = queryTalentPoolCandidateColumns + `WHERE tpc.talent_pool_id = ? AND tpc.user_id = ?`
+
+	// Candidates who made their profile private since being added stay in
+	// the pool but are not listed.
+	queryGetTalentPoolCandidates = queryTalentPoolCandidateColumns + `
+    WHERE tpc.talent_pool_id = ? AND (? = '' OR ? = ANY(tpc.tags)) AND u.profile_visibility <> 'private'
+    ORDER BY tpc.created_at DESC
+    `
+
+	queryUpdateTalentPoolCandidate = `
+    UPDATE talent_pool_candidates
+    SET tags = ?, note = ?, updated_at = ?
+    WHERE talent_pool_id = ? AND user_id = ?
+    `
+
+	queryRemoveTalentPoolCandidate = `
+    DELETE FROM talent_pool_candidates
+    WHERE talent_pool_id = ? AND user_id = ?
+    `
+)
+
+const (
+	queryCreateJobInvitation = `
+    INSERT INTO job_invitations (
+        id, job_vacancy_id, user_id, company_id, talent_pool_id, message, status, created_at, updated_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryCheckJobInvitationExists = `
+    SELECT EXISTS (SELECT 1 FROM job_invitations WHERE job_vacancy_id = ? AND user_id = ?)
+    `
+
+	queryJobInvitationColumns = `
+    SELECT ji.id, ji.job_vacancy_id, ji.user_id, ji.company_id, ji.talent_pool_id, ji.message, ji.status,
+           ji.created_at, ji.updated_at,
+           jv.id, jv.recruiter_id, jv.title, jv.description, jv.requirements, jv.location, jv.job_type,
+           jv.deadline, jv.is_active, jv.headcount, jv.created_at, jv.updated_at,
+           COALESCE((SELECT c.is_verified FROM companies c WHERE c.id = jv.recruiter_id), FALSE)
+    FROM job_invitations ji
+    JOIN job_vacancies jv ON jv.id = ji.job_vacancy_id
+    `
+
+	queryGetJobInvitationByID = queryJobInvitationColumns + `WHERE ji.id = ?`
+
+	queryGetJobInvitationsByUserID = queryJobInvitationColumns + `WHERE ji.user_id = ? ORDER BY ji.created_at DESC`
+
+	queryUpdateJobInvitationStatus = `
+    UPDATE job_invitations
+    SET status = ?, updated_at = ?
+    WHERE id = ?
+    `
+
+	queryAcceptJobInvitation = `
+    UPDATE job_invitations
+    SET status = 'accepted', updated_at = ?
+    WHERE job_vacancy_id = ? AND user_id = ? AND status = 'pending'
+    `
+)
+
+const (
+	queryCreateInterview = `
+    INSERT INTO interviews (
+        id, job_application_id, company_id, title, format, location, meeting_link, timezone,
+        duration_minutes, status, scheduled_start, scheduled_end, sequence, created_at, updated_at
+    ) VALUES (
+        :id, :job_application_id, :company_id, :title, :format, :location, :meeting_link, :timezone,
+        :duration_minutes, :status, :scheduled_start, :scheduled_end, :sequence, :created_at, :updated_at
+    )`
+
+	queryUpdateInterview = `
+    UPDATE interviews
+    SET title = :title,
+        format = :format,
+        location = :location,
+        meeting_link = :meeting_link,
+        timezone = :timezone,
+        duration_minutes = :duration_minutes,
+        status = :status,
+        scheduled_start = :scheduled_start,
+        scheduled_end = :scheduled_end,
+        sequence = :sequence,
+        updated_at = :updated_at
+    WHERE id = :id
+    `
+
+	queryInterviewColumns = `
+    SELECT i.id, i.job_application_id, i.company_id, i.title, i.format, i.location, i.meeting_link,
+           i.timezone, i.duration_minutes, i.status, i.scheduled_start, i.scheduled_end, i.sequence,
+           i.created_at, i.updated_at
+    FROM interviews i
+    `
+
+	queryGetInterviewByID = queryInterviewColumns + `WHERE i.id = ?`
+
+	queryGetInterviewsByJobApplicationID = queryInterviewColumns + `
+    WHERE i.job_application_id = ?
+    ORDER BY i.created_at DESC
+    `
+
+	queryGetInterviewsByUserID = queryInterviewColumns + `
+    JOIN job_applications ja ON ja.id = i.job_application_id
+    WHERE ja.user_id = ?
+    ORDER BY i.created_at DESC
+    `
+
+	queryCreateInterviewSlot = `
+    INSERT INTO interview_slots (id, interview_id, start_time, end_time)
+    VALUES (:id, :interview_id, :start_time, :end_time)
+    `
+
+	queryGetInterviewSlots = `
+    SELECT id, interview_id, start_time, end_time
+    FROM interview_slots
+    WHERE interview_id = ANY(?)
+    ORDER BY start_time
+    `
+
+	queryDeleteInterviewSlots = `
+    DELETE FROM interview_slots
+    WHERE interview_id = ?
+    `
+
+	queryCreateInterviewer = `
+    INSERT INTO interview_interviewers (interview_id, name, email)
+    VALUES (:interview_id, :name, :email)
+    `
+
+	queryGetInterviewers = `
+    SELECT interview_id, name, email
+    FROM interview_interviewers
+    WHERE interview_id = ANY(?)
+    ORDER BY name
+    `
+
+	queryDeleteInterviewers = `
+    DELETE FROM interview_interviewers
+    WHERE interview_id = ?
+    `
+
+	queryGetInterviewerMemberIDs = `
+    SELECT DISTINCT cm.id
+    FROM interviews i
+    JOIN interview_interviewers ii ON ii.interview_id = i.id
+    JOIN company_members cm ON cm.company_id = i.company_id AND LOWER(cm.email) = LOWER(ii.email)
+    WHERE i.job_application_id = ? AND i.status <> 'cancelled'
+    `
+)
+
+const (
+	queryCreateScorecardTemplate = `
+    INSERT INTO scorecard_templates (id, job_vacancy_id, company_id, name, created_at, updated_at)
+    VALUES (:id, :job_vacancy_id, :company_id, :name, :created_at, :updated_at)
+    `
+
+	queryUpdateScorecardTemplate = `
+    UPDATE scorecard_templates
+    SET name = ?, updated_at = ?
+    WHERE id = ?
+    `
+
+	queryGetScorecardTemplateByJobVacancyID = `
+    SELECT id, job_vacancy_id, company_id, name, created_at, updated_at
+    FROM scorecard_templates
+    WHERE job_vacancy_id = ?
+    `
+
+	queryDeleteScorecardTemplate = `
+    DELETE FROM scorecard_templates
+    WHERE id = ?
+    `
+
+	queryCreateScorecardCompetency = `
+    INSERT INTO scorecard_competencies (id, template_id, name, description, scale_max, scale_labels, position)
+    VALUES (?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryGetScorecardCompetencies = `
+    SELECT id, template_id, name, description, scale_max, scale_labels, position
+    FROM scorecard_competencies
+    WHERE template_id = ?
+    ORDER BY position
+    `
+
+	queryDeleteScorecardCompetencies = `
+    DELETE FROM scorecard_competencies
+    WHERE template_id = ?
+    `
+
+	queryCountScorecardsByTemplateID = `
+    SELECT COUNT(*)
+    FROM scorecards
+    WHERE template_id = ?
+    `
+
+	queryUpsertScorecard = `
+    INSERT INTO scorecards (
+        id, job_application_id, template_id, interviewer_id, recommendation, notes, submitted_at, updated_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    ON CONFLICT (job_application_id, interviewer_id) DO UPDATE
+    SET template_id = EXCLUDED.template_id,
+        recommendation = EXCLUDED.recommendation,
+        notes = EXCLUDED.notes,
+        updated_at = EXCLUDED.updated_at
+    RETURNING id
+    `
+
+	queryGetScorecardsByJobApplicationIDs = `
+    SELECT id, job_application_id, template_id, interviewer_id, recommendation, notes, submitted_at, updated_at
+    FROM scorecards
+    WHERE job_application_id = ANY(?)
+    ORDER BY submitted_at
+    `
+
+	queryCreateScorecardRating = `
+    INSERT INTO scorecard_ratings (scorecard_id, competency_id, rating, comment)
+    VALUES (?, ?, ?, ?)
+    `
+
+	queryDeleteScorecardRatings = `
+    DELETE FROM scorecard_ratings
+    WHERE scorecard_id = ?
+    `
+
+	queryGetScorecardRatings = `
+    SELECT sr.scorecard_id, sr.competency_id, sr.rating, sr.comment
+    FROM scorecard_ratings sr
+    JOIN scorecard_competencies sc ON sc.id = sr.competency_id
+    WHERE sr.scorecard_id = ANY(?)
+    ORDER BY sc.position
+    `
+)
+
+const (
+	queryCreateOffer = `
+    INSERT INTO offers (
+        id, job_application_id, company_id, salary_amount, salary_currency, salary_period,
+        start_date, expires_at, terms, status, access_token, responded_at, created_at, updated_at
+    ) VALUES (
+        :id, :job_application_id, :company_id, :salary_amount, :salary_currency, :salary_period,
+        :start_date, :expires_at, :terms, :status, :access_token, :responded_at, :created_at, :updated_at
+    )`
+
+	queryOfferColumns = `
+    SELECT o.id, o.job_application_id, o.company_id, o.salary_amount, o.salary_currency, o.salary_period,
+           o.start_date, o.expires_at, o.terms, o.status, o.access_token, o.responded_at, o.created_at, o.updated_at,
+           ja.user_id, jv.id, jv.title, c.name
+    FROM offers o
+    JOIN job_applications ja ON ja.id = o.job_application_id
+    JOIN job_vacancies jv ON jv.id = ja.job_vacancy_id
+    JOIN companies c ON c.id = o.company_id
+    `
+
+	queryGetOfferByID = queryOfferColumns + `WHERE o.id = ?`
+
+	queryGetOfferByIDForUpdate = queryOfferColumns + `WHERE o.id = ? FOR UPDATE OF o`
+
+	queryGetOfferByAccessToken = queryOfferColumns + `WHERE o.access_token = ?`
+
+	queryGetOffersByJobApplicationID = queryOfferColumns + `
+    WHERE o.job_application_id = ?
+    ORDER BY o.created_at DESC
+    `
+
+	queryGetOffersByUserID = queryOfferColumns + `
+    WHERE ja.user_id = ?
+    ORDER BY o.created_at DESC
+    `
+
+	queryUpdateSentOfferStatus = `
+    UPDATE offers
+    SET status = ?, responded_at = ?, updated_at = ?
+    WHERE id = ? AND status = 'sent'
+    `
+
+	queryExpireSentOffers = `
+    UPDATE offers
+    SET status = 'expired', updated_at = ?
+    WHERE status = 'sent' AND expires_at <= ?
+    RETURNING job_application_id
+    `
+
+	queryReopenOfferedJobApplications = `
+    UPDATE job_applications
+    SET status = 'interview', status_updated_by = NULL, updated_at = ?
+    WHERE id = ANY(?) AND status = 'offer'
+    `
+
+	queryCountHiredJobApplications = `
+    SELECT COUNT(*)
+    FROM job_applications
+    WHERE job_vacancy_id = ? AND status = 'hired'
+    `
+
+	queryCloseJobVacancy = `
+    UPDATE job_vacancies
+    SET is_active = FALSE, updated_at = ?
+    WHERE id = ?
+    `
+
+	queryDeleteJobVacancySkills = `
+    DELETE FROM vacancy_skills
+    WHERE job_vacancy_id = ?
+    `
+
+	queryCreateJobVacancySkills = `
+    INSERT INTO vacancy_skills (job_vacancy_id, skill_id, position)
+    SELECT ?, l.skill_id, l.position - 1
+    FROM unnest(?::VARCHAR[]) WITH ORDINALITY AS l(skill_id, position)
+    `
+
+	queryGetSkillsByJobVacancyIDs = `
+    SELECT l.job_vacancy_id, s.id, s.name, s.normalized_name, COALESCE(s.category, ''), s.created_at
+    FROM vacancy_skills l
+    JOIN skills s ON s.id = l.skill_id
+    WHERE l.job_vacancy_id = ANY(?)
+    ORDER BY l.job_vacancy_id, l.position
+    `
+
+	queryDeleteJobVacancyLanguages = `
+    DELETE FROM vacancy_languages
+    WHERE job_vacancy_id = ?
+    `
+
+	queryCreateJobVacancyLanguages = `
+    INSERT INTO vacancy_languages (job_vacancy_id, language, min_level, position)
+    SELECT ?, l.language, l.min_level, l.position - 1
+    FROM unnest(?::VARCHAR[], ?::VARCHAR[]) WITH ORDINALITY AS l(language, min_level, position)
+    `
+
+	queryGetLanguagesByJobVacancyIDs = `
+    SELECT job_vacancy_id, language, min_level
+    FROM vacancy_languages
+    WHERE job_vacancy_id = ANY(?)
+    ORDER BY job_vacancy_id, position
+    `
+)
+
+const (
+	queryCreateScreeningQuestion = `
+    INSERT INTO screening_questions (
+        id, job_vacancy_id, prompt, type, options, required, knockout_answers,
+        knockout_min, knockout_max, knockout_action, position, created_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryDeleteScreeningQuestions = `
+    DELETE FROM screening_questions WHERE job_vacancy_id = ?
+    `
+
+	queryGetScreeningQuestionsByJobVacancyID = `
+    SELECT id, job_vacancy_id, prompt, type, options, required, knockout_answers,
+           knockout_min, knockout_max, knockout_action, position, created_at
+    FROM screening_questions
+    WHERE job_vacancy_id = ?
+    ORDER BY position
+    `
+
+	queryCreateScreeningAnswer = `
+    INSERT INTO screening_answers (
+        id, job_application_id, question_id, answer_values, answer_number, file_url, knocked_out, created_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryGetScreeningAnswersByJobApplicationID = `
+    SELECT sa.id, sa.job_application_id, sa.question_id, sa.answer_values, sa.answer_number,
+           sa.file_url, sa.knocked_out, sa.created_at
+    FROM screening_answers sa
+    JOIN screening_questions sq ON sq.id = sa.question_id
+    WHERE sa.job_application_id = ?
+    ORDER BY sq.position
+    `
+
+	queryCountJobApplicationsByJobVacancyID = `
+    SELECT COUNT(*) FROM job_applications WHERE job_vacancy_id = ?
     `
 )
@@ -1,10 +1,12 @@
 package recruitmentRepository
 
 import (
+	"ProjectGolang/internal/api/recruitment"
 	"ProjectGolang/internal/entity"
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"time"
 )
 
 func New(db *sqlx.DB, log *logrus.Logger) Repository {
@@ -53,7 +55,17 @@ func (r *repository) NewClient(tx bool) (Client, error) {
 	}
 
 	return Client{
-		JobVacancies: &jobVacanciesRepository{q: db, log: r.log},
+		JobVacancies:       &jobVacanciesRepository{q: db, log: r.log},
+		JobApplications:    &jobApplicationsRepository{q: db, log: r.log},
+		SavedJobs:          &savedJobsRepository{q: db, log: r.log},
+		SavedSearches:      &savedSearchesRepository{q: db, log: r.log},
+		Candidates:         &candidatesRepository{q: db, log: r.log},
+		TalentPools:        &talentPoolsRepository{q: db, log: r.log},
+		JobInvitations:     &jobInvitationsRepository{q: db, log: r.log},
+		Interviews:         &interviewsRepository{q: db, log: r.log},
+		Scorecards:         &scorecardsRepository{q: db, log: r.log},
+		Offers:             &offersRepository{q: db, log: r.log},
+		ScreeningQuestions: &screeningQuestionsRepository{q: db, log: r.log},
 		Commit: func() error {
 			if tx {
 				r.log.Debug("Committing transaction")
@@ -76,6 +88,116 @@ type Client struct {
 		CheckJobVacancyExists(c context.Context, id string) (bool, error)
 		UpdateJobVacancy(c context.Context, jobVacancy entity.JobVacancy) error
 		DeleteJobVacancy(c context.Context, id string) error
+		GetJobVacancyByID(c context.Context, id string) (entity.JobVacancy, error)
+		GetJobVacancyByIDForUpdate(c context.Context, id string) (entity.JobVacancy, error)
+		GetActiveJobVacancies(c context.Context, now time.Time) ([]entity.JobVacancy, error)
+		GetJobVacanciesCreatedSince(c context.Context, since time.Time, now time.Time) ([]entity.JobVacancy, error)
+		CloseJobVacancy(c context.Context, id string, updatedAt time.Time) error
+		CountActiveJobVacanciesByRecruiterID(c context.Context, recruiterID string, now time.Time) (int, error)
+		IsCompanyVerified(c context.Context, companyID string) (bool, error)
+		ReplaceJobVacancySkills(c context.Context, jobVacancyID string, skillIDs []string) error
+		GetSkillsByJobVacancyIDs(c context.Context, jobVacancyIDs []string) (map[string][]entity.Skill, error)
+		ReplaceJobVacancyLanguages(c context.Context, jobVacancyID string, languages []entity.LanguageRequirement) error
+		GetLanguagesByJobVacancyIDs(c context.Context, jobVacancyIDs []string) (map[string][]entity.LanguageRequirement, error)
+	}
+
+	JobApplications interface {
+		CreateJobApplication(c context.Context, application entity.JobApplication) error
+		CheckJobApplicationExists(c context.Context, jobVacancyID string, userID string) (bool, error)
+		GetJobApplicationsByUserID(c context.Context, userID string) ([]entity.JobApplication, error)
+		GetAppliedJobVacancies(c context.Context, userID string) ([]entity.JobVacancy, error)
+		GetJobApplicationByID(c context.Context, id string) (entity.JobApplication, error)
+		GetJobApplicationByIDForUpdate(c context.Context, id string) (entity.JobApplication, error)
+		GetJobApplicationsByJobVacancyID(c context.Context, jobVacancyID string) ([]entity.JobApplication, error)
+		UpdateJobApplicationStatus(c context.Context, id string, status entity.ApplicationStatus, updatedBy string, updatedAt time.Time) error
+		ReopenOfferedJobApplications(c context.Context, ids []string, updatedAt time.Time) (int64, error)
+		CountHiredJobApplications(c context.Context, jobVacancyID string) (int, error)
+		CountJobApplicationsByJobVacancyID(c context.Context, jobVacancyID string) (int, error)
+	}
+
+	SavedJobs interface {
+		SaveJob(c context.Context, savedJob entity.SavedJob) error
+		DeleteSavedJob(c context.Context, userID string, jobVacancyID string) (bool, error)
+		GetSavedJobsByUserID(c context.Context, userID string) ([]entity.SavedJob, error)
+	}
+
+	SavedSearches interface {
+		CreateSavedSearch(c context.Context, search entity.SavedSearch) error
+		GetSavedSearchByID(c context.Context, id string) (entity.SavedSearch, error)
+		GetSavedSearchesByUserID(c context.Context, userID string) ([]entity.SavedSearch, error)
+		GetActiveSavedSearchesByFrequency(c context.Context, frequency entity.SavedSearchFrequency) ([]entity.SavedSearch, error)
+		DeleteSavedSearch(c context.Context, id string) error
+		GetSavedSearchByUnsubscribeToken(c context.Context, token string) (entity.SavedSearch, error)
+		UnsubscribeSavedSearch(c context.Context, token string, now time.Time) (bool, error)
+		UpdateSavedSearchLastNotified(c context.Context, id string, notifiedAt time.Time) error
+	}
+
+	Candidates interface {
+		SearchCandidates(c context.Context, filter recruitment.SearchCandidates) ([]entity.User, int, error)
+		GetLatestExperiences(c context.Context, userIDs []string) (map[string]entity.Experience, error)
+		GetLatestEducations(c context.Context, userIDs []string) (map[string]entity.Education, error)
+	}
+
+	TalentPools interface {
+		CreateTalentPool(c context.Context, pool entity.TalentPool) error
+		GetTalentPoolByID(c context.Context, id string) (entity.TalentPool, error)
+		GetTalentPoolsByCompanyID(c context.Context, companyID string) ([]entity.TalentPool, error)
+		CheckTalentPoolNameExists(c context.Context, companyID string, name string, excludeID string) (bool, error)
+		UpdateTalentPool(c context.Context, pool entity.TalentPool) error
+		DeleteTalentPool(c context.Context, id string) error
+		AddCandidate(c context.Context, candidate entity.TalentPoolCandidate) error
+		GetCandidate(c context.Context, talentPoolID string, userID string) (entity.TalentPoolCandidate, error)
+		GetCandidates(c context.Context, talentPoolID string, tag string) ([]entity.TalentPoolCandidate, error)
+		UpdateCandidate(c context.Context, candidate entity.TalentPoolCandidate) error
+		RemoveCandidate(c context.Context, talentPoolID string, userID string) (bool, error)
+	}
+
+	JobInvitations interface {
+		CreateJobInvitation(c context.Context, invitation entity.JobInvitation) error
+		CheckJobInvitationExists(c context.Context, jobVacancyID string, userID string) (bool, error)
+		GetJobInvitationByID(c context.Context, id string) (entity.JobInvitation, error)
+		GetJobInvitationsByUserID(c context.Context, userID string) ([]entity.JobInvitation, error)
+		UpdateJobInvitationStatus(c context.Context, id string, status entity.InvitationStatus, updatedAt time.Time) error
+		AcceptJobInvitation(c context.Context, jobVacancyID string, userID string, updatedAt time.Time) error
+	}
+
+	Interviews interface {
+		CreateInterview(c context.Context, interview entity.Interview) error
+		UpdateInterview(c context.Context, interview entity.Interview) error
+		GetInterviewByID(c context.Context, id string) (entity.Interview, error)
+		GetInterviewsByJobApplicationID(c context.Context, jobApplicationID string) ([]entity.Interview, error)
+		GetInterviewsByUserID(c context.Context, userID string) ([]entity.Interview, error)
+		ReplaceInterviewSlots(c context.Context, interviewID string, slots []entity.InterviewSlot) error
+		ReplaceInterviewers(c context.Context, interviewID string, interviewers []entity.Interviewer) error
+		GetInterviewerMemberIDs(c context.Context, jobApplicationID string) ([]string, error)
+	}
+
+	Scorecards interface {
+		CreateScorecardTemplate(c context.Context, template entity.ScorecardTemplate) error
+		UpdateScorecardTemplate(c context.Context, template entity.ScorecardTemplate) error
+		GetScorecardTemplateByJobVacancyID(c context.Context, jobVacancyID string) (entity.ScorecardTemplate, error)
+		DeleteScorecardTemplate(c context.Context, id string) error
+		CountScorecardsByTemplateID(c context.Context, templateID string) (int, error)
+		UpsertScorecard(c context.Context, scorecard entity.Scorecard) (string, error)
+		GetScorecardsByJobApplicationIDs(c context.Context, jobApplicationIDs []string) ([]entity.Scorecard, error)
+	}
+
+	Offers interface {
+		CreateOffer(c context.Context, offer entity.Offer) error
+		GetOfferByID(c context.Context, id string) (entity.Offer, error)
+		GetOfferByIDForUpdate(c context.Context, id string) (entity.Offer, error)
+		GetOfferByAccessToken(c context.Context, token string) (entity.Offer, error)
+		GetOffersByJobApplicationID(c context.Context, jobApplicationID string) ([]entity.Offer, error)
+		GetOffersByUserID(c context.Context, userID string) ([]entity.Offer, error)
+		UpdateSentOfferStatus(c context.Context, id string, status entity.OfferStatus, respondedAt *time.Time, updatedAt time.Time) (bool, error)
+		ExpireSentOffers(c context.Context, now time.Time) ([]string, error)
+	}
+
+	ScreeningQuestions interface {
+		ReplaceScreeningQuestions(c context.Context, jobVacancyID string, questions []entity.ScreeningQuestion) error
+		GetScreeningQuestionsByJobVacancyID(c context.Context, jobVacancyID string) ([]entity.ScreeningQuestion, error)
+		CreateScreeningAnswers(c context.Context, answers []entity.ScreeningAnswer) error
+		GetScreeningAnswersByJobApplicationID(c context.Context, jobApplicationID string) ([]entity.ScreeningAnswer, error)
 	}
 
 	Commit   func() error
@@ -86,3 +208,53 @@ type jobVacanciesRepository struct {
 	q   sqlx.ExtContext
 	log *logrus.Logger
 }
+
+type jobApplicationsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type savedJobsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type savedSearchesRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type candidatesRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type talentPoolsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type jobInvitationsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type interviewsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type scorecardsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type offersRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type screeningQuestionsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
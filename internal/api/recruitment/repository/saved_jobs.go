@@ -0,0 +1,122 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+)
+
+func (r *savedJobsRepository) SaveJob(c context.Context, savedJob entity.SavedJob) error {
+	r.log.WithFields(map[string]interface{}{
+		"user_id":        savedJob.UserID,
+		"job_vacancy_id": savedJob.JobVacancyID,
+	}).Debug("Saving job vacancy in database")
+
+	query := r.q.Rebind(querySaveJob)
+
+	_, err := r.q.ExecContext(c, query, savedJob.UserID, savedJob.JobVacancyID, savedJob.CreatedAt)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when saving job vacancy")
+		return err
+	}
+
+	return nil
+}
+
+func (r *savedJobsRepository) DeleteSavedJob(c context.Context, userID string, jobVacancyID string) (bool, error) {
+	r.log.WithFields(map[string]interface{}{
+		"user_id":        userID,
+		"job_vacancy_id": jobVacancyID,
+	}).Debug("Deleting saved job from database")
+
+	query := r.q.Rebind(queryDeleteSavedJob)
+
+	result, err := r.q.ExecContext(c, query, userID, jobVacancyID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when deleting saved job")
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to get rows affected after delete")
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *savedJobsRepository) GetSavedJobsByUserID(c context.Context, userID string) ([]entity.SavedJob, error) {
+	r.log.WithFields(map[string]interface{}{
+		"user_id": userID,
+	}).Debug("Getting saved jobs by user ID")
+
+	query := r.q.Rebind(queryGetSavedJobsByUserID)
+
+	rows, err := r.q.QueryContext(c, query, userID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Database error when getting saved jobs by user ID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var savedJobs []entity.SavedJob
+	for rows.Next() {
+		var (
+			savedUserID  sql.NullString
+			jobVacancyID sql.NullString
+			savedAt      sql.NullTime
+			jv           recruitment.JobVacancyDB
+		)
+		err := rows.Scan(
+			&savedUserID,
+			&jobVacancyID,
+			&savedAt,
+			&jv.ID,
+			&jv.RecruiterID,
+			&jv.Title,
+			&jv.Description,
+			&jv.Requirements,
+			&jv.Location,
+			&jv.JobType,
+			&jv.Deadline,
+			&jv.IsActive,
+			&jv.Headcount,
+			&jv.CreatedAt,
+			&jv.UpdatedAt,
+			&jv.CompanyVerified,
+		)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning saved job row")
+			return nil, err
+		}
+
+		savedJobs = append(savedJobs, entity.SavedJob{
+			UserID:       savedUserID.String,
+			JobVacancyID: jobVacancyID.String,
+			CreatedAt:    savedAt.Time,
+			JobVacancy:   makeJobVacancy(jv),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through saved job rows")
+		return nil, err
+	}
+
+	return savedJobs, nil
+}
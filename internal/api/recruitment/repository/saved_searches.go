@@ -0,0 +1,269 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+func (r *savedSearchesRepository) CreateSavedSearch(c context.Context, search entity.SavedSearch) error {
+	r.log.WithFields(map[string]interface{}{
+		"saved_search_id": search.ID,
+		"user_id":         search.UserID,
+		"frequency":       search.Frequency,
+	}).Debug("Creating saved search in database")
+
+	query, args, err := sqlx.Named(queryCreateSavedSearch, search)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to build SQL query for CreateSavedSearch")
+		return err
+	}
+
+	query = r.q.Rebind(query)
+
+	_, err = r.q.ExecContext(c, query, args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating saved search")
+		return err
+	}
+
+	return nil
+}
+
+func (r *savedSearchesRepository) GetSavedSearchByID(c context.Context, id string) (entity.SavedSearch, error) {
+	r.log.WithFields(map[string]interface{}{
+		"saved_search_id": id,
+	}).Debug("Getting saved search by ID")
+
+	query := r.q.Rebind(queryGetSavedSearchByID)
+
+	rows, err := r.q.QueryContext(c, query, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when getting saved search by ID")
+		return entity.SavedSearch{}, err
+	}
+	defer rows.Close()
+
+	searches, err := scanSavedSearches(rows, r.log)
+	if err != nil {
+		return entity.SavedSearch{}, err
+	}
+
+	if len(searches) == 0 {
+		r.log.WithFields(map[string]interface{}{
+			"id": id,
+		}).Warn("Saved search not found")
+		return entity.SavedSearch{}, nil
+	}
+
+	return searches[0], nil
+}
+
+func (r *savedSearchesRepository) GetSavedSearchByUnsubscribeToken(c context.Context, token string) (entity.SavedSearch, error) {
+	r.log.Debug("Getting saved search by unsubscribe token")
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetSavedSearchByUnsubscribeToken), token)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting saved search by unsubscribe token")
+		return entity.SavedSearch{}, err
+	}
+	defer rows.Close()
+
+	searches, err := scanSavedSearches(rows, r.log)
+	if err != nil || len(searches) == 0 {
+		return entity.SavedSearch{}, err
+	}
+
+	return searches[0], nil
+}
+
+func (r *savedSearchesRepository) GetSavedSearchesByUserID(c context.Context, userID string) ([]entity.SavedSearch, error) {
+	r.log.WithFields(map[string]interface{}{
+		"user_id": userID,
+	}).Debug("Getting saved searches by user ID")
+
+	query := r.q.Rebind(queryGetSavedSearchesByUserID)
+
+	rows, err := r.q.QueryContext(c, query, userID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Database error when getting saved searches by user ID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSavedSearches(rows, r.log)
+}
+
+func (r *savedSearchesRepository) GetActiveSavedSearchesByFrequency(c context.Context, frequency entity.SavedSearchFrequency) ([]entity.SavedSearch, error) {
+	r.log.WithFields(map[string]interface{}{
+		"frequency": frequency,
+	}).Debug("Getting active saved searches by frequency")
+
+	query := r.q.Rebind(queryGetActiveSavedSearchesByFrequency)
+
+	rows, err := r.q.QueryContext(c, query, string(frequency))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":     err.Error(),
+			"frequency": frequency,
+		}).Error("Database error when getting active saved searches")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSavedSearches(rows, r.log)
+}
+
+func (r *savedSearchesRepository) DeleteSavedSearch(c context.Context, id string) error {
+	r.log.WithFields(map[string]interface{}{
+		"saved_search_id": id,
+	}).Debug("Deleting saved search from database")
+
+	query := r.q.Rebind(queryDeleteSavedSearch)
+
+	result, err := r.q.ExecContext(c, query, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when deleting saved search")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to get rows affected after delete")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		r.log.WithFields(map[string]interface{}{
+			"id": id,
+		}).Warn("No saved search was deleted")
+		return fmt.Errorf("saved search with ID %s not found", id)
+	}
+
+	return nil
+}
+
+func (r *savedSearchesRepository) UnsubscribeSavedSearch(c context.Context, token string, now time.Time) (bool, error) {
+	r.log.Debug("Unsubscribing saved search by token")
+
+	query := r.q.Rebind(queryUnsubscribeSavedSearch)
+
+	result, err := r.q.ExecContext(c, query, now, token)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when unsubscribing saved search")
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to get rows affected after unsubscribe")
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *savedSearchesRepository) UpdateSavedSearchLastNotified(c context.Context, id string, notifiedAt time.Time) error {
+	r.log.WithFields(map[string]interface{}{
+		"saved_search_id": id,
+		"notified_at":     notifiedAt,
+	}).Debug("Updating saved search last notified timestamp")
+
+	query := r.q.Rebind(queryUpdateSavedSearchLastNotified)
+
+	_, err := r.q.ExecContext(c, query, notifiedAt, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when updating saved search last notified timestamp")
+		return err
+	}
+
+	return nil
+}
+
+func scanSavedSearches(rows *sql.Rows, log *logrus.Logger) ([]entity.SavedSearch, error) {
+	var searches []entity.SavedSearch
+	for rows.Next() {
+		var ss recruitment.SavedSearchDB
+		err := rows.Scan(
+			&ss.ID,
+			&ss.UserID,
+			&ss.Name,
+			&ss.Query,
+			&ss.Location,
+			&ss.JobType,
+			&ss.Frequency,
+			&ss.UnsubscribeToken,
+			&ss.IsActive,
+			&ss.LastNotifiedAt,
+			&ss.CreatedAt,
+			&ss.UpdatedAt,
+		)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning saved search row")
+			return nil, err
+		}
+		searches = append(searches, makeSavedSearch(ss))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through saved search rows")
+		return nil, err
+	}
+
+	return searches, nil
+}
+
+func makeSavedSearch(ss recruitment.SavedSearchDB) entity.SavedSearch {
+	search := entity.SavedSearch{
+		ID:               ss.ID.String,
+		UserID:           ss.UserID.String,
+		Name:             ss.Name.String,
+		Query:            ss.Query.String,
+		Location:         ss.Location.String,
+		JobType:          ss.JobType.String,
+		Frequency:        entity.SavedSearchFrequency(ss.Frequency.String),
+		UnsubscribeToken: ss.UnsubscribeToken.String,
+		IsActive:         ss.IsActive.Bool,
+		CreatedAt:        ss.CreatedAt.Time,
+		UpdatedAt:        ss.UpdatedAt.Time,
+	}
+
+	if ss.LastNotifiedAt.Valid {
+		lastNotifiedAt := ss.LastNotifiedAt.Time
+		search.LastNotifiedAt = &lastNotifiedAt
+	}
+
+	return search
+}
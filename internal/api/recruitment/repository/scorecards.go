@@ -0,0 +1,315 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+func (r *scorecardsRepository) CreateScorecardTemplate(c context.Context, template entity.ScorecardTemplate) error {
+	r.log.WithFields(map[string]interface{}{
+		"template_id":    template.ID,
+		"job_vacancy_id": template.JobVacancyID,
+	}).Debug("Creating scorecard template in database")
+
+	query, args, err := sqlx.Named(queryCreateScorecardTemplate, template)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to build SQL query for CreateScorecardTemplate")
+		return err
+	}
+
+	_, err = r.q.ExecContext(c, r.q.Rebind(query), args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating scorecard template")
+		return err
+	}
+
+	return r.insertCompetencies(c, template.Competencies)
+}
+
+// UpdateScorecardTemplate renames the template and replaces its competencies.
+func (r *scorecardsRepository) UpdateScorecardTemplate(c context.Context, template entity.ScorecardTemplate) error {
+	r.log.WithFields(map[string]interface{}{
+		"template_id": template.ID,
+	}).Debug("Updating scorecard template in database")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryUpdateScorecardTemplate), template.Name, template.UpdatedAt, template.ID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    template.ID,
+		}).Error("Database error when updating scorecard template")
+		return err
+	}
+
+	_, err = r.q.ExecContext(c, r.q.Rebind(queryDeleteScorecardCompetencies), template.ID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    template.ID,
+		}).Error("Database error when deleting scorecard competencies")
+		return err
+	}
+
+	return r.insertCompetencies(c, template.Competencies)
+}
+
+func (r *scorecardsRepository) GetScorecardTemplateByJobVacancyID(c context.Context, jobVacancyID string) (entity.ScorecardTemplate, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": jobVacancyID,
+	}).Debug("Getting scorecard template by job vacancy ID")
+
+	var (
+		template  entity.ScorecardTemplate
+		updatedAt sql.NullTime
+	)
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryGetScorecardTemplateByJobVacancyID), jobVacancyID).Scan(
+		&template.ID,
+		&template.JobVacancyID,
+		&template.CompanyID,
+		&template.Name,
+		&template.CreatedAt,
+		&updatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.ScorecardTemplate{}, nil
+		}
+
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Database error when getting scorecard template")
+		return entity.ScorecardTemplate{}, err
+	}
+	template.UpdatedAt = updatedAt.Time
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetScorecardCompetencies), template.ID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    template.ID,
+		}).Error("Database error when getting scorecard competencies")
+		return entity.ScorecardTemplate{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			competency  entity.ScorecardCompetency
+			description sql.NullString
+			labels      pq.StringArray
+		)
+		err := rows.Scan(&competency.ID, &competency.TemplateID, &competency.Name, &description,
+			&competency.ScaleMax, &labels, &competency.Position)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning scorecard competency row")
+			return entity.ScorecardTemplate{}, err
+		}
+		competency.Description = description.String
+		competency.ScaleLabels = []string(labels)
+		template.Competencies = append(template.Competencies, competency)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through scorecard competency rows")
+		return entity.ScorecardTemplate{}, err
+	}
+
+	return template, nil
+}
+
+func (r *scorecardsRepository) DeleteScorecardTemplate(c context.Context, id string) error {
+	r.log.WithFields(map[string]interface{}{
+		"template_id": id,
+	}).Debug("Deleting scorecard template")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryDeleteScorecardTemplate), id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when deleting scorecard template")
+		return err
+	}
+
+	return nil
+}
+
+func (r *scorecardsRepository) CountScorecardsByTemplateID(c context.Context, templateID string) (int, error) {
+	var count int
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryCountScorecardsByTemplateID), templateID).Scan(&count)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"template_id": templateID,
+		}).Error("Database error when counting scorecards")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// UpsertScorecard stores the interviewer's scorecard, replacing a previous
+// submission for the same application, and returns the row's ID.
+func (r *scorecardsRepository) UpsertScorecard(c context.Context, scorecard entity.Scorecard) (string, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_application_id": scorecard.JobApplicationID,
+		"interviewer_id":     scorecard.InterviewerID,
+	}).Debug("Saving scorecard in database")
+
+	var id string
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryUpsertScorecard),
+		scorecard.ID,
+		scorecard.JobApplicationID,
+		scorecard.TemplateID,
+		scorecard.InterviewerID,
+		scorecard.Recommendation,
+		nullString(scorecard.Notes),
+		scorecard.SubmittedAt,
+		scorecard.UpdatedAt,
+	).Scan(&id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when saving scorecard")
+		return "", err
+	}
+
+	_, err = r.q.ExecContext(c, r.q.Rebind(queryDeleteScorecardRatings), id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when deleting scorecard ratings")
+		return "", err
+	}
+
+	for _, rating := range scorecard.Ratings {
+		_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateScorecardRating),
+			id, rating.CompetencyID, rating.Rating, nullString(rating.Comment))
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}).Error("Database error when creating scorecard rating")
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+func (r *scorecardsRepository) GetScorecardsByJobApplicationIDs(c context.Context, jobApplicationIDs []string) ([]entity.Scorecard, error) {
+	if len(jobApplicationIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetScorecardsByJobApplicationIDs), pq.Array(jobApplicationIDs))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting scorecards")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scorecards []entity.Scorecard
+	index := map[string]int{}
+	for rows.Next() {
+		var (
+			scorecard entity.Scorecard
+			notes     sql.NullString
+			updatedAt sql.NullTime
+		)
+		err := rows.Scan(&scorecard.ID, &scorecard.JobApplicationID, &scorecard.TemplateID, &scorecard.InterviewerID,
+			&scorecard.Recommendation, &notes, &scorecard.SubmittedAt, &updatedAt)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning scorecard row")
+			return nil, err
+		}
+		scorecard.Notes = notes.String
+		scorecard.UpdatedAt = updatedAt.Time
+		index[scorecard.ID] = len(scorecards)
+		scorecards = append(scorecards, scorecard)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through scorecard rows")
+		return nil, err
+	}
+
+	if len(scorecards) == 0 {
+		return scorecards, nil
+	}
+
+	ids := make([]string, len(scorecards))
+	for i, scorecard := range scorecards {
+		ids[i] = scorecard.ID
+	}
+
+	ratingRows, err := r.q.QueryContext(c, r.q.Rebind(queryGetScorecardRatings), pq.Array(ids))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting scorecard ratings")
+		return nil, err
+	}
+	defer ratingRows.Close()
+
+	for ratingRows.Next() {
+		var (
+			rating  entity.ScorecardRating
+			comment sql.NullString
+		)
+		if err := ratingRows.Scan(&rating.ScorecardID, &rating.CompetencyID, &rating.Rating, &comment); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning scorecard rating row")
+			return nil, err
+		}
+		rating.Comment = comment.String
+		i := index[rating.ScorecardID]
+		scorecards[i].Ratings = append(scorecards[i].Ratings, rating)
+	}
+
+	return scorecards, ratingRows.Err()
+}
+
+func (r *scorecardsRepository) insertCompetencies(c context.Context, competencies []entity.ScorecardCompetency) error {
+	for _, competency := range competencies {
+		_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateScorecardCompetency),
+			competency.ID,
+			competency.TemplateID,
+			competency.Name,
+			nullString(competency.Description),
+			competency.ScaleMax,
+			pq.Array(competency.ScaleLabels),
+			competency.Position,
+		)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error":       err.Error(),
+				"template_id": competency.TemplateID,
+			}).Error("Database error when creating scorecard competency")
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"github.com/lib/pq"
+)
+
+// ReplaceScreeningQuestions swaps the vacancy's questions for the given set.
+func (r *screeningQuestionsRepository) ReplaceScreeningQuestions(c context.Context, jobVacancyID string, questions []entity.ScreeningQuestion) error {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": jobVacancyID,
+		"count":          len(questions),
+	}).Debug("Replacing screening questions in database")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryDeleteScreeningQuestions), jobVacancyID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Database error when deleting screening questions")
+		return err
+	}
+
+	for _, question := range questions {
+		_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateScreeningQuestion),
+			question.ID,
+			question.JobVacancyID,
+			question.Prompt,
+			question.Type,
+			pq.Array(question.Options),
+			question.Required,
+			pq.Array(question.KnockoutAnswers),
+			question.KnockoutMin,
+			question.KnockoutMax,
+			nullString(string(question.KnockoutAction)),
+			question.Position,
+			question.CreatedAt,
+		)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error":          err.Error(),
+				"job_vacancy_id": jobVacancyID,
+			}).Error("Database error when creating screening question")
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *screeningQuestionsRepository) GetScreeningQuestionsByJobVacancyID(c context.Context, jobVacancyID string) ([]entity.ScreeningQuestion, error) {
+	r.log.WithFields(map[string]interface{}{
+		"job_vacancy_id": jobVacancyID,
+	}).Debug("Getting screening questions by job vacancy ID")
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetScreeningQuestionsByJobVacancyID), jobVacancyID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Database error when getting screening questions")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []entity.ScreeningQuestion
+	for rows.Next() {
+		var (
+			question        entity.ScreeningQuestion
+			options         pq.StringArray
+			knockoutAnswers pq.StringArray
+			knockoutMin     sql.NullFloat64
+			knockoutMax     sql.NullFloat64
+			knockoutAction  sql.NullString
+		)
+		err := rows.Scan(&question.ID, &question.JobVacancyID, &question.Prompt, &question.Type, &options,
+			&question.Required, &knockoutAnswers, &knockoutMin, &knockoutMax, &knockoutAction,
+			&question.Position, &question.CreatedAt)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning screening question row")
+			return nil, err
+		}
+		question.Options = []string(options)
+		question.KnockoutAnswers = []string(knockoutAnswers)
+		question.KnockoutMin = nullFloat(knockoutMin)
+		question.KnockoutMax = nullFloat(knockoutMax)
+		question.KnockoutAction = entity.KnockoutAction(knockoutAction.String)
+		questions = append(questions, question)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through screening question rows")
+		return nil, err
+	}
+
+	return questions, nil
+}
+
+func (r *screeningQuestionsRepository) CreateScreeningAnswers(c context.Context, answers []entity.ScreeningAnswer) error {
+	for _, answer := range answers {
+		_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateScreeningAnswer),
+			answer.ID,
+			answer.JobApplicationID,
+			answer.QuestionID,
+			pq.Array(answer.Values),
+			answer.Number,
+			nullString(answer.FileURL),
+			answer.KnockedOut,
+			answer.CreatedAt,
+		)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error":              err.Error(),
+				"job_application_id": answer.JobApplicationID,
+				"question_id":        answer.QuestionID,
+			}).Error("Database error when creating screening answer")
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *screeningQuestionsRepository) GetScreeningAnswersByJobApplicationID(c context.Context, jobApplicationID string) ([]entity.ScreeningAnswer, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetScreeningAnswersByJobApplicationID), jobApplicationID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":              err.Error(),
+			"job_application_id": jobApplicationID,
+		}).Error("Database error when getting screening answers")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var answers []entity.ScreeningAnswer
+	for rows.Next() {
+		var (
+			answer  entity.ScreeningAnswer
+			values  pq.StringArray
+			number  sql.NullFloat64
+			fileURL sql.NullString
+		)
+		err := rows.Scan(&answer.ID, &answer.JobApplicationID, &answer.QuestionID, &values, &number,
+			&fileURL, &answer.KnockedOut, &answer.CreatedAt)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning screening answer row")
+			return nil, err
+		}
+		answer.Values = []string(values)
+		answer.Number = nullFloat(number)
+		answer.FileURL = fileURL.String
+		answers = append(answers, answer)
+	}
+
+	return answers, rows.Err()
+}
+
+func nullFloat(value sql.NullFloat64) *float64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Float64
+}
@@ -0,0 +1,385 @@
+package recruitmentRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *talentPoolsRepository) CreateTalentPool(c context.Context, pool entity.TalentPool) error {
+	r.log.WithFields(map[string]interface{}{
+		"talent_pool_id": pool.ID,
+		"company_id":     pool.CompanyID,
+		"name":           pool.Name,
+	}).Debug("Creating talent pool in database")
+
+	query, args, err := sqlx.Named(queryCreateTalentPool, pool)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to build SQL query for CreateTalentPool")
+		return err
+	}
+
+	query = r.q.Rebind(query)
+
+	_, err = r.q.ExecContext(c, query, args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating talent pool")
+		return err
+	}
+
+	return nil
+}
+
+func (r *talentPoolsRepository) GetTalentPoolByID(c context.Context, id string) (entity.TalentPool, error) {
+	r.log.WithFields(map[string]interface{}{
+		"talent_pool_id": id,
+	}).Debug("Getting talent pool by ID")
+
+	query := r.q.Rebind(queryGetTalentPoolByID)
+
+	rows, err := r.q.QueryContext(c, query, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Database error when getting talent pool by ID")
+		return entity.TalentPool{}, err
+	}
+	defer rows.Close()
+
+	pools, err := scanTalentPools(rows, r.log)
+	if err != nil {
+		return entity.TalentPool{}, err
+	}
+
+	if len(pools) == 0 {
+		r.log.WithFields(map[string]interface{}{
+			"id": id,
+		}).Warn("Talent pool not found")
+		return entity.TalentPool{}, nil
+	}
+
+	return pools[0], nil
+}
+
+func (r *talentPoolsRepository) GetTalentPoolsByCompanyID(c context.Context, companyID string) ([]entity.TalentPool, error) {
+	r.log.WithFields(map[string]interface{}{
+		"company_id": companyID,
+	}).Debug("Getting talent pools by company ID")
+
+	query := r.q.Rebind(queryGetTalentPoolsByCompanyID)
+
+	rows, err := r.q.QueryContext(c, query, companyID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when getting talent pools by company ID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTalentPools(rows, r.log)
+}
+
+func (r *talentPoolsRepository) CheckTalentPoolNameExists(c context.Context, companyID string, name string, excludeID string) (bool, error) {
+	r.log.WithFields(map[string]interface{}{
+		"company_id": companyID,
+		"name":       name,
+	}).Debug("Checking if talent pool name exists")
+
+	var exists bool
+	query := r.q.Rebind(queryCheckTalentPoolNameExists)
+	err := r.q.QueryRowxContext(c, query, companyID, name, excludeID).Scan(&exists)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when checking talent pool name existence")
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *talentPoolsRepository) UpdateTalentPool(c context.Context, pool entity.TalentPool) error {
+	r.log.WithFields(map[string]interface{}{
+		"talent_pool_id": pool.ID,
+		"name":           pool.Name,
+	}).Debug("Updating talent pool in database")
+
+	query, args, err := sqlx.Named(queryUpdateTalentPool, pool)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to build SQL query for UpdateTalentPool")
+		return err
+	}
+
+	query = r.q.Rebind(query)
+
+	_, err = r.q.ExecContext(c, query, args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when updating talent pool")
+		return err
+	}
+
+	return nil
+}
+
+func (r *talentPoolsRepository) DeleteTalentPool(c context.Context, id string) error {
+	r.log.WithFields(map[string]interface{}{
+		"talent_pool_id": id,
+	}).Debug("Deleting talent pool from database")
+
+	query := r.q.Rebind(queryDeleteTalentPool)
+
+	result, err := r.q.ExecContext(c, query, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when deleting talent pool")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to get rows affected after delete")
+		return err
+	}
+
+	if rowsAffected == 0 {
+		r.log.WithFields(map[string]interface{}{
+			"id": id,
+		}).Warn("No talent pool was deleted")
+		return fmt.Errorf("talent pool with ID %s not found", id)
+	}
+
+	return nil
+}
+
+func (r *talentPoolsRepository) AddCandidate(c context.Context, candidate entity.TalentPoolCandidate) error {
+	r.log.WithFields(map[string]interface{}{
+		"talent_pool_id": candidate.TalentPoolID,
+		"user_id":        candidate.UserID,
+	}).Debug("Adding candidate to talent pool")
+
+	query := r.q.Rebind(queryAddTalentPoolCandidate)
+
+	_, err := r.q.ExecContext(c, query,
+		candidate.TalentPoolID,
+		candidate.UserID,
+		pq.Array(candidate.Tags),
+		candidate.Note,
+		candidate.CreatedAt,
+		candidate.UpdatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when adding candidate to talent pool")
+		return err
+	}
+
+	return nil
+}
+
+func (r *talentPoolsRepository) GetCandidate(c context.Context, talentPoolID string, userID string) (entity.TalentPoolCandidate, error) {
+	r.log.WithFields(map[string]interface{}{
+		"talent_pool_id": talentPoolID,
+		"user_id":        userID,
+	}).Debug("Getting talent pool candidate")
+
+	query := r.q.Rebind(queryGetTalentPoolCandidate)
+
+	rows, err := r.q.QueryContext(c, query, talentPoolID, userID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting talent pool candidate")
+		return entity.TalentPoolCandidate{}, err
+	}
+	defer rows.Close()
+
+	candidates, err := scanTalentPoolCandidates(rows, r.log)
+	if err != nil {
+		return entity.TalentPoolCandidate{}, err
+	}
+
+	if len(candidates) == 0 {
+		return entity.TalentPoolCandidate{}, nil
+	}
+
+	return candidates[0], nil
+}
+
+func (r *talentPoolsRepository) GetCandidates(c context.Context, talentPoolID string, tag string) ([]entity.TalentPoolCandidate, error) {
+	r.log.WithFields(map[string]interface{}{
+		"talent_pool_id": talentPoolID,
+		"tag":            tag,
+	}).Debug("Getting talent pool candidates")
+
+	query := r.q.Rebind(queryGetTalentPoolCandidates)
+
+	rows, err := r.q.QueryContext(c, query, talentPoolID, tag, tag)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting talent pool candidates")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTalentPoolCandidates(rows, r.log)
+}
+
+func (r *talentPoolsRepository) UpdateCandidate(c context.Context, candidate entity.TalentPoolCandidate) error {
+	r.log.WithFields(map[string]interface{}{
+		"talent_pool_id": candidate.TalentPoolID,
+		"user_id":        candidate.UserID,
+	}).Debug("Updating talent pool candidate")
+
+	query := r.q.Rebind(queryUpdateTalentPoolCandidate)
+
+	_, err := r.q.ExecContext(c, query,
+		pq.Array(candidate.Tags),
+		candidate.Note,
+		candidate.UpdatedAt,
+		candidate.TalentPoolID,
+		candidate.UserID,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when updating talent pool candidate")
+		return err
+	}
+
+	return nil
+}
+
+func (r *talentPoolsRepository) RemoveCandidate(c context.Context, talentPoolID string, userID string) (bool, error) {
+	r.log.WithFields(map[string]interface{}{
+		"talent_pool_id": talentPoolID,
+		"user_id":        userID,
+	}).Debug("Removing candidate from talent pool")
+
+	query := r.q.Rebind(queryRemoveTalentPoolCandidate)
+
+	result, err := r.q.ExecContext(c, query, talentPoolID, userID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when removing candidate from talent pool")
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to get rows affected after delete")
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func scanTalentPools(rows *sql.Rows, log *logrus.Logger) ([]entity.TalentPool, error) {
+	var pools []entity.TalentPool
+	for rows.Next() {
+		var (
+			id, companyID, name, description sql.NullString
+			createdAt, updatedAt             sql.NullTime
+			candidateCount                   int
+		)
+		err := rows.Scan(&id, &companyID, &name, &description, &createdAt, &updatedAt, &candidateCount)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning talent pool row")
+			return nil, err
+		}
+
+		pools = append(pools, entity.TalentPool{
+			ID:             id.String,
+			CompanyID:      companyID.String,
+			Name:           name.String,
+			Description:    description.String,
+			CandidateCount: candidateCount,
+			CreatedAt:      createdAt.Time,
+			UpdatedAt:      updatedAt.Time,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through talent pool rows")
+		return nil, err
+	}
+
+	return pools, nil
+}
+
+func scanTalentPoolCandidates(rows *sql.Rows, log *logrus.Logger) ([]entity.TalentPoolCandidate, error) {
+	var candidates []entity.TalentPoolCandidate
+	for rows.Next() {
+		var (
+			talentPoolID, userID, note               sql.NullString
+			tags                                     pq.StringArray
+			createdAt, updatedAt                     sql.NullTime
+			name, headline, location, profilePicture sql.NullString
+		)
+		err := rows.Scan(&talentPoolID, &userID, &tags, &note, &createdAt, &updatedAt,
+			&name, &headline, &location, &profilePicture)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning talent pool candidate row")
+			return nil, err
+		}
+
+		candidates = append(candidates, entity.TalentPoolCandidate{
+			TalentPoolID: talentPoolID.String,
+			UserID:       userID.String,
+			Tags:         []string(tags),
+			Note:         note.String,
+			CreatedAt:    createdAt.Time,
+			UpdatedAt:    updatedAt.Time,
+			User: entity.User{
+				ID:             userID.String,
+				Name:           name.String,
+				Headline:       headline.String,
+				Location:       location.String,
+				ProfilePicture: profilePicture.String,
+			},
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through talent pool candidate rows")
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// nullString stores empty optional references as NULL so foreign keys hold.
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -0,0 +1,29 @@
+package recruitment
+
+import (
+	"ProjectGolang/internal/entity"
+	"strings"
+)
+
+// MatchSavedSearch reports whether a vacancy satisfies a saved search. Every
+// word of the query has to appear in the title, description or requirements;
+// location is a substring match and job type an exact one.
+func MatchSavedSearch(search entity.SavedSearch, jv entity.JobVacancy) bool {
+	if search.JobType != "" && search.JobType != jv.JobType {
+		return false
+	}
+
+	location := strings.ToLower(strings.TrimSpace(search.Location))
+	if location != "" && !strings.Contains(strings.ToLower(jv.Location), location) {
+		return false
+	}
+
+	body := strings.ToLower(strings.Join([]string{jv.Title, jv.Description, jv.Requirements}, " "))
+	for _, word := range strings.Fields(strings.ToLower(search.Query)) {
+		if !strings.Contains(body, word) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,76 @@
+package recruitment
+
+import (
+	"ProjectGolang/internal/entity"
+	"testing"
+)
+
+func TestMatchSavedSearch(t *testing.T) {
+	vacancy := entity.JobVacancy{
+		Title:        "Senior Backend Engineer",
+		Description:  "Build payment services",
+		Requirements: "Go and PostgreSQL",
+		Location:     "Jakarta Selatan",
+		JobType:      "FULL_TIME",
+	}
+
+	tests := []struct {
+		name   string
+		search entity.SavedSearch
+		want   bool
+	}{
+		{
+			name:   "empty search matches everything",
+			search: entity.SavedSearch{},
+			want:   true,
+		},
+		{
+			name:   "every query word across title, description and requirements",
+			search: entity.SavedSearch{Query: "backend payment postgresql"},
+			want:   true,
+		},
+		{
+			name:   "query is case insensitive",
+			search: entity.SavedSearch{Query: "BACKEND Engineer"},
+			want:   true,
+		},
+		{
+			name:   "one missing query word fails",
+			search: entity.SavedSearch{Query: "backend rust"},
+			want:   false,
+		},
+		{
+			name:   "location is a substring match",
+			search: entity.SavedSearch{Location: " jakarta "},
+			want:   true,
+		},
+		{
+			name:   "other location fails",
+			search: entity.SavedSearch{Location: "Bandung"},
+			want:   false,
+		},
+		{
+			name:   "same job type",
+			search: entity.SavedSearch{JobType: "FULL_TIME"},
+			want:   true,
+		},
+		{
+			name:   "job type is exact",
+			search: entity.SavedSearch{JobType: "REMOTE"},
+			want:   false,
+		},
+		{
+			name:   "all criteria together",
+			search: entity.SavedSearch{Query: "engineer", Location: "jakarta", JobType: "FULL_TIME"},
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchSavedSearch(tt.search, vacancy); got != tt.want {
+				t.Errorf("MatchSavedSearch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
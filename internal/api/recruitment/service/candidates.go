@@ -0,0 +1,105 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/api/recruitment"
+	"context"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *candidateImpl) SearchCandidates(c context.Context, req recruitment.SearchCandidates) (recruitment.PaginatedCandidatesResponse, error) {
+	requirements, err := bio.ParseLanguageRequirements(req.Languages)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":     err.Error(),
+			"languages": req.Languages,
+		}).Warn("Invalid language filter")
+		return recruitment.PaginatedCandidatesResponse{}, err
+	}
+	req.LanguageRequirements = requirements
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.PaginatedCandidatesResponse{}, err
+	}
+
+	candidates, totalCount, err := repo.Candidates.SearchCandidates(c, req)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to search candidates")
+		return recruitment.PaginatedCandidatesResponse{}, err
+	}
+
+	userIDs := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		userIDs[i] = candidate.ID
+	}
+
+	experiences, err := repo.Candidates.GetLatestExperiences(c, userIDs)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to get latest experiences for candidates")
+		return recruitment.PaginatedCandidatesResponse{}, err
+	}
+
+	educations, err := repo.Candidates.GetLatestEducations(c, userIDs)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to get latest educations for candidates")
+		return recruitment.PaginatedCandidatesResponse{}, err
+	}
+
+	cards := make([]recruitment.CandidateCardResponse, len(candidates))
+	for i, candidate := range candidates {
+		cards[i] = recruitment.CandidateCardResponse{
+			ID:             candidate.ID,
+			Name:           candidate.Name,
+			Headline:       candidate.Headline,
+			Location:       candidate.Location,
+			ProfilePicture: candidate.ProfilePicture,
+		}
+
+		if exp, ok := experiences[candidate.ID]; ok {
+			cards[i].LatestExperience = &recruitment.CandidateExperience{
+				ID:          exp.ID,
+				JobTitle:    exp.JobTitle,
+				JobLocation: exp.JobLocation,
+				SkillUsed:   exp.SkillUsed,
+				StartDate:   exp.StartDate.Format(bio.YearMonthLayout),
+				EndDate:     bio.FormatYearMonth(exp.EndDate),
+				IsCurrent:   exp.IsCurrent,
+			}
+		}
+
+		if edu, ok := educations[candidate.ID]; ok {
+			cards[i].LatestEducation = &recruitment.CandidateEducation{
+				ID:                edu.ID,
+				TitleDegree:       edu.TitleDegree,
+				InstitutionalName: edu.InstitutionalName,
+				StartDate:         edu.StartDate.Format(bio.YearMonthLayout),
+				EndDate:           bio.FormatYearMonth(edu.EndDate),
+				IsCurrent:         edu.IsCurrent,
+			}
+		}
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"page":  req.Page,
+		"size":  req.PageSize,
+		"total": totalCount,
+	}).Info("Candidate search completed successfully")
+
+	return recruitment.PaginatedCandidatesResponse{
+		Candidates:  cards,
+		TotalCount:  totalCount,
+		TotalPages:  totalPages(totalCount, req.PageSize),
+		CurrentPage: req.Page,
+		PageSize:    req.PageSize,
+	}, nil
+}
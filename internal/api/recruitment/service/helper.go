@@ -1 +1,469 @@
 package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/api/skill"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/realtime"
+	"context"
+	"github.com/sirupsen/logrus"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	skillMatchWeight    = 3.0
+	headlineMatchWeight = 2.0
+	locationMatchWeight = 2.0
+	historyMatchWeight  = 1.0
+	remoteBonusWeight   = 1.0
+)
+
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "at": {}, "for": {}, "in": {}, "of": {},
+	"on": {}, "or": {}, "the": {}, "to": {}, "with": {}, "di": {}, "dan": {},
+	"yang": {}, "untuk": {},
+}
+
+// candidateSignals is everything about a candidate that feeds the ranking.
+// Skills are the profile's catalogue skills, so aliases already resolved to
+// one ID.
+type candidateSignals struct {
+	skills       []entity.Skill
+	headline     map[string]struct{}
+	location     string
+	historyTerms map[string]struct{}
+	historyTypes map[string]struct{}
+}
+
+// newCandidateSignals folds saved vacancies into the same history signal as
+// applications: both say "more like this".
+func newCandidateSignals(user entity.User, skills []entity.Skill, experiences []entity.Experience, applied []entity.JobVacancy, saved []entity.JobVacancy) candidateSignals {
+	signals := candidateSignals{
+		skills:       skills,
+		headline:     tokenize(user.Headline),
+		location:     strings.ToLower(strings.TrimSpace(user.Location)),
+		historyTerms: map[string]struct{}{},
+		historyTypes: map[string]struct{}{},
+	}
+
+	for _, exp := range experiences {
+		for term := range tokenize(exp.JobTitle) {
+			signals.headline[term] = struct{}{}
+		}
+	}
+
+	for _, jv := range append(applied, saved...) {
+		for term := range tokenize(jv.Title) {
+			signals.historyTerms[term] = struct{}{}
+		}
+		signals.historyTypes[jv.JobType] = struct{}{}
+	}
+
+	return signals
+}
+
+// scoreJobVacancy matches skills by catalogue ID against the vacancy's
+// required skills. Vacancies without any fall back to finding the skill's
+// name in their text.
+func scoreJobVacancy(signals candidateSignals, jv entity.JobVacancy) (float64, []string) {
+	var score float64
+	matchedSkills := []string{}
+
+	required := make(map[string]struct{}, len(jv.Skills))
+	for _, skill := range jv.Skills {
+		required[skill.ID] = struct{}{}
+	}
+
+	body := strings.ToLower(strings.Join([]string{jv.Title, jv.Description, jv.Requirements}, " "))
+	bodyTerms := tokenize(body)
+	for _, skill := range signals.skills {
+		matched := false
+		if len(required) > 0 {
+			_, matched = required[skill.ID]
+		} else {
+			matched = containsSkill(body, bodyTerms, skill.NormalizedName)
+		}
+
+		if matched {
+			score += skillMatchWeight
+			matchedSkills = append(matchedSkills, skill.Name)
+		}
+	}
+
+	titleTerms := tokenize(jv.Title)
+	for term := range titleTerms {
+		if _, ok := signals.headline[term]; ok {
+			score += headlineMatchWeight
+		}
+		if _, ok := signals.historyTerms[term]; ok {
+			score += historyMatchWeight
+		}
+	}
+
+	if _, ok := signals.historyTypes[jv.JobType]; ok {
+		score += historyMatchWeight
+	}
+
+	location := strings.ToLower(jv.Location)
+	if signals.location != "" && location != "" &&
+		(strings.Contains(location, signals.location) || strings.Contains(signals.location, location)) {
+		score += locationMatchWeight
+	}
+
+	if jv.JobType == "REMOTE" {
+		score += remoteBonusWeight
+	}
+
+	return score, matchedSkills
+}
+
+func rankJobVacancies(signals candidateSignals, vacancies []entity.JobVacancy, exclude map[string]struct{}) []recruitment.RecommendedJobVacancyResponse {
+	ranked := make([]recruitment.RecommendedJobVacancyResponse, 0, len(vacancies))
+	for _, jv := range vacancies {
+		if _, ok := exclude[jv.ID]; ok {
+			continue
+		}
+
+		score, matchedSkills := scoreJobVacancy(signals, jv)
+		ranked = append(ranked, recruitment.RecommendedJobVacancyResponse{
+			JobVacancyResponse: makeJobVacancyResponse(jv),
+			Score:              score,
+			MatchedSkills:      matchedSkills,
+		})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].CreatedAt.After(ranked[j].CreatedAt)
+	})
+
+	return ranked
+}
+
+func makeJobVacancyResponse(jv entity.JobVacancy) recruitment.JobVacancyResponse {
+	return recruitment.JobVacancyResponse{
+		ID:           jv.ID,
+		RecruiterID:  jv.RecruiterID,
+		Title:        jv.Title,
+		Description:  jv.Description,
+		Requirements: jv.Requirements,
+		Location:     jv.Location,
+		JobType:      jv.JobType,
+		Deadline:     jv.Deadline,
+		IsActive:     jv.IsActive,
+		Headcount:    jv.Headcount,
+		CreatedAt:    jv.CreatedAt,
+		UpdatedAt:    jv.UpdatedAt,
+
+		CompanyVerified: jv.CompanyVerified,
+		Skills:          skill.MakeSkillResponses(jv.Skills),
+
+		Languages: makeLanguageRequirementResponses(jv.Languages),
+	}
+}
+
+func makeLanguageRequirementResponses(languages []entity.LanguageRequirement) []recruitment.LanguageRequirementResponse {
+	responses := make([]recruitment.LanguageRequirementResponse, len(languages))
+	for i, language := range languages {
+		responses[i] = recruitment.LanguageRequirementResponse{
+			Language: language.Language,
+			Name:     bio.LanguageName(language.Language),
+			MinLevel: string(language.MinLevel),
+		}
+	}
+	return responses
+}
+
+func makeSavedSearchResponse(ss entity.SavedSearch) recruitment.SavedSearchResponse {
+	return recruitment.SavedSearchResponse{
+		ID:             ss.ID,
+		Name:           ss.Name,
+		Query:          ss.Query,
+		Location:       ss.Location,
+		JobType:        ss.JobType,
+		Frequency:      ss.Frequency,
+		IsActive:       ss.IsActive,
+		LastNotifiedAt: ss.LastNotifiedAt,
+		CreatedAt:      ss.CreatedAt,
+		UpdatedAt:      ss.UpdatedAt,
+	}
+}
+
+func makeTalentPoolResponse(pool entity.TalentPool) recruitment.TalentPoolResponse {
+	return recruitment.TalentPoolResponse{
+		ID:             pool.ID,
+		Name:           pool.Name,
+		Description:    pool.Description,
+		CandidateCount: pool.CandidateCount,
+		CreatedAt:      pool.CreatedAt,
+		UpdatedAt:      pool.UpdatedAt,
+	}
+}
+
+func makeTalentPoolCandidateResponse(candidate entity.TalentPoolCandidate) recruitment.TalentPoolCandidateResponse {
+	return recruitment.TalentPoolCandidateResponse{
+		UserID:         candidate.UserID,
+		Name:           candidate.User.Name,
+		Headline:       candidate.User.Headline,
+		Location:       candidate.User.Location,
+		ProfilePicture: candidate.User.ProfilePicture,
+		Tags:           candidate.Tags,
+		Note:           candidate.Note,
+		CreatedAt:      candidate.CreatedAt,
+		UpdatedAt:      candidate.UpdatedAt,
+	}
+}
+
+func makeJobInvitationResponse(invitation entity.JobInvitation) recruitment.JobInvitationResponse {
+	return recruitment.JobInvitationResponse{
+		ID:         invitation.ID,
+		CompanyID:  invitation.CompanyID,
+		Message:    invitation.Message,
+		Status:     invitation.Status,
+		JobVacancy: makeJobVacancyResponse(invitation.JobVacancy),
+		CreatedAt:  invitation.CreatedAt,
+		UpdatedAt:  invitation.UpdatedAt,
+	}
+}
+
+// normalizeTags lowercases and de-duplicates tags so filtering is exact.
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := map[string]struct{}{}
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+func makeJobApplicationResponse(ja entity.JobApplication) recruitment.JobApplicationResponse {
+	return recruitment.JobApplicationResponse{
+		ID:           ja.ID,
+		JobVacancyID: ja.JobVacancyID,
+		UserID:       ja.UserID,
+		Status:       ja.Status,
+		CoverLetter:  ja.CoverLetter,
+		CreatedAt:    ja.CreatedAt,
+		UpdatedAt:    ja.UpdatedAt,
+	}
+}
+
+func makeInterviewResponse(interview entity.Interview) recruitment.InterviewResponse {
+	slots := make([]recruitment.InterviewSlotResponse, len(interview.Slots))
+	for i, slot := range interview.Slots {
+		slots[i] = recruitment.InterviewSlotResponse{
+			ID:        slot.ID,
+			StartTime: slot.StartTime,
+			EndTime:   slot.EndTime,
+		}
+	}
+
+	interviewers := make([]recruitment.InterviewerResponse, len(interview.Interviewers))
+	for i, interviewer := range interview.Interviewers {
+		interviewers[i] = recruitment.InterviewerResponse{
+			Name:  interviewer.Name,
+			Email: interviewer.Email,
+		}
+	}
+
+	return recruitment.InterviewResponse{
+		ID:               interview.ID,
+		JobApplicationID: interview.JobApplicationID,
+		CompanyID:        interview.CompanyID,
+		Title:            interview.Title,
+		Format:           interview.Format,
+		Location:         interview.Location,
+		MeetingLink:      interview.MeetingLink,
+		Timezone:         interview.Timezone,
+		DurationMinutes:  interview.DurationMinutes,
+		Status:           interview.Status,
+		ScheduledStart:   interview.ScheduledStart,
+		ScheduledEnd:     interview.ScheduledEnd,
+		Slots:            slots,
+		Interviewers:     interviewers,
+		CreatedAt:        interview.CreatedAt,
+		UpdatedAt:        interview.UpdatedAt,
+	}
+}
+
+func makeScorecardTemplateResponse(template entity.ScorecardTemplate) recruitment.ScorecardTemplateResponse {
+	competencies := make([]recruitment.ScorecardCompetencyResponse, len(template.Competencies))
+	for i, competency := range template.Competencies {
+		competencies[i] = recruitment.ScorecardCompetencyResponse{
+			ID:          competency.ID,
+			Name:        competency.Name,
+			Description: competency.Description,
+			ScaleMax:    competency.ScaleMax,
+			ScaleLabels: competency.ScaleLabels,
+		}
+	}
+
+	return recruitment.ScorecardTemplateResponse{
+		ID:           template.ID,
+		JobVacancyID: template.JobVacancyID,
+		Name:         template.Name,
+		Competencies: competencies,
+		CreatedAt:    template.CreatedAt,
+		UpdatedAt:    template.UpdatedAt,
+	}
+}
+
+func makeScorecardResponse(scorecard entity.Scorecard) recruitment.ScorecardResponse {
+	ratings := make([]recruitment.ScorecardRatingResponse, len(scorecard.Ratings))
+	for i, rating := range scorecard.Ratings {
+		ratings[i] = recruitment.ScorecardRatingResponse{
+			CompetencyID: rating.CompetencyID,
+			Rating:       rating.Rating,
+			Comment:      rating.Comment,
+		}
+	}
+
+	return recruitment.ScorecardResponse{
+		ID:             scorecard.ID,
+		InterviewerID:  scorecard.InterviewerID,
+		Recommendation: scorecard.Recommendation,
+		Notes:          scorecard.Notes,
+		Ratings:        ratings,
+		SubmittedAt:    scorecard.SubmittedAt,
+		UpdatedAt:      scorecard.UpdatedAt,
+	}
+}
+
+func makeOfferResponse(offer entity.Offer, now time.Time) recruitment.OfferResponse {
+	return recruitment.OfferResponse{
+		ID:               offer.ID,
+		JobApplicationID: offer.JobApplicationID,
+		JobVacancyID:     offer.JobVacancyID,
+		JobTitle:         offer.JobTitle,
+		CompanyID:        offer.CompanyID,
+		CompanyName:      offer.CompanyName,
+		SalaryAmount:     offer.SalaryAmount,
+		SalaryCurrency:   offer.SalaryCurrency,
+		SalaryPeriod:     offer.SalaryPeriod,
+		StartDate:        offer.StartDate,
+		ExpiresAt:        offer.ExpiresAt,
+		Terms:            offer.Terms,
+		Status:           offerStatus(offer, now),
+		RespondedAt:      offer.RespondedAt,
+		CreatedAt:        offer.CreatedAt,
+		UpdatedAt:        offer.UpdatedAt,
+	}
+}
+
+func makeOfferResponses(offers []entity.Offer) []recruitment.OfferResponse {
+	now := time.Now()
+	responses := make([]recruitment.OfferResponse, len(offers))
+	for i, offer := range offers {
+		responses[i] = makeOfferResponse(offer, now)
+	}
+	return responses
+}
+
+// containsSkill matches single-term skills on whole terms so "go" does not hit
+// "good"; anything else ("machine learning", "node.js") is matched as a phrase.
+func containsSkill(body string, bodyTerms map[string]struct{}, skill string) bool {
+	if _, single := tokenize(skill)[skill]; single {
+		_, ok := bodyTerms[skill]
+		return ok
+	}
+	return strings.Contains(body, skill)
+}
+
+func tokenize(text string) map[string]struct{} {
+	terms := map[string]struct{}{}
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#'
+	})
+	for _, field := range fields {
+		if len(field) < 2 {
+			continue
+		}
+		if _, ok := stopWords[field]; ok {
+			continue
+		}
+		terms[field] = struct{}{}
+	}
+	return terms
+}
+
+func totalPages(totalCount, pageSize int) int {
+	pages := totalCount / pageSize
+	if totalCount%pageSize > 0 {
+		pages++
+	}
+	return pages
+}
+
+// makeScreeningQuestionResponses leaves out the knockout criteria unless
+// withKnockout is set, so applicants cannot tailor their answers.
+func makeScreeningQuestionResponses(questions []entity.ScreeningQuestion, withKnockout bool) []recruitment.ScreeningQuestionResponse {
+	responses := make([]recruitment.ScreeningQuestionResponse, len(questions))
+	for i, question := range questions {
+		responses[i] = recruitment.ScreeningQuestionResponse{
+			ID:       question.ID,
+			Prompt:   question.Prompt,
+			Type:     question.Type,
+			Options:  question.Options,
+			Required: question.Required,
+		}
+		if question.Type == entity.ScreeningQuestionYesNo {
+			responses[i].Options = yesNoOptions
+		}
+		if withKnockout && question.KnockoutAction != "" {
+			responses[i].Knockout = &recruitment.ScreeningKnockoutResponse{
+				Answers: question.KnockoutAnswers,
+				Min:     question.KnockoutMin,
+				Max:     question.KnockoutMax,
+				Action:  question.KnockoutAction,
+			}
+		}
+	}
+	return responses
+}
+
+// publishEvent pushes an event to the user's open realtime connections.
+// Delivery is best effort, so failures are only logged.
+func publishEvent(c context.Context, rt realtime.ItfRealtime, log *logrus.Logger, userID string, eventType realtime.EventType, data map[string]interface{}) {
+	if err := rt.Publish(c, userID, realtime.Event{Type: eventType, Data: data}); err != nil {
+		log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+			"type":    eventType,
+		}).Warn("Failed to publish realtime event")
+	}
+}
+
+// applicationStatusData is the webhook payload for an application whose
+// status changed, whichever flow changed it.
+func applicationStatusData(application entity.JobApplication, status entity.ApplicationStatus) map[string]interface{} {
+	return map[string]interface{}{
+		"job_application_id": application.ID,
+		"job_vacancy_id":     application.JobVacancyID,
+		"user_id":            application.UserID,
+		"status":             status,
+	}
+}
+
+// vacancyClosedData is the webhook payload for a vacancy that stopped taking
+// applications. Reason is "closed", "filled" or "deleted".
+func vacancyClosedData(jobVacancy entity.JobVacancy, reason string) map[string]interface{} {
+	return map[string]interface{}{
+		"job_vacancy_id": jobVacancy.ID,
+		"title":          jobVacancy.Title,
+		"reason":         reason,
+	}
+}
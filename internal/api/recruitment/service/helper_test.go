@@ -0,0 +1,159 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/entity"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	skillGo     = entity.Skill{ID: "skill-go", Name: "Go", NormalizedName: "go"}
+	skillPython = entity.Skill{ID: "skill-python", Name: "Python", NormalizedName: "python"}
+	skillML     = entity.Skill{ID: "skill-ml", Name: "Machine Learning", NormalizedName: "machine learning"}
+)
+
+func TestScoreJobVacancy(t *testing.T) {
+	tests := []struct {
+		name        string
+		user        entity.User
+		skills      []entity.Skill
+		experiences []entity.Experience
+		applied     []entity.JobVacancy
+		vacancy     entity.JobVacancy
+		wantScore   float64
+		wantMatched []string
+	}{
+		{
+			name:        "required skill matched by ID",
+			skills:      []entity.Skill{skillGo, skillPython},
+			vacancy:     entity.JobVacancy{Title: "Platform", Skills: []entity.Skill{skillGo}},
+			wantScore:   skillMatchWeight,
+			wantMatched: []string{"Go"},
+		},
+		{
+			name:   "required skills ignore the vacancy text",
+			skills: []entity.Skill{skillGo, skillPython},
+			vacancy: entity.JobVacancy{
+				Title:       "Platform",
+				Description: "Our services are written in Go",
+				Skills:      []entity.Skill{skillPython},
+			},
+			wantScore:   skillMatchWeight,
+			wantMatched: []string{"Python"},
+		},
+		{
+			name:        "falls back to single word skill in text",
+			skills:      []entity.Skill{skillGo, skillPython},
+			vacancy:     entity.JobVacancy{Title: "Platform", Requirements: "Go, PostgreSQL"},
+			wantScore:   skillMatchWeight,
+			wantMatched: []string{"Go"},
+		},
+		{
+			name:        "single word skill must be a whole term",
+			skills:      []entity.Skill{skillGo},
+			vacancy:     entity.JobVacancy{Title: "Platform", Description: "A good place to grow"},
+			wantScore:   0,
+			wantMatched: []string{},
+		},
+		{
+			name:        "falls back to multi word skill in text",
+			skills:      []entity.Skill{skillML},
+			vacancy:     entity.JobVacancy{Title: "Research", Description: "Apply machine learning to hiring"},
+			wantScore:   skillMatchWeight,
+			wantMatched: []string{"Machine Learning"},
+		},
+		{
+			name: "headline, location and remote",
+			user: entity.User{Headline: "Backend Engineer", Location: "Jakarta"},
+			vacancy: entity.JobVacancy{
+				Title:    "Senior Backend Engineer",
+				Location: "Jakarta Selatan",
+				JobType:  "REMOTE",
+			},
+			wantScore:   2*headlineMatchWeight + locationMatchWeight + remoteBonusWeight,
+			wantMatched: []string{},
+		},
+		{
+			name:        "experience titles count as headline",
+			experiences: []entity.Experience{{JobTitle: "Data Engineer"}},
+			vacancy:     entity.JobVacancy{Title: "Data Platform"},
+			wantScore:   headlineMatchWeight,
+			wantMatched: []string{},
+		},
+		{
+			name:        "application history",
+			applied:     []entity.JobVacancy{{Title: "Data Analyst", JobType: "FULL_TIME"}},
+			vacancy:     entity.JobVacancy{Title: "Data Analyst Intern", JobType: "FULL_TIME"},
+			wantScore:   3 * historyMatchWeight,
+			wantMatched: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signals := newCandidateSignals(tt.user, tt.skills, tt.experiences, tt.applied, nil)
+
+			score, matched := scoreJobVacancy(signals, tt.vacancy)
+			if score != tt.wantScore {
+				t.Errorf("score = %v, want %v", score, tt.wantScore)
+			}
+			if !reflect.DeepEqual(matched, tt.wantMatched) {
+				t.Errorf("matched = %v, want %v", matched, tt.wantMatched)
+			}
+		})
+	}
+}
+
+func TestRankJobVacancies(t *testing.T) {
+	now := time.Now()
+	signals := newCandidateSignals(entity.User{}, []entity.Skill{skillGo, skillPython}, nil, nil, nil)
+
+	tests := []struct {
+		name      string
+		vacancies []entity.JobVacancy
+		exclude   map[string]struct{}
+		want      []string
+	}{
+		{
+			name: "higher score first",
+			vacancies: []entity.JobVacancy{
+				{ID: "one", Skills: []entity.Skill{skillGo}, CreatedAt: now},
+				{ID: "two", Skills: []entity.Skill{skillGo, skillPython}, CreatedAt: now},
+				{ID: "none", CreatedAt: now},
+			},
+			want: []string{"two", "one", "none"},
+		},
+		{
+			name: "ties go to the newest",
+			vacancies: []entity.JobVacancy{
+				{ID: "old", Skills: []entity.Skill{skillGo}, CreatedAt: now.Add(-time.Hour)},
+				{ID: "new", Skills: []entity.Skill{skillGo}, CreatedAt: now},
+			},
+			want: []string{"new", "old"},
+		},
+		{
+			name: "excluded vacancies are dropped",
+			vacancies: []entity.JobVacancy{
+				{ID: "applied", Skills: []entity.Skill{skillGo, skillPython}, CreatedAt: now},
+				{ID: "open", CreatedAt: now},
+			},
+			exclude: map[string]struct{}{"applied": {}},
+			want:    []string{"open"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked := rankJobVacancies(signals, tt.vacancies, tt.exclude)
+
+			got := make([]string, len(ranked))
+			for i, jv := range ranked {
+				got[i] = jv.ID
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,665 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/api/recruitment"
+	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/ical"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/smtp"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"html"
+	"strings"
+	"time"
+)
+
+const interviewUIDDomain = "arkavidia-academy"
+
+func (s *interviewImpl) CreateInterview(c context.Context, req recruitment.CreateInterview) (recruitment.InterviewResponse, error) {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.InterviewResponse{}, err
+	}
+	defer repo.Rollback()
+
+	application, err := getOwnedJobApplication(c, repo, s.log, req.JobApplicationID, req.CompanyID)
+	if err != nil {
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if application.Status != entity.ApplicationStatusInterview {
+		return recruitment.InterviewResponse{}, recruitment.ErrorApplicationNotInterview
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return recruitment.InterviewResponse{}, err
+	}
+
+	slots, err := makeInterviewSlots(id, req.Slots, req.DurationMinutes, now)
+	if err != nil {
+		return recruitment.InterviewResponse{}, err
+	}
+
+	interview := entity.Interview{
+		ID:               id,
+		JobApplicationID: application.ID,
+		CompanyID:        req.CompanyID,
+		Title:            req.Title,
+		Format:           req.Format,
+		Location:         req.Location,
+		MeetingLink:      req.MeetingLink,
+		Timezone:         req.Timezone,
+		DurationMinutes:  req.DurationMinutes,
+		Status:           entity.InterviewStatusProposed,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		Slots:            slots,
+		Interviewers:     makeInterviewers(id, req.Interviewers),
+	}
+
+	if err := repo.Interviews.CreateInterview(c, interview); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":              err.Error(),
+			"job_application_id": application.ID,
+		}).Error("Failed to create interview")
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if err := repo.Interviews.ReplaceInterviewSlots(c, id, interview.Slots); err != nil {
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if err := repo.Interviews.ReplaceInterviewers(c, id, interview.Interviewers); err != nil {
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit interview creation")
+		return recruitment.InterviewResponse{}, err
+	}
+
+	s.notifySlotProposal(c, interview, application)
+	publishEvent(c, s.realtime, s.log, application.UserID, realtime.EventInterviewProposed, map[string]interface{}{
+		"interview_id":       interview.ID,
+		"job_application_id": application.ID,
+		"title":              interview.Title,
+	})
+
+	s.log.WithFields(logrus.Fields{
+		"id":                 interview.ID,
+		"job_application_id": interview.JobApplicationID,
+		"slots":              len(interview.Slots),
+	}).Info("Interview created successfully")
+
+	return makeInterviewResponse(interview), nil
+}
+
+func (s *interviewImpl) GetInterviewsByJobApplicationID(c context.Context, jobApplicationID string, companyID string) ([]recruitment.InterviewResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	if _, err := getOwnedJobApplication(c, repo, s.log, jobApplicationID, companyID); err != nil {
+		return nil, err
+	}
+
+	interviews, err := repo.Interviews.GetInterviewsByJobApplicationID(c, jobApplicationID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":              err.Error(),
+			"job_application_id": jobApplicationID,
+		}).Error("Failed to get interviews by job application ID")
+		return nil, err
+	}
+
+	responses := make([]recruitment.InterviewResponse, len(interviews))
+	for i, interview := range interviews {
+		responses[i] = makeInterviewResponse(interview)
+	}
+
+	return responses, nil
+}
+
+func (s *interviewImpl) GetInterviewsByUserID(c context.Context, userID string) ([]recruitment.InterviewResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	interviews, err := repo.Interviews.GetInterviewsByUserID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get interviews by user ID")
+		return nil, err
+	}
+
+	responses := make([]recruitment.InterviewResponse, len(interviews))
+	for i, interview := range interviews {
+		responses[i] = makeInterviewResponse(interview)
+	}
+
+	return responses, nil
+}
+
+// UpdateInterview edits the interview details. New slots put a scheduled
+// interview back up for selection, which cancels the booked calendar event;
+// other edits to a scheduled interview are sent out as an updated event.
+func (s *interviewImpl) UpdateInterview(c context.Context, req recruitment.UpdateInterview) (recruitment.InterviewResponse, error) {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.InterviewResponse{}, err
+	}
+	defer repo.Rollback()
+
+	interview, application, err := s.getOwnedInterview(c, repo, req.ID, req.CompanyID)
+	if err != nil {
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if interview.Status == entity.InterviewStatusCancelled {
+		return recruitment.InterviewResponse{}, recruitment.ErrorInterviewCancelled
+	}
+
+	now := time.Now()
+	previous := interview
+	rescheduled := len(req.Slots) > 0
+
+	interview.Title = req.Title
+	interview.Format = req.Format
+	interview.Location = req.Location
+	interview.MeetingLink = req.MeetingLink
+	interview.Timezone = req.Timezone
+	interview.DurationMinutes = req.DurationMinutes
+	interview.Interviewers = makeInterviewers(interview.ID, req.Interviewers)
+	interview.UpdatedAt = now
+
+	if rescheduled {
+		slots, err := makeInterviewSlots(interview.ID, req.Slots, req.DurationMinutes, now)
+		if err != nil {
+			return recruitment.InterviewResponse{}, err
+		}
+		interview.Slots = slots
+		interview.Status = entity.InterviewStatusProposed
+		interview.ScheduledStart = nil
+		interview.ScheduledEnd = nil
+	} else if interview.ScheduledStart != nil {
+		end := interview.ScheduledStart.Add(time.Duration(req.DurationMinutes) * time.Minute)
+		interview.ScheduledEnd = &end
+	}
+
+	if previous.Status == entity.InterviewStatusScheduled {
+		interview.Sequence++
+	}
+
+	if err := repo.Interviews.UpdateInterview(c, interview); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    interview.ID,
+		}).Error("Failed to update interview")
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if rescheduled {
+		if err := repo.Interviews.ReplaceInterviewSlots(c, interview.ID, interview.Slots); err != nil {
+			return recruitment.InterviewResponse{}, err
+		}
+	}
+
+	if err := repo.Interviews.ReplaceInterviewers(c, interview.ID, interview.Interviewers); err != nil {
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit interview update")
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if previous.Status == entity.InterviewStatusScheduled {
+		if rescheduled {
+			cancelled := previous
+			cancelled.Sequence = interview.Sequence
+			s.sendCalendarInvites(c, cancelled, application, ical.MethodCancel, nil)
+		} else {
+			s.sendCalendarInvites(c, interview, application, ical.MethodRequest, nil)
+			s.sendCalendarInvites(c, interview, application, ical.MethodCancel, removedInterviewers(previous, interview))
+		}
+	}
+
+	if rescheduled {
+		s.notifySlotProposal(c, interview, application)
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":          interview.ID,
+		"status":      interview.Status,
+		"sequence":    interview.Sequence,
+		"rescheduled": rescheduled,
+	}).Info("Interview updated successfully")
+
+	return makeInterviewResponse(interview), nil
+}
+
+func (s *interviewImpl) CancelInterview(c context.Context, id string, companyID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	interview, application, err := s.getOwnedInterview(c, repo, id, companyID)
+	if err != nil {
+		return err
+	}
+
+	if interview.Status == entity.InterviewStatusCancelled {
+		return recruitment.ErrorInterviewCancelled
+	}
+
+	wasScheduled := interview.Status == entity.InterviewStatusScheduled
+
+	interview.Status = entity.InterviewStatusCancelled
+	interview.Sequence++
+	interview.UpdatedAt = time.Now()
+
+	if err := repo.Interviews.UpdateInterview(c, interview); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to cancel interview")
+		return err
+	}
+
+	if wasScheduled {
+		s.sendCalendarInvites(c, interview, application, ical.MethodCancel, nil)
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id": id,
+	}).Info("Interview cancelled successfully")
+
+	return nil
+}
+
+func (s *interviewImpl) SelectInterviewSlot(c context.Context, req recruitment.SelectInterviewSlot) (recruitment.InterviewResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.InterviewResponse{}, err
+	}
+
+	interview, err := repo.Interviews.GetInterviewByID(c, req.InterviewID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    req.InterviewID,
+		}).Error("Failed to get interview by ID")
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if interview.ID == "" {
+		return recruitment.InterviewResponse{}, recruitment.ErrorInterviewNotFound
+	}
+
+	application, err := repo.JobApplications.GetJobApplicationByID(c, interview.JobApplicationID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    interview.JobApplicationID,
+		}).Error("Failed to get job application by ID")
+		return recruitment.InterviewResponse{}, err
+	}
+
+	if application.UserID != req.UserID {
+		return recruitment.InterviewResponse{}, recruitment.ErrorInterviewNotFound
+	}
+
+	if interview.Status != entity.InterviewStatusProposed {
+		return recruitment.InterviewResponse{}, recruitment.ErrorInterviewNotProposed
+	}
+
+	var selected *entity.InterviewSlot
+	for i := range interview.Slots {
+		if interview.Slots[i].ID == req.SlotID {
+			selected = &interview.Slots[i]
+			break
+		}
+	}
+
+	if selected == nil {
+		return recruitment.InterviewResponse{}, recruitment.ErrorInterviewSlotNotFound
+	}
+
+	now := time.Now()
+	if !selected.StartTime.After(now) {
+		return recruitment.InterviewResponse{}, recruitment.ErrorInterviewSlotInPast
+	}
+
+	start, end := selected.StartTime, selected.EndTime
+	interview.Status = entity.InterviewStatusScheduled
+	interview.ScheduledStart = &start
+	interview.ScheduledEnd = &end
+	interview.UpdatedAt = now
+
+	// A rescheduled interview already went out and was cancelled under this
+	// UID, so the new booking has to outrank that cancellation.
+	if interview.Sequence > 0 {
+		interview.Sequence++
+	}
+
+	if err := repo.Interviews.UpdateInterview(c, interview); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    interview.ID,
+		}).Error("Failed to schedule interview")
+		return recruitment.InterviewResponse{}, err
+	}
+
+	s.sendCalendarInvites(c, interview, application, ical.MethodRequest, nil)
+
+	s.log.WithFields(logrus.Fields{
+		"id":      interview.ID,
+		"slot_id": req.SlotID,
+		"start":   start,
+	}).Info("Interview slot selected successfully")
+
+	return makeInterviewResponse(interview), nil
+}
+
+// getOwnedInterview hides interviews of other companies behind not found.
+func (s *interviewImpl) getOwnedInterview(c context.Context, repo recruitmentRepository.Client, id string, companyID string) (entity.Interview, entity.JobApplication, error) {
+	interview, err := repo.Interviews.GetInterviewByID(c, id)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to get interview by ID")
+		return entity.Interview{}, entity.JobApplication{}, err
+	}
+
+	if interview.ID == "" || interview.CompanyID != companyID {
+		return entity.Interview{}, entity.JobApplication{}, recruitment.ErrorInterviewNotFound
+	}
+
+	application, err := repo.JobApplications.GetJobApplicationByID(c, interview.JobApplicationID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    interview.JobApplicationID,
+		}).Error("Failed to get job application by ID")
+		return entity.Interview{}, entity.JobApplication{}, err
+	}
+
+	return interview, application, nil
+}
+
+// notifySlotProposal asks the candidate to pick one of the offered slots.
+func (s *interviewImpl) notifySlotProposal(c context.Context, interview entity.Interview, application entity.JobApplication) {
+	candidate, company, err := s.getParticipants(c, interview, application)
+	if err != nil {
+		return
+	}
+
+	loc := interviewLocation(interview.Timezone)
+
+	var body strings.Builder
+	body.WriteString(fmt.Sprintf("<p>Hello %s,</p>", html.EscapeString(candidate.Name)))
+	body.WriteString(fmt.Sprintf("<p><b>%s</b> would like to schedule <b>%s</b> (%s, %d minutes). Please choose one of these times:</p><ul>",
+		html.EscapeString(company.Name), html.EscapeString(interview.Title),
+		strings.ToLower(string(interview.Format)), interview.DurationMinutes))
+	for _, slot := range interview.Slots {
+		body.WriteString(fmt.Sprintf("<li>%s</li>", slot.StartTime.In(loc).Format("Mon, 02 Jan 2006 15:04 MST")))
+	}
+	body.WriteString("</ul><p>Log in to confirm your slot.</p>")
+
+	mail := smtp.Mail{
+		To:      candidate.Email,
+		Subject: fmt.Sprintf("Choose a time for your interview with %s", company.Name),
+		Body:    body.String(),
+	}
+
+	s.notifier.Notify(c, notification.Event{
+		RecipientID: candidate.ID,
+		Type:        entity.NotificationInterview,
+		Title:       mail.Subject,
+		Body:        fmt.Sprintf("%s would like to schedule %s. Please choose one of the proposed times.", company.Name, interview.Title),
+		Data: map[string]string{
+			"interview_id":       interview.ID,
+			"job_application_id": application.ID,
+		},
+		Mail: &mail,
+	})
+}
+
+// sendCalendarInvites mails the event to the candidate and every interviewer,
+// or only to recipients when it is given.
+func (s *interviewImpl) sendCalendarInvites(c context.Context, interview entity.Interview, application entity.JobApplication, method ical.Method, recipients []ical.Attendee) {
+	if interview.ScheduledStart == nil || interview.ScheduledEnd == nil {
+		return
+	}
+
+	candidate, company, err := s.getParticipants(c, interview, application)
+	if err != nil {
+		return
+	}
+
+	attendees := []ical.Attendee{{Name: candidate.Name, Email: candidate.Email}}
+	for _, interviewer := range interview.Interviewers {
+		attendees = append(attendees, ical.Attendee{Name: interviewer.Name, Email: interviewer.Email})
+	}
+
+	if recipients == nil {
+		recipients = attendees
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	location := interview.Location
+	if interview.Format == entity.InterviewFormatVideo {
+		location = interview.MeetingLink
+	}
+
+	event := ical.Event{
+		UID:         fmt.Sprintf("%s@%s", interview.ID, interviewUIDDomain),
+		Sequence:    interview.Sequence,
+		Start:       *interview.ScheduledStart,
+		End:         *interview.ScheduledEnd,
+		Summary:     fmt.Sprintf("%s - %s", interview.Title, company.Name),
+		Description: fmt.Sprintf("Interview (%s) with %s for %s.", strings.ToLower(string(interview.Format)), company.Name, candidate.Name),
+		Location:    location,
+		URL:         interview.MeetingLink,
+		Organizer:   ical.Attendee{Name: company.Name, Email: company.Email},
+		Attendees:   attendees,
+	}
+
+	attachment := smtp.Attachment{
+		Filename:    "invite.ics",
+		ContentType: fmt.Sprintf("text/calendar; method=%s; charset=UTF-8", method),
+		Data:        ical.Build(method, event),
+	}
+
+	subject := fmt.Sprintf("Interview scheduled: %s", event.Summary)
+	if method == ical.MethodCancel {
+		subject = fmt.Sprintf("Interview cancelled: %s", event.Summary)
+	} else if interview.Sequence > 0 {
+		subject = fmt.Sprintf("Interview updated: %s", event.Summary)
+	}
+
+	loc := interviewLocation(interview.Timezone)
+	when := fmt.Sprintf("%s - %s",
+		interview.ScheduledStart.In(loc).Format("Mon, 02 Jan 2006 15:04"),
+		interview.ScheduledEnd.In(loc).Format("15:04 MST"))
+
+	for _, recipient := range recipients {
+		var body strings.Builder
+		body.WriteString(fmt.Sprintf("<p>Hello %s,</p>", html.EscapeString(recipient.Name)))
+		if method == ical.MethodCancel {
+			body.WriteString(fmt.Sprintf("<p>The interview <b>%s</b> on %s has been cancelled.</p>",
+				html.EscapeString(interview.Title), when))
+		} else {
+			body.WriteString(fmt.Sprintf("<p><b>%s</b> is scheduled for %s.</p>", html.EscapeString(interview.Title), when))
+			if location != "" {
+				body.WriteString(fmt.Sprintf("<p>Location: %s</p>", html.EscapeString(location)))
+			}
+		}
+		body.WriteString("<p>The attached invite updates your calendar.</p>")
+
+		mail := smtp.Mail{
+			To:          recipient.Email,
+			Subject:     subject,
+			Body:        body.String(),
+			Attachments: []smtp.Attachment{attachment},
+		}
+
+		// Interviewers have no account here, so only the candidate has
+		// preferences and an in-app notification.
+		event := notification.Event{Type: entity.NotificationInterview, Mail: &mail}
+		if recipient.Email == candidate.Email {
+			event.RecipientID = candidate.ID
+			event.Title = subject
+			event.Body = fmt.Sprintf("%s on %s.", interview.Title, when)
+			event.Data = map[string]string{
+				"interview_id":       interview.ID,
+				"job_application_id": application.ID,
+			}
+		}
+		s.notifier.Notify(c, event)
+	}
+}
+
+func (s *interviewImpl) getParticipants(c context.Context, interview entity.Interview, application entity.JobApplication) (entity.User, entity.Company, error) {
+	authRepo, err := s.authRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create auth repository client")
+		return entity.User{}, entity.Company{}, err
+	}
+
+	candidate, err := authRepo.User.GetUserByID(c, application.UserID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": application.UserID,
+		}).Error("Failed to get candidate for interview email")
+		return entity.User{}, entity.Company{}, err
+	}
+
+	company, err := authRepo.Company.GetCompanyByID(c, interview.CompanyID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":      err.Error(),
+			"company_id": interview.CompanyID,
+		}).Error("Failed to get company for interview email")
+		return entity.User{}, entity.Company{}, err
+	}
+
+	return candidate, company, nil
+}
+
+func makeInterviewSlots(interviewID string, requests []recruitment.InterviewSlotRequest, durationMinutes int, now time.Time) ([]entity.InterviewSlot, error) {
+	slots := make([]entity.InterviewSlot, 0, len(requests))
+	seen := make(map[time.Time]struct{}, len(requests))
+	for _, req := range requests {
+		start := req.StartTime.UTC().Truncate(time.Minute)
+		if !start.After(now) {
+			return nil, recruitment.ErrorInterviewSlotInPast
+		}
+		if _, ok := seen[start]; ok {
+			continue
+		}
+		seen[start] = struct{}{}
+
+		id, err := utils.NewUlidFromTimestamp(now)
+		if err != nil {
+			return nil, err
+		}
+
+		slots = append(slots, entity.InterviewSlot{
+			ID:          id,
+			InterviewID: interviewID,
+			StartTime:   start,
+			EndTime:     start.Add(time.Duration(durationMinutes) * time.Minute),
+		})
+	}
+
+	return slots, nil
+}
+
+func makeInterviewers(interviewID string, requests []recruitment.InterviewerRequest) []entity.Interviewer {
+	interviewers := make([]entity.Interviewer, 0, len(requests))
+	seen := make(map[string]struct{}, len(requests))
+	for _, req := range requests {
+		email := strings.ToLower(strings.TrimSpace(req.Email))
+		if _, ok := seen[email]; ok {
+			continue
+		}
+		seen[email] = struct{}{}
+
+		interviewers = append(interviewers, entity.Interviewer{
+			InterviewID: interviewID,
+			Name:        strings.TrimSpace(req.Name),
+			Email:       email,
+		})
+	}
+
+	return interviewers
+}
+
+// removedInterviewers returns who was on the previous event but not the new
+// one, so they receive a cancellation instead of silently keeping the slot.
+func removedInterviewers(previous entity.Interview, current entity.Interview) []ical.Attendee {
+	kept := make(map[string]struct{}, len(current.Interviewers))
+	for _, interviewer := range current.Interviewers {
+		kept[interviewer.Email] = struct{}{}
+	}
+
+	removed := []ical.Attendee{}
+	for _, interviewer := range previous.Interviewers {
+		if _, ok := kept[interviewer.Email]; !ok {
+			removed = append(removed, ical.Attendee{Name: interviewer.Name, Email: interviewer.Email})
+		}
+	}
+
+	return removed
+}
+
+func interviewLocation(timezone string) *time.Location {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,447 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/api/recruitment"
+	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"mime/multipart"
+	"time"
+)
+
+func (s *jobApplicationImpl) CreateJobApplication(c context.Context, req recruitment.CreateJobApplication) error {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+	defer repo.Rollback()
+
+	jobVacancy, err := repo.JobVacancies.GetJobVacancyByID(c, req.JobVacancyID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": req.JobVacancyID,
+		}).Error("Failed to get job vacancy by ID")
+		return err
+	}
+
+	if jobVacancy.ID == "" {
+		s.log.WithFields(logrus.Fields{
+			"job_vacancy_id": req.JobVacancyID,
+		}).Warn("Job vacancy not found")
+		return recruitment.ErrorJobVacancyNotFound
+	}
+
+	now := time.Now()
+	if !jobVacancy.IsActive || (!jobVacancy.Deadline.IsZero() && jobVacancy.Deadline.Before(now)) {
+		s.log.WithFields(logrus.Fields{
+			"job_vacancy_id": req.JobVacancyID,
+			"deadline":       jobVacancy.Deadline,
+		}).Warn("Job vacancy is closed")
+		return recruitment.ErrorJobVacancyClosed
+	}
+
+	exists, err := repo.JobApplications.CheckJobApplicationExists(c, req.JobVacancyID, req.UserID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": req.JobVacancyID,
+			"user_id":        req.UserID,
+		}).Error("Failed to check if job application exists")
+		return err
+	}
+
+	if exists {
+		s.log.WithFields(logrus.Fields{
+			"job_vacancy_id": req.JobVacancyID,
+			"user_id":        req.UserID,
+		}).Warn("User already applied to job vacancy")
+		return recruitment.ErrorAlreadyApplied
+	}
+
+	questions, err := repo.ScreeningQuestions.GetScreeningQuestionsByJobVacancyID(c, req.JobVacancyID)
+	if err != nil {
+		return err
+	}
+
+	screening, err := evaluateScreeningAnswers(questions, req)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": req.JobVacancyID,
+			"user_id":        req.UserID,
+		}).Warn("Screening answers rejected")
+		return err
+	}
+
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return err
+	}
+
+	status := entity.ApplicationStatusApplied
+	if screening.Rejected {
+		status = entity.ApplicationStatusRejected
+	}
+
+	application := entity.JobApplication{
+		ID:               id,
+		JobVacancyID:     req.JobVacancyID,
+		UserID:           req.UserID,
+		Status:           status,
+		CoverLetter:      req.CoverLetter,
+		ScreeningFlagged: screening.Flagged,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	if err := repo.JobApplications.CreateJobApplication(c, application); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": req.JobVacancyID,
+			"user_id":        req.UserID,
+		}).Error("Failed to create job application")
+		return err
+	}
+
+	uploaded, err := s.storeScreeningAnswers(c, repo, application, screening.Answers, req.Files)
+	if err != nil {
+		s.deleteUploads(uploaded)
+		return err
+	}
+
+	if err := repo.JobInvitations.AcceptJobInvitation(c, req.JobVacancyID, req.UserID, now); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": req.JobVacancyID,
+			"user_id":        req.UserID,
+		}).Warn("Failed to mark job invitation as accepted")
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit job application")
+		s.deleteUploads(uploaded)
+		return err
+	}
+
+	if err := s.redis.DeleteCache(c, recruitment.RecommendedJobsCacheKey(req.UserID)); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": req.UserID,
+		}).Warn("Failed to invalidate recommended jobs cache")
+	}
+
+	s.webhooks.Dispatch(c, jobVacancy.RecruiterID, entity.WebhookApplicationCreated, map[string]interface{}{
+		"job_application_id": application.ID,
+		"job_vacancy_id":     application.JobVacancyID,
+		"user_id":            application.UserID,
+		"status":             application.Status,
+		"screening_flagged":  application.ScreeningFlagged,
+		"created_at":         application.CreatedAt,
+	})
+
+	s.log.WithFields(logrus.Fields{
+		"id":                application.ID,
+		"job_vacancy_id":    application.JobVacancyID,
+		"user_id":           application.UserID,
+		"status":            application.Status,
+		"screening_flagged": application.ScreeningFlagged,
+	}).Info("Job application created successfully")
+
+	return nil
+}
+
+func (s *jobApplicationImpl) GetJobApplicationsByUserID(c context.Context, userID string) ([]recruitment.JobApplicationResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	applications, err := repo.JobApplications.GetJobApplicationsByUserID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get job applications by user ID")
+		return nil, err
+	}
+
+	responses := make([]recruitment.JobApplicationResponse, len(applications))
+	for i, ja := range applications {
+		responses[i] = makeJobApplicationResponse(ja)
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"user_id": userID,
+		"count":   len(responses),
+	}).Debug("Job applications retrieved successfully")
+
+	return responses, nil
+}
+
+func (s *jobApplicationImpl) GetJobApplicationsByJobVacancyID(c context.Context, jobVacancyID string, recruiterID string, interviewerID string) ([]recruitment.JobApplicationResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	if _, err := getOwnedJobVacancy(c, repo, s.log, jobVacancyID, recruiterID); err != nil {
+		return nil, err
+	}
+
+	applications, err := repo.JobApplications.GetJobApplicationsByJobVacancyID(c, jobVacancyID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Failed to get job applications by job vacancy ID")
+		return nil, err
+	}
+
+	template, err := repo.Scorecards.GetScorecardTemplateByJobVacancyID(c, jobVacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationIDs := make([]string, len(applications))
+	for i, ja := range applications {
+		applicationIDs[i] = ja.ID
+	}
+
+	scorecards, err := repo.Scorecards.GetScorecardsByJobApplicationIDs(c, applicationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byApplication := map[string][]entity.Scorecard{}
+	for _, scorecard := range scorecards {
+		byApplication[scorecard.JobApplicationID] = append(byApplication[scorecard.JobApplicationID], scorecard)
+	}
+
+	responses := make([]recruitment.JobApplicationResponse, len(applications))
+	for i, ja := range applications {
+		responses[i] = makeJobApplicationResponse(ja)
+		responses[i].ScreeningFlagged = ja.ScreeningFlagged
+		responses[i].StatusUpdatedBy = ja.StatusUpdatedBy
+		for _, scorecard := range byApplication[ja.ID] {
+			if scorecard.InterviewerID == interviewerID {
+				responses[i].Scorecards = summarizeScorecards(template, byApplication[ja.ID])
+				break
+			}
+		}
+	}
+
+	return responses, nil
+}
+
+func (s *jobApplicationImpl) UpdateJobApplicationStatus(c context.Context, req recruitment.UpdateJobApplicationStatus) error {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+	defer repo.Rollback()
+
+	application, err := lockOwnedJobApplication(c, repo, s.log, req.ID, req.RecruiterID)
+	if err != nil {
+		return err
+	}
+
+	if !canTransitionApplication(application.Status, req.Status) {
+		return recruitment.ErrorInvalidStatusTransition
+	}
+
+	if err := requireHiringDecision(c, repo, application, req.Status); err != nil {
+		return err
+	}
+
+	if err := repo.JobApplications.UpdateJobApplicationStatus(c, application.ID, req.Status, req.MemberID, time.Now()); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    req.ID,
+		}).Error("Failed to update job application status")
+		return err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit job application status")
+		return err
+	}
+
+	publishEvent(c, s.realtime, s.log, application.UserID, realtime.EventApplicationStatusChanged, map[string]interface{}{
+		"job_application_id": application.ID,
+		"job_vacancy_id":     application.JobVacancyID,
+		"status":             req.Status,
+	})
+	s.notifyStatusChange(c, repo, application, req.Status)
+	s.webhooks.Dispatch(c, req.RecruiterID, entity.WebhookApplicationStatusChanged, applicationStatusData(application, req.Status))
+
+	s.log.WithFields(logrus.Fields{
+		"id":     req.ID,
+		"status": req.Status,
+	}).Info("Job application status updated successfully")
+
+	return nil
+}
+
+// notifyStatusChange tells the candidate their application moved on. Status
+// changes have no email, so this only ever lands in-app.
+func (s *jobApplicationImpl) notifyStatusChange(c context.Context, repo recruitmentRepository.Client, application entity.JobApplication, status entity.ApplicationStatus) {
+	jobVacancy, err := repo.JobVacancies.GetJobVacancyByID(c, application.JobVacancyID)
+	if err != nil {
+		return
+	}
+
+	s.notifier.Notify(c, notification.Event{
+		RecipientID: application.UserID,
+		Type:        entity.NotificationApplicationStatus,
+		Title:       fmt.Sprintf("Your application for %s is now %s", jobVacancy.Title, status),
+		Data: map[string]string{
+			"job_application_id": application.ID,
+			"job_vacancy_id":     application.JobVacancyID,
+			"status":             string(status),
+		},
+	})
+}
+
+// applicationTransitions lists the statuses a recruiter may move an
+// application to from each status. Hired and rejected are final, and an
+// application never goes back before the interview stage once it got there.
+// Offer and hired are reached only through the offer flow, which also owns
+// every move out of the offer stage.
+var applicationTransitions = map[entity.ApplicationStatus][]entity.ApplicationStatus{
+	entity.ApplicationStatusApplied:   {entity.ApplicationStatusReviewing, entity.ApplicationStatusInterview, entity.ApplicationStatusRejected},
+	entity.ApplicationStatusReviewing: {entity.ApplicationStatusInterview, entity.ApplicationStatusRejected},
+	entity.ApplicationStatusInterview: {entity.ApplicationStatusRejected},
+	entity.ApplicationStatusOffer:     {},
+	entity.ApplicationStatusHired:     {},
+	entity.ApplicationStatusRejected:  {},
+}
+
+func canTransitionApplication(from entity.ApplicationStatus, to entity.ApplicationStatus) bool {
+	for _, status := range applicationTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// getOwnedJobApplication loads an application and checks its vacancy belongs
+// to the given company. Applications to other companies are reported missing.
+func getOwnedJobApplication(c context.Context, repo recruitmentRepository.Client, log *logrus.Logger, id string, recruiterID string) (entity.JobApplication, error) {
+	application, err := repo.JobApplications.GetJobApplicationByID(c, id)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to get job application by ID")
+		return entity.JobApplication{}, err
+	}
+
+	return checkJobApplicationOwner(c, repo, log, application, recruiterID)
+}
+
+// lockOwnedJobApplication is getOwnedJobApplication with the application row
+// locked until the transaction ends, for changes that depend on its current
+// status.
+func lockOwnedJobApplication(c context.Context, repo recruitmentRepository.Client, log *logrus.Logger, id string, recruiterID string) (entity.JobApplication, error) {
+	application, err := repo.JobApplications.GetJobApplicationByIDForUpdate(c, id)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to lock job application by ID")
+		return entity.JobApplication{}, err
+	}
+
+	return checkJobApplicationOwner(c, repo, log, application, recruiterID)
+}
+
+func checkJobApplicationOwner(c context.Context, repo recruitmentRepository.Client, log *logrus.Logger, application entity.JobApplication, recruiterID string) (entity.JobApplication, error) {
+	if application.ID == "" {
+		return entity.JobApplication{}, recruitment.ErrorJobApplicationNotFound
+	}
+
+	if _, err := getOwnedJobVacancy(c, repo, log, application.JobVacancyID, recruiterID); err != nil {
+		if errors.Is(err, recruitment.ErrorNotVacancyOwner) {
+			return entity.JobApplication{}, recruitment.ErrorJobApplicationNotFound
+		}
+		return entity.JobApplication{}, err
+	}
+
+	return application, nil
+}
+
+// storeScreeningAnswers uploads file answers and saves every answer. It
+// returns the uploaded file URLs so the caller can remove them when the
+// application is not saved after all.
+func (s *jobApplicationImpl) storeScreeningAnswers(c context.Context, repo recruitmentRepository.Client, application entity.JobApplication, answers []entity.ScreeningAnswer, files map[string]*multipart.FileHeader) ([]string, error) {
+	var uploaded []string
+	for i := range answers {
+		id, err := utils.NewUlidFromTimestamp(application.CreatedAt)
+		if err != nil {
+			return uploaded, err
+		}
+		answers[i].ID = id
+		answers[i].JobApplicationID = application.ID
+		answers[i].CreatedAt = application.CreatedAt
+
+		file, ok := files[answers[i].QuestionID]
+		if !ok {
+			continue
+		}
+
+		fileURL, err := s.s3.UploadFile(file, file.Filename)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"error":       err.Error(),
+				"question_id": answers[i].QuestionID,
+			}).Error("Failed to upload screening answer file")
+			return uploaded, err
+		}
+		uploaded = append(uploaded, fileURL)
+		answers[i].FileURL = fileURL
+	}
+
+	if err := repo.ScreeningQuestions.CreateScreeningAnswers(c, answers); err != nil {
+		return uploaded, err
+	}
+
+	return uploaded, nil
+}
+
+func (s *jobApplicationImpl) deleteUploads(fileURLs []string) {
+	for _, fileURL := range fileURLs {
+		if err := s.s3.DeleteFile(fileURL); err != nil {
+			s.log.WithFields(logrus.Fields{
+				"error":    err.Error(),
+				"file_url": fileURL,
+			}).Warn("Failed to delete orphaned screening answer file")
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/entity"
+	"testing"
+)
+
+func TestCanTransitionApplication(t *testing.T) {
+	tests := []struct {
+		from entity.ApplicationStatus
+		to   entity.ApplicationStatus
+		want bool
+	}{
+		{entity.ApplicationStatusApplied, entity.ApplicationStatusReviewing, true},
+		{entity.ApplicationStatusApplied, entity.ApplicationStatusInterview, true},
+		{entity.ApplicationStatusApplied, entity.ApplicationStatusRejected, true},
+		{entity.ApplicationStatusApplied, entity.ApplicationStatusOffer, false},
+		{entity.ApplicationStatusApplied, entity.ApplicationStatusHired, false},
+		{entity.ApplicationStatusReviewing, entity.ApplicationStatusInterview, true},
+		{entity.ApplicationStatusReviewing, entity.ApplicationStatusApplied, false},
+		{entity.ApplicationStatusReviewing, entity.ApplicationStatusOffer, false},
+		{entity.ApplicationStatusInterview, entity.ApplicationStatusRejected, true},
+		{entity.ApplicationStatusInterview, entity.ApplicationStatusOffer, false},
+		{entity.ApplicationStatusInterview, entity.ApplicationStatusReviewing, false},
+		{entity.ApplicationStatusInterview, entity.ApplicationStatusHired, false},
+		{entity.ApplicationStatusOffer, entity.ApplicationStatusOffer, false},
+		{entity.ApplicationStatusOffer, entity.ApplicationStatusInterview, false},
+		{entity.ApplicationStatusOffer, entity.ApplicationStatusHired, false},
+		{entity.ApplicationStatusOffer, entity.ApplicationStatusRejected, false},
+		{entity.ApplicationStatusOffer, entity.ApplicationStatusApplied, false},
+		{entity.ApplicationStatusHired, entity.ApplicationStatusRejected, false},
+		{entity.ApplicationStatusHired, entity.ApplicationStatusOffer, false},
+		{entity.ApplicationStatusRejected, entity.ApplicationStatusReviewing, false},
+		{entity.ApplicationStatusRejected, entity.ApplicationStatusRejected, false},
+		{"unknown", entity.ApplicationStatusReviewing, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			if got := canTransitionApplication(tt.from, tt.to); got != tt.want {
+				t.Errorf("canTransitionApplication(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
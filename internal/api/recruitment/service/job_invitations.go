@@ -0,0 +1,77 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+func (s *jobInvitationImpl) GetJobInvitationsByUserID(c context.Context, userID string) ([]recruitment.JobInvitationResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	invitations, err := repo.JobInvitations.GetJobInvitationsByUserID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get job invitations by user ID")
+		return nil, err
+	}
+
+	responses := make([]recruitment.JobInvitationResponse, len(invitations))
+	for i, invitation := range invitations {
+		responses[i] = makeJobInvitationResponse(invitation)
+	}
+
+	return responses, nil
+}
+
+func (s *jobInvitationImpl) DeclineJobInvitation(c context.Context, id string, userID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	invitation, err := repo.JobInvitations.GetJobInvitationByID(c, id)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to get job invitation by ID")
+		return err
+	}
+
+	if invitation.ID == "" || invitation.UserID != userID {
+		return recruitment.ErrorInvitationNotFound
+	}
+
+	if invitation.Status != entity.InvitationStatusPending {
+		return nil
+	}
+
+	if err := repo.JobInvitations.UpdateJobInvitationStatus(c, id, entity.InvitationStatusDeclined, time.Now()); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to decline job invitation")
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":      id,
+		"user_id": userID,
+	}).Info("Job invitation declined")
+
+	return nil
+}
@@ -1,29 +1,76 @@
 package recruitmentService
 
 import (
+	"ProjectGolang/internal/api/bio"
 	"ProjectGolang/internal/api/recruitment"
+	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	"ProjectGolang/internal/api/skill"
 	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
 	"context"
-	"fmt"
 	"github.com/sirupsen/logrus"
 	"time"
 )
 
+// unverifiedActiveVacancyLimit caps how many open vacancies a company can
+// publish before an admin has verified it.
+const unverifiedActiveVacancyLimit = 3
+
 func (s *jobVacancyImpl) CreateJobVacancy(c context.Context, req recruitment.CreateJobVacancy) error {
-	repo, err := s.repo.NewClient(false)
+	skills, err := s.resolveSkills(c, req.Skills)
+	if err != nil {
+		return err
+	}
+
+	languages, err := makeLanguageRequirements(req.Languages)
+	if err != nil {
+		return err
+	}
+
+	repo, err := s.repo.NewClient(true)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"error": err.Error(),
 		}).Error("Failed to create repository client")
+		return err
+	}
+	defer repo.Rollback()
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return err
 	}
 
 	jobVacancy := entity.JobVacancy{
-		Title:       req.Title,
-		Description: req.Description,
-		Location:    req.Location,
-		JobType:     req.JobType,
-		Deadline:    req.Deadline,
-		IsActive:    req.IsActive,
+		ID:           id,
+		RecruiterID:  req.RecruiterID,
+		Title:        req.Title,
+		Description:  req.Description,
+		Requirements: req.Requirements,
+		Location:     req.Location,
+		JobType:      req.JobType,
+		Deadline:     req.Deadline,
+		IsActive:     req.IsActive,
+		Headcount:    defaultHeadcount(req.Headcount),
+		CreatedBy:    req.MemberID,
+		UpdatedBy:    req.MemberID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	questions, err := makeScreeningQuestions(id, req.ScreeningQuestions, now)
+	if err != nil {
+		return err
+	}
+
+	if jobVacancy.IsActive {
+		if err := checkActiveVacancyLimit(c, repo, s.log, req.RecruiterID, now); err != nil {
+			return err
+		}
 	}
 
 	if err := repo.JobVacancies.CreateJobVacancy(c, jobVacancy); err != nil {
@@ -33,6 +80,36 @@ func (s *jobVacancyImpl) CreateJobVacancy(c context.Context, req recruitment.Cre
 		return err
 	}
 
+	if len(questions) > 0 {
+		if err := repo.ScreeningQuestions.ReplaceScreeningQuestions(c, id, questions); err != nil {
+			s.log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Failed to create screening questions")
+			return err
+		}
+	}
+
+	if len(skills) > 0 {
+		if err := s.replaceSkills(c, repo, id, skills); err != nil {
+			return err
+		}
+	}
+
+	if len(languages) > 0 {
+		if err := s.replaceLanguages(c, repo, id, languages); err != nil {
+			return err
+		}
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit job vacancy creation")
+		return err
+	}
+
+	s.invalidateRecommendations(c)
+
 	s.log.WithFields(logrus.Fields{
 		"title":       req.Title,
 		"description": req.Description,
@@ -64,26 +141,19 @@ func (s *jobVacancyImpl) GetJobVacancies(c context.Context, req recruitment.GetJ
 		return recruitment.PaginatedJobVacanciesResponse{}, err
 	}
 
-	totalPages := totalCount / req.PageSize
-	if totalCount%req.PageSize > 0 {
-		totalPages++
+	if err := s.attachSkills(c, repo, jobVacancies); err != nil {
+		return recruitment.PaginatedJobVacanciesResponse{}, err
+	}
+
+	if err := s.attachLanguages(c, repo, jobVacancies); err != nil {
+		return recruitment.PaginatedJobVacanciesResponse{}, err
 	}
 
+	totalPages := totalPages(totalCount, req.PageSize)
+
 	jobVacancyResponses := make([]recruitment.JobVacancyResponse, len(jobVacancies))
 	for i, jv := range jobVacancies {
-		jobVacancyResponses[i] = recruitment.JobVacancyResponse{
-			ID:           jv.ID,
-			RecruiterID:  jv.RecruiterID,
-			Title:        jv.Title,
-			Description:  jv.Description,
-			Requirements: jv.Requirements,
-			Location:     jv.Location,
-			JobType:      jv.JobType,
-			Deadline:     jv.Deadline,
-			IsActive:     jv.IsActive,
-			CreatedAt:    jv.CreatedAt,
-			UpdatedAt:    jv.UpdatedAt,
-		}
+		jobVacancyResponses[i] = makeJobVacancyResponse(jv)
 	}
 
 	response := recruitment.PaginatedJobVacanciesResponse{
@@ -106,28 +176,32 @@ func (s *jobVacancyImpl) GetJobVacancies(c context.Context, req recruitment.GetJ
 }
 
 func (s *jobVacancyImpl) UpdateJobVacancy(c context.Context, req recruitment.UpdateJobVacancy) error {
-	repo, err := s.repo.NewClient(false)
+	var skills []entity.Skill
+	if req.Skills != nil {
+		resolved, err := s.resolveSkills(c, req.Skills)
+		if err != nil {
+			return err
+		}
+		skills = resolved
+	}
+
+	languages, err := makeLanguageRequirements(req.Languages)
 	if err != nil {
-		s.log.WithFields(logrus.Fields{
-			"error": err.Error(),
-		}).Error("Failed to create repository client")
 		return err
 	}
 
-	exists, err := repo.JobVacancies.CheckJobVacancyExists(c, req.ID)
+	repo, err := s.repo.NewClient(true)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
 			"error": err.Error(),
-			"id":    req.ID,
-		}).Error("Failed to check if job vacancy exists")
+		}).Error("Failed to create repository client")
 		return err
 	}
+	defer repo.Rollback()
 
-	if !exists {
-		s.log.WithFields(logrus.Fields{
-			"id": req.ID,
-		}).Warn("Job vacancy not found")
-		return fmt.Errorf("job vacancy with ID %s not found", req.ID)
+	existing, err := getOwnedJobVacancy(c, repo, s.log, req.ID, req.RecruiterID)
+	if err != nil {
+		return err
 	}
 
 	jobVacancy := entity.JobVacancy{
@@ -139,9 +213,17 @@ func (s *jobVacancyImpl) UpdateJobVacancy(c context.Context, req recruitment.Upd
 		JobType:      req.JobType,
 		Deadline:     req.Deadline,
 		IsActive:     req.IsActive,
+		Headcount:    defaultHeadcount(req.Headcount),
+		UpdatedBy:    req.MemberID,
 		UpdatedAt:    time.Now(),
 	}
 
+	if jobVacancy.IsActive && !isOpenVacancy(existing, jobVacancy.UpdatedAt) {
+		if err := checkActiveVacancyLimit(c, repo, s.log, req.RecruiterID, jobVacancy.UpdatedAt); err != nil {
+			return err
+		}
+	}
+
 	if err := repo.JobVacancies.UpdateJobVacancy(c, jobVacancy); err != nil {
 		s.log.WithFields(logrus.Fields{
 			"error": err.Error(),
@@ -150,6 +232,32 @@ func (s *jobVacancyImpl) UpdateJobVacancy(c context.Context, req recruitment.Upd
 		return err
 	}
 
+	if req.Skills != nil {
+		if err := s.replaceSkills(c, repo, req.ID, skills); err != nil {
+			return err
+		}
+	}
+
+	if req.Languages != nil {
+		if err := s.replaceLanguages(c, repo, req.ID, languages); err != nil {
+			return err
+		}
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    req.ID,
+		}).Error("Failed to commit job vacancy update")
+		return err
+	}
+
+	s.invalidateRecommendations(c)
+
+	if existing.IsActive && !req.IsActive {
+		s.webhooks.Dispatch(c, req.RecruiterID, entity.WebhookVacancyClosed, vacancyClosedData(existing, "closed"))
+	}
+
 	s.log.WithFields(logrus.Fields{
 		"id":          req.ID,
 		"title":       req.Title,
@@ -163,7 +271,7 @@ func (s *jobVacancyImpl) UpdateJobVacancy(c context.Context, req recruitment.Upd
 	return nil
 }
 
-func (s *jobVacancyImpl) DeleteJobVacancy(c context.Context, id string) error {
+func (s *jobVacancyImpl) DeleteJobVacancy(c context.Context, id string, recruiterID string) error {
 	repo, err := s.repo.NewClient(false)
 	if err != nil {
 		s.log.WithFields(logrus.Fields{
@@ -172,33 +280,229 @@ func (s *jobVacancyImpl) DeleteJobVacancy(c context.Context, id string) error {
 		return err
 	}
 
-	exists, err := repo.JobVacancies.CheckJobVacancyExists(c, id)
+	jobVacancy, err := getOwnedJobVacancy(c, repo, s.log, id, recruiterID)
 	if err != nil {
+		return err
+	}
+
+	if err := repo.JobVacancies.DeleteJobVacancy(c, id); err != nil {
 		s.log.WithFields(logrus.Fields{
 			"error": err.Error(),
 			"id":    id,
-		}).Error("Failed to check if job vacancy exists")
+		}).Error("Failed to delete job vacancy")
 		return err
 	}
 
-	if !exists {
-		s.log.WithFields(logrus.Fields{
+	s.invalidateRecommendations(c)
+
+	if jobVacancy.IsActive {
+		s.webhooks.Dispatch(c, recruiterID, entity.WebhookVacancyClosed, vacancyClosedData(jobVacancy, "deleted"))
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id": id,
+	}).Info("Job vacancy deleted successfully")
+
+	return nil
+}
+
+// getOwnedJobVacancy loads a vacancy and checks it belongs to the given company.
+func getOwnedJobVacancy(c context.Context, repo recruitmentRepository.Client, log *logrus.Logger, id string, recruiterID string) (entity.JobVacancy, error) {
+	jobVacancy, err := repo.JobVacancies.GetJobVacancyByID(c, id)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to get job vacancy by ID")
+		return entity.JobVacancy{}, err
+	}
+
+	if jobVacancy.ID == "" {
+		log.WithFields(logrus.Fields{
 			"id": id,
 		}).Warn("Job vacancy not found")
-		return fmt.Errorf("job vacancy with ID %s not found", id)
+		return entity.JobVacancy{}, recruitment.ErrorJobVacancyNotFound
 	}
 
-	if err := repo.JobVacancies.DeleteJobVacancy(c, id); err != nil {
+	if jobVacancy.RecruiterID != recruiterID {
+		log.WithFields(logrus.Fields{
+			"id":           id,
+			"recruiter_id": recruiterID,
+		}).Warn("Job vacancy belongs to another company")
+		return entity.JobVacancy{}, recruitment.ErrorNotVacancyOwner
+	}
+
+	return jobVacancy, nil
+}
+
+// checkActiveVacancyLimit refuses to open one more vacancy for an unverified
+// company that already has unverifiedActiveVacancyLimit open.
+func checkActiveVacancyLimit(c context.Context, repo recruitmentRepository.Client, log *logrus.Logger, companyID string, now time.Time) error {
+	verified, err := repo.JobVacancies.IsCompanyVerified(c, companyID)
+	if err != nil || verified {
+		return err
+	}
+
+	count, err := repo.JobVacancies.CountActiveJobVacanciesByRecruiterID(c, companyID, now)
+	if err != nil {
+		return err
+	}
+
+	if count >= unverifiedActiveVacancyLimit {
+		log.WithFields(logrus.Fields{
+			"company_id": companyID,
+			"active":     count,
+		}).Warn("Unverified company reached active vacancy limit")
+		return recruitment.ErrorUnverifiedVacancyLimit
+	}
+
+	return nil
+}
+
+// isOpenVacancy matches the active vacancy queries: active and not past
+// its deadline.
+func isOpenVacancy(jobVacancy entity.JobVacancy, now time.Time) bool {
+	return jobVacancy.IsActive && (jobVacancy.Deadline.IsZero() || !jobVacancy.Deadline.Before(now))
+}
+
+// defaultHeadcount treats a missing headcount as a single opening.
+func defaultHeadcount(headcount int) int {
+	if headcount < 1 {
+		return 1
+	}
+	return headcount
+}
+
+// resolveSkills maps the requested skill names onto the catalogue.
+func (s *jobVacancyImpl) resolveSkills(c context.Context, names []string) ([]entity.Skill, error) {
+	names = skill.CleanNames(names)
+	if len(names) == 0 {
+		return nil, nil
+	}
+	if err := skill.ValidateNames(names); err != nil {
+		return nil, err
+	}
+
+	skills, err := s.skills.Resolve(c, names)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to resolve job vacancy skills")
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+func (s *jobVacancyImpl) replaceSkills(c context.Context, repo recruitmentRepository.Client, id string, skills []entity.Skill) error {
+	ids := make([]string, len(skills))
+	for i, item := range skills {
+		ids[i] = item.ID
+	}
+
+	if err := repo.JobVacancies.ReplaceJobVacancySkills(c, id, ids); err != nil {
 		s.log.WithFields(logrus.Fields{
 			"error": err.Error(),
 			"id":    id,
-		}).Error("Failed to delete job vacancy")
+		}).Error("Failed to save job vacancy skills")
 		return err
 	}
 
-	s.log.WithFields(logrus.Fields{
-		"id": id,
-	}).Info("Job vacancy deleted successfully")
+	return nil
+}
 
+// attachSkills loads the required skills of a page of vacancies in one query.
+func (s *jobVacancyImpl) attachSkills(c context.Context, repo recruitmentRepository.Client, jobVacancies []entity.JobVacancy) error {
+	if len(jobVacancies) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(jobVacancies))
+	for i, jv := range jobVacancies {
+		ids[i] = jv.ID
+	}
+
+	skills, err := repo.JobVacancies.GetSkillsByJobVacancyIDs(c, ids)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to get job vacancy skills")
+		return err
+	}
+
+	for i := range jobVacancies {
+		jobVacancies[i].Skills = skills[jobVacancies[i].ID]
+	}
+	return nil
+}
+
+// invalidateRecommendations drops every cached feed, since any change to the
+// vacancy set can reorder all of them.
+func (s *jobVacancyImpl) invalidateRecommendations(c context.Context) {
+	if err := s.redis.DeleteCacheByPattern(c, recruitment.RecommendedJobsCachePattern); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Warn("Failed to invalidate recommended jobs cache")
+	}
+}
+
+// makeLanguageRequirements normalizes the requested language codes; a
+// language listed twice is rejected.
+func makeLanguageRequirements(requests []recruitment.LanguageRequirementRequest) ([]entity.LanguageRequirement, error) {
+	languages := make([]entity.LanguageRequirement, 0, len(requests))
+	seen := make(map[string]bool, len(requests))
+	for _, req := range requests {
+		code, err := bio.NormalizeLanguage(req.Language)
+		if err != nil {
+			return nil, err
+		}
+		if seen[code] {
+			return nil, bio.ErrorDuplicateLanguage
+		}
+		seen[code] = true
+
+		languages = append(languages, entity.LanguageRequirement{
+			Language: code,
+			MinLevel: entity.LanguageLevel(req.MinLevel),
+		})
+	}
+	return languages, nil
+}
+
+func (s *jobVacancyImpl) replaceLanguages(c context.Context, repo recruitmentRepository.Client, id string, languages []entity.LanguageRequirement) error {
+	if err := repo.JobVacancies.ReplaceJobVacancyLanguages(c, id, languages); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to save job vacancy languages")
+		return err
+	}
+
+	return nil
+}
+
+// attachLanguages loads the language requirements of a page of vacancies in
+// one query.
+func (s *jobVacancyImpl) attachLanguages(c context.Context, repo recruitmentRepository.Client, jobVacancies []entity.JobVacancy) error {
+	if len(jobVacancies) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(jobVacancies))
+	for i, jv := range jobVacancies {
+		ids[i] = jv.ID
+	}
+
+	languages, err := repo.JobVacancies.GetLanguagesByJobVacancyIDs(c, ids)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to get job vacancy languages")
+		return err
+	}
+
+	for i := range jobVacancies {
+		jobVacancies[i].Languages = languages[jobVacancies[i].ID]
+	}
 	return nil
 }
@@ -0,0 +1,31 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestIsOpenVacancy(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		jobVacancy entity.JobVacancy
+		want       bool
+	}{
+		{name: "active without deadline", jobVacancy: entity.JobVacancy{IsActive: true}, want: true},
+		{name: "active before deadline", jobVacancy: entity.JobVacancy{IsActive: true, Deadline: now.Add(time.Hour)}, want: true},
+		{name: "active on the deadline", jobVacancy: entity.JobVacancy{IsActive: true, Deadline: now}, want: true},
+		{name: "active past deadline", jobVacancy: entity.JobVacancy{IsActive: true, Deadline: now.Add(-time.Hour)}, want: false},
+		{name: "closed", jobVacancy: entity.JobVacancy{Deadline: now.Add(time.Hour)}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isOpenVacancy(tt.jobVacancy, now); got != tt.want {
+				t.Errorf("isOpenVacancy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
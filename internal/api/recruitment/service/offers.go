@@ -0,0 +1,673 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/api/recruitment"
+	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/pdf"
+	"ProjectGolang/pkg/smtp"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"html"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+func (s *offerImpl) CreateOffer(c context.Context, req recruitment.CreateOffer) (recruitment.OfferResponse, error) {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.OfferResponse{}, err
+	}
+	defer repo.Rollback()
+
+	// Lock the application so a status change or a second offer racing this
+	// one waits for it to commit.
+	application, err := lockOwnedJobApplication(c, repo, s.log, req.JobApplicationID, req.CompanyID)
+	if err != nil {
+		return recruitment.OfferResponse{}, err
+	}
+
+	if !canMakeOffer(application.Status) {
+		return recruitment.OfferResponse{}, recruitment.ErrorApplicationNotOffer
+	}
+
+	if err := requireHiringDecision(c, repo, application, entity.ApplicationStatusOffer); err != nil {
+		return recruitment.OfferResponse{}, err
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	startDate := time.Date(req.StartDate.Year(), req.StartDate.Month(), req.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+	if !req.ExpiresAt.After(now) || startDate.Before(today) {
+		return recruitment.OfferResponse{}, recruitment.ErrorOfferInvalidDates
+	}
+
+	existing, err := repo.Offers.GetOffersByJobApplicationID(c, application.ID)
+	if err != nil {
+		return recruitment.OfferResponse{}, err
+	}
+
+	for _, offer := range existing {
+		if offerStatus(offer, now) == entity.OfferStatusSent {
+			return recruitment.OfferResponse{}, recruitment.ErrorOfferPending
+		}
+	}
+
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return recruitment.OfferResponse{}, err
+	}
+
+	token, err := utils.GenerateToken(32)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate offer access token")
+		return recruitment.OfferResponse{}, err
+	}
+
+	offer := entity.Offer{
+		ID:               id,
+		JobApplicationID: application.ID,
+		CompanyID:        req.CompanyID,
+		SalaryAmount:     req.SalaryAmount,
+		SalaryCurrency:   req.SalaryCurrency,
+		SalaryPeriod:     req.SalaryPeriod,
+		StartDate:        startDate,
+		ExpiresAt:        req.ExpiresAt.UTC(),
+		Terms:            req.Terms,
+		Status:           entity.OfferStatusSent,
+		AccessToken:      token,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	if err := repo.Offers.CreateOffer(c, offer); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":              err.Error(),
+			"job_application_id": application.ID,
+		}).Error("Failed to create offer")
+		return recruitment.OfferResponse{}, err
+	}
+
+	if err := repo.JobApplications.UpdateJobApplicationStatus(c, application.ID, entity.ApplicationStatusOffer, req.MemberID, now); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    application.ID,
+		}).Error("Failed to move job application to offer")
+		return recruitment.OfferResponse{}, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit offer creation")
+		return recruitment.OfferResponse{}, err
+	}
+
+	// Reload to pick up the vacancy title and company name for the email.
+	offer, err = s.getOffer(c, offer.ID)
+	if err != nil {
+		return recruitment.OfferResponse{}, err
+	}
+
+	s.sendOfferMail(c, offer)
+	s.dispatchStatusChange(c, offer, entity.ApplicationStatusOffer)
+
+	s.log.WithFields(logrus.Fields{
+		"id":                 offer.ID,
+		"job_application_id": offer.JobApplicationID,
+	}).Info("Offer created successfully")
+
+	return makeOfferResponse(offer, now), nil
+}
+
+func (s *offerImpl) GetOffersByJobApplicationID(c context.Context, jobApplicationID string, companyID string) ([]recruitment.OfferResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	if _, err := getOwnedJobApplication(c, repo, s.log, jobApplicationID, companyID); err != nil {
+		return nil, err
+	}
+
+	offers, err := repo.Offers.GetOffersByJobApplicationID(c, jobApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return makeOfferResponses(offers), nil
+}
+
+func (s *offerImpl) GetOffersByUserID(c context.Context, userID string) ([]recruitment.OfferResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	offers, err := repo.Offers.GetOffersByUserID(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return makeOfferResponses(offers), nil
+}
+
+func (s *offerImpl) GetOfferByAccessToken(c context.Context, token string) (recruitment.OfferResponse, error) {
+	offer, err := s.getOfferByAccessToken(c, token)
+	if err != nil {
+		return recruitment.OfferResponse{}, err
+	}
+
+	return makeOfferResponse(offer, time.Now()), nil
+}
+
+func (s *offerImpl) GetOfferPDFByAccessToken(c context.Context, token string) ([]byte, error) {
+	offer, err := s.getOfferByAccessToken(c, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.renderOfferPDF(c, offer)
+}
+
+func (s *offerImpl) GetCompanyOfferPDF(c context.Context, id string, companyID string) ([]byte, error) {
+	offer, err := s.getOffer(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if offer.CompanyID != companyID {
+		return nil, recruitment.ErrorOfferNotFound
+	}
+
+	return s.renderOfferPDF(c, offer)
+}
+
+func (s *offerImpl) GetCandidateOfferPDF(c context.Context, id string, userID string) ([]byte, error) {
+	offer, err := s.getOffer(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if offer.UserID != userID {
+		return nil, recruitment.ErrorOfferNotFound
+	}
+
+	return s.renderOfferPDF(c, offer)
+}
+
+// WithdrawOffer pulls an open offer and puts the application back into the
+// interview stage.
+func (s *offerImpl) WithdrawOffer(c context.Context, id string, companyID string, memberID string) error {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+	defer repo.Rollback()
+
+	offer, err := repo.Offers.GetOfferByIDForUpdate(c, id)
+	if err != nil {
+		return err
+	}
+
+	if offer.ID == "" || offer.CompanyID != companyID {
+		return recruitment.ErrorOfferNotFound
+	}
+
+	now := time.Now()
+	if offerStatus(offer, now) != entity.OfferStatusSent {
+		return recruitment.ErrorOfferNotOpen
+	}
+
+	if err := updateSentOfferStatus(c, repo, id, entity.OfferStatusWithdrawn, nil, now); err != nil {
+		return err
+	}
+
+	if err := repo.JobApplications.UpdateJobApplicationStatus(c, offer.JobApplicationID, entity.ApplicationStatusInterview, memberID, now); err != nil {
+		return err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit offer withdrawal")
+		return err
+	}
+
+	s.dispatchStatusChange(c, offer, entity.ApplicationStatusInterview)
+
+	s.log.WithFields(logrus.Fields{
+		"id": id,
+	}).Info("Offer withdrawn successfully")
+
+	return nil
+}
+
+// AcceptOffer hires the candidate and closes the vacancy once as many
+// candidates were hired as it has openings.
+func (s *offerImpl) AcceptOffer(c context.Context, id string, userID string) error {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+	defer repo.Rollback()
+
+	offer, now, err := s.getRespondableOffer(c, repo, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := updateSentOfferStatus(c, repo, id, entity.OfferStatusAccepted, &now, now); err != nil {
+		return err
+	}
+
+	// Lock the vacancy before counting, so two acceptances racing for the
+	// last opening cannot both see it free.
+	jobVacancy, err := repo.JobVacancies.GetJobVacancyByIDForUpdate(c, offer.JobVacancyID)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.JobApplications.UpdateJobApplicationStatus(c, offer.JobApplicationID, entity.ApplicationStatusHired, "", now); err != nil {
+		return err
+	}
+
+	hired, err := repo.JobApplications.CountHiredJobApplications(c, offer.JobVacancyID)
+	if err != nil {
+		return err
+	}
+
+	filled := jobVacancy.IsActive && hired >= defaultHeadcount(jobVacancy.Headcount)
+	if filled {
+		if err := repo.JobVacancies.CloseJobVacancy(c, jobVacancy.ID, now); err != nil {
+			return err
+		}
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit offer acceptance")
+		return err
+	}
+
+	if filled {
+		if err := s.redis.DeleteCacheByPattern(c, recruitment.RecommendedJobsCachePattern); err != nil {
+			s.log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Warn("Failed to invalidate recommended jobs cache")
+		}
+	}
+
+	s.notifyCompany(c, offer, entity.OfferStatusAccepted)
+	s.dispatchStatusChange(c, offer, entity.ApplicationStatusHired)
+	if filled {
+		s.webhooks.Dispatch(c, offer.CompanyID, entity.WebhookVacancyClosed, vacancyClosedData(jobVacancy, "filled"))
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":             id,
+		"job_vacancy_id": offer.JobVacancyID,
+		"hired":          hired,
+		"vacancy_closed": filled,
+	}).Info("Offer accepted successfully")
+
+	return nil
+}
+
+func (s *offerImpl) DeclineOffer(c context.Context, id string, userID string) error {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+	defer repo.Rollback()
+
+	offer, now, err := s.getRespondableOffer(c, repo, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := updateSentOfferStatus(c, repo, id, entity.OfferStatusDeclined, &now, now); err != nil {
+		return err
+	}
+
+	if err := repo.JobApplications.UpdateJobApplicationStatus(c, offer.JobApplicationID, entity.ApplicationStatusRejected, "", now); err != nil {
+		return err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit offer decline")
+		return err
+	}
+
+	s.notifyCompany(c, offer, entity.OfferStatusDeclined)
+	s.dispatchStatusChange(c, offer, entity.ApplicationStatusRejected)
+
+	s.log.WithFields(logrus.Fields{
+		"id": id,
+	}).Info("Offer declined successfully")
+
+	return nil
+}
+
+// canMakeOffer reports whether an application in the given status can get an
+// offer. Applications already at the offer stage can get a new one once their
+// previous offers are closed.
+func canMakeOffer(status entity.ApplicationStatus) bool {
+	return status == entity.ApplicationStatusInterview || status == entity.ApplicationStatusOffer
+}
+
+// getRespondableOffer loads and locks an offer the candidate can still accept
+// or decline.
+func (s *offerImpl) getRespondableOffer(c context.Context, repo recruitmentRepository.Client, id string, userID string) (entity.Offer, time.Time, error) {
+	offer, err := repo.Offers.GetOfferByIDForUpdate(c, id)
+	if err != nil {
+		return entity.Offer{}, time.Time{}, err
+	}
+
+	if offer.ID == "" || offer.UserID != userID {
+		return entity.Offer{}, time.Time{}, recruitment.ErrorOfferNotFound
+	}
+
+	now := time.Now()
+	switch offerStatus(offer, now) {
+	case entity.OfferStatusSent:
+		return offer, now, nil
+	case entity.OfferStatusExpired:
+		return entity.Offer{}, time.Time{}, recruitment.ErrorOfferExpired
+	default:
+		return entity.Offer{}, time.Time{}, recruitment.ErrorOfferNotOpen
+	}
+}
+
+// updateSentOfferStatus closes a sent offer, failing when another request
+// already answered or withdrew it.
+func updateSentOfferStatus(c context.Context, repo recruitmentRepository.Client, id string, status entity.OfferStatus, respondedAt *time.Time, now time.Time) error {
+	updated, err := repo.Offers.UpdateSentOfferStatus(c, id, status, respondedAt, now)
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		return recruitment.ErrorOfferNotOpen
+	}
+
+	return nil
+}
+
+func (s *offerImpl) getOffer(c context.Context, id string) (entity.Offer, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return entity.Offer{}, err
+	}
+
+	offer, err := repo.Offers.GetOfferByID(c, id)
+	if err != nil {
+		return entity.Offer{}, err
+	}
+
+	if offer.ID == "" {
+		return entity.Offer{}, recruitment.ErrorOfferNotFound
+	}
+
+	return offer, nil
+}
+
+func (s *offerImpl) getOfferByAccessToken(c context.Context, token string) (entity.Offer, error) {
+	if token == "" {
+		return entity.Offer{}, recruitment.ErrorOfferNotFound
+	}
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return entity.Offer{}, err
+	}
+
+	offer, err := repo.Offers.GetOfferByAccessToken(c, token)
+	if err != nil {
+		return entity.Offer{}, err
+	}
+
+	if offer.ID == "" {
+		return entity.Offer{}, recruitment.ErrorOfferNotFound
+	}
+
+	return offer, nil
+}
+
+func (s *offerImpl) renderOfferPDF(c context.Context, offer entity.Offer) ([]byte, error) {
+	authRepo, err := s.authRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create auth repository client")
+		return nil, err
+	}
+
+	candidate, err := authRepo.User.GetUserByID(c, offer.UserID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": offer.UserID,
+		}).Error("Failed to get candidate for offer letter")
+		return nil, err
+	}
+
+	return buildOfferPDF(offer, candidate, time.Now()), nil
+}
+
+func (s *offerImpl) sendOfferMail(c context.Context, offer entity.Offer) {
+	authRepo, err := s.authRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create auth repository client")
+		return
+	}
+
+	candidate, err := authRepo.User.GetUserByID(c, offer.UserID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": offer.UserID,
+		}).Error("Failed to get candidate for offer email")
+		return
+	}
+
+	offerURL := fmt.Sprintf("%s/api/v1/recruitment/offers/token/%s",
+		strings.TrimRight(os.Getenv("APP_URL"), "/"), url.PathEscape(offer.AccessToken))
+
+	var body strings.Builder
+	body.WriteString(fmt.Sprintf("<p>Hello %s,</p>", html.EscapeString(candidate.Name)))
+	body.WriteString(fmt.Sprintf("<p><b>%s</b> has made you an offer for <b>%s</b>.</p>",
+		html.EscapeString(offer.CompanyName), html.EscapeString(offer.JobTitle)))
+	body.WriteString(fmt.Sprintf("<p>The offer is valid until %s. <a href=\"%s/pdf\">Download the offer letter</a>.</p>",
+		offer.ExpiresAt.Format("02 January 2006 15:04 MST"), offerURL))
+	body.WriteString("<p>Log in to accept or decline the offer. This link is personal, please do not share it.</p>")
+
+	mail := smtp.Mail{
+		To:      candidate.Email,
+		Subject: fmt.Sprintf("Your offer from %s", offer.CompanyName),
+		Body:    body.String(),
+	}
+
+	s.notifier.Notify(c, notification.Event{
+		RecipientID: candidate.ID,
+		Type:        entity.NotificationOffer,
+		Title:       mail.Subject,
+		Body: fmt.Sprintf("%s has made you an offer for %s, valid until %s.",
+			offer.CompanyName, offer.JobTitle, offer.ExpiresAt.Format("02 January 2006")),
+		Data: map[string]string{
+			"offer_id":           offer.ID,
+			"job_application_id": offer.JobApplicationID,
+		},
+		Mail: &mail,
+	})
+}
+
+func (s *offerImpl) notifyCompany(c context.Context, offer entity.Offer, status entity.OfferStatus) {
+	authRepo, err := s.authRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create auth repository client")
+		return
+	}
+
+	company, err := authRepo.Company.GetCompanyByID(c, offer.CompanyID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":      err.Error(),
+			"company_id": offer.CompanyID,
+		}).Error("Failed to get company for offer response email")
+		return
+	}
+
+	verb := strings.ToLower(string(status))
+	mail := smtp.Mail{
+		To:      company.Email,
+		Subject: fmt.Sprintf("Offer for %s %s", offer.JobTitle, verb),
+		Body: fmt.Sprintf("<p>The candidate has %s your offer for <b>%s</b>.</p>",
+			verb, html.EscapeString(offer.JobTitle)),
+	}
+
+	s.notifier.Notify(c, notification.Event{
+		RecipientID: company.ID,
+		Type:        entity.NotificationOffer,
+		Title:       mail.Subject,
+		Body:        fmt.Sprintf("The candidate has %s your offer for %s.", verb, offer.JobTitle),
+		Data: map[string]string{
+			"offer_id":           offer.ID,
+			"job_application_id": offer.JobApplicationID,
+		},
+		Mail: &mail,
+	})
+}
+
+// offerStatus reports open offers past their expiry as expired.
+func offerStatus(offer entity.Offer, now time.Time) entity.OfferStatus {
+	if offer.Status == entity.OfferStatusSent && !offer.ExpiresAt.After(now) {
+		return entity.OfferStatusExpired
+	}
+	return offer.Status
+}
+
+func buildOfferPDF(offer entity.Offer, candidate entity.User, now time.Time) []byte {
+	doc := pdf.New()
+
+	doc.SetFont(pdf.HelveticaBold, 20)
+	doc.Paragraph(offer.CompanyName)
+	doc.SetFont(pdf.Helvetica, 10)
+	doc.Paragraph(fmt.Sprintf("Offer reference %s", offer.ID))
+	doc.Rule()
+	doc.Space(10)
+
+	doc.SetFont(pdf.HelveticaBold, 14)
+	doc.Paragraph(fmt.Sprintf("Offer of employment: %s", offer.JobTitle))
+	doc.Space(6)
+
+	doc.SetFont(pdf.Helvetica, 11)
+	doc.Paragraph(fmt.Sprintf("Dear %s,", candidate.Name))
+	doc.Space(4)
+	doc.Paragraph(fmt.Sprintf("We are pleased to offer you the position of %s at %s on the terms below.",
+		offer.JobTitle, offer.CompanyName))
+	doc.Space(10)
+
+	fields := [][2]string{
+		{"Salary", fmt.Sprintf("%s %s per %s", offer.SalaryCurrency, formatAmount(offer.SalaryAmount), salaryPeriodUnit(offer.SalaryPeriod))},
+		{"Start date", offer.StartDate.Format("02 January 2006")},
+		{"Offer valid until", offer.ExpiresAt.Format("02 January 2006 15:04 MST")},
+		{"Status", string(offerStatus(offer, now))},
+	}
+	for _, field := range fields {
+		doc.SetFont(pdf.HelveticaBold, 11)
+		doc.Cell(130, field[0])
+		doc.SetFont(pdf.Helvetica, 11)
+		doc.Cell(doc.Width()-130, field[1])
+		doc.Ln()
+	}
+
+	doc.Space(10)
+	doc.SetFont(pdf.HelveticaBold, 12)
+	doc.Paragraph("Terms")
+	doc.SetFont(pdf.Helvetica, 11)
+	doc.Paragraph(offer.Terms)
+
+	doc.Space(16)
+	doc.SetFont(pdf.Helvetica, 9)
+	doc.Paragraph(fmt.Sprintf("Generated on %s. Accept or decline this offer from your account.",
+		now.UTC().Format("02 January 2006 15:04 MST")))
+
+	return doc.Bytes()
+}
+
+// formatAmount groups thousands with dots, e.g. 12500000 -> 12.500.000.
+func formatAmount(amount int64) string {
+	digits := fmt.Sprintf("%d", amount)
+	var out strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			out.WriteByte('.')
+		}
+		out.WriteRune(d)
+	}
+	return out.String()
+}
+
+func salaryPeriodUnit(period entity.SalaryPeriod) string {
+	switch period {
+	case entity.SalaryPeriodHourly:
+		return "hour"
+	case entity.SalaryPeriodYearly:
+		return "year"
+	default:
+		return "month"
+	}
+}
+
+// dispatchStatusChange emits the application status webhook for a status an
+// offer moved the application to.
+func (s *offerImpl) dispatchStatusChange(c context.Context, offer entity.Offer, status entity.ApplicationStatus) {
+	application := entity.JobApplication{
+		ID:           offer.JobApplicationID,
+		JobVacancyID: offer.JobVacancyID,
+		UserID:       offer.UserID,
+	}
+	s.webhooks.Dispatch(c, offer.CompanyID, entity.WebhookApplicationStatusChanged, applicationStatusData(application, status))
+}
@@ -0,0 +1,56 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestOfferStatus(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		status    entity.OfferStatus
+		expiresAt time.Time
+		want      entity.OfferStatus
+	}{
+		{name: "open offer", status: entity.OfferStatusSent, expiresAt: now.Add(time.Hour), want: entity.OfferStatusSent},
+		{name: "open offer past expiry", status: entity.OfferStatusSent, expiresAt: now.Add(-time.Hour), want: entity.OfferStatusExpired},
+		{name: "open offer expiring now", status: entity.OfferStatusSent, expiresAt: now, want: entity.OfferStatusExpired},
+		{name: "accepted stays accepted", status: entity.OfferStatusAccepted, expiresAt: now.Add(-time.Hour), want: entity.OfferStatusAccepted},
+		{name: "declined stays declined", status: entity.OfferStatusDeclined, expiresAt: now.Add(-time.Hour), want: entity.OfferStatusDeclined},
+		{name: "withdrawn stays withdrawn", status: entity.OfferStatusWithdrawn, expiresAt: now.Add(-time.Hour), want: entity.OfferStatusWithdrawn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offer := entity.Offer{Status: tt.status, ExpiresAt: tt.expiresAt}
+			if got := offerStatus(offer, now); got != tt.want {
+				t.Errorf("offerStatus() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanMakeOffer(t *testing.T) {
+	tests := []struct {
+		status entity.ApplicationStatus
+		want   bool
+	}{
+		{entity.ApplicationStatusApplied, false},
+		{entity.ApplicationStatusReviewing, false},
+		{entity.ApplicationStatusInterview, true},
+		{entity.ApplicationStatusOffer, true},
+		{entity.ApplicationStatusHired, false},
+		{entity.ApplicationStatusRejected, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			if got := canMakeOffer(tt.status); got != tt.want {
+				t.Errorf("canMakeOffer(%s) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
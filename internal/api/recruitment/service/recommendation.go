@@ -0,0 +1,205 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"context"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// recommendedJobsCacheTTL bounds how long a vacancy closed after ranking can
+// linger in a cached feed; closing one cannot reach every user's cache.
+const recommendedJobsCacheTTL = 10 * time.Minute
+
+func (s *jobVacancyImpl) GetRecommendedJobVacancies(c context.Context, userID string, req recruitment.GetRecommendedJobs) (recruitment.PaginatedRecommendedJobsResponse, error) {
+	ranked, err := s.getRankedJobVacancies(c, userID)
+	if err != nil {
+		return recruitment.PaginatedRecommendedJobsResponse{}, err
+	}
+
+	totalCount := len(ranked)
+	start := (req.Page - 1) * req.PageSize
+	if start > totalCount {
+		start = totalCount
+	}
+	end := start + req.PageSize
+	if end > totalCount {
+		end = totalCount
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"user_id": userID,
+		"page":    req.Page,
+		"size":    req.PageSize,
+		"total":   totalCount,
+	}).Info("Recommended job vacancies fetched successfully")
+
+	return recruitment.PaginatedRecommendedJobsResponse{
+		JobVacancies: ranked[start:end],
+		TotalCount:   totalCount,
+		TotalPages:   totalPages(totalCount, req.PageSize),
+		CurrentPage:  req.Page,
+		PageSize:     req.PageSize,
+	}, nil
+}
+
+// getRankedJobVacancies returns the candidate's full ranked feed, served from
+// Redis when present so paging through it does not re-score every vacancy.
+func (s *jobVacancyImpl) getRankedJobVacancies(c context.Context, userID string) ([]recruitment.RecommendedJobVacancyResponse, error) {
+	cacheKey := recruitment.RecommendedJobsCacheKey(userID)
+
+	cached, err := s.redis.GetCache(c, cacheKey)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Warn("Failed to read recommended jobs cache")
+	}
+
+	if cached != "" {
+		var ranked []recruitment.RecommendedJobVacancyResponse
+		if err := json.Unmarshal([]byte(cached), &ranked); err == nil {
+			return openRecommendations(ranked, time.Now()), nil
+		}
+		s.log.WithFields(logrus.Fields{
+			"user_id": userID,
+		}).Warn("Discarding unreadable recommended jobs cache entry")
+	}
+
+	ranked, err := s.rankJobVacanciesForUser(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(ranked)
+	if err == nil {
+		err = s.redis.SetCache(c, cacheKey, string(payload), recommendedJobsCacheTTL)
+	}
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Warn("Failed to cache recommended jobs")
+	}
+
+	return ranked, nil
+}
+
+// openRecommendations drops cached vacancies whose deadline has passed since
+// the feed was ranked.
+func openRecommendations(ranked []recruitment.RecommendedJobVacancyResponse, now time.Time) []recruitment.RecommendedJobVacancyResponse {
+	open := make([]recruitment.RecommendedJobVacancyResponse, 0, len(ranked))
+	for _, jv := range ranked {
+		if isOpenVacancy(entity.JobVacancy{IsActive: jv.IsActive, Deadline: jv.Deadline}, now) {
+			open = append(open, jv)
+		}
+	}
+	return open
+}
+
+func (s *jobVacancyImpl) rankJobVacanciesForUser(c context.Context, userID string) ([]recruitment.RecommendedJobVacancyResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	authRepo, err := s.authRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create auth repository client")
+		return nil, err
+	}
+
+	bioRepo, err := s.bioRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create bio repository client")
+		return nil, err
+	}
+
+	user, err := authRepo.User.GetUserByID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get user by ID")
+		return nil, err
+	}
+
+	experiences, err := bioRepo.Experience.GetExperiencesByUserID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get experiences for recommendations")
+		return nil, err
+	}
+
+	skills, err := bioRepo.Skill.GetSkillsByUserID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get skills for recommendations")
+		return nil, err
+	}
+
+	applied, err := repo.JobApplications.GetAppliedJobVacancies(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get applied job vacancies")
+		return nil, err
+	}
+
+	savedJobs, err := repo.SavedJobs.GetSavedJobsByUserID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get saved jobs for recommendations")
+		return nil, err
+	}
+
+	saved := make([]entity.JobVacancy, len(savedJobs))
+	for i, sj := range savedJobs {
+		saved[i] = sj.JobVacancy
+	}
+
+	vacancies, err := repo.JobVacancies.GetActiveJobVacancies(c, time.Now())
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to get active job vacancies")
+		return nil, err
+	}
+
+	if err := s.attachSkills(c, repo, vacancies); err != nil {
+		return nil, err
+	}
+
+	exclude := make(map[string]struct{}, len(applied))
+	for _, jv := range applied {
+		exclude[jv.ID] = struct{}{}
+	}
+
+	signals := newCandidateSignals(user, skills, experiences, applied, saved)
+	ranked := rankJobVacancies(signals, vacancies, exclude)
+
+	s.log.WithFields(logrus.Fields{
+		"user_id":    userID,
+		"candidates": len(vacancies),
+		"excluded":   len(exclude),
+		"ranked":     len(ranked),
+	}).Debug("Ranked job vacancies for user")
+
+	return ranked, nil
+}
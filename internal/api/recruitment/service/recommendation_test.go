@@ -0,0 +1,35 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOpenRecommendations(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	recommendation := func(id string, isActive bool, deadline time.Time) recruitment.RecommendedJobVacancyResponse {
+		return recruitment.RecommendedJobVacancyResponse{
+			JobVacancyResponse: recruitment.JobVacancyResponse{ID: id, IsActive: isActive, Deadline: deadline},
+		}
+	}
+
+	ranked := []recruitment.RecommendedJobVacancyResponse{
+		recommendation("open", true, now.Add(time.Hour)),
+		recommendation("no-deadline", true, time.Time{}),
+		recommendation("past-deadline", true, now.Add(-time.Minute)),
+		recommendation("inactive", false, now.Add(time.Hour)),
+		recommendation("on-deadline", true, now),
+	}
+
+	var got []string
+	for _, jv := range openRecommendations(ranked, now) {
+		got = append(got, jv.ID)
+	}
+
+	// Rank order is kept for what remains.
+	if want := []string{"open", "no-deadline", "on-deadline"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("openRecommendations() = %v, want %v", got, want)
+	}
+}
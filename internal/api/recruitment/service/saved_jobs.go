@@ -0,0 +1,141 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+func (s *savedJobImpl) SaveJobVacancy(c context.Context, userID string, jobVacancyID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	exists, err := repo.JobVacancies.CheckJobVacancyExists(c, jobVacancyID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Failed to check if job vacancy exists")
+		return err
+	}
+
+	if !exists {
+		s.log.WithFields(logrus.Fields{
+			"job_vacancy_id": jobVacancyID,
+		}).Warn("Job vacancy not found")
+		return recruitment.ErrorJobVacancyNotFound
+	}
+
+	savedJob := entity.SavedJob{
+		UserID:       userID,
+		JobVacancyID: jobVacancyID,
+		CreatedAt:    time.Now(),
+	}
+
+	if err := repo.SavedJobs.SaveJob(c, savedJob); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"user_id":        userID,
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Failed to save job vacancy")
+		return err
+	}
+
+	s.invalidateRecommendations(c, userID)
+
+	s.log.WithFields(logrus.Fields{
+		"user_id":        userID,
+		"job_vacancy_id": jobVacancyID,
+	}).Info("Job vacancy saved successfully")
+
+	return nil
+}
+
+func (s *savedJobImpl) UnsaveJobVacancy(c context.Context, userID string, jobVacancyID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	deleted, err := repo.SavedJobs.DeleteSavedJob(c, userID, jobVacancyID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"user_id":        userID,
+			"job_vacancy_id": jobVacancyID,
+		}).Error("Failed to delete saved job")
+		return err
+	}
+
+	if !deleted {
+		s.log.WithFields(logrus.Fields{
+			"user_id":        userID,
+			"job_vacancy_id": jobVacancyID,
+		}).Warn("Saved job not found")
+		return recruitment.ErrorSavedJobNotFound
+	}
+
+	s.invalidateRecommendations(c, userID)
+
+	s.log.WithFields(logrus.Fields{
+		"user_id":        userID,
+		"job_vacancy_id": jobVacancyID,
+	}).Info("Saved job removed successfully")
+
+	return nil
+}
+
+func (s *savedJobImpl) GetSavedJobsByUserID(c context.Context, userID string) ([]recruitment.SavedJobResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	savedJobs, err := repo.SavedJobs.GetSavedJobsByUserID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get saved jobs by user ID")
+		return nil, err
+	}
+
+	responses := make([]recruitment.SavedJobResponse, len(savedJobs))
+	for i, sj := range savedJobs {
+		responses[i] = recruitment.SavedJobResponse{
+			JobVacancyResponse: makeJobVacancyResponse(sj.JobVacancy),
+			SavedAt:            sj.CreatedAt,
+		}
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"user_id": userID,
+		"count":   len(responses),
+	}).Debug("Saved jobs retrieved successfully")
+
+	return responses, nil
+}
+
+// invalidateRecommendations drops the user's cached feed since saved jobs feed
+// the ranking.
+func (s *savedJobImpl) invalidateRecommendations(c context.Context, userID string) {
+	if err := s.redis.DeleteCache(c, recruitment.RecommendedJobsCacheKey(userID)); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Warn("Failed to invalidate recommended jobs cache")
+	}
+}
@@ -0,0 +1,188 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+const unsubscribeTokenSize = 32
+
+func (s *savedSearchImpl) CreateSavedSearch(c context.Context, req recruitment.CreateSavedSearch) (recruitment.SavedSearchResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.SavedSearchResponse{}, err
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return recruitment.SavedSearchResponse{}, err
+	}
+
+	token, err := utils.GenerateToken(unsubscribeTokenSize)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate unsubscribe token")
+		return recruitment.SavedSearchResponse{}, err
+	}
+
+	search := entity.SavedSearch{
+		ID:               id,
+		UserID:           req.UserID,
+		Name:             strings.TrimSpace(req.Name),
+		Query:            strings.TrimSpace(req.Query),
+		Location:         strings.TrimSpace(req.Location),
+		JobType:          req.JobType,
+		Frequency:        req.Frequency,
+		UnsubscribeToken: token,
+		IsActive:         true,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	if err := repo.SavedSearches.CreateSavedSearch(c, search); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": req.UserID,
+		}).Error("Failed to create saved search")
+		return recruitment.SavedSearchResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":        search.ID,
+		"user_id":   search.UserID,
+		"frequency": search.Frequency,
+	}).Info("Saved search created successfully")
+
+	return makeSavedSearchResponse(search), nil
+}
+
+func (s *savedSearchImpl) GetSavedSearchesByUserID(c context.Context, userID string) ([]recruitment.SavedSearchResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	searches, err := repo.SavedSearches.GetSavedSearchesByUserID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get saved searches by user ID")
+		return nil, err
+	}
+
+	responses := make([]recruitment.SavedSearchResponse, len(searches))
+	for i, ss := range searches {
+		responses[i] = makeSavedSearchResponse(ss)
+	}
+
+	return responses, nil
+}
+
+func (s *savedSearchImpl) DeleteSavedSearch(c context.Context, id string, userID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	search, err := repo.SavedSearches.GetSavedSearchByID(c, id)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to get saved search by ID")
+		return err
+	}
+
+	if search.ID == "" || search.UserID != userID {
+		s.log.WithFields(logrus.Fields{
+			"id":      id,
+			"user_id": userID,
+		}).Warn("Saved search not found")
+		return recruitment.ErrorSavedSearchNotFound
+	}
+
+	if err := repo.SavedSearches.DeleteSavedSearch(c, id); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to delete saved search")
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":      id,
+		"user_id": userID,
+	}).Info("Saved search deleted successfully")
+
+	return nil
+}
+
+// GetUnsubscribeSavedSearch looks up the alert an unsubscribe link points at,
+// so it can be confirmed before anything changes.
+func (s *savedSearchImpl) GetUnsubscribeSavedSearch(c context.Context, token string) (recruitment.SavedSearchResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.SavedSearchResponse{}, err
+	}
+
+	search, err := repo.SavedSearches.GetSavedSearchByUnsubscribeToken(c, token)
+	if err != nil {
+		return recruitment.SavedSearchResponse{}, err
+	}
+
+	if search.ID == "" {
+		return recruitment.SavedSearchResponse{}, recruitment.ErrorInvalidUnsubscribeToken
+	}
+
+	return makeSavedSearchResponse(search), nil
+}
+
+func (s *savedSearchImpl) Unsubscribe(c context.Context, token string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	updated, err := repo.SavedSearches.UnsubscribeSavedSearch(c, token, time.Now())
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to unsubscribe saved search")
+		return err
+	}
+
+	if !updated {
+		s.log.Warn("Unsubscribe token did not match any saved search")
+		return recruitment.ErrorInvalidUnsubscribeToken
+	}
+
+	s.log.Info("Saved search unsubscribed successfully")
+
+	return nil
+}
@@ -0,0 +1,469 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"github.com/sirupsen/logrus"
+	"math"
+	"time"
+)
+
+// recommendationScores maps each recommendation onto a 1-4 scale so several
+// scorecards can be averaged into one.
+var recommendationScores = map[entity.ScorecardRecommendation]float64{
+	entity.RecommendationStrongNo:  1,
+	entity.RecommendationNo:        2,
+	entity.RecommendationYes:       3,
+	entity.RecommendationStrongYes: 4,
+}
+
+func (s *scorecardImpl) SaveScorecardTemplate(c context.Context, req recruitment.SaveScorecardTemplate) (recruitment.ScorecardTemplateResponse, error) {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.ScorecardTemplateResponse{}, err
+	}
+	defer repo.Rollback()
+
+	if _, err := getOwnedJobVacancy(c, repo, s.log, req.JobVacancyID, req.CompanyID); err != nil {
+		return recruitment.ScorecardTemplateResponse{}, err
+	}
+
+	existing, err := repo.Scorecards.GetScorecardTemplateByJobVacancyID(c, req.JobVacancyID)
+	if err != nil {
+		return recruitment.ScorecardTemplateResponse{}, err
+	}
+
+	now := time.Now()
+	template := entity.ScorecardTemplate{
+		ID:           existing.ID,
+		JobVacancyID: req.JobVacancyID,
+		CompanyID:    req.CompanyID,
+		Name:         req.Name,
+		CreatedAt:    existing.CreatedAt,
+		UpdatedAt:    now,
+	}
+
+	if existing.ID != "" {
+		count, err := repo.Scorecards.CountScorecardsByTemplateID(c, existing.ID)
+		if err != nil {
+			return recruitment.ScorecardTemplateResponse{}, err
+		}
+
+		// Ratings point at competencies, so a template in use is frozen.
+		if count > 0 {
+			return recruitment.ScorecardTemplateResponse{}, recruitment.ErrorScorecardTemplateInUse
+		}
+	} else {
+		template.ID, err = utils.NewUlidFromTimestamp(now)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Failed to generate ULID")
+			return recruitment.ScorecardTemplateResponse{}, err
+		}
+		template.CreatedAt = now
+	}
+
+	for i, req := range req.Competencies {
+		if len(req.ScaleLabels) > 0 && len(req.ScaleLabels) != req.ScaleMax {
+			return recruitment.ScorecardTemplateResponse{}, recruitment.ErrorScorecardLabelsMismatch
+		}
+
+		id, err := utils.NewUlidFromTimestamp(now)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Failed to generate ULID")
+			return recruitment.ScorecardTemplateResponse{}, err
+		}
+
+		labels := req.ScaleLabels
+		if labels == nil {
+			labels = []string{}
+		}
+
+		template.Competencies = append(template.Competencies, entity.ScorecardCompetency{
+			ID:          id,
+			TemplateID:  template.ID,
+			Name:        req.Name,
+			Description: req.Description,
+			ScaleMax:    req.ScaleMax,
+			ScaleLabels: labels,
+			Position:    i,
+		})
+	}
+
+	if existing.ID != "" {
+		err = repo.Scorecards.UpdateScorecardTemplate(c, template)
+	} else {
+		err = repo.Scorecards.CreateScorecardTemplate(c, template)
+	}
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": req.JobVacancyID,
+		}).Error("Failed to save scorecard template")
+		return recruitment.ScorecardTemplateResponse{}, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit scorecard template")
+		return recruitment.ScorecardTemplateResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":             template.ID,
+		"job_vacancy_id": template.JobVacancyID,
+		"competencies":   len(template.Competencies),
+	}).Info("Scorecard template saved successfully")
+
+	return makeScorecardTemplateResponse(template), nil
+}
+
+func (s *scorecardImpl) GetScorecardTemplate(c context.Context, jobVacancyID string, companyID string) (recruitment.ScorecardTemplateResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.ScorecardTemplateResponse{}, err
+	}
+
+	if _, err := getOwnedJobVacancy(c, repo, s.log, jobVacancyID, companyID); err != nil {
+		return recruitment.ScorecardTemplateResponse{}, err
+	}
+
+	template, err := repo.Scorecards.GetScorecardTemplateByJobVacancyID(c, jobVacancyID)
+	if err != nil {
+		return recruitment.ScorecardTemplateResponse{}, err
+	}
+
+	if template.ID == "" {
+		return recruitment.ScorecardTemplateResponse{}, recruitment.ErrorScorecardTemplateNotFound
+	}
+
+	return makeScorecardTemplateResponse(template), nil
+}
+
+func (s *scorecardImpl) DeleteScorecardTemplate(c context.Context, jobVacancyID string, companyID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	if _, err := getOwnedJobVacancy(c, repo, s.log, jobVacancyID, companyID); err != nil {
+		return err
+	}
+
+	template, err := repo.Scorecards.GetScorecardTemplateByJobVacancyID(c, jobVacancyID)
+	if err != nil {
+		return err
+	}
+
+	if template.ID == "" {
+		return recruitment.ErrorScorecardTemplateNotFound
+	}
+
+	count, err := repo.Scorecards.CountScorecardsByTemplateID(c, template.ID)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return recruitment.ErrorScorecardTemplateInUse
+	}
+
+	if err := repo.Scorecards.DeleteScorecardTemplate(c, template.ID); err != nil {
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":             template.ID,
+		"job_vacancy_id": jobVacancyID,
+	}).Info("Scorecard template deleted successfully")
+
+	return nil
+}
+
+func (s *scorecardImpl) SubmitScorecard(c context.Context, req recruitment.SubmitScorecard) (recruitment.ScorecardResponse, error) {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.ScorecardResponse{}, err
+	}
+	defer repo.Rollback()
+
+	// The lock keeps a scorecard from changing while a status change is
+	// checking the hiring decision.
+	application, err := lockOwnedJobApplication(c, repo, s.log, req.JobApplicationID, req.CompanyID)
+	if err != nil {
+		return recruitment.ScorecardResponse{}, err
+	}
+
+	if application.Status == entity.ApplicationStatusApplied || application.Status == entity.ApplicationStatusReviewing {
+		return recruitment.ScorecardResponse{}, recruitment.ErrorScorecardNotInterviewed
+	}
+
+	template, err := repo.Scorecards.GetScorecardTemplateByJobVacancyID(c, application.JobVacancyID)
+	if err != nil {
+		return recruitment.ScorecardResponse{}, err
+	}
+
+	if template.ID == "" {
+		return recruitment.ScorecardResponse{}, recruitment.ErrorScorecardTemplateNotFound
+	}
+
+	ratings, err := makeScorecardRatings(template, req.Ratings)
+	if err != nil {
+		return recruitment.ScorecardResponse{}, err
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return recruitment.ScorecardResponse{}, err
+	}
+
+	scorecard := entity.Scorecard{
+		ID:               id,
+		JobApplicationID: application.ID,
+		TemplateID:       template.ID,
+		InterviewerID:    req.InterviewerID,
+		Recommendation:   req.Recommendation,
+		Notes:            req.Notes,
+		SubmittedAt:      now,
+		UpdatedAt:        now,
+		Ratings:          ratings,
+	}
+
+	scorecard.ID, err = repo.Scorecards.UpsertScorecard(c, scorecard)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":              err.Error(),
+			"job_application_id": application.ID,
+		}).Error("Failed to save scorecard")
+		return recruitment.ScorecardResponse{}, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit scorecard")
+		return recruitment.ScorecardResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":                 scorecard.ID,
+		"job_application_id": application.ID,
+		"interviewer_id":     req.InterviewerID,
+		"recommendation":     req.Recommendation,
+	}).Info("Scorecard submitted successfully")
+
+	return makeScorecardResponse(scorecard), nil
+}
+
+func (s *scorecardImpl) GetApplicationScorecards(c context.Context, jobApplicationID string, companyID string, interviewerID string) (recruitment.ApplicationScorecardsResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.ApplicationScorecardsResponse{}, err
+	}
+
+	application, err := getOwnedJobApplication(c, repo, s.log, jobApplicationID, companyID)
+	if err != nil {
+		return recruitment.ApplicationScorecardsResponse{}, err
+	}
+
+	template, err := repo.Scorecards.GetScorecardTemplateByJobVacancyID(c, application.JobVacancyID)
+	if err != nil {
+		return recruitment.ApplicationScorecardsResponse{}, err
+	}
+
+	scorecards, err := repo.Scorecards.GetScorecardsByJobApplicationIDs(c, []string{application.ID})
+	if err != nil {
+		return recruitment.ApplicationScorecardsResponse{}, err
+	}
+
+	response := recruitment.ApplicationScorecardsResponse{SubmittedCount: len(scorecards)}
+	for _, scorecard := range scorecards {
+		if scorecard.InterviewerID == interviewerID {
+			own := makeScorecardResponse(scorecard)
+			response.OwnScorecard = &own
+		}
+	}
+
+	if response.OwnScorecard == nil {
+		return response, nil
+	}
+
+	response.Summary = summarizeScorecards(template, scorecards)
+	response.Scorecards = make([]recruitment.ScorecardResponse, len(scorecards))
+	for i, scorecard := range scorecards {
+		response.Scorecards[i] = makeScorecardResponse(scorecard)
+	}
+
+	return response, nil
+}
+
+// requireHiringDecision blocks offers and rejections of interviewed
+// applications until the interviewers have decided. See checkHiringDecision.
+func requireHiringDecision(c context.Context, repo recruitmentRepository.Client, application entity.JobApplication, status entity.ApplicationStatus) error {
+	if application.Status != entity.ApplicationStatusInterview {
+		return nil
+	}
+	if status != entity.ApplicationStatusOffer && status != entity.ApplicationStatusRejected {
+		return nil
+	}
+
+	required, err := repo.Interviews.GetInterviewerMemberIDs(c, application.ID)
+	if err != nil {
+		return err
+	}
+
+	scorecards, err := repo.Scorecards.GetScorecardsByJobApplicationIDs(c, []string{application.ID})
+	if err != nil {
+		return err
+	}
+
+	return checkHiringDecision(status, required, scorecards)
+}
+
+// checkHiringDecision needs a scorecard from every required interviewer, and
+// at least one when no member sat on the interviews. An offer also needs the
+// aggregated recommendation to come out as a yes.
+func checkHiringDecision(status entity.ApplicationStatus, required []string, scorecards []entity.Scorecard) error {
+	if len(scorecards) == 0 {
+		return recruitment.ErrorHiringDecisionPending
+	}
+
+	submitted := make(map[string]struct{}, len(scorecards))
+	for _, scorecard := range scorecards {
+		submitted[scorecard.InterviewerID] = struct{}{}
+	}
+	for _, interviewerID := range required {
+		if _, ok := submitted[interviewerID]; !ok {
+			return recruitment.ErrorHiringDecisionPending
+		}
+	}
+
+	if status != entity.ApplicationStatusOffer {
+		return nil
+	}
+
+	summary := summarizeScorecards(entity.ScorecardTemplate{}, scorecards)
+	if summary.Recommendation != entity.RecommendationYes && summary.Recommendation != entity.RecommendationStrongYes {
+		return recruitment.ErrorHiringDecisionNegative
+	}
+
+	return nil
+}
+
+// makeScorecardRatings checks the ratings cover the template exactly and stay
+// within each competency's scale.
+func makeScorecardRatings(template entity.ScorecardTemplate, requests []recruitment.ScorecardRatingRequest) ([]entity.ScorecardRating, error) {
+	if len(requests) != len(template.Competencies) {
+		return nil, recruitment.ErrorScorecardRatingsMismatch
+	}
+
+	scales := make(map[string]int, len(template.Competencies))
+	for _, competency := range template.Competencies {
+		scales[competency.ID] = competency.ScaleMax
+	}
+
+	ratings := make([]entity.ScorecardRating, 0, len(requests))
+	seen := make(map[string]struct{}, len(requests))
+	for _, req := range requests {
+		scaleMax, ok := scales[req.CompetencyID]
+		if !ok {
+			return nil, recruitment.ErrorScorecardRatingsMismatch
+		}
+		if _, ok := seen[req.CompetencyID]; ok {
+			return nil, recruitment.ErrorScorecardRatingsMismatch
+		}
+		seen[req.CompetencyID] = struct{}{}
+
+		if req.Rating < 1 || req.Rating > scaleMax {
+			return nil, recruitment.ErrorScorecardRatingOutOfScale
+		}
+
+		ratings = append(ratings, entity.ScorecardRating{
+			CompetencyID: req.CompetencyID,
+			Rating:       req.Rating,
+			Comment:      req.Comment,
+		})
+	}
+
+	return ratings, nil
+}
+
+func summarizeScorecards(template entity.ScorecardTemplate, scorecards []entity.Scorecard) *recruitment.ScorecardSummary {
+	if len(scorecards) == 0 {
+		return nil
+	}
+
+	summary := &recruitment.ScorecardSummary{
+		Votes:          map[entity.ScorecardRecommendation]int{},
+		Competencies:   []recruitment.CompetencyAverage{},
+		SubmittedCount: len(scorecards),
+	}
+
+	totals := map[string]float64{}
+	counts := map[string]int{}
+	var score float64
+	for _, scorecard := range scorecards {
+		summary.Votes[scorecard.Recommendation]++
+		score += recommendationScores[scorecard.Recommendation]
+		for _, rating := range scorecard.Ratings {
+			totals[rating.CompetencyID] += float64(rating.Rating)
+			counts[rating.CompetencyID]++
+		}
+	}
+
+	summary.Score = roundScore(score / float64(len(scorecards)))
+	switch {
+	case summary.Score >= 3.5:
+		summary.Recommendation = entity.RecommendationStrongYes
+	case summary.Score >= 2.5:
+		summary.Recommendation = entity.RecommendationYes
+	case summary.Score >= 1.5:
+		summary.Recommendation = entity.RecommendationNo
+	default:
+		summary.Recommendation = entity.RecommendationStrongNo
+	}
+
+	for _, competency := range template.Competencies {
+		if counts[competency.ID] == 0 {
+			continue
+		}
+		summary.Competencies = append(summary.Competencies, recruitment.CompetencyAverage{
+			CompetencyID: competency.ID,
+			Name:         competency.Name,
+			ScaleMax:     competency.ScaleMax,
+			Average:      roundScore(totals[competency.ID] / float64(counts[competency.ID])),
+		})
+	}
+
+	return summary
+}
+
+func roundScore(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,79 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"errors"
+	"testing"
+)
+
+func scorecard(interviewerID string, recommendation entity.ScorecardRecommendation) entity.Scorecard {
+	return entity.Scorecard{InterviewerID: interviewerID, Recommendation: recommendation}
+}
+
+func TestCheckHiringDecision(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     entity.ApplicationStatus
+		required   []string
+		scorecards []entity.Scorecard
+		want       error
+	}{
+		{
+			name:   "no scorecards",
+			status: entity.ApplicationStatusRejected,
+			want:   recruitment.ErrorHiringDecisionPending,
+		},
+		{
+			name:       "required interviewer missing",
+			status:     entity.ApplicationStatusOffer,
+			required:   []string{"alice", "bob"},
+			scorecards: []entity.Scorecard{scorecard("alice", entity.RecommendationStrongYes)},
+			want:       recruitment.ErrorHiringDecisionPending,
+		},
+		{
+			name:       "one scorecard is enough without required interviewers",
+			status:     entity.ApplicationStatusOffer,
+			scorecards: []entity.Scorecard{scorecard("alice", entity.RecommendationYes)},
+		},
+		{
+			name:     "every required interviewer agrees",
+			status:   entity.ApplicationStatusOffer,
+			required: []string{"alice", "bob"},
+			scorecards: []entity.Scorecard{
+				scorecard("alice", entity.RecommendationStrongYes),
+				scorecard("bob", entity.RecommendationYes),
+			},
+		},
+		{
+			name:   "split vote averaging to yes",
+			status: entity.ApplicationStatusOffer,
+			scorecards: []entity.Scorecard{
+				scorecard("alice", entity.RecommendationYes),
+				scorecard("bob", entity.RecommendationNo),
+			},
+		},
+		{
+			name:   "offer against a negative summary",
+			status: entity.ApplicationStatusOffer,
+			scorecards: []entity.Scorecard{
+				scorecard("alice", entity.RecommendationYes),
+				scorecard("bob", entity.RecommendationStrongNo),
+			},
+			want: recruitment.ErrorHiringDecisionNegative,
+		},
+		{
+			name:       "rejection only needs the scorecards in",
+			status:     entity.ApplicationStatusRejected,
+			scorecards: []entity.Scorecard{scorecard("alice", entity.RecommendationStrongYes)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkHiringDecision(tt.status, tt.required, tt.scorecards); !errors.Is(err, tt.want) {
+				t.Errorf("checkHiringDecision() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,407 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+const maxScreeningFileSize = 10 * 1024 * 1024
+
+var yesNoOptions = []string{"yes", "no"}
+
+// SaveScreeningQuestions replaces the vacancy's questions. Once somebody has
+// applied the questions are frozen, so every applicant answered the same set.
+func (s *screeningQuestionImpl) SaveScreeningQuestions(c context.Context, req recruitment.SaveScreeningQuestions) ([]recruitment.ScreeningQuestionResponse, error) {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+	defer repo.Rollback()
+
+	if _, err := getOwnedJobVacancy(c, repo, s.log, req.JobVacancyID, req.RecruiterID); err != nil {
+		return nil, err
+	}
+
+	applications, err := repo.JobApplications.CountJobApplicationsByJobVacancyID(c, req.JobVacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if applications > 0 {
+		return nil, recruitment.ErrorScreeningQuestionsInUse
+	}
+
+	questions, err := makeScreeningQuestions(req.JobVacancyID, req.Questions, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.ScreeningQuestions.ReplaceScreeningQuestions(c, req.JobVacancyID, questions); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": req.JobVacancyID,
+		}).Error("Failed to save screening questions")
+		return nil, err
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit screening questions")
+		return nil, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"job_vacancy_id": req.JobVacancyID,
+		"count":          len(questions),
+	}).Info("Screening questions saved successfully")
+
+	return makeScreeningQuestionResponses(questions, true), nil
+}
+
+// GetScreeningQuestions lists the questions for applicants, without the
+// knockout criteria.
+func (s *screeningQuestionImpl) GetScreeningQuestions(c context.Context, jobVacancyID string) ([]recruitment.ScreeningQuestionResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	jobVacancy, err := repo.JobVacancies.GetJobVacancyByID(c, jobVacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if jobVacancy.ID == "" {
+		return nil, recruitment.ErrorJobVacancyNotFound
+	}
+
+	questions, err := repo.ScreeningQuestions.GetScreeningQuestionsByJobVacancyID(c, jobVacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return makeScreeningQuestionResponses(questions, false), nil
+}
+
+func (s *screeningQuestionImpl) GetScreeningQuestionConfig(c context.Context, jobVacancyID string, recruiterID string) ([]recruitment.ScreeningQuestionResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	if _, err := getOwnedJobVacancy(c, repo, s.log, jobVacancyID, recruiterID); err != nil {
+		return nil, err
+	}
+
+	questions, err := repo.ScreeningQuestions.GetScreeningQuestionsByJobVacancyID(c, jobVacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return makeScreeningQuestionResponses(questions, true), nil
+}
+
+func (s *screeningQuestionImpl) GetScreeningAnswers(c context.Context, jobApplicationID string, recruiterID string) ([]recruitment.ScreeningAnswerResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	application, err := getOwnedJobApplication(c, repo, s.log, jobApplicationID, recruiterID)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := repo.ScreeningQuestions.GetScreeningQuestionsByJobVacancyID(c, application.JobVacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := repo.ScreeningQuestions.GetScreeningAnswersByJobApplicationID(c, application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]entity.ScreeningQuestion, len(questions))
+	for _, question := range questions {
+		byID[question.ID] = question
+	}
+
+	responses := make([]recruitment.ScreeningAnswerResponse, len(answers))
+	for i, answer := range answers {
+		question := byID[answer.QuestionID]
+		responses[i] = recruitment.ScreeningAnswerResponse{
+			QuestionID: answer.QuestionID,
+			Prompt:     question.Prompt,
+			Type:       question.Type,
+			Values:     answer.Values,
+			Number:     answer.Number,
+			FileURL:    answer.FileURL,
+			KnockedOut: answer.KnockedOut,
+		}
+	}
+
+	return responses, nil
+}
+
+func makeScreeningQuestions(jobVacancyID string, reqs []recruitment.ScreeningQuestionRequest, now time.Time) ([]entity.ScreeningQuestion, error) {
+	questions := make([]entity.ScreeningQuestion, len(reqs))
+	for i, req := range reqs {
+		if err := validateScreeningQuestion(req); err != nil {
+			return nil, err
+		}
+
+		id, err := utils.NewUlidFromTimestamp(now)
+		if err != nil {
+			return nil, err
+		}
+
+		action := req.KnockoutAction
+		if action == "" && hasKnockout(req) {
+			action = entity.KnockoutActionReject
+		}
+
+		questions[i] = entity.ScreeningQuestion{
+			ID:              id,
+			JobVacancyID:    jobVacancyID,
+			Prompt:          strings.TrimSpace(req.Prompt),
+			Type:            req.Type,
+			Options:         req.Options,
+			Required:        req.Required,
+			KnockoutAnswers: req.KnockoutAnswers,
+			KnockoutMin:     req.KnockoutMin,
+			KnockoutMax:     req.KnockoutMax,
+			KnockoutAction:  action,
+			Position:        i,
+			CreatedAt:       now,
+		}
+	}
+
+	return questions, nil
+}
+
+func validateScreeningQuestion(req recruitment.ScreeningQuestionRequest) error {
+	if req.KnockoutAction != "" && !hasKnockout(req) {
+		return recruitment.ErrorScreeningQuestionInvalid
+	}
+
+	if (req.KnockoutMin != nil || req.KnockoutMax != nil) && req.Type != entity.ScreeningQuestionNumber {
+		return recruitment.ErrorScreeningQuestionInvalid
+	}
+
+	switch req.Type {
+	case entity.ScreeningQuestionYesNo:
+		if len(req.Options) > 0 || !containsAll(yesNoOptions, req.KnockoutAnswers) {
+			return recruitment.ErrorScreeningQuestionInvalid
+		}
+	case entity.ScreeningQuestionSingleChoice, entity.ScreeningQuestionMultipleChoice:
+		if len(req.Options) < 2 || hasDuplicates(req.Options) || !containsAll(req.Options, req.KnockoutAnswers) {
+			return recruitment.ErrorScreeningQuestionInvalid
+		}
+	case entity.ScreeningQuestionNumber:
+		if len(req.Options) > 0 || len(req.KnockoutAnswers) > 0 {
+			return recruitment.ErrorScreeningQuestionInvalid
+		}
+		if req.KnockoutMin != nil && req.KnockoutMax != nil && *req.KnockoutMin > *req.KnockoutMax {
+			return recruitment.ErrorScreeningQuestionInvalid
+		}
+	default:
+		if len(req.Options) > 0 || hasKnockout(req) {
+			return recruitment.ErrorScreeningQuestionInvalid
+		}
+	}
+
+	return nil
+}
+
+func hasKnockout(req recruitment.ScreeningQuestionRequest) bool {
+	return len(req.KnockoutAnswers) > 0 || req.KnockoutMin != nil || req.KnockoutMax != nil
+}
+
+// screeningResult is the outcome of checking an application's answers
+// against the vacancy's questions.
+type screeningResult struct {
+	Answers  []entity.ScreeningAnswer
+	Rejected bool
+	Flagged  bool
+}
+
+// evaluateScreeningAnswers validates the answers and applies the knockout
+// criteria. File answers come back with an empty FileURL; the caller uploads
+// the file once the whole application is known to be valid.
+func evaluateScreeningAnswers(questions []entity.ScreeningQuestion, req recruitment.CreateJobApplication) (screeningResult, error) {
+	byQuestion := make(map[string]recruitment.ScreeningAnswerRequest, len(req.Answers))
+	for _, answer := range req.Answers {
+		if _, ok := byQuestion[answer.QuestionID]; ok {
+			return screeningResult{}, recruitment.ErrorScreeningAnswerInvalid
+		}
+		byQuestion[answer.QuestionID] = answer
+	}
+
+	known := make(map[string]bool, len(questions))
+	for _, question := range questions {
+		known[question.ID] = true
+	}
+	for questionID := range byQuestion {
+		if !known[questionID] {
+			return screeningResult{}, recruitment.ErrorScreeningQuestionUnknown
+		}
+	}
+	for questionID := range req.Files {
+		if !known[questionID] {
+			return screeningResult{}, recruitment.ErrorScreeningQuestionUnknown
+		}
+	}
+
+	var result screeningResult
+	for _, question := range questions {
+		answer, answered := byQuestion[question.ID]
+		file := req.Files[question.ID]
+
+		if question.Type == entity.ScreeningQuestionFile {
+			if answered {
+				return screeningResult{}, recruitment.ErrorScreeningAnswerInvalid
+			}
+			if file == nil {
+				if question.Required {
+					return screeningResult{}, recruitment.ErrorScreeningAnswerRequired
+				}
+				continue
+			}
+			if file.Size > maxScreeningFileSize {
+				return screeningResult{}, recruitment.ErrorScreeningAnswerFileTooBig
+			}
+			result.Answers = append(result.Answers, entity.ScreeningAnswer{QuestionID: question.ID})
+			continue
+		}
+
+		if file != nil {
+			return screeningResult{}, recruitment.ErrorScreeningAnswerInvalid
+		}
+
+		if !answered || isBlankAnswer(answer) {
+			if question.Required {
+				return screeningResult{}, recruitment.ErrorScreeningAnswerRequired
+			}
+			continue
+		}
+
+		stored, err := makeScreeningAnswer(question, answer)
+		if err != nil {
+			return screeningResult{}, err
+		}
+
+		if knockedOut(question, stored) {
+			stored.KnockedOut = true
+			if question.KnockoutAction == entity.KnockoutActionFlag {
+				result.Flagged = true
+			} else {
+				result.Rejected = true
+			}
+		}
+
+		result.Answers = append(result.Answers, stored)
+	}
+
+	return result, nil
+}
+
+func isBlankAnswer(answer recruitment.ScreeningAnswerRequest) bool {
+	return strings.TrimSpace(answer.Value) == "" && len(answer.Values) == 0 && answer.Number == nil
+}
+
+func makeScreeningAnswer(question entity.ScreeningQuestion, answer recruitment.ScreeningAnswerRequest) (entity.ScreeningAnswer, error) {
+	stored := entity.ScreeningAnswer{QuestionID: question.ID}
+	value := strings.TrimSpace(answer.Value)
+
+	switch question.Type {
+	case entity.ScreeningQuestionYesNo:
+		value = strings.ToLower(value)
+		if len(answer.Values) > 0 || answer.Number != nil || !containsAll(yesNoOptions, []string{value}) {
+			return entity.ScreeningAnswer{}, recruitment.ErrorScreeningAnswerInvalid
+		}
+		stored.Values = []string{value}
+	case entity.ScreeningQuestionSingleChoice:
+		if len(answer.Values) > 0 || answer.Number != nil || !containsAll(question.Options, []string{value}) {
+			return entity.ScreeningAnswer{}, recruitment.ErrorScreeningAnswerInvalid
+		}
+		stored.Values = []string{value}
+	case entity.ScreeningQuestionMultipleChoice:
+		if value != "" || answer.Number != nil || hasDuplicates(answer.Values) || !containsAll(question.Options, answer.Values) {
+			return entity.ScreeningAnswer{}, recruitment.ErrorScreeningAnswerInvalid
+		}
+		stored.Values = answer.Values
+	case entity.ScreeningQuestionNumber:
+		if value != "" || len(answer.Values) > 0 || answer.Number == nil {
+			return entity.ScreeningAnswer{}, recruitment.ErrorScreeningAnswerInvalid
+		}
+		stored.Number = answer.Number
+	case entity.ScreeningQuestionText:
+		if len(answer.Values) > 0 || answer.Number != nil {
+			return entity.ScreeningAnswer{}, recruitment.ErrorScreeningAnswerInvalid
+		}
+		stored.Values = []string{value}
+	}
+
+	return stored, nil
+}
+
+func knockedOut(question entity.ScreeningQuestion, answer entity.ScreeningAnswer) bool {
+	if question.Type == entity.ScreeningQuestionNumber {
+		if answer.Number == nil {
+			return false
+		}
+		return (question.KnockoutMin != nil && *answer.Number < *question.KnockoutMin) ||
+			(question.KnockoutMax != nil && *answer.Number > *question.KnockoutMax)
+	}
+
+	for _, value := range answer.Values {
+		if containsAll(question.KnockoutAnswers, []string{value}) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAll reports whether every value is one of the allowed ones.
+func containsAll(allowed []string, values []string) bool {
+	set := make(map[string]bool, len(allowed))
+	for _, option := range allowed {
+		set[option] = true
+	}
+	for _, value := range values {
+		if !set[value] {
+			return false
+		}
+	}
+	return true
+}
+
+func hasDuplicates(values []string) bool {
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if seen[value] {
+			return true
+		}
+		seen[value] = true
+	}
+	return false
+}
@@ -0,0 +1,322 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"errors"
+	"mime/multipart"
+	"testing"
+)
+
+func number(n float64) *float64 {
+	return &n
+}
+
+func TestValidateScreeningQuestion(t *testing.T) {
+	tests := []struct {
+		name string
+		req  recruitment.ScreeningQuestionRequest
+		want error
+	}{
+		{
+			name: "yes/no with knockout",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionYesNo, KnockoutAnswers: []string{"no"}},
+		},
+		{
+			name: "yes/no with options",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionYesNo, Options: []string{"yes", "no"}},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "yes/no knockout outside yes and no",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionYesNo, KnockoutAnswers: []string{"maybe"}},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "single choice",
+			req: recruitment.ScreeningQuestionRequest{
+				Type:            entity.ScreeningQuestionSingleChoice,
+				Options:         []string{"Onsite", "Remote"},
+				KnockoutAnswers: []string{"Onsite"},
+				KnockoutAction:  entity.KnockoutActionFlag,
+			},
+		},
+		{
+			name: "choice needs two options",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionSingleChoice, Options: []string{"Only"}},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "choice with duplicate options",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionMultipleChoice, Options: []string{"Go", "Go"}},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "choice knockout outside the options",
+			req: recruitment.ScreeningQuestionRequest{
+				Type:            entity.ScreeningQuestionMultipleChoice,
+				Options:         []string{"Go", "Rust"},
+				KnockoutAnswers: []string{"Java"},
+			},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "number with bounds",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionNumber, KnockoutMin: number(2), KnockoutMax: number(10)},
+		},
+		{
+			name: "number with min above max",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionNumber, KnockoutMin: number(10), KnockoutMax: number(2)},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "number with knockout answers",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionNumber, KnockoutAnswers: []string{"1"}},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "bounds on a non-number question",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionYesNo, KnockoutMin: number(1)},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "action without criteria",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionYesNo, KnockoutAction: entity.KnockoutActionReject},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "text",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionText, Required: true},
+		},
+		{
+			name: "text with knockout",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionText, KnockoutAnswers: []string{"no"}},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+		{
+			name: "file with options",
+			req:  recruitment.ScreeningQuestionRequest{Type: entity.ScreeningQuestionFile, Options: []string{"a", "b"}},
+			want: recruitment.ErrorScreeningQuestionInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateScreeningQuestion(tt.req); !errors.Is(err, tt.want) {
+				t.Errorf("validateScreeningQuestion() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateScreeningAnswers(t *testing.T) {
+	questions := []entity.ScreeningQuestion{
+		{
+			ID:              "relocate",
+			Type:            entity.ScreeningQuestionYesNo,
+			Required:        true,
+			KnockoutAnswers: []string{"no"},
+			KnockoutAction:  entity.KnockoutActionReject,
+		},
+		{
+			ID:       "stack",
+			Type:     entity.ScreeningQuestionMultipleChoice,
+			Options:  []string{"Go", "Rust", "Java"},
+			Required: false,
+		},
+		{
+			ID:             "years",
+			Type:           entity.ScreeningQuestionNumber,
+			KnockoutMin:    number(2),
+			KnockoutMax:    number(15),
+			KnockoutAction: entity.KnockoutActionFlag,
+		},
+		{
+			ID:   "portfolio",
+			Type: entity.ScreeningQuestionFile,
+		},
+	}
+
+	relocate := recruitment.ScreeningAnswerRequest{QuestionID: "relocate", Value: "Yes"}
+
+	tests := []struct {
+		name         string
+		answers      []recruitment.ScreeningAnswerRequest
+		files        map[string]*multipart.FileHeader
+		want         error
+		wantAnswers  int
+		wantRejected bool
+		wantFlagged  bool
+	}{
+		{
+			name:        "required answered, optional skipped",
+			answers:     []recruitment.ScreeningAnswerRequest{relocate},
+			wantAnswers: 1,
+		},
+		{
+			name:    "required missing",
+			answers: []recruitment.ScreeningAnswerRequest{{QuestionID: "years", Number: number(5)}},
+			want:    recruitment.ErrorScreeningAnswerRequired,
+		},
+		{
+			name:    "required left blank",
+			answers: []recruitment.ScreeningAnswerRequest{{QuestionID: "relocate", Value: "  "}},
+			want:    recruitment.ErrorScreeningAnswerRequired,
+		},
+		{
+			name:    "unknown question",
+			answers: []recruitment.ScreeningAnswerRequest{relocate, {QuestionID: "other", Value: "x"}},
+			want:    recruitment.ErrorScreeningQuestionUnknown,
+		},
+		{
+			name:    "question answered twice",
+			answers: []recruitment.ScreeningAnswerRequest{relocate, relocate},
+			want:    recruitment.ErrorScreeningAnswerInvalid,
+		},
+		{
+			name:    "yes/no outside yes and no",
+			answers: []recruitment.ScreeningAnswerRequest{{QuestionID: "relocate", Value: "maybe"}},
+			want:    recruitment.ErrorScreeningAnswerInvalid,
+		},
+		{
+			name:        "choices within the options",
+			answers:     []recruitment.ScreeningAnswerRequest{relocate, {QuestionID: "stack", Values: []string{"Go", "Rust"}}},
+			wantAnswers: 2,
+		},
+		{
+			name:    "choice out of range",
+			answers: []recruitment.ScreeningAnswerRequest{relocate, {QuestionID: "stack", Values: []string{"Go", "Cobol"}}},
+			want:    recruitment.ErrorScreeningAnswerInvalid,
+		},
+		{
+			name:    "choice picked twice",
+			answers: []recruitment.ScreeningAnswerRequest{relocate, {QuestionID: "stack", Values: []string{"Go", "Go"}}},
+			want:    recruitment.ErrorScreeningAnswerInvalid,
+		},
+		{
+			name:    "number given as text",
+			answers: []recruitment.ScreeningAnswerRequest{relocate, {QuestionID: "years", Value: "5"}},
+			want:    recruitment.ErrorScreeningAnswerInvalid,
+		},
+		{
+			name:        "number within bounds",
+			answers:     []recruitment.ScreeningAnswerRequest{relocate, {QuestionID: "years", Number: number(2)}},
+			wantAnswers: 2,
+		},
+		{
+			name:        "number below min flags",
+			answers:     []recruitment.ScreeningAnswerRequest{relocate, {QuestionID: "years", Number: number(1)}},
+			wantAnswers: 2,
+			wantFlagged: true,
+		},
+		{
+			name:        "number above max flags",
+			answers:     []recruitment.ScreeningAnswerRequest{relocate, {QuestionID: "years", Number: number(20)}},
+			wantAnswers: 2,
+			wantFlagged: true,
+		},
+		{
+			name:         "knockout answer rejects",
+			answers:      []recruitment.ScreeningAnswerRequest{{QuestionID: "relocate", Value: "No"}},
+			wantAnswers:  1,
+			wantRejected: true,
+		},
+		{
+			name:        "file question",
+			answers:     []recruitment.ScreeningAnswerRequest{relocate},
+			files:       map[string]*multipart.FileHeader{"portfolio": {Size: 1024}},
+			wantAnswers: 2,
+		},
+		{
+			name:    "file too big",
+			answers: []recruitment.ScreeningAnswerRequest{relocate},
+			files:   map[string]*multipart.FileHeader{"portfolio": {Size: maxScreeningFileSize + 1}},
+			want:    recruitment.ErrorScreeningAnswerFileTooBig,
+		},
+		{
+			name:    "file question answered as text",
+			answers: []recruitment.ScreeningAnswerRequest{relocate, {QuestionID: "portfolio", Value: "link"}},
+			want:    recruitment.ErrorScreeningAnswerInvalid,
+		},
+		{
+			name:    "file for a non-file question",
+			answers: []recruitment.ScreeningAnswerRequest{relocate},
+			files:   map[string]*multipart.FileHeader{"years": {Size: 1024}},
+			want:    recruitment.ErrorScreeningAnswerInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := recruitment.CreateJobApplication{Answers: tt.answers, Files: tt.files}
+
+			result, err := evaluateScreeningAnswers(questions, req)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("evaluateScreeningAnswers() error = %v, want %v", err, tt.want)
+			}
+			if len(result.Answers) != tt.wantAnswers {
+				t.Errorf("answers = %d, want %d", len(result.Answers), tt.wantAnswers)
+			}
+			if result.Rejected != tt.wantRejected {
+				t.Errorf("rejected = %v, want %v", result.Rejected, tt.wantRejected)
+			}
+			if result.Flagged != tt.wantFlagged {
+				t.Errorf("flagged = %v, want %v", result.Flagged, tt.wantFlagged)
+			}
+		})
+	}
+}
+
+func TestKnockedOut(t *testing.T) {
+	tests := []struct {
+		name     string
+		question entity.ScreeningQuestion
+		answer   entity.ScreeningAnswer
+		want     bool
+	}{
+		{
+			name:     "choice in knockout answers",
+			question: entity.ScreeningQuestion{Type: entity.ScreeningQuestionMultipleChoice, KnockoutAnswers: []string{"Java"}},
+			answer:   entity.ScreeningAnswer{Values: []string{"Go", "Java"}},
+			want:     true,
+		},
+		{
+			name:     "choice outside knockout answers",
+			question: entity.ScreeningQuestion{Type: entity.ScreeningQuestionMultipleChoice, KnockoutAnswers: []string{"Java"}},
+			answer:   entity.ScreeningAnswer{Values: []string{"Go"}},
+			want:     false,
+		},
+		{
+			name:     "number on the min bound",
+			question: entity.ScreeningQuestion{Type: entity.ScreeningQuestionNumber, KnockoutMin: number(3)},
+			answer:   entity.ScreeningAnswer{Number: number(3)},
+			want:     false,
+		},
+		{
+			name:     "number below min",
+			question: entity.ScreeningQuestion{Type: entity.ScreeningQuestionNumber, KnockoutMin: number(3)},
+			answer:   entity.ScreeningAnswer{Number: number(2.5)},
+			want:     true,
+		},
+		{
+			name:     "number above max",
+			question: entity.ScreeningQuestion{Type: entity.ScreeningQuestionNumber, KnockoutMax: number(3)},
+			answer:   entity.ScreeningAnswer{Number: number(4)},
+			want:     true,
+		},
+		{
+			name:     "number without criteria",
+			question: entity.ScreeningQuestion{Type: entity.ScreeningQuestionNumber},
+			answer:   entity.ScreeningAnswer{Number: number(100)},
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := knockedOut(tt.question, tt.answer); got != tt.want {
+				t.Errorf("knockedOut() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,61 +1,318 @@
 package recruitmentService
 
 import (
+	authRepository "ProjectGolang/internal/api/auth/repository"
+	bioRepository "ProjectGolang/internal/api/bio/repository"
+	notificationService "ProjectGolang/internal/api/notification/service"
 	"ProjectGolang/internal/api/recruitment"
 	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	skillService "ProjectGolang/internal/api/skill/service"
+	webhookService "ProjectGolang/internal/api/webhook/service"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/redis"
+	"ProjectGolang/pkg/s3"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
 
 type RecruitmentService interface {
 	JobVacancy() JobVacancyDomain
-	//JobApplication() AuthDomain
+	JobApplication() JobApplicationDomain
+	SavedJob() SavedJobDomain
+	SavedSearch() SavedSearchDomain
+	Candidate() CandidateDomain
+	TalentPool() TalentPoolDomain
+	JobInvitation() JobInvitationDomain
+	Interview() InterviewDomain
+	Scorecard() ScorecardDomain
+	Offer() OfferDomain
+	ScreeningQuestion() ScreeningQuestionDomain
 }
 
 type JobVacancyDomain interface {
 	CreateJobVacancy(c context.Context, req recruitment.CreateJobVacancy) error
 	GetJobVacancies(c context.Context, req recruitment.GetJobVacancies) (recruitment.PaginatedJobVacanciesResponse, error)
 	UpdateJobVacancy(c context.Context, req recruitment.UpdateJobVacancy) error
-	DeleteJobVacancy(c context.Context, id string) error
+	DeleteJobVacancy(c context.Context, id string, recruiterID string) error
+	GetRecommendedJobVacancies(c context.Context, userID string, req recruitment.GetRecommendedJobs) (recruitment.PaginatedRecommendedJobsResponse, error)
 }
 
-//type JobApplication interface {
-//	UpdatePassword(c context.Context, req auth.ResetPassword) error
-//}
+type JobApplicationDomain interface {
+	CreateJobApplication(c context.Context, req recruitment.CreateJobApplication) error
+	GetJobApplicationsByUserID(c context.Context, userID string) ([]recruitment.JobApplicationResponse, error)
+	GetJobApplicationsByJobVacancyID(c context.Context, jobVacancyID string, recruiterID string, interviewerID string) ([]recruitment.JobApplicationResponse, error)
+	UpdateJobApplicationStatus(c context.Context, req recruitment.UpdateJobApplicationStatus) error
+}
+
+type SavedJobDomain interface {
+	SaveJobVacancy(c context.Context, userID string, jobVacancyID string) error
+	UnsaveJobVacancy(c context.Context, userID string, jobVacancyID string) error
+	GetSavedJobsByUserID(c context.Context, userID string) ([]recruitment.SavedJobResponse, error)
+}
+
+type SavedSearchDomain interface {
+	CreateSavedSearch(c context.Context, req recruitment.CreateSavedSearch) (recruitment.SavedSearchResponse, error)
+	GetSavedSearchesByUserID(c context.Context, userID string) ([]recruitment.SavedSearchResponse, error)
+	DeleteSavedSearch(c context.Context, id string, userID string) error
+	GetUnsubscribeSavedSearch(c context.Context, token string) (recruitment.SavedSearchResponse, error)
+	Unsubscribe(c context.Context, token string) error
+}
+
+type CandidateDomain interface {
+	SearchCandidates(c context.Context, req recruitment.SearchCandidates) (recruitment.PaginatedCandidatesResponse, error)
+}
+
+type TalentPoolDomain interface {
+	CreateTalentPool(c context.Context, req recruitment.CreateTalentPool) (recruitment.TalentPoolResponse, error)
+	GetTalentPools(c context.Context, companyID string) ([]recruitment.TalentPoolResponse, error)
+	UpdateTalentPool(c context.Context, req recruitment.UpdateTalentPool) error
+	DeleteTalentPool(c context.Context, id string, companyID string) error
+	AddCandidate(c context.Context, req recruitment.AddTalentPoolCandidate) error
+	GetCandidates(c context.Context, talentPoolID string, companyID string, req recruitment.GetTalentPoolCandidates) ([]recruitment.TalentPoolCandidateResponse, error)
+	UpdateCandidate(c context.Context, req recruitment.UpdateTalentPoolCandidate) error
+	RemoveCandidate(c context.Context, talentPoolID string, userID string, companyID string) error
+	InviteCandidate(c context.Context, req recruitment.InviteCandidate) error
+}
+
+type JobInvitationDomain interface {
+	GetJobInvitationsByUserID(c context.Context, userID string) ([]recruitment.JobInvitationResponse, error)
+	DeclineJobInvitation(c context.Context, id string, userID string) error
+}
+
+type InterviewDomain interface {
+	CreateInterview(c context.Context, req recruitment.CreateInterview) (recruitment.InterviewResponse, error)
+	GetInterviewsByJobApplicationID(c context.Context, jobApplicationID string, companyID string) ([]recruitment.InterviewResponse, error)
+	GetInterviewsByUserID(c context.Context, userID string) ([]recruitment.InterviewResponse, error)
+	UpdateInterview(c context.Context, req recruitment.UpdateInterview) (recruitment.InterviewResponse, error)
+	CancelInterview(c context.Context, id string, companyID string) error
+	SelectInterviewSlot(c context.Context, req recruitment.SelectInterviewSlot) (recruitment.InterviewResponse, error)
+}
+
+type ScorecardDomain interface {
+	SaveScorecardTemplate(c context.Context, req recruitment.SaveScorecardTemplate) (recruitment.ScorecardTemplateResponse, error)
+	GetScorecardTemplate(c context.Context, jobVacancyID string, companyID string) (recruitment.ScorecardTemplateResponse, error)
+	DeleteScorecardTemplate(c context.Context, jobVacancyID string, companyID string) error
+	SubmitScorecard(c context.Context, req recruitment.SubmitScorecard) (recruitment.ScorecardResponse, error)
+	GetApplicationScorecards(c context.Context, jobApplicationID string, companyID string, interviewerID string) (recruitment.ApplicationScorecardsResponse, error)
+}
+
+type OfferDomain interface {
+	CreateOffer(c context.Context, req recruitment.CreateOffer) (recruitment.OfferResponse, error)
+	GetOffersByJobApplicationID(c context.Context, jobApplicationID string, companyID string) ([]recruitment.OfferResponse, error)
+	GetOffersByUserID(c context.Context, userID string) ([]recruitment.OfferResponse, error)
+	GetOfferByAccessToken(c context.Context, token string) (recruitment.OfferResponse, error)
+	GetOfferPDFByAccessToken(c context.Context, token string) ([]byte, error)
+	GetCompanyOfferPDF(c context.Context, id string, companyID string) ([]byte, error)
+	GetCandidateOfferPDF(c context.Context, id string, userID string) ([]byte, error)
+	WithdrawOffer(c context.Context, id string, companyID string, memberID string) error
+	AcceptOffer(c context.Context, id string, userID string) error
+	DeclineOffer(c context.Context, id string, userID string) error
+}
+
+type ScreeningQuestionDomain interface {
+	SaveScreeningQuestions(c context.Context, req recruitment.SaveScreeningQuestions) ([]recruitment.ScreeningQuestionResponse, error)
+	GetScreeningQuestions(c context.Context, jobVacancyID string) ([]recruitment.ScreeningQuestionResponse, error)
+	GetScreeningQuestionConfig(c context.Context, jobVacancyID string, recruiterID string) ([]recruitment.ScreeningQuestionResponse, error)
+	GetScreeningAnswers(c context.Context, jobApplicationID string, recruiterID string) ([]recruitment.ScreeningAnswerResponse, error)
+}
 
 type recruitmentService struct {
 	recruitmentRepository recruitmentRepository.Repository
 	log                   *logrus.Logger
 
-	jobVacancyDomain JobVacancyDomain
-	//jobApplication JobApplication
+	jobVacancyDomain        JobVacancyDomain
+	jobApplicationDomain    JobApplicationDomain
+	savedJobDomain          SavedJobDomain
+	savedSearchDomain       SavedSearchDomain
+	candidateDomain         CandidateDomain
+	talentPoolDomain        TalentPoolDomain
+	jobInvitationDomain     JobInvitationDomain
+	interviewDomain         InterviewDomain
+	scorecardDomain         ScorecardDomain
+	offerDomain             OfferDomain
+	screeningQuestionDomain ScreeningQuestionDomain
 }
 
 func (s *recruitmentService) JobVacancy() JobVacancyDomain {
 	return s.jobVacancyDomain
 }
 
-//func (r *recruitmentService) JobApplication() JobApplication {
-//	return r.jobApplication
-//}
+func (s *recruitmentService) JobApplication() JobApplicationDomain {
+	return s.jobApplicationDomain
+}
+
+func (s *recruitmentService) SavedJob() SavedJobDomain {
+	return s.savedJobDomain
+}
+
+func (s *recruitmentService) SavedSearch() SavedSearchDomain {
+	return s.savedSearchDomain
+}
+
+func (s *recruitmentService) Candidate() CandidateDomain {
+	return s.candidateDomain
+}
+
+func (s *recruitmentService) TalentPool() TalentPoolDomain {
+	return s.talentPoolDomain
+}
+
+func (s *recruitmentService) JobInvitation() JobInvitationDomain {
+	return s.jobInvitationDomain
+}
+
+func (s *recruitmentService) Interview() InterviewDomain {
+	return s.interviewDomain
+}
+
+func (s *recruitmentService) Scorecard() ScorecardDomain {
+	return s.scorecardDomain
+}
+
+func (s *recruitmentService) Offer() OfferDomain {
+	return s.offerDomain
+}
+
+func (s *recruitmentService) ScreeningQuestion() ScreeningQuestionDomain {
+	return s.screeningQuestionDomain
+}
 
 type jobVacancyImpl struct {
+	repo     recruitmentRepository.Repository
+	authRepo authRepository.Repository
+	bioRepo  bioRepository.Repository
+	redis    redis.ItfRedis
+	webhooks webhookService.Dispatcher
+	skills   skillService.Resolver
+	log      *logrus.Logger
+}
+
+type jobApplicationImpl struct {
+	repo     recruitmentRepository.Repository
+	redis    redis.ItfRedis
+	s3       s3.ItfS3
+	realtime realtime.ItfRealtime
+	notifier notificationService.Notifier
+	webhooks webhookService.Dispatcher
+	log      *logrus.Logger
+}
+
+type savedJobImpl struct {
+	repo  recruitmentRepository.Repository
+	redis redis.ItfRedis
+	log   *logrus.Logger
+}
+
+type savedSearchImpl struct {
+	repo recruitmentRepository.Repository
+	log  *logrus.Logger
+}
+
+type candidateImpl struct {
+	repo recruitmentRepository.Repository
+	log  *logrus.Logger
+}
+
+type talentPoolImpl struct {
+	repo     recruitmentRepository.Repository
+	authRepo authRepository.Repository
+	notifier notificationService.Notifier
+	realtime realtime.ItfRealtime
+	log      *logrus.Logger
+}
+
+type jobInvitationImpl struct {
+	repo recruitmentRepository.Repository
+	log  *logrus.Logger
+}
+
+type scorecardImpl struct {
 	repo recruitmentRepository.Repository
 	log  *logrus.Logger
 }
 
-//type jobApplicationImpl struct {
-//	repo recruitmentRepository.Repository
-//}
+type interviewImpl struct {
+	repo     recruitmentRepository.Repository
+	authRepo authRepository.Repository
+	notifier notificationService.Notifier
+	realtime realtime.ItfRealtime
+	log      *logrus.Logger
+}
+
+type offerImpl struct {
+	repo     recruitmentRepository.Repository
+	authRepo authRepository.Repository
+	redis    redis.ItfRedis
+	notifier notificationService.Notifier
+	webhooks webhookService.Dispatcher
+	log      *logrus.Logger
+}
+
+type screeningQuestionImpl struct {
+	repo recruitmentRepository.Repository
+	log  *logrus.Logger
+}
 
 func New(recruitmentRepo recruitmentRepository.Repository,
+	authRepo authRepository.Repository,
+	bioRepo bioRepository.Repository,
 	log *logrus.Logger,
+	redis redis.ItfRedis,
+	notifier notificationService.Notifier,
+	s3 s3.ItfS3,
+	realtime realtime.ItfRealtime,
+	webhooks webhookService.Dispatcher,
+	skills skillService.Resolver,
 ) RecruitmentService {
 	return &recruitmentService{
 		recruitmentRepository: recruitmentRepo,
 		log:                   log,
 
-		jobVacancyDomain: &jobVacancyImpl{repo: recruitmentRepo, log: log},
-		//jobApplication: &jobApplicationImpl{repo: recruitmentRepo},
+		jobVacancyDomain: &jobVacancyImpl{
+			repo:     recruitmentRepo,
+			authRepo: authRepo,
+			bioRepo:  bioRepo,
+			redis:    redis,
+			webhooks: webhooks,
+			skills:   skills,
+			log:      log,
+		},
+		jobApplicationDomain: &jobApplicationImpl{
+			repo:     recruitmentRepo,
+			redis:    redis,
+			s3:       s3,
+			realtime: realtime,
+			notifier: notifier,
+			webhooks: webhooks,
+			log:      log,
+		},
+		savedJobDomain:    &savedJobImpl{repo: recruitmentRepo, redis: redis, log: log},
+		savedSearchDomain: &savedSearchImpl{repo: recruitmentRepo, log: log},
+		candidateDomain:   &candidateImpl{repo: recruitmentRepo, log: log},
+		talentPoolDomain: &talentPoolImpl{
+			repo:     recruitmentRepo,
+			authRepo: authRepo,
+			notifier: notifier,
+			realtime: realtime,
+			log:      log,
+		},
+		jobInvitationDomain: &jobInvitationImpl{repo: recruitmentRepo, log: log},
+		interviewDomain: &interviewImpl{
+			repo:     recruitmentRepo,
+			authRepo: authRepo,
+			notifier: notifier,
+			realtime: realtime,
+			log:      log,
+		},
+		scorecardDomain: &scorecardImpl{repo: recruitmentRepo, log: log},
+		offerDomain: &offerImpl{
+			repo:     recruitmentRepo,
+			authRepo: authRepo,
+			redis:    redis,
+			notifier: notifier,
+			webhooks: webhooks,
+			log:      log,
+		},
+		screeningQuestionDomain: &screeningQuestionImpl{repo: recruitmentRepo, log: log},
 	}
 }
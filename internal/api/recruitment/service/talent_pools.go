@@ -0,0 +1,559 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/api/recruitment"
+	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/smtp"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"html"
+	"strings"
+	"time"
+)
+
+func (s *talentPoolImpl) CreateTalentPool(c context.Context, req recruitment.CreateTalentPool) (recruitment.TalentPoolResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return recruitment.TalentPoolResponse{}, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	exists, err := repo.TalentPools.CheckTalentPoolNameExists(c, req.CompanyID, name, "")
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":      err.Error(),
+			"company_id": req.CompanyID,
+		}).Error("Failed to check talent pool name")
+		return recruitment.TalentPoolResponse{}, err
+	}
+
+	if exists {
+		s.log.WithFields(logrus.Fields{
+			"company_id": req.CompanyID,
+			"name":       name,
+		}).Warn("Talent pool name already taken")
+		return recruitment.TalentPoolResponse{}, recruitment.ErrorTalentPoolNameTaken
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return recruitment.TalentPoolResponse{}, err
+	}
+
+	pool := entity.TalentPool{
+		ID:          id,
+		CompanyID:   req.CompanyID,
+		Name:        name,
+		Description: req.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := repo.TalentPools.CreateTalentPool(c, pool); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":      err.Error(),
+			"company_id": req.CompanyID,
+		}).Error("Failed to create talent pool")
+		return recruitment.TalentPoolResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id":         pool.ID,
+		"company_id": pool.CompanyID,
+	}).Info("Talent pool created successfully")
+
+	return makeTalentPoolResponse(pool), nil
+}
+
+func (s *talentPoolImpl) GetTalentPools(c context.Context, companyID string) ([]recruitment.TalentPoolResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	pools, err := repo.TalentPools.GetTalentPoolsByCompanyID(c, companyID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Failed to get talent pools")
+		return nil, err
+	}
+
+	responses := make([]recruitment.TalentPoolResponse, len(pools))
+	for i, pool := range pools {
+		responses[i] = makeTalentPoolResponse(pool)
+	}
+
+	return responses, nil
+}
+
+func (s *talentPoolImpl) UpdateTalentPool(c context.Context, req recruitment.UpdateTalentPool) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	pool, err := s.getOwnedTalentPool(c, repo, req.ID, req.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	exists, err := repo.TalentPools.CheckTalentPoolNameExists(c, req.CompanyID, name, pool.ID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":      err.Error(),
+			"company_id": req.CompanyID,
+		}).Error("Failed to check talent pool name")
+		return err
+	}
+
+	if exists {
+		return recruitment.ErrorTalentPoolNameTaken
+	}
+
+	pool.Name = name
+	pool.Description = req.Description
+	pool.UpdatedAt = time.Now()
+
+	if err := repo.TalentPools.UpdateTalentPool(c, pool); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    pool.ID,
+		}).Error("Failed to update talent pool")
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id": pool.ID,
+	}).Info("Talent pool updated successfully")
+
+	return nil
+}
+
+func (s *talentPoolImpl) DeleteTalentPool(c context.Context, id string, companyID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	if _, err := s.getOwnedTalentPool(c, repo, id, companyID); err != nil {
+		return err
+	}
+
+	if err := repo.TalentPools.DeleteTalentPool(c, id); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to delete talent pool")
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"id": id,
+	}).Info("Talent pool deleted successfully")
+
+	return nil
+}
+
+func (s *talentPoolImpl) AddCandidate(c context.Context, req recruitment.AddTalentPoolCandidate) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	if _, err := s.getOwnedTalentPool(c, repo, req.TalentPoolID, req.CompanyID); err != nil {
+		return err
+	}
+
+	if _, err := s.getCandidateUser(c, req.CompanyID, req.UserID); err != nil {
+		return err
+	}
+
+	existing, err := repo.TalentPools.GetCandidate(c, req.TalentPoolID, req.UserID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"talent_pool_id": req.TalentPoolID,
+		}).Error("Failed to get talent pool candidate")
+		return err
+	}
+
+	if existing.UserID != "" {
+		return recruitment.ErrorTalentPoolCandidateExists
+	}
+
+	now := time.Now()
+	candidate := entity.TalentPoolCandidate{
+		TalentPoolID: req.TalentPoolID,
+		UserID:       req.UserID,
+		Tags:         normalizeTags(req.Tags),
+		Note:         req.Note,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := repo.TalentPools.AddCandidate(c, candidate); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"talent_pool_id": req.TalentPoolID,
+			"user_id":        req.UserID,
+		}).Error("Failed to add candidate to talent pool")
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"talent_pool_id": req.TalentPoolID,
+		"user_id":        req.UserID,
+	}).Info("Candidate added to talent pool successfully")
+
+	return nil
+}
+
+func (s *talentPoolImpl) GetCandidates(c context.Context, talentPoolID string, companyID string, req recruitment.GetTalentPoolCandidates) ([]recruitment.TalentPoolCandidateResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	if _, err := s.getOwnedTalentPool(c, repo, talentPoolID, companyID); err != nil {
+		return nil, err
+	}
+
+	candidates, err := repo.TalentPools.GetCandidates(c, talentPoolID, strings.ToLower(strings.TrimSpace(req.Tag)))
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"talent_pool_id": talentPoolID,
+		}).Error("Failed to get talent pool candidates")
+		return nil, err
+	}
+
+	responses := make([]recruitment.TalentPoolCandidateResponse, len(candidates))
+	for i, candidate := range candidates {
+		responses[i] = makeTalentPoolCandidateResponse(candidate)
+	}
+
+	return responses, nil
+}
+
+func (s *talentPoolImpl) UpdateCandidate(c context.Context, req recruitment.UpdateTalentPoolCandidate) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	if _, err := s.getOwnedTalentPool(c, repo, req.TalentPoolID, req.CompanyID); err != nil {
+		return err
+	}
+
+	candidate, err := repo.TalentPools.GetCandidate(c, req.TalentPoolID, req.UserID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"talent_pool_id": req.TalentPoolID,
+		}).Error("Failed to get talent pool candidate")
+		return err
+	}
+
+	if candidate.UserID == "" {
+		return recruitment.ErrorTalentPoolCandidateNotFound
+	}
+
+	candidate.Tags = normalizeTags(req.Tags)
+	candidate.Note = req.Note
+	candidate.UpdatedAt = time.Now()
+
+	if err := repo.TalentPools.UpdateCandidate(c, candidate); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"talent_pool_id": req.TalentPoolID,
+			"user_id":        req.UserID,
+		}).Error("Failed to update talent pool candidate")
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"talent_pool_id": req.TalentPoolID,
+		"user_id":        req.UserID,
+	}).Info("Talent pool candidate updated successfully")
+
+	return nil
+}
+
+func (s *talentPoolImpl) RemoveCandidate(c context.Context, talentPoolID string, userID string, companyID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	if _, err := s.getOwnedTalentPool(c, repo, talentPoolID, companyID); err != nil {
+		return err
+	}
+
+	removed, err := repo.TalentPools.RemoveCandidate(c, talentPoolID, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"talent_pool_id": talentPoolID,
+			"user_id":        userID,
+		}).Error("Failed to remove talent pool candidate")
+		return err
+	}
+
+	if !removed {
+		return recruitment.ErrorTalentPoolCandidateNotFound
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"talent_pool_id": talentPoolID,
+		"user_id":        userID,
+	}).Info("Candidate removed from talent pool successfully")
+
+	return nil
+}
+
+func (s *talentPoolImpl) InviteCandidate(c context.Context, req recruitment.InviteCandidate) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	if _, err := s.getOwnedTalentPool(c, repo, req.TalentPoolID, req.CompanyID); err != nil {
+		return err
+	}
+
+	candidate, err := repo.TalentPools.GetCandidate(c, req.TalentPoolID, req.UserID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"talent_pool_id": req.TalentPoolID,
+		}).Error("Failed to get talent pool candidate")
+		return err
+	}
+
+	if candidate.UserID == "" {
+		return recruitment.ErrorTalentPoolCandidateNotFound
+	}
+
+	jobVacancy, err := getOwnedJobVacancy(c, repo, s.log, req.JobVacancyID, req.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	if !jobVacancy.IsActive || (!jobVacancy.Deadline.IsZero() && jobVacancy.Deadline.Before(time.Now())) {
+		return recruitment.ErrorJobVacancyClosed
+	}
+
+	applied, err := repo.JobApplications.CheckJobApplicationExists(c, req.JobVacancyID, req.UserID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to check if job application exists")
+		return err
+	}
+
+	if applied {
+		return recruitment.ErrorAlreadyApplied
+	}
+
+	invited, err := repo.JobInvitations.CheckJobInvitationExists(c, req.JobVacancyID, req.UserID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to check if job invitation exists")
+		return err
+	}
+
+	if invited {
+		return recruitment.ErrorAlreadyInvited
+	}
+
+	user, err := s.getCandidateUser(c, req.CompanyID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	authRepo, err := s.authRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create auth repository client")
+		return err
+	}
+
+	company, err := authRepo.Company.GetCompanyByID(c, req.CompanyID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":      err.Error(),
+			"company_id": req.CompanyID,
+		}).Error("Failed to get company by ID")
+		return err
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return err
+	}
+
+	invitation := entity.JobInvitation{
+		ID:           id,
+		JobVacancyID: req.JobVacancyID,
+		UserID:       req.UserID,
+		CompanyID:    req.CompanyID,
+		TalentPoolID: req.TalentPoolID,
+		Message:      req.Message,
+		Status:       entity.InvitationStatusPending,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := repo.JobInvitations.CreateJobInvitation(c, invitation); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":          err.Error(),
+			"job_vacancy_id": req.JobVacancyID,
+			"user_id":        req.UserID,
+		}).Error("Failed to create job invitation")
+		return err
+	}
+
+	mail := buildInvitationMail(user, company, jobVacancy, req.Message)
+	s.notifier.Notify(c, notification.Event{
+		RecipientID: user.ID,
+		Type:        entity.NotificationJobInvitation,
+		Title:       mail.Subject,
+		Body:        req.Message,
+		Data: map[string]string{
+			"job_invitation_id": invitation.ID,
+			"job_vacancy_id":    invitation.JobVacancyID,
+		},
+		Mail: &mail,
+	})
+
+	publishEvent(c, s.realtime, s.log, invitation.UserID, realtime.EventJobInvitationReceived, map[string]interface{}{
+		"job_invitation_id": invitation.ID,
+		"job_vacancy_id":    invitation.JobVacancyID,
+		"company_name":      company.Name,
+	})
+
+	s.log.WithFields(logrus.Fields{
+		"id":             invitation.ID,
+		"job_vacancy_id": invitation.JobVacancyID,
+		"user_id":        invitation.UserID,
+	}).Info("Candidate invited to job vacancy successfully")
+
+	return nil
+}
+
+func (s *talentPoolImpl) getOwnedTalentPool(c context.Context, repo recruitmentRepository.Client, id string, companyID string) (entity.TalentPool, error) {
+	pool, err := repo.TalentPools.GetTalentPoolByID(c, id)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    id,
+		}).Error("Failed to get talent pool by ID")
+		return entity.TalentPool{}, err
+	}
+
+	// Pools of other companies are reported as missing so IDs cannot be probed.
+	if pool.ID == "" || pool.CompanyID != companyID {
+		s.log.WithFields(logrus.Fields{
+			"id":         id,
+			"company_id": companyID,
+		}).Warn("Talent pool not found")
+		return entity.TalentPool{}, recruitment.ErrorTalentPoolNotFound
+	}
+
+	return pool, nil
+}
+
+// getCandidateUser loads a candidate the company may see, following the same
+// visibility rules as their profile page.
+func (s *talentPoolImpl) getCandidateUser(c context.Context, companyID string, userID string) (entity.User, error) {
+	authRepo, err := s.authRepo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create auth repository client")
+		return entity.User{}, err
+	}
+
+	user, err := authRepo.User.GetUserByID(c, userID)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"user_id": userID,
+		}).Error("Failed to get user by ID")
+		return entity.User{}, err
+	}
+
+	if user.ID == "" || user.Role != entity.RoleCandidate {
+		return entity.User{}, recruitment.ErrorCandidateNotFound
+	}
+
+	if !bio.CanViewProfile(user, entity.UserLoginData{ID: companyID, Role: entity.RoleRecruiter}) {
+		return entity.User{}, recruitment.ErrorCandidateNotFound
+	}
+
+	return user, nil
+}
+
+func buildInvitationMail(user entity.User, company entity.Company, jv entity.JobVacancy, message string) smtp.Mail {
+	var body strings.Builder
+	body.WriteString(fmt.Sprintf("<p>Hello %s,</p>", html.EscapeString(user.Name)))
+	body.WriteString(fmt.Sprintf("<p><b>%s</b> invited you to apply for <b>%s</b> (%s, %s).</p>",
+		html.EscapeString(company.Name), html.EscapeString(jv.Title),
+		html.EscapeString(jv.Location), html.EscapeString(jv.JobType)))
+	if message != "" {
+		body.WriteString(fmt.Sprintf("<blockquote>%s</blockquote>", html.EscapeString(message)))
+	}
+	body.WriteString("<p>Log in to view the invitation and apply.</p>")
+
+	return smtp.Mail{
+		To:      user.Email,
+		Subject: fmt.Sprintf("%s invited you to apply for %s", company.Name, jv.Title),
+		Body:    body.String(),
+	}
+}
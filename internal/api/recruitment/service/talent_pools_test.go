@@ -0,0 +1,76 @@
+package recruitmentService
+
+import (
+	"ProjectGolang/internal/entity"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{name: "nil", tags: nil, want: []string{}},
+		{name: "lower case and trimmed", tags: []string{" Senior ", "GO"}, want: []string{"senior", "go"}},
+		{name: "blanks dropped", tags: []string{"", "  ", "remote"}, want: []string{"remote"}},
+		{name: "repeats dropped in order", tags: []string{"remote", "Senior", "REMOTE", "senior "}, want: []string{"remote", "senior"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeTags(tt.tags); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeTags(%q) = %q, want %q", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildInvitationMail(t *testing.T) {
+	user := entity.User{Name: "Jane <Doe>", Email: "jane@example.com"}
+	company := entity.Company{Name: "Acme & Co"}
+	jobVacancy := entity.JobVacancy{Title: "Backend Engineer", Location: "Jakarta", JobType: "FULL_TIME"}
+
+	tests := []struct {
+		name     string
+		message  string
+		want     []string
+		wantNone []string
+	}{
+		{
+			name:     "escapes names",
+			want:     []string{"Jane &lt;Doe&gt;", "Acme &amp; Co", "Backend Engineer", "Jakarta, FULL_TIME"},
+			wantNone: []string{"<blockquote>"},
+		},
+		{
+			name:    "quotes the escaped message",
+			message: "We'd love <b>you</b> here",
+			want:    []string{"<blockquote>We&#39;d love &lt;b&gt;you&lt;/b&gt; here</blockquote>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mail := buildInvitationMail(user, company, jobVacancy, tt.message)
+
+			if mail.To != user.Email {
+				t.Errorf("To = %q, want %q", mail.To, user.Email)
+			}
+			if want := "Acme & Co invited you to apply for Backend Engineer"; mail.Subject != want {
+				t.Errorf("Subject = %q, want %q", mail.Subject, want)
+			}
+			for _, part := range tt.want {
+				if !strings.Contains(mail.Body, part) {
+					t.Errorf("Body missing %q in %s", part, mail.Body)
+				}
+			}
+			for _, part := range tt.wantNone {
+				if strings.Contains(mail.Body, part) {
+					t.Errorf("Body contains %q in %s", part, mail.Body)
+				}
+			}
+		})
+	}
+}
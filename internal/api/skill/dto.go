@@ -0,0 +1,28 @@
+package skill
+
+import "ProjectGolang/internal/entity"
+
+type SearchSkills struct {
+	Query    string `query:"q" validate:"required,max=100"`
+	Category string `query:"category" validate:"omitempty,oneof=programming framework database cloud devops data design management soft_skill"`
+	Limit    int    `query:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+type SkillResponse struct {
+	ID       string               `json:"id"`
+	Name     string               `json:"name"`
+	Category entity.SkillCategory `json:"category,omitempty"`
+}
+
+// MakeSkillResponses is shared by every domain that shows linked skills.
+func MakeSkillResponses(skills []entity.Skill) []SkillResponse {
+	responses := make([]SkillResponse, 0, len(skills))
+	for _, skill := range skills {
+		responses = append(responses, SkillResponse{
+			ID:       skill.ID,
+			Name:     skill.Name,
+			Category: skill.Category,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,11 @@
+package skill
+
+import (
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrorSkillNameTooLong = response.New(fiber.StatusBadRequest, "skill names must be at most 100 characters")
+	ErrorTooManySkills    = response.New(fiber.StatusBadRequest, "at most 30 skills can be listed")
+)
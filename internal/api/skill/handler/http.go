@@ -0,0 +1,32 @@
+package skillHandler
+
+import (
+	skillService "ProjectGolang/internal/api/skill/service"
+	"ProjectGolang/internal/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+type SkillHandler struct {
+	skillService skillService.SkillService
+	validator    *validator.Validate
+	middleware   middleware.Middleware
+	log          *logrus.Logger
+}
+
+func New(ss skillService.SkillService, validate *validator.Validate, middleware middleware.Middleware, log *logrus.Logger) *SkillHandler {
+	return &SkillHandler{
+		skillService: ss,
+		validator:    validate,
+		middleware:   middleware,
+		log:          log,
+	}
+}
+
+// Start registers the catalogue search; it is public so sign-up and search
+// forms can autocomplete before a user logs in.
+func (h *SkillHandler) Start(srv fiber.Router) {
+	sk := srv.Group("/skills")
+	sk.Get("/", h.SearchSkills)
+}
@@ -0,0 +1,58 @@
+package skill
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxNameLength = 100
+	// MaxSkillsPerEntry caps the skills linked to one experience, portfolio,
+	// profile or vacancy.
+	MaxSkillsPerEntry = 30
+)
+
+// Normalize is the form skill names and aliases are matched on. The skills
+// migration applies the same rule in SQL, so keep the two in step.
+func Normalize(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// SplitNames breaks a comma-separated skill list into names with spacing
+// tidied, dropping blanks and repeats.
+func SplitNames(raw string) []string {
+	return CleanNames(strings.Split(raw, ","))
+}
+
+// CleanNames tidies the spacing of each name and drops blanks and names that
+// normalize to one already listed, keeping the first spelling.
+func CleanNames(names []string) []string {
+	seen := make(map[string]struct{}, len(names))
+	var cleaned []string
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		if name == "" {
+			continue
+		}
+		key := Normalize(name)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		cleaned = append(cleaned, name)
+	}
+	return cleaned
+}
+
+// ValidateNames checks cleaned names against the catalogue limits.
+func ValidateNames(names []string) error {
+	if len(names) > MaxSkillsPerEntry {
+		return ErrorTooManySkills
+	}
+	for _, name := range names {
+		if utf8.RuneCountInString(name) > MaxNameLength {
+			return ErrorSkillNameTooLong
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package skill
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// The cases mirror normalize_skill_name in the skills migration: collapse
+// whitespace runs to one space, trim, lower case. Punctuation is kept.
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "lower case", in: "PostgreSQL", want: "postgresql"},
+		{name: "trimmed", in: "  Go  ", want: "go"},
+		{name: "inner spaces collapsed", in: "Machine    Learning", want: "machine learning"},
+		{name: "tabs and newlines", in: "\tMachine\n\tLearning\n", want: "machine learning"},
+		{name: "punctuation kept", in: "C++", want: "c++"},
+		{name: "dots and hashes kept", in: "Node.js / C#", want: "node.js / c#"},
+		{name: "blank", in: " \t ", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitNames(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []string
+	}{
+		{name: "empty", raw: "", want: nil},
+		{name: "comma separated", raw: "Go, Python,SQL", want: []string{"Go", "Python", "SQL"}},
+		{name: "blanks dropped", raw: "Go,, ,Python,", want: []string{"Go", "Python"}},
+		{name: "inner spacing tidied", raw: " Machine   Learning ", want: []string{"Machine Learning"}},
+		{name: "repeats keep the first spelling", raw: "golang, Go, GO, go ", want: []string{"golang", "Go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitNames(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitNames(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCleanNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+		want  []string
+	}{
+		{name: "nil", names: nil, want: nil},
+		{name: "kept in order", names: []string{"Rust", "Go"}, want: []string{"Rust", "Go"}},
+		{name: "case insensitive dedupe", names: []string{"Docker", "docker", "DOCKER"}, want: []string{"Docker"}},
+		{name: "whitespace insensitive dedupe", names: []string{"Machine Learning", "machine\tlearning"}, want: []string{"Machine Learning"}},
+		{name: "punctuation is not ignored", names: []string{"C", "C++", "C#"}, want: []string{"C", "C++", "C#"}},
+		{name: "blanks dropped", names: []string{"", "  ", "Go"}, want: []string{"Go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CleanNames(tt.names); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CleanNames(%q) = %q, want %q", tt.names, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNames(t *testing.T) {
+	atLimit := make([]string, MaxSkillsPerEntry)
+	for i := range atLimit {
+		atLimit[i] = strings.Repeat("a", MaxNameLength-i)
+	}
+	tooMany := append(atLimit[:len(atLimit):len(atLimit)], "b")
+
+	tests := []struct {
+		name  string
+		names []string
+		want  error
+	}{
+		{name: "none", names: nil},
+		{name: "at the limits", names: atLimit},
+		{name: "too many", names: tooMany, want: ErrorTooManySkills},
+		{name: "name too long", names: []string{strings.Repeat("a", MaxNameLength+1)}, want: ErrorSkillNameTooLong},
+		{name: "length counts characters, not bytes", names: []string{strings.Repeat("é", MaxNameLength)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateNames(tt.names); !errors.Is(err, tt.want) {
+				t.Errorf("ValidateNames() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package skillRepository
+
+const (
+	// querySearchSkills matches the start of a skill's name, or of any word in
+	// it, against both canonical names and aliases. Exact matches rank first,
+	// then prefix matches, then shorter names.
+	querySearchSkills = `
+    SELECT s.id, s.name, s.normalized_name, COALESCE(s.category, ''), s.created_at
+    FROM skills s
+    JOIN (
+        SELECT id AS skill_id, normalized_name AS term FROM skills
+        UNION ALL
+        SELECT skill_id, alias AS term FROM skill_aliases
+    ) t ON t.skill_id = s.id
+    WHERE (t.term LIKE ? OR t.term LIKE ?)
+      AND (? = '' OR s.category = ?)
+    GROUP BY s.id
+    ORDER BY MIN(CASE WHEN t.term = ? THEN 0 WHEN t.term LIKE ? THEN 1 ELSE 2 END), char_length(s.name), s.name
+    LIMIT ?
+    `
+
+	queryGetSkillsByNormalizedNames = `
+    SELECT s.id, s.name, s.normalized_name, COALESCE(s.category, ''), s.created_at, s.normalized_name
+    FROM skills s
+    WHERE s.normalized_name = ANY(?)
+    UNION ALL
+    SELECT s.id, s.name, s.normalized_name, COALESCE(s.category, ''), s.created_at, a.alias
+    FROM skill_aliases a
+    JOIN skills s ON s.id = a.skill_id
+    WHERE a.alias = ANY(?)
+    `
+
+	// queryCreateSkill leaves a name someone else added in the meantime alone;
+	// callers read the catalogue again afterwards.
+	queryCreateSkill = `
+    INSERT INTO skills (id, name, normalized_name, category, created_at)
+    VALUES (?, ?, ?, NULLIF(?, ''), ?)
+    ON CONFLICT (normalized_name) DO NOTHING
+    `
+)
@@ -0,0 +1,113 @@
+package skillRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"github.com/lib/pq"
+	"strings"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchSkills takes an already normalized query.
+func (r *skillsRepository) SearchSkills(c context.Context, query string, category string, limit int) ([]entity.Skill, error) {
+	r.log.WithFields(map[string]interface{}{
+		"query":    query,
+		"category": category,
+	}).Debug("Searching skills in database")
+
+	prefix := likeEscaper.Replace(query) + "%"
+	rows, err := r.q.QueryContext(c, r.q.Rebind(querySearchSkills),
+		prefix,
+		"% "+prefix,
+		category,
+		category,
+		query,
+		prefix,
+		limit,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when searching skills")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var skills []entity.Skill
+	for rows.Next() {
+		var skill entity.Skill
+		if err := rows.Scan(&skill.ID, &skill.Name, &skill.NormalizedName, &skill.Category, &skill.CreatedAt); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning skill row")
+			return nil, err
+		}
+		skills = append(skills, skill)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through skill rows")
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+func (r *skillsRepository) GetSkillsByNormalizedNames(c context.Context, names []string) (map[string]entity.Skill, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetSkillsByNormalizedNames), pq.Array(names), pq.Array(names))
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when getting skills by name")
+		return nil, err
+	}
+	defer rows.Close()
+
+	skills := make(map[string]entity.Skill, len(names))
+	for rows.Next() {
+		var (
+			skill   entity.Skill
+			matched string
+		)
+		if err := rows.Scan(&skill.ID, &skill.Name, &skill.NormalizedName, &skill.Category, &skill.CreatedAt, &matched); err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning skill row")
+			return nil, err
+		}
+		skills[matched] = skill
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through skill rows")
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+func (r *skillsRepository) CreateSkills(c context.Context, skills []entity.Skill) error {
+	for _, skill := range skills {
+		_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateSkill),
+			skill.ID,
+			skill.Name,
+			skill.NormalizedName,
+			string(skill.Category),
+			skill.CreatedAt,
+		)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+				"name":  skill.Name,
+			}).Error("Database error when creating skill")
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package skillService
+
+import (
+	"ProjectGolang/internal/api/skill"
+	skillRepository "ProjectGolang/internal/api/skill/repository"
+	"ProjectGolang/internal/entity"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+type SkillService interface {
+	SearchSkills(c context.Context, req skill.SearchSkills) ([]skill.SkillResponse, error)
+	Resolver() Resolver
+}
+
+// Resolver is how other domains turn free-text skill names into catalogue
+// entries before linking them. Names that match neither a skill nor an alias
+// are added to the catalogue, uncategorized.
+type Resolver interface {
+	Resolve(c context.Context, names []string) ([]entity.Skill, error)
+}
+
+type skillService struct {
+	repo     skillRepository.Repository
+	resolver Resolver
+	log      *logrus.Logger
+}
+
+type resolverImpl struct {
+	repo skillRepository.Repository
+	log  *logrus.Logger
+}
+
+func New(skillRepo skillRepository.Repository, log *logrus.Logger) SkillService {
+	return &skillService{
+		repo:     skillRepo,
+		resolver: &resolverImpl{repo: skillRepo, log: log},
+		log:      log,
+	}
+}
+
+func (s *skillService) Resolver() Resolver {
+	return s.resolver
+}
@@ -0,0 +1,153 @@
+package skillService
+
+import (
+	"ProjectGolang/internal/api/skill"
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/utils"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const defaultSearchLimit = 10
+
+func (s *skillService) SearchSkills(c context.Context, req skill.SearchSkills) ([]skill.SkillResponse, error) {
+	requestID := contextPkg.GetRequestID(c)
+
+	query := skill.Normalize(req.Query)
+	if query == "" {
+		return []skill.SkillResponse{}, nil
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	skills, err := repo.Skills.SearchSkills(c, query, req.Category, limit)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+			"query":      query,
+		}).Error("Failed to search skills")
+		return nil, err
+	}
+
+	return skill.MakeSkillResponses(skills), nil
+}
+
+// Resolve returns one skill per distinct catalogue entry the names map to,
+// in the order the names were given. Names must already be cleaned and
+// validated.
+func (r *resolverImpl) Resolve(c context.Context, names []string) ([]entity.Skill, error) {
+	requestID := contextPkg.GetRequestID(c)
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	normalized := make([]string, len(names))
+	for i, name := range names {
+		normalized[i] = skill.Normalize(name)
+	}
+
+	repo, err := r.repo.NewClient(false)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	known, err := repo.Skills.GetSkillsByNormalizedNames(c, normalized)
+	if err != nil {
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to look up skills")
+		return nil, err
+	}
+
+	now := time.Now()
+	var missing []entity.Skill
+	var missingNames []string
+	for i, name := range names {
+		if _, ok := known[normalized[i]]; ok {
+			continue
+		}
+
+		id, err := utils.NewUlidFromTimestamp(now)
+		if err != nil {
+			r.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Failed to generate ULID")
+			return nil, err
+		}
+
+		missing = append(missing, entity.Skill{
+			ID:             id,
+			Name:           name,
+			NormalizedName: normalized[i],
+			CreatedAt:      now,
+		})
+		missingNames = append(missingNames, normalized[i])
+	}
+
+	if len(missing) > 0 {
+		if err := repo.Skills.CreateSkills(c, missing); err != nil {
+			r.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Failed to add skills to the catalogue")
+			return nil, err
+		}
+
+		// Read the new entries back rather than trusting our IDs, since a
+		// concurrent request may have added the same name first.
+		added, err := repo.Skills.GetSkillsByNormalizedNames(c, missingNames)
+		if err != nil {
+			r.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Failed to look up added skills")
+			return nil, err
+		}
+		for name, s := range added {
+			known[name] = s
+		}
+
+		r.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"count":      len(missing),
+		}).Info("Skills added to the catalogue")
+	}
+
+	seen := make(map[string]struct{}, len(names))
+	skills := make([]entity.Skill, 0, len(names))
+	for _, name := range normalized {
+		s, ok := known[name]
+		if !ok {
+			continue
+		}
+		if _, dup := seen[s.ID]; dup {
+			continue
+		}
+		seen[s.ID] = struct{}{}
+		skills = append(skills, s)
+	}
+
+	return skills, nil
+}
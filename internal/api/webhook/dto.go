@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"ProjectGolang/internal/entity"
+	"time"
+)
+
+type CreateWebhookEndpoint struct {
+	CompanyID   string   `json:"-"`
+	URL         string   `json:"url" validate:"required,url,max=2048"`
+	Description string   `json:"description" validate:"omitempty,max=255"`
+	Events      []string `json:"events" validate:"required,min=1,unique,dive,oneof=application.created application.status_changed vacancy.closed"`
+}
+
+type UpdateWebhookEndpoint struct {
+	ID          string   `json:"-"`
+	CompanyID   string   `json:"-"`
+	URL         string   `json:"url" validate:"omitempty,url,max=2048"`
+	Description *string  `json:"description" validate:"omitempty,max=255"`
+	Events      []string `json:"events" validate:"omitempty,min=1,unique,dive,oneof=application.created application.status_changed vacancy.closed"`
+	IsActive    *bool    `json:"is_active"`
+}
+
+type WebhookEndpointResponse struct {
+	ID          string   `json:"id"`
+	URL         string   `json:"url"`
+	Description string   `json:"description"`
+	Events      []string `json:"events"`
+	IsActive    bool     `json:"is_active"`
+	// Secret is only returned when the endpoint is created or its secret is
+	// rotated.
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetWebhookDeliveries struct {
+	EndpointID string                       `json:"-"`
+	CompanyID  string                       `json:"-"`
+	Status     entity.WebhookDeliveryStatus `query:"status" validate:"omitempty,oneof=pending succeeded failed"`
+	Before     string                       `query:"before"`
+	Limit      int                          `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type WebhookDeliveryAttemptResponse struct {
+	StatusCode  int       `json:"status_code,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	DurationMs  int       `json:"duration_ms"`
+	AttemptedAt time.Time `json:"attempted_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string                           `json:"id"`
+	EndpointID     string                           `json:"endpoint_id"`
+	EventID        string                           `json:"event_id"`
+	EventType      entity.WebhookEventType          `json:"event_type"`
+	Status         entity.WebhookDeliveryStatus     `json:"status"`
+	Attempts       int                              `json:"attempts"`
+	NextAttemptAt  *time.Time                       `json:"next_attempt_at"`
+	LastStatusCode int                              `json:"last_status_code,omitempty"`
+	LastError      string                           `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time                       `json:"delivered_at"`
+	CreatedAt      time.Time                        `json:"created_at"`
+	Payload        string                           `json:"payload,omitempty"`
+	AttemptLog     []WebhookDeliveryAttemptResponse `json:"attempt_log,omitempty"`
+}
+
+type WebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	// NextBefore is the cursor for the next, older page.
+	NextBefore string `json:"next_before,omitempty"`
+}
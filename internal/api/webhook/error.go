@@ -0,0 +1,16 @@
+package webhook
+
+import (
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrorWebhookEndpointNotFound = response.New(fiber.StatusNotFound, "webhook endpoint not found")
+	ErrorWebhookDeliveryNotFound = response.New(fiber.StatusNotFound, "webhook delivery not found")
+	ErrorWebhookLimitReached     = response.New(fiber.StatusConflict, "webhook endpoint limit reached")
+	ErrorWebhookURLInvalid       = response.New(fiber.StatusBadRequest, "webhook url must use http or https")
+	ErrorWebhookURLNotPublic     = response.New(fiber.StatusBadRequest, "webhook url must resolve to a public address")
+	ErrorRecruiterOnly           = response.New(fiber.StatusForbidden, "only recruiters can manage webhooks")
+	ErrorCompanyManagerOnly      = response.New(fiber.StatusForbidden, "only company owners and admins can manage webhooks")
+)
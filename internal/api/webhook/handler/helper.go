@@ -0,0 +1,27 @@
+package webhookHandler
+
+import (
+	"ProjectGolang/internal/api/webhook"
+	"ProjectGolang/internal/entity"
+	jwtPkg "ProjectGolang/pkg/jwt"
+	"github.com/gofiber/fiber/v2"
+)
+
+// requireRecruiter returns the authenticated recruiter. Webhooks belong to
+// the recruiter's company, whose ID is the recruiter's user ID.
+func (h *WebhookHandler) requireRecruiter(ctx *fiber.Ctx) (entity.UserLoginData, error) {
+	user, err := jwtPkg.GetUserLoginData(ctx)
+	if err != nil {
+		return entity.UserLoginData{}, err
+	}
+
+	if user.Role != entity.RoleRecruiter {
+		return entity.UserLoginData{}, webhook.ErrorRecruiterOnly
+	}
+
+	if user.CompanyRole != entity.CompanyRoleOwner && user.CompanyRole != entity.CompanyRoleAdmin {
+		return entity.UserLoginData{}, webhook.ErrorCompanyManagerOnly
+	}
+
+	return user, nil
+}
@@ -0,0 +1,39 @@
+package webhookHandler
+
+import (
+	webhookService "ProjectGolang/internal/api/webhook/service"
+	"ProjectGolang/internal/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+type WebhookHandler struct {
+	webhookService webhookService.WebhookService
+	validator      *validator.Validate
+	middleware     middleware.Middleware
+	log            *logrus.Logger
+}
+
+func New(ws webhookService.WebhookService, validate *validator.Validate, middleware middleware.Middleware, log *logrus.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: ws,
+		validator:      validate,
+		middleware:     middleware,
+		log:            log,
+	}
+}
+
+func (h *WebhookHandler) Start(srv fiber.Router) {
+	wh := srv.Group("/webhooks")
+	wh.Get("/deliveries/:id", h.middleware.NewTokenMiddleware, h.GetDelivery)
+	wh.Post("/deliveries/:id/redeliver", h.middleware.NewTokenMiddleware, h.Redeliver)
+
+	wh.Post("/", h.middleware.NewTokenMiddleware, h.CreateEndpoint)
+	wh.Get("/", h.middleware.NewTokenMiddleware, h.GetEndpoints)
+	wh.Get("/:id", h.middleware.NewTokenMiddleware, h.GetEndpoint)
+	wh.Put("/:id", h.middleware.NewTokenMiddleware, h.UpdateEndpoint)
+	wh.Delete("/:id", h.middleware.NewTokenMiddleware, h.DeleteEndpoint)
+	wh.Post("/:id/rotate_secret", h.middleware.NewTokenMiddleware, h.RotateSecret)
+	wh.Get("/:id/deliveries", h.middleware.NewTokenMiddleware, h.GetDeliveries)
+}
@@ -0,0 +1,277 @@
+package webhookHandler
+
+import (
+	"ProjectGolang/internal/api/webhook"
+	contextPkg "ProjectGolang/pkg/context"
+	"ProjectGolang/pkg/log"
+	"ProjectGolang/pkg/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func (h *WebhookHandler) CreateEndpoint(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to create webhook endpoint")
+	}
+
+	var req webhook.CreateWebhookEndpoint
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse webhook endpoint request")
+		return err
+	}
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for webhook endpoint request")
+		return err
+	}
+
+	result, err := h.webhookService.Endpoint().CreateEndpoint(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to create webhook endpoint")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusCreated).JSON(result)
+	}
+}
+
+func (h *WebhookHandler) GetEndpoints(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get webhook endpoints")
+	}
+
+	result, err := h.webhookService.Endpoint().GetEndpoints(c, user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get webhook endpoints")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(result)
+	}
+}
+
+func (h *WebhookHandler) GetEndpoint(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get webhook endpoint")
+	}
+
+	result, err := h.webhookService.Endpoint().GetEndpoint(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get webhook endpoint")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(result)
+	}
+}
+
+func (h *WebhookHandler) UpdateEndpoint(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update webhook endpoint")
+	}
+
+	var req webhook.UpdateWebhookEndpoint
+	if err := ctx.BodyParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse webhook endpoint update request")
+		return err
+	}
+	req.ID = ctx.Params("id")
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for webhook endpoint update request")
+		return err
+	}
+
+	result, err := h.webhookService.Endpoint().UpdateEndpoint(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to update webhook endpoint")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(result)
+	}
+}
+
+func (h *WebhookHandler) DeleteEndpoint(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to delete webhook endpoint")
+	}
+
+	if err := h.webhookService.Endpoint().DeleteEndpoint(c, ctx.Params("id"), user.ID); err != nil {
+		return response.WriteError(ctx, err, "Failed to delete webhook endpoint")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *WebhookHandler) RotateSecret(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to rotate webhook secret")
+	}
+
+	result, err := h.webhookService.Endpoint().RotateSecret(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to rotate webhook secret")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(result)
+	}
+}
+
+func (h *WebhookHandler) GetDeliveries(ctx *fiber.Ctx) error {
+	requestID := h.middleware.GetRequestID(ctx)
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get webhook deliveries")
+	}
+
+	var req webhook.GetWebhookDeliveries
+	if err := ctx.QueryParser(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Failed to parse webhook deliveries query parameters")
+		return err
+	}
+	req.EndpointID = ctx.Params("id")
+	req.CompanyID = user.ID
+
+	if err := h.validator.Struct(&req); err != nil {
+		h.log.WithFields(log.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Warn("Validation failed for webhook deliveries request")
+		return err
+	}
+
+	result, err := h.webhookService.Delivery().GetDeliveries(c, req)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get webhook deliveries")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(result)
+	}
+}
+
+func (h *WebhookHandler) GetDelivery(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get webhook delivery")
+	}
+
+	result, err := h.webhookService.Delivery().GetDelivery(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to get webhook delivery")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusOK).JSON(result)
+	}
+}
+
+func (h *WebhookHandler) Redeliver(ctx *fiber.Ctx) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	user, err := h.requireRecruiter(ctx)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to redeliver webhook")
+	}
+
+	result, err := h.webhookService.Delivery().Redeliver(c, ctx.Params("id"), user.ID)
+	if err != nil {
+		return response.WriteError(ctx, err, "Failed to redeliver webhook")
+	}
+
+	select {
+	case <-c.Done():
+		return ctx.Status(fiber.StatusRequestTimeout).
+			JSON(utils.StatusMessage(fiber.StatusRequestTimeout))
+	default:
+		return ctx.Status(fiber.StatusAccepted).JSON(result)
+	}
+}
@@ -0,0 +1,249 @@
+package webhookRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+func (r *deliveriesRepository) CreateDelivery(c context.Context, delivery entity.WebhookDelivery) error {
+	r.log.WithFields(map[string]interface{}{
+		"delivery_id": delivery.ID,
+		"endpoint_id": delivery.EndpointID,
+		"event_type":  delivery.EventType,
+	}).Debug("Creating webhook delivery in database")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateDelivery),
+		delivery.ID,
+		delivery.EndpointID,
+		delivery.EventID,
+		delivery.EventType,
+		delivery.Payload,
+		delivery.Status,
+		delivery.Attempts,
+		nullTime(delivery.NextAttemptAt),
+		delivery.CreatedAt,
+		delivery.UpdatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating webhook delivery")
+		return err
+	}
+
+	return nil
+}
+
+func (r *deliveriesRepository) GetDeliveryByID(c context.Context, id string) (entity.WebhookDelivery, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetDeliveryByID), id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"delivery_id": id,
+		}).Error("Database error when getting webhook delivery")
+		return entity.WebhookDelivery{}, err
+	}
+	defer rows.Close()
+
+	deliveries, err := scanDeliveries(rows, r.log)
+	if err != nil || len(deliveries) == 0 {
+		return entity.WebhookDelivery{}, err
+	}
+
+	return deliveries[0], nil
+}
+
+// GetDeliveriesByEndpointID returns the newest deliveries first, older than
+// the before cursor when it is set.
+func (r *deliveriesRepository) GetDeliveriesByEndpointID(c context.Context, endpointID string, status entity.WebhookDeliveryStatus, before string, limit int) ([]entity.WebhookDelivery, error) {
+	var query strings.Builder
+	query.WriteString(queryDeliveryColumns)
+	query.WriteString("WHERE endpoint_id = ?")
+	args := []interface{}{endpointID}
+
+	if status != "" {
+		query.WriteString(" AND status = ?")
+		args = append(args, status)
+	}
+	if before != "" {
+		query.WriteString(" AND id < ?")
+		args = append(args, before)
+	}
+	query.WriteString(" ORDER BY id DESC LIMIT ?")
+	args = append(args, limit)
+
+	rows, err := r.q.QueryContext(c, r.q.Rebind(query.String()), args...)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"endpoint_id": endpointID,
+		}).Error("Database error when getting webhook deliveries")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDeliveries(rows, r.log)
+}
+
+// ClaimDueDeliveries leases up to limit pending deliveries whose next attempt
+// is due, together with the URL and secret of their endpoint.
+func (r *deliveriesRepository) ClaimDueDeliveries(c context.Context, now time.Time, leaseUntil time.Time, limit int) ([]entity.WebhookDelivery, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryClaimDueDeliveries), leaseUntil, now, now, limit)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when claiming due webhook deliveries")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []entity.WebhookDelivery
+	for rows.Next() {
+		delivery := entity.WebhookDelivery{Status: entity.WebhookDeliveryPending}
+		err := rows.Scan(&delivery.ID, &delivery.EndpointID, &delivery.EventID, &delivery.EventType,
+			&delivery.Payload, &delivery.Attempts, &delivery.URL, &delivery.Secret)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning claimed webhook delivery row")
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through claimed webhook delivery rows")
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *deliveriesRepository) UpdateDeliveryResult(c context.Context, delivery entity.WebhookDelivery) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryUpdateDeliveryResult),
+		delivery.Status,
+		delivery.Attempts,
+		nullTime(delivery.NextAttemptAt),
+		sql.NullInt64{Int64: int64(delivery.LastStatusCode), Valid: delivery.LastStatusCode != 0},
+		nullString(delivery.LastError),
+		nullTime(delivery.DeliveredAt),
+		delivery.UpdatedAt,
+		delivery.ID,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"delivery_id": delivery.ID,
+		}).Error("Database error when updating webhook delivery")
+		return err
+	}
+
+	return nil
+}
+
+func (r *deliveriesRepository) CreateAttempt(c context.Context, attempt entity.WebhookDeliveryAttempt) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateAttempt),
+		attempt.ID,
+		attempt.DeliveryID,
+		sql.NullInt64{Int64: int64(attempt.StatusCode), Valid: attempt.StatusCode != 0},
+		nullString(attempt.Error),
+		attempt.DurationMs,
+		attempt.AttemptedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"delivery_id": attempt.DeliveryID,
+		}).Error("Database error when recording webhook delivery attempt")
+		return err
+	}
+
+	return nil
+}
+
+func (r *deliveriesRepository) GetAttemptsByDeliveryID(c context.Context, deliveryID string) ([]entity.WebhookDeliveryAttempt, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetAttemptsByDeliveryID), deliveryID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"delivery_id": deliveryID,
+		}).Error("Database error when getting webhook delivery attempts")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []entity.WebhookDeliveryAttempt
+	for rows.Next() {
+		var (
+			attempt      entity.WebhookDeliveryAttempt
+			statusCode   sql.NullInt64
+			errorMessage sql.NullString
+		)
+		err := rows.Scan(&attempt.ID, &attempt.DeliveryID, &statusCode, &errorMessage,
+			&attempt.DurationMs, &attempt.AttemptedAt)
+		if err != nil {
+			r.log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning webhook delivery attempt row")
+			return nil, err
+		}
+		attempt.StatusCode = int(statusCode.Int64)
+		attempt.Error = errorMessage.String
+		attempts = append(attempts, attempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through webhook delivery attempt rows")
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+func scanDeliveries(rows *sql.Rows, log *logrus.Logger) ([]entity.WebhookDelivery, error) {
+	var deliveries []entity.WebhookDelivery
+	for rows.Next() {
+		var (
+			delivery       entity.WebhookDelivery
+			nextAttemptAt  sql.NullTime
+			lastStatusCode sql.NullInt64
+			lastError      sql.NullString
+			deliveredAt    sql.NullTime
+		)
+		err := rows.Scan(&delivery.ID, &delivery.EndpointID, &delivery.EventID, &delivery.EventType,
+			&delivery.Payload, &delivery.Status, &delivery.Attempts, &nextAttemptAt, &lastStatusCode,
+			&lastError, &deliveredAt, &delivery.CreatedAt, &delivery.UpdatedAt)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning webhook delivery row")
+			return nil, err
+		}
+		if nextAttemptAt.Valid {
+			delivery.NextAttemptAt = &nextAttemptAt.Time
+		}
+		delivery.LastStatusCode = int(lastStatusCode.Int64)
+		delivery.LastError = lastError.String
+		if deliveredAt.Valid {
+			delivery.DeliveredAt = &deliveredAt.Time
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through webhook delivery rows")
+		return nil, err
+	}
+
+	return deliveries, nil
+}
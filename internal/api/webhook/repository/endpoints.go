@@ -0,0 +1,187 @@
+package webhookRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"database/sql"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+func (r *endpointsRepository) CreateEndpoint(c context.Context, endpoint entity.WebhookEndpoint) error {
+	r.log.WithFields(map[string]interface{}{
+		"endpoint_id": endpoint.ID,
+		"company_id":  endpoint.CompanyID,
+	}).Debug("Creating webhook endpoint in database")
+
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryCreateEndpoint),
+		endpoint.ID,
+		endpoint.CompanyID,
+		endpoint.URL,
+		nullString(endpoint.Description),
+		endpoint.Secret,
+		pq.Array(endpoint.Events),
+		endpoint.IsActive,
+		endpoint.CreatedAt,
+		endpoint.UpdatedAt,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Database error when creating webhook endpoint")
+		return err
+	}
+
+	return nil
+}
+
+func (r *endpointsRepository) GetEndpointByID(c context.Context, id string) (entity.WebhookEndpoint, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetEndpointByID), id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"endpoint_id": id,
+		}).Error("Database error when getting webhook endpoint")
+		return entity.WebhookEndpoint{}, err
+	}
+	defer rows.Close()
+
+	endpoints, err := scanEndpoints(rows, r.log)
+	if err != nil || len(endpoints) == 0 {
+		return entity.WebhookEndpoint{}, err
+	}
+
+	return endpoints[0], nil
+}
+
+func (r *endpointsRepository) GetEndpointsByCompanyID(c context.Context, companyID string) ([]entity.WebhookEndpoint, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetEndpointsByCompanyID), companyID)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when getting webhook endpoints")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanEndpoints(rows, r.log)
+}
+
+func (r *endpointsRepository) GetActiveEndpointsByEvent(c context.Context, companyID string, eventType entity.WebhookEventType) ([]entity.WebhookEndpoint, error) {
+	rows, err := r.q.QueryContext(c, r.q.Rebind(queryGetActiveEndpointsByEvent), companyID, eventType)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"company_id": companyID,
+			"event_type": eventType,
+		}).Error("Database error when getting subscribed webhook endpoints")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanEndpoints(rows, r.log)
+}
+
+func (r *endpointsRepository) CountEndpointsByCompanyID(c context.Context, companyID string) (int, error) {
+	var count int
+	err := r.q.QueryRowxContext(c, r.q.Rebind(queryCountEndpointsByCompanyID), companyID).Scan(&count)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":      err.Error(),
+			"company_id": companyID,
+		}).Error("Database error when counting webhook endpoints")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *endpointsRepository) UpdateEndpoint(c context.Context, endpoint entity.WebhookEndpoint) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryUpdateEndpoint),
+		endpoint.URL,
+		nullString(endpoint.Description),
+		pq.Array(endpoint.Events),
+		endpoint.IsActive,
+		endpoint.UpdatedAt,
+		endpoint.ID,
+	)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"endpoint_id": endpoint.ID,
+		}).Error("Database error when updating webhook endpoint")
+		return err
+	}
+
+	return nil
+}
+
+func (r *endpointsRepository) UpdateEndpointSecret(c context.Context, id string, secret string, updatedAt time.Time) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryUpdateEndpointSecret), secret, updatedAt, id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"endpoint_id": id,
+		}).Error("Database error when rotating webhook secret")
+		return err
+	}
+
+	return nil
+}
+
+func (r *endpointsRepository) DeleteEndpoint(c context.Context, id string) error {
+	_, err := r.q.ExecContext(c, r.q.Rebind(queryDeleteEndpoint), id)
+	if err != nil {
+		r.log.WithFields(map[string]interface{}{
+			"error":       err.Error(),
+			"endpoint_id": id,
+		}).Error("Database error when deleting webhook endpoint")
+		return err
+	}
+
+	return nil
+}
+
+func scanEndpoints(rows *sql.Rows, log *logrus.Logger) ([]entity.WebhookEndpoint, error) {
+	var endpoints []entity.WebhookEndpoint
+	for rows.Next() {
+		var (
+			endpoint    entity.WebhookEndpoint
+			description sql.NullString
+			updatedAt   sql.NullTime
+		)
+		err := rows.Scan(&endpoint.ID, &endpoint.CompanyID, &endpoint.URL, &description, &endpoint.Secret,
+			pq.Array(&endpoint.Events), &endpoint.IsActive, &endpoint.CreatedAt, &updatedAt)
+		if err != nil {
+			log.WithFields(map[string]interface{}{
+				"error": err.Error(),
+			}).Error("Error scanning webhook endpoint row")
+			return nil, err
+		}
+		endpoint.Description = description.String
+		endpoint.UpdatedAt = updatedAt.Time
+		endpoints = append(endpoints, endpoint)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Error iterating through webhook endpoint rows")
+		return nil, err
+	}
+
+	return endpoints, nil
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func nullTime(value *time.Time) sql.NullTime {
+	if value == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *value, Valid: true}
+}
@@ -0,0 +1,91 @@
+package webhookRepository
+
+const (
+	queryCreateEndpoint = `
+    INSERT INTO webhook_endpoints (id, company_id, url, description, secret, events, is_active, created_at, updated_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryEndpointColumns = `
+    SELECT id, company_id, url, description, secret, events, is_active, created_at, updated_at
+    FROM webhook_endpoints
+    `
+
+	queryGetEndpointByID = queryEndpointColumns + `WHERE id = ?`
+
+	queryGetEndpointsByCompanyID = queryEndpointColumns + `WHERE company_id = ? ORDER BY created_at`
+
+	queryGetActiveEndpointsByEvent = queryEndpointColumns + `WHERE company_id = ? AND is_active AND ? = ANY(events)`
+
+	queryCountEndpointsByCompanyID = `
+    SELECT COUNT(*) FROM webhook_endpoints WHERE company_id = ?
+    `
+
+	queryUpdateEndpoint = `
+    UPDATE webhook_endpoints
+    SET url = ?, description = ?, events = ?, is_active = ?, updated_at = ?
+    WHERE id = ?
+    `
+
+	queryUpdateEndpointSecret = `
+    UPDATE webhook_endpoints SET secret = ?, updated_at = ? WHERE id = ?
+    `
+
+	queryDeleteEndpoint = `
+    DELETE FROM webhook_endpoints WHERE id = ?
+    `
+)
+
+const (
+	queryCreateDelivery = `
+    INSERT INTO webhook_deliveries (
+        id, endpoint_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, updated_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	queryDeliveryColumns = `
+    SELECT id, endpoint_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+           last_status_code, last_error, delivered_at, created_at, updated_at
+    FROM webhook_deliveries
+    `
+
+	queryGetDeliveryByID = queryDeliveryColumns + `WHERE id = ?`
+
+	// queryClaimDueDeliveries leases due deliveries by pushing their next
+	// attempt out, so other replicas skip them while they are being sent.
+	queryClaimDueDeliveries = `
+    UPDATE webhook_deliveries d
+    SET next_attempt_at = ?, updated_at = ?
+    FROM webhook_endpoints e
+    WHERE e.id = d.endpoint_id AND d.id IN (
+        SELECT dd.id
+        FROM webhook_deliveries dd
+        JOIN webhook_endpoints ee ON ee.id = dd.endpoint_id
+        WHERE dd.status = 'pending' AND dd.next_attempt_at <= ? AND ee.is_active
+        ORDER BY dd.next_attempt_at
+        LIMIT ?
+        FOR UPDATE OF dd SKIP LOCKED
+    )
+    RETURNING d.id, d.endpoint_id, d.event_id, d.event_type, d.payload, d.attempts, e.url, e.secret
+    `
+
+	queryUpdateDeliveryResult = `
+    UPDATE webhook_deliveries
+    SET status = ?, attempts = ?, next_attempt_at = ?, last_status_code = ?, last_error = ?,
+        delivered_at = ?, updated_at = ?
+    WHERE id = ?
+    `
+
+	queryCreateAttempt = `
+    INSERT INTO webhook_delivery_attempts (
+        id, delivery_id, status_code, error, duration_ms, attempted_at
+    ) VALUES (?, ?, ?, ?, ?, ?)
+    `
+
+	queryGetAttemptsByDeliveryID = `
+    SELECT id, delivery_id, status_code, error, duration_ms, attempted_at
+    FROM webhook_delivery_attempts
+    WHERE delivery_id = ?
+    ORDER BY attempted_at
+    `
+)
@@ -0,0 +1,108 @@
+package webhookRepository
+
+import (
+	"ProjectGolang/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+func New(db *sqlx.DB, log *logrus.Logger) Repository {
+	return &repository{
+		DB:  db,
+		log: log,
+	}
+}
+
+type repository struct {
+	DB  *sqlx.DB
+	log *logrus.Logger
+}
+
+type Repository interface {
+	NewClient(tx bool) (Client, error)
+}
+
+func (r *repository) NewClient(tx bool) (Client, error) {
+	var db sqlx.ExtContext
+	var commitFunc, rollbackFunc func() error
+
+	r.log.WithFields(logrus.Fields{
+		"transaction": tx,
+	}).Debug("Creating new repository client")
+
+	db = r.DB
+
+	if tx {
+		r.log.Debug("Starting database transaction")
+		var err error
+		txx, err := r.DB.Beginx()
+		if err != nil {
+			r.log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Failed to begin transaction")
+			return Client{}, err
+		}
+
+		db = txx
+		commitFunc = txx.Commit
+		rollbackFunc = txx.Rollback
+	} else {
+		commitFunc = func() error { return nil }
+		rollbackFunc = func() error { return nil }
+	}
+
+	return Client{
+		Endpoints:  &endpointsRepository{q: db, log: r.log},
+		Deliveries: &deliveriesRepository{q: db, log: r.log},
+		Commit: func() error {
+			if tx {
+				r.log.Debug("Committing transaction")
+			}
+			return commitFunc()
+		},
+		Rollback: func() error {
+			if tx {
+				r.log.Debug("Rolling back transaction")
+			}
+			return rollbackFunc()
+		},
+	}, nil
+}
+
+type Client struct {
+	Endpoints interface {
+		CreateEndpoint(c context.Context, endpoint entity.WebhookEndpoint) error
+		GetEndpointByID(c context.Context, id string) (entity.WebhookEndpoint, error)
+		GetEndpointsByCompanyID(c context.Context, companyID string) ([]entity.WebhookEndpoint, error)
+		GetActiveEndpointsByEvent(c context.Context, companyID string, eventType entity.WebhookEventType) ([]entity.WebhookEndpoint, error)
+		CountEndpointsByCompanyID(c context.Context, companyID string) (int, error)
+		UpdateEndpoint(c context.Context, endpoint entity.WebhookEndpoint) error
+		UpdateEndpointSecret(c context.Context, id string, secret string, updatedAt time.Time) error
+		DeleteEndpoint(c context.Context, id string) error
+	}
+
+	Deliveries interface {
+		CreateDelivery(c context.Context, delivery entity.WebhookDelivery) error
+		GetDeliveryByID(c context.Context, id string) (entity.WebhookDelivery, error)
+		GetDeliveriesByEndpointID(c context.Context, endpointID string, status entity.WebhookDeliveryStatus, before string, limit int) ([]entity.WebhookDelivery, error)
+		ClaimDueDeliveries(c context.Context, now time.Time, leaseUntil time.Time, limit int) ([]entity.WebhookDelivery, error)
+		UpdateDeliveryResult(c context.Context, delivery entity.WebhookDelivery) error
+		CreateAttempt(c context.Context, attempt entity.WebhookDeliveryAttempt) error
+		GetAttemptsByDeliveryID(c context.Context, deliveryID string) ([]entity.WebhookDeliveryAttempt, error)
+	}
+
+	Commit   func() error
+	Rollback func() error
+}
+
+type endpointsRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
+
+type deliveriesRepository struct {
+	q   sqlx.ExtContext
+	log *logrus.Logger
+}
@@ -0,0 +1,102 @@
+package webhookService
+
+import (
+	"ProjectGolang/internal/api/webhook"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// errAddressNotPublic is returned when a delivery would connect to an address
+// inside our own network.
+var errAddressNotPublic = errors.New("webhook url resolves to a non-public address")
+
+// nonPublicPrefixes are the special-purpose ranges netip has no predicate
+// for: shared address space, benchmarking, IETF protocol assignments, the
+// reserved block, and NAT64 which can tunnel to any IPv4 address.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+// isPublicAddr reports whether addr is routable on the public internet, so
+// loopback, private, link-local (cloud metadata included) and other
+// special-purpose addresses are refused.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateURL checks a receiver URL when it is registered: http or https, and
+// every address the host resolves to must be public. Deliveries check again
+// when they dial, since DNS can change after registration.
+func validateURL(c context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return webhook.ErrorWebhookURLInvalid
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(c, "ip", u.Hostname())
+	if err != nil || len(addrs) == 0 {
+		return webhook.ErrorWebhookURLNotPublic
+	}
+	for _, addr := range addrs {
+		if !isPublicAddr(addr) {
+			return webhook.ErrorWebhookURLNotPublic
+		}
+	}
+	return nil
+}
+
+// newDeliveryClient returns the client deliveries are sent with. It refuses
+// to connect to non-public addresses at dial time, after DNS resolution, and
+// does not follow redirects, so a receiver cannot bounce a delivery into our
+// network. Proxies from the environment are ignored for the same reason.
+func newDeliveryClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: deliveryTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !isPublicAddr(addrPort.Addr()) {
+				return errAddressNotPublic
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: deliveryTimeout,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   deliveryTimeout,
+			ExpectContinueTimeout: time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
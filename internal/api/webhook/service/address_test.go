@@ -0,0 +1,51 @@
+package webhookService
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "93.184.216.34", want: true},
+		{addr: "8.8.8.8", want: true},
+		{addr: "2606:4700:4700::1111", want: true},
+		{addr: "0.0.0.0", want: false},
+		{addr: "127.0.0.1", want: false},
+		{addr: "10.1.2.3", want: false},
+		{addr: "172.16.0.1", want: false},
+		{addr: "192.168.1.1", want: false},
+		{addr: "169.254.169.254", want: false},
+		{addr: "100.64.0.1", want: false},
+		{addr: "192.0.0.8", want: false},
+		{addr: "198.18.0.1", want: false},
+		{addr: "224.0.0.1", want: false},
+		{addr: "255.255.255.255", want: false},
+		{addr: "::", want: false},
+		{addr: "::1", want: false},
+		{addr: "fc00::1", want: false},
+		{addr: "fe80::1", want: false},
+		{addr: "ff02::1", want: false},
+		{addr: "::ffff:127.0.0.1", want: false},
+		{addr: "::ffff:10.0.0.1", want: false},
+		{addr: "::ffff:93.184.216.34", want: true},
+		{addr: "64:ff9b::a9fe:a9fe", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := isPublicAddr(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Errorf("isPublicAddr(%s) = %v, want %v", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPublicAddrInvalid(t *testing.T) {
+	if isPublicAddr(netip.Addr{}) {
+		t.Errorf("isPublicAddr(zero) = true, want false")
+	}
+}
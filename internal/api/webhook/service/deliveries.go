@@ -0,0 +1,157 @@
+package webhookService
+
+import (
+	"ProjectGolang/internal/api/webhook"
+	webhookRepository "ProjectGolang/internal/api/webhook/repository"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const defaultDeliveryPageSize = 20
+
+func (s *deliveryImpl) GetDeliveries(c context.Context, req webhook.GetWebhookDeliveries) (webhook.WebhookDeliveriesResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return webhook.WebhookDeliveriesResponse{}, err
+	}
+
+	if _, err := getOwnedEndpoint(c, repo, req.EndpointID, req.CompanyID); err != nil {
+		return webhook.WebhookDeliveriesResponse{}, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultDeliveryPageSize
+	}
+
+	deliveries, err := repo.Deliveries.GetDeliveriesByEndpointID(c, req.EndpointID, req.Status, req.Before, limit)
+	if err != nil {
+		return webhook.WebhookDeliveriesResponse{}, err
+	}
+
+	response := webhook.WebhookDeliveriesResponse{
+		Deliveries: make([]webhook.WebhookDeliveryResponse, len(deliveries)),
+	}
+	for i, delivery := range deliveries {
+		response.Deliveries[i] = makeDeliveryResponse(delivery)
+	}
+
+	if len(deliveries) == limit {
+		response.NextBefore = deliveries[len(deliveries)-1].ID
+	}
+
+	return response, nil
+}
+
+// GetDelivery returns the delivery with its payload and every attempt made
+// to send it.
+func (s *deliveryImpl) GetDelivery(c context.Context, id string, companyID string) (webhook.WebhookDeliveryResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return webhook.WebhookDeliveryResponse{}, err
+	}
+
+	delivery, err := getOwnedDelivery(c, repo, id, companyID)
+	if err != nil {
+		return webhook.WebhookDeliveryResponse{}, err
+	}
+
+	attempts, err := repo.Deliveries.GetAttemptsByDeliveryID(c, delivery.ID)
+	if err != nil {
+		return webhook.WebhookDeliveryResponse{}, err
+	}
+
+	response := makeDeliveryResponse(delivery)
+	response.Payload = delivery.Payload
+	response.AttemptLog = make([]webhook.WebhookDeliveryAttemptResponse, len(attempts))
+	for i, attempt := range attempts {
+		response.AttemptLog[i] = webhook.WebhookDeliveryAttemptResponse{
+			StatusCode:  attempt.StatusCode,
+			Error:       attempt.Error,
+			DurationMs:  attempt.DurationMs,
+			AttemptedAt: attempt.AttemptedAt,
+		}
+	}
+
+	return response, nil
+}
+
+// Redeliver queues the same event again as a new delivery, keeping the
+// original one and its attempts in the log.
+func (s *deliveryImpl) Redeliver(c context.Context, id string, companyID string) (webhook.WebhookDeliveryResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return webhook.WebhookDeliveryResponse{}, err
+	}
+
+	original, err := getOwnedDelivery(c, repo, id, companyID)
+	if err != nil {
+		return webhook.WebhookDeliveryResponse{}, err
+	}
+
+	now := time.Now()
+	deliveryID, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return webhook.WebhookDeliveryResponse{}, err
+	}
+
+	delivery := entity.WebhookDelivery{
+		ID:            deliveryID,
+		EndpointID:    original.EndpointID,
+		EventID:       original.EventID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        entity.WebhookDeliveryPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := repo.Deliveries.CreateDelivery(c, delivery); err != nil {
+		return webhook.WebhookDeliveryResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"delivery_id":          delivery.ID,
+		"original_delivery_id": original.ID,
+		"endpoint_id":          delivery.EndpointID,
+	}).Info("Webhook redelivery queued")
+
+	return makeDeliveryResponse(delivery), nil
+}
+
+func getOwnedDelivery(c context.Context, repo webhookRepository.Client, id string, companyID string) (entity.WebhookDelivery, error) {
+	delivery, err := repo.Deliveries.GetDeliveryByID(c, id)
+	if err != nil {
+		return entity.WebhookDelivery{}, err
+	}
+
+	if delivery.ID == "" {
+		return entity.WebhookDelivery{}, webhook.ErrorWebhookDeliveryNotFound
+	}
+
+	if _, err := getOwnedEndpoint(c, repo, delivery.EndpointID, companyID); err != nil {
+		if errors.Is(err, webhook.ErrorWebhookEndpointNotFound) {
+			return entity.WebhookDelivery{}, webhook.ErrorWebhookDeliveryNotFound
+		}
+		return entity.WebhookDelivery{}, err
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,90 @@
+package webhookService
+
+import (
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// eventPayload is the body every receiver gets. ID identifies the event and
+// stays the same across retries and redeliveries.
+type eventPayload struct {
+	ID        string                  `json:"id"`
+	Type      entity.WebhookEventType `json:"type"`
+	CreatedAt time.Time               `json:"created_at"`
+	Data      map[string]interface{}  `json:"data"`
+}
+
+func (s *dispatcherImpl) Dispatch(c context.Context, companyID string, eventType entity.WebhookEventType, data map[string]interface{}) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return
+	}
+
+	endpoints, err := repo.Endpoints.GetActiveEndpointsByEvent(c, companyID, eventType)
+	if err != nil || len(endpoints) == 0 {
+		return
+	}
+
+	now := time.Now()
+	eventID, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return
+	}
+
+	payload, err := json.Marshal(eventPayload{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: now,
+		Data:      data,
+	})
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error":      err.Error(),
+			"event_type": eventType,
+		}).Error("Failed to encode webhook payload")
+		return
+	}
+
+	for _, endpoint := range endpoints {
+		id, err := utils.NewUlidFromTimestamp(now)
+		if err != nil {
+			s.log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Error("Failed to generate ULID")
+			return
+		}
+
+		delivery := entity.WebhookDelivery{
+			ID:            id,
+			EndpointID:    endpoint.ID,
+			EventID:       eventID,
+			EventType:     eventType,
+			Payload:       string(payload),
+			Status:        entity.WebhookDeliveryPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+
+		if err := repo.Deliveries.CreateDelivery(c, delivery); err != nil {
+			continue
+		}
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"event_id":   eventID,
+		"event_type": eventType,
+		"company_id": companyID,
+		"endpoints":  len(endpoints),
+	}).Debug("Webhook event queued")
+}
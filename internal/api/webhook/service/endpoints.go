@@ -0,0 +1,235 @@
+package webhookService
+
+import (
+	"ProjectGolang/internal/api/webhook"
+	webhookRepository "ProjectGolang/internal/api/webhook/repository"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// maxEndpointsPerCompany keeps a single company from fanning one event out to
+// an unbounded number of receivers.
+const maxEndpointsPerCompany = 10
+
+func (s *endpointImpl) CreateEndpoint(c context.Context, req webhook.CreateWebhookEndpoint) (webhook.WebhookEndpointResponse, error) {
+	if err := validateURL(c, req.URL); err != nil {
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	count, err := repo.Endpoints.CountEndpointsByCompanyID(c, req.CompanyID)
+	if err != nil {
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	if count >= maxEndpointsPerCompany {
+		return webhook.WebhookEndpointResponse{}, webhook.ErrorWebhookLimitReached
+	}
+
+	now := time.Now()
+	id, err := utils.NewUlidFromTimestamp(now)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate ULID")
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate webhook secret")
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	endpoint := entity.WebhookEndpoint{
+		ID:          id,
+		CompanyID:   req.CompanyID,
+		URL:         req.URL,
+		Description: req.Description,
+		Secret:      secret,
+		Events:      req.Events,
+		IsActive:    true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := repo.Endpoints.CreateEndpoint(c, endpoint); err != nil {
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"endpoint_id": endpoint.ID,
+		"company_id":  endpoint.CompanyID,
+	}).Info("Webhook endpoint created")
+
+	response := makeEndpointResponse(endpoint)
+	response.Secret = secret
+	return response, nil
+}
+
+func (s *endpointImpl) GetEndpoints(c context.Context, companyID string) ([]webhook.WebhookEndpointResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return nil, err
+	}
+
+	endpoints, err := repo.Endpoints.GetEndpointsByCompanyID(c, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]webhook.WebhookEndpointResponse, len(endpoints))
+	for i, endpoint := range endpoints {
+		response[i] = makeEndpointResponse(endpoint)
+	}
+
+	return response, nil
+}
+
+func (s *endpointImpl) GetEndpoint(c context.Context, id string, companyID string) (webhook.WebhookEndpointResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	endpoint, err := getOwnedEndpoint(c, repo, id, companyID)
+	if err != nil {
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	return makeEndpointResponse(endpoint), nil
+}
+
+func (s *endpointImpl) UpdateEndpoint(c context.Context, req webhook.UpdateWebhookEndpoint) (webhook.WebhookEndpointResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	endpoint, err := getOwnedEndpoint(c, repo, req.ID, req.CompanyID)
+	if err != nil {
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	if req.URL != "" {
+		if err := validateURL(c, req.URL); err != nil {
+			return webhook.WebhookEndpointResponse{}, err
+		}
+		endpoint.URL = req.URL
+	}
+	if req.Description != nil {
+		endpoint.Description = *req.Description
+	}
+	if len(req.Events) > 0 {
+		endpoint.Events = req.Events
+	}
+	if req.IsActive != nil {
+		endpoint.IsActive = *req.IsActive
+	}
+	endpoint.UpdatedAt = time.Now()
+
+	if err := repo.Endpoints.UpdateEndpoint(c, endpoint); err != nil {
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	return makeEndpointResponse(endpoint), nil
+}
+
+func (s *endpointImpl) DeleteEndpoint(c context.Context, id string, companyID string) error {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return err
+	}
+
+	if _, err := getOwnedEndpoint(c, repo, id, companyID); err != nil {
+		return err
+	}
+
+	if err := repo.Endpoints.DeleteEndpoint(c, id); err != nil {
+		return err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"endpoint_id": id,
+		"company_id":  companyID,
+	}).Info("Webhook endpoint deleted")
+
+	return nil
+}
+
+// RotateSecret replaces the signing secret right away. Deliveries still
+// queued are signed with the new one when they are sent.
+func (s *endpointImpl) RotateSecret(c context.Context, id string, companyID string) (webhook.WebhookEndpointResponse, error) {
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	endpoint, err := getOwnedEndpoint(c, repo, id, companyID)
+	if err != nil {
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to generate webhook secret")
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	endpoint.Secret = secret
+	endpoint.UpdatedAt = time.Now()
+	if err := repo.Endpoints.UpdateEndpointSecret(c, endpoint.ID, endpoint.Secret, endpoint.UpdatedAt); err != nil {
+		return webhook.WebhookEndpointResponse{}, err
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"endpoint_id": endpoint.ID,
+		"company_id":  companyID,
+	}).Info("Webhook secret rotated")
+
+	response := makeEndpointResponse(endpoint)
+	response.Secret = secret
+	return response, nil
+}
+
+func getOwnedEndpoint(c context.Context, repo webhookRepository.Client, id string, companyID string) (entity.WebhookEndpoint, error) {
+	endpoint, err := repo.Endpoints.GetEndpointByID(c, id)
+	if err != nil {
+		return entity.WebhookEndpoint{}, err
+	}
+
+	if endpoint.ID == "" || endpoint.CompanyID != companyID {
+		return entity.WebhookEndpoint{}, webhook.ErrorWebhookEndpointNotFound
+	}
+
+	return endpoint, nil
+}
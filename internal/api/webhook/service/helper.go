@@ -0,0 +1,60 @@
+package webhookService
+
+import (
+	"ProjectGolang/internal/api/webhook"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+func generateSecret() (string, error) {
+	token, err := utils.GenerateToken(24)
+	if err != nil {
+		return "", err
+	}
+	return "whsec_" + token, nil
+}
+
+// sign returns the value of the signature header. Receivers recompute the
+// HMAC over "<timestamp>.<body>" with their secret and compare, and reject
+// old timestamps to stop replays.
+func sign(secret string, timestamp string, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func makeEndpointResponse(endpoint entity.WebhookEndpoint) webhook.WebhookEndpointResponse {
+	events := endpoint.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	return webhook.WebhookEndpointResponse{
+		ID:          endpoint.ID,
+		URL:         endpoint.URL,
+		Description: endpoint.Description,
+		Events:      events,
+		IsActive:    endpoint.IsActive,
+		CreatedAt:   endpoint.CreatedAt,
+		UpdatedAt:   endpoint.UpdatedAt,
+	}
+}
+
+func makeDeliveryResponse(delivery entity.WebhookDelivery) webhook.WebhookDeliveryResponse {
+	return webhook.WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		EndpointID:     delivery.EndpointID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
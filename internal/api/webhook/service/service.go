@@ -0,0 +1,103 @@
+package webhookService
+
+import (
+	"ProjectGolang/internal/api/webhook"
+	webhookRepository "ProjectGolang/internal/api/webhook/repository"
+	"ProjectGolang/internal/entity"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"net/http"
+)
+
+type WebhookService interface {
+	Endpoint() EndpointDomain
+	Delivery() DeliveryDomain
+	Dispatcher() Dispatcher
+	Worker() Worker
+}
+
+type EndpointDomain interface {
+	CreateEndpoint(c context.Context, req webhook.CreateWebhookEndpoint) (webhook.WebhookEndpointResponse, error)
+	GetEndpoints(c context.Context, companyID string) ([]webhook.WebhookEndpointResponse, error)
+	GetEndpoint(c context.Context, id string, companyID string) (webhook.WebhookEndpointResponse, error)
+	UpdateEndpoint(c context.Context, req webhook.UpdateWebhookEndpoint) (webhook.WebhookEndpointResponse, error)
+	DeleteEndpoint(c context.Context, id string, companyID string) error
+	RotateSecret(c context.Context, id string, companyID string) (webhook.WebhookEndpointResponse, error)
+}
+
+type DeliveryDomain interface {
+	GetDeliveries(c context.Context, req webhook.GetWebhookDeliveries) (webhook.WebhookDeliveriesResponse, error)
+	GetDelivery(c context.Context, id string, companyID string) (webhook.WebhookDeliveryResponse, error)
+	Redeliver(c context.Context, id string, companyID string) (webhook.WebhookDeliveryResponse, error)
+}
+
+// Dispatcher is how other domains emit webhook events. It only queues a
+// delivery per subscribed endpoint; the Worker sends them. Failures are
+// logged, never returned, so a broken endpoint never fails the action that
+// caused the event. Call it after the action's transaction commits.
+type Dispatcher interface {
+	Dispatch(c context.Context, companyID string, eventType entity.WebhookEventType, data map[string]interface{})
+}
+
+// Worker sends queued deliveries whose next attempt is due. It is driven by
+// the scheduler and is safe to run on every replica at once.
+type Worker interface {
+	DeliverDue(c context.Context)
+}
+
+type webhookService struct {
+	endpointDomain EndpointDomain
+	deliveryDomain DeliveryDomain
+	dispatcher     Dispatcher
+	worker         Worker
+}
+
+func (s *webhookService) Endpoint() EndpointDomain {
+	return s.endpointDomain
+}
+
+func (s *webhookService) Delivery() DeliveryDomain {
+	return s.deliveryDomain
+}
+
+func (s *webhookService) Dispatcher() Dispatcher {
+	return s.dispatcher
+}
+
+func (s *webhookService) Worker() Worker {
+	return s.worker
+}
+
+type endpointImpl struct {
+	repo webhookRepository.Repository
+	log  *logrus.Logger
+}
+
+type deliveryImpl struct {
+	repo webhookRepository.Repository
+	log  *logrus.Logger
+}
+
+type dispatcherImpl struct {
+	repo webhookRepository.Repository
+	log  *logrus.Logger
+}
+
+type workerImpl struct {
+	repo   webhookRepository.Repository
+	client *http.Client
+	log    *logrus.Logger
+}
+
+func New(webhookRepo webhookRepository.Repository, log *logrus.Logger) WebhookService {
+	return &webhookService{
+		endpointDomain: &endpointImpl{repo: webhookRepo, log: log},
+		deliveryDomain: &deliveryImpl{repo: webhookRepo, log: log},
+		dispatcher:     &dispatcherImpl{repo: webhookRepo, log: log},
+		worker: &workerImpl{
+			repo:   webhookRepo,
+			client: newDeliveryClient(),
+			log:    log,
+		},
+	}
+}
@@ -0,0 +1,184 @@
+package webhookService
+
+import (
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// deliveryTimeout bounds a single request to a receiver.
+	deliveryTimeout = 10 * time.Second
+	// deliveryLease is how long a claimed delivery is hidden from other
+	// workers. It must outlast a whole batch being sent.
+	deliveryLease = 2 * time.Minute
+	deliveryBatch = 50
+	// deliveryConcurrency is how many requests one worker has in flight.
+	deliveryConcurrency = 10
+	// maxDeliveryAttempts includes the first attempt. With the backoff below
+	// a delivery is retried for a little over an hour before it fails.
+	maxDeliveryAttempts = 8
+	retryBaseDelay      = 30 * time.Second
+	retryMaxDelay       = 30 * time.Minute
+	// maxResponseDrain is how much of a receiver's response is read, and
+	// thrown away, so the connection can be reused.
+	maxResponseDrain = 4096
+)
+
+func (s *workerImpl) DeliverDue(c context.Context) {
+	repo, err := s.repo.NewClient(true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return
+	}
+	defer repo.Rollback()
+
+	now := time.Now()
+	deliveries, err := repo.Deliveries.ClaimDueDeliveries(c, now, now.Add(deliveryLease), deliveryBatch)
+	if err != nil {
+		return
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to commit webhook delivery claim")
+		return
+	}
+
+	if len(deliveries) == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, deliveryConcurrency)
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(delivery entity.WebhookDelivery) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			s.deliver(c, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+
+	s.log.WithFields(logrus.Fields{
+		"count": len(deliveries),
+	}).Info("Webhook deliveries processed")
+}
+
+func (s *workerImpl) deliver(c context.Context, delivery entity.WebhookDelivery) {
+	attempt := s.send(c, delivery)
+
+	repo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to create repository client")
+		return
+	}
+
+	if err := repo.Deliveries.CreateAttempt(c, attempt); err != nil {
+		return
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = attempt.StatusCode
+	delivery.LastError = attempt.Error
+	delivery.UpdatedAt = now
+
+	switch {
+	case attempt.Error == "":
+		delivery.Status = entity.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+	case delivery.Attempts >= maxDeliveryAttempts:
+		delivery.Status = entity.WebhookDeliveryFailed
+		delivery.NextAttemptAt = nil
+		s.log.WithFields(logrus.Fields{
+			"delivery_id": delivery.ID,
+			"endpoint_id": delivery.EndpointID,
+			"error":       attempt.Error,
+		}).Warn("Webhook delivery failed permanently")
+	default:
+		next := now.Add(retryDelay(delivery.Attempts))
+		delivery.NextAttemptAt = &next
+	}
+
+	_ = repo.Deliveries.UpdateDeliveryResult(c, delivery)
+}
+
+// send makes one signed request and describes the outcome as an attempt.
+// Any non-2xx response counts as a failure.
+func (s *workerImpl) send(c context.Context, delivery entity.WebhookDelivery) entity.WebhookDeliveryAttempt {
+	attempt := entity.WebhookDeliveryAttempt{
+		DeliveryID:  delivery.ID,
+		AttemptedAt: time.Now(),
+	}
+
+	id, err := utils.NewUlidFromTimestamp(attempt.AttemptedAt)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	attempt.ID = id
+
+	req, err := http.NewRequestWithContext(c, http.MethodPost, delivery.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+
+	timestamp := strconv.FormatInt(attempt.AttemptedAt.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ProjectGolang-Webhooks/1.0")
+	req.Header.Set("X-Webhook-Event", string(delivery.EventType))
+	req.Header.Set("X-Webhook-Event-ID", delivery.EventID)
+	req.Header.Set("X-Webhook-Delivery", delivery.ID)
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	attempt.DurationMs = int(time.Since(attempt.AttemptedAt).Milliseconds())
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer resp.Body.Close()
+
+	// Only the status code is recorded: the attempt log is readable by the
+	// company, and a response body would echo whatever the URL serves.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseDrain))
+	attempt.StatusCode = resp.StatusCode
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		attempt.Error = fmt.Sprintf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return attempt
+}
+
+// retryDelay doubles the wait after every failed attempt, up to
+// retryMaxDelay.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,31 @@
+package webhookService
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: 30 * time.Second},
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 3, want: 2 * time.Minute},
+		{attempts: 6, want: 16 * time.Minute},
+		{attempts: 7, want: retryMaxDelay},
+		{attempts: maxDeliveryAttempts, want: retryMaxDelay},
+		{attempts: 100, want: retryMaxDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt %d", tt.attempts), func(t *testing.T) {
+			if got := retryDelay(tt.attempts); got != tt.want {
+				t.Errorf("retryDelay(%d) = %s, want %s", tt.attempts, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,13 +2,32 @@ package config
 
 import (
 	"ProjectGolang/database/postgres"
+	apikeyHandler "ProjectGolang/internal/api/apikey/handler"
+	apikeyRepository "ProjectGolang/internal/api/apikey/repository"
+	apikeyService "ProjectGolang/internal/api/apikey/service"
 	authHandler "ProjectGolang/internal/api/auth/handler"
 	authRepository "ProjectGolang/internal/api/auth/repository"
 	authService "ProjectGolang/internal/api/auth/service"
 	bioHandler "ProjectGolang/internal/api/bio/handler"
 	bioRepository "ProjectGolang/internal/api/bio/repository"
 	bioService "ProjectGolang/internal/api/bio/service"
+	messagingHandler "ProjectGolang/internal/api/messaging/handler"
+	messagingRepository "ProjectGolang/internal/api/messaging/repository"
+	messagingService "ProjectGolang/internal/api/messaging/service"
+	notificationHandler "ProjectGolang/internal/api/notification/handler"
+	notificationRepository "ProjectGolang/internal/api/notification/repository"
+	notificationService "ProjectGolang/internal/api/notification/service"
+	recruitmentHandler "ProjectGolang/internal/api/recruitment/handler"
+	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	recruitmentService "ProjectGolang/internal/api/recruitment/service"
+	skillHandler "ProjectGolang/internal/api/skill/handler"
+	skillRepository "ProjectGolang/internal/api/skill/repository"
+	skillService "ProjectGolang/internal/api/skill/service"
+	webhookHandler "ProjectGolang/internal/api/webhook/handler"
+	webhookRepository "ProjectGolang/internal/api/webhook/repository"
+	webhookService "ProjectGolang/internal/api/webhook/service"
 	"ProjectGolang/internal/middleware"
+	"ProjectGolang/pkg/realtime"
 	"ProjectGolang/pkg/redis"
 	"ProjectGolang/pkg/s3"
 	"ProjectGolang/pkg/scheduler"
@@ -31,6 +50,7 @@ type Server struct {
 	s3         s3.ItfS3
 	smtp       smtp.ItfSmtp
 	redis      redis.ItfRedis
+	realtime   realtime.ItfRealtime
 	scheduler  *scheduler.Scheduler
 	handlers   []handler
 }
@@ -53,35 +73,73 @@ func NewServer(fiberApp *fiber.App, log *logrus.Logger, validator *validator.Val
 	}
 
 	bootstrap := &Server{
-		engine:     fiberApp,
-		DB:         DB,
-		log:        log,
-		validator:  validator,
-		middleware: middleware.New(log),
-		s3:         objectDB,
-		smtp:       smtp.New(),
-		redis:      redis.New(),
+		engine:    fiberApp,
+		DB:        DB,
+		log:       log,
+		validator: validator,
+		s3:        objectDB,
+		smtp:      smtp.New(),
+		redis:     redis.New(),
 	}
 
 	return bootstrap, nil
 }
 
 func (s *Server) RegisterHandler() {
+	s.realtime = realtime.New(s.redis, s.log)
+	s.realtime.Start()
+
+	//API Key Domain
+	apiKeyRepo := apikeyRepository.New(s.DB, s.log)
+	apiKeyServices := apikeyService.New(apiKeyRepo, s.log)
+
+	//Notification Domain
+	notificationRepo := notificationRepository.New(s.DB, s.log)
+	notificationServices := notificationService.New(notificationRepo, s.log, s.smtp, s.realtime)
+	notifier := notificationServices.Notifier()
+
 	//Auth Domain
 	authRepo := authRepository.New(s.DB, s.log)
-	authServices := authService.New(authRepo, s.log, s.smtp, s.redis, s.s3)
+	authServices := authService.New(authRepo, s.log, s.smtp, s.redis, s.s3, notifier)
+
+	// The middleware authenticates through the API key and auth services, so
+	// their handlers are built once it exists.
+	s.middleware = middleware.New(s.log, apiKeyServices, authServices, s.realtime)
+	apiKeyHandlers := apikeyHandler.New(apiKeyServices, s.validator, s.middleware, s.log)
+	notificationHandlers := notificationHandler.New(notificationServices, s.realtime, s.validator, s.middleware, s.log)
 	authHandlers := authHandler.New(authServices, s.validator, s.middleware, s.log)
-	timeScheduler := scheduler.NewScheduler(authRepo, s.log)
+
+	//Skill Domain
+	skillRepo := skillRepository.New(s.DB, s.log)
+	skillServices := skillService.New(skillRepo, s.log)
+	skillHandlers := skillHandler.New(skillServices, s.validator, s.middleware, s.log)
 
 	//Bio Domain
 	bioRepo := bioRepository.New(s.DB, s.log)
-	bioServices := bioService.New(authRepo, bioRepo, s.log, s.smtp, s.redis, s.s3)
+	bioServices := bioService.New(authRepo, bioRepo, s.log, s.smtp, s.redis, s.s3, skillServices.Resolver(), notifier)
 	bioHandlers := bioHandler.New(bioServices, s.validator, s.middleware, s.log)
 
+	//Webhook Domain
+	webhookRepo := webhookRepository.New(s.DB, s.log)
+	webhookServices := webhookService.New(webhookRepo, s.log)
+	webhookHandlers := webhookHandler.New(webhookServices, s.validator, s.middleware, s.log)
+
+	//Recruitment Domain
+	recruitmentRepo := recruitmentRepository.New(s.DB, s.log)
+	recruitmentServices := recruitmentService.New(recruitmentRepo, authRepo, bioRepo, s.log, s.redis, notifier, s.s3, s.realtime, webhookServices.Dispatcher(), skillServices.Resolver())
+	recruitmentHandlers := recruitmentHandler.New(recruitmentServices, s.validator, s.middleware, s.log)
+
+	//Messaging Domain
+	messagingRepo := messagingRepository.New(s.DB, s.log)
+	messagingServices := messagingService.New(messagingRepo, recruitmentRepo, authRepo, s.log, s.redis, notifier, s.s3, s.realtime)
+	messagingHandlers := messagingHandler.New(messagingServices, s.validator, s.middleware, s.log)
+
+	timeScheduler := scheduler.NewScheduler(authRepo, recruitmentRepo, bioRepo, notifier, webhookServices.Worker(), s.realtime, s.s3, s.log)
+
 	timeScheduler.Start()
 	s.scheduler = timeScheduler
 	s.checkHealth()
-	s.handlers = append(s.handlers, authHandlers, bioHandlers)
+	s.handlers = append(s.handlers, authHandlers, bioHandlers, recruitmentHandlers, messagingHandlers, notificationHandlers, webhookHandlers, apiKeyHandlers, skillHandlers)
 }
 
 func (s *Server) Run() error {
@@ -123,6 +181,11 @@ func (s *Server) Shutdown() {
 		s.log.Info("Scheduler stopped")
 	}
 
+	if s.realtime != nil {
+		s.realtime.Stop()
+		s.log.Info("Realtime hub stopped")
+	}
+
 	if s.DB != nil {
 		err := s.DB.Close()
 		if err != nil {
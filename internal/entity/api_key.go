@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+type APIKeyScope string
+
+const (
+	ScopeVacanciesRead     APIKeyScope = "vacancies:read"
+	ScopeVacanciesWrite    APIKeyScope = "vacancies:write"
+	ScopeApplicationsRead  APIKeyScope = "applications:read"
+	ScopeApplicationsWrite APIKeyScope = "applications:write"
+)
+
+// APIKey lets a company's own systems call the API without a login. Only the
+// SHA-256 of the key is stored; Prefix is the public part used to look it up
+// and to tell keys apart in listings. Requests made with it act as MemberID,
+// the member who created it, with Role. A key whose member was removed stops
+// working.
+type APIKey struct {
+	ID         string      `db:"id"`
+	CompanyID  string      `db:"company_id"`
+	MemberID   string      `db:"member_id"`
+	Role       CompanyRole `db:"role"`
+	Name       string      `db:"name"`
+	Prefix     string      `db:"prefix"`
+	KeyHash    string      `db:"key_hash"`
+	Scopes     []string    `db:"scopes"`
+	ExpiresAt  *time.Time  `db:"expires_at"`
+	LastUsedAt *time.Time  `db:"last_used_at"`
+	RevokedAt  *time.Time  `db:"revoked_at"`
+	CreatedAt  time.Time   `db:"created_at"`
+
+	CompanyName  string `db:"-"`
+	CompanyEmail string `db:"-"`
+	// MemberRole is the creating member's current role.
+	MemberRole CompanyRole `db:"-"`
+}
@@ -3,43 +3,76 @@ package entity
 import "time"
 
 type Experience struct {
-	ID          string    `db:"id"`
-	UserID      string    `db:"user_id"`
-	ImageURL    string    `db:"image_url"`
-	JobTitle    string    `db:"job_title"`
-	JobLocation string    `db:"job_location"`
-	SkillUsed   string    `db:"skill_used"`
-	StartDate   string    `db:"start_date"`
-	EndDate     string    `db:"end_date"`
-	Description string    `db:"description"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID          string     `db:"id"`
+	UserID      string     `db:"user_id"`
+	ImageURL    string     `db:"image_url"`
+	JobTitle    string     `db:"job_title"`
+	JobLocation string     `db:"job_location"`
+	SkillUsed   string     `db:"skill_used"`
+	StartDate   time.Time  `db:"start_date"`
+	EndDate     *time.Time `db:"end_date"`
+	IsCurrent   bool       `db:"is_current"`
+	Description string     `db:"description"`
+	IsHidden    bool       `db:"is_hidden"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+
+	// Skills are the catalogue entries linked from SkillUsed, read from
+	// experience_skills rather than this row.
+	Skills []Skill `db:"-"`
 }
 
 type Education struct {
-	ID                string    `db:"id"`
-	Image             string    `db:"image"`
-	UserID            string    `db:"user_id"`
-	TitleDegree       string    `db:"title_degree"`
-	InstitutionalName string    `db:"institutional_name"`
-	StartDate         string    `db:"start_date"`
-	EndDate           string    `db:"end_date"`
-	Description       string    `db:"description"`
-	CreatedAt         time.Time `db:"created_at"`
-	UpdatedAt         time.Time `db:"updated_at"`
+	ID                string     `db:"id"`
+	Image             string     `db:"image"`
+	UserID            string     `db:"user_id"`
+	TitleDegree       string     `db:"title_degree"`
+	InstitutionalName string     `db:"institutional_name"`
+	StartDate         time.Time  `db:"start_date"`
+	EndDate           *time.Time `db:"end_date"`
+	IsCurrent         bool       `db:"is_current"`
+	Description       string     `db:"description"`
+	IsHidden          bool       `db:"is_hidden"`
+	CreatedAt         time.Time  `db:"created_at"`
+	UpdatedAt         time.Time  `db:"updated_at"`
 }
 
 type Portfolio struct {
-	ID               string    `db:"id"`
-	UserID           string    `db:"user_id"`
-	Image            string    `db:"image"`
-	ProjectName      string    `db:"project_name"`
-	ProjectLocation  string    `db:"project_location"`
-	DescriptionImage string    `db:"description_image"`
-	ProjectLink      string    `db:"project_link"`
-	StartDate        string    `db:"start_date"`
-	EndDate          string    `db:"end_date"`
-	Description      string    `db:"description"`
-	CreatedAt        time.Time `db:"created_at"`
-	UpdatedAt        time.Time `db:"updated_at"`
+	ID               string     `db:"id"`
+	UserID           string     `db:"user_id"`
+	Image            string     `db:"image"`
+	ProjectName      string     `db:"project_name"`
+	ProjectLocation  string     `db:"project_location"`
+	DescriptionImage string     `db:"description_image"`
+	ProjectLink      string     `db:"project_link"`
+	StartDate        time.Time  `db:"start_date"`
+	EndDate          *time.Time `db:"end_date"`
+	IsCurrent        bool       `db:"is_current"`
+	Description      string     `db:"description"`
+	IsHidden         bool       `db:"is_hidden"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
+
+	// Skills are read from portfolio_skills rather than this row.
+	Skills []Skill `db:"-"`
+}
+
+// Certification is a certificate or licence. Dates are months like the other
+// bio entries, and a certification stays valid through its expiry month.
+type Certification struct {
+	ID            string     `db:"id"`
+	UserID        string     `db:"user_id"`
+	Name          string     `db:"name"`
+	Issuer        string     `db:"issuer"`
+	IssueDate     time.Time  `db:"issue_date"`
+	ExpiryDate    *time.Time `db:"expiry_date"`
+	CredentialID  string     `db:"credential_id"`
+	CredentialURL string     `db:"credential_url"`
+	Image         string     `db:"image"`
+	IsHidden      bool       `db:"is_hidden"`
+	// ExpiryReminderSentAt is set once the owner has been told the
+	// certification is about to expire, and cleared when the expiry changes.
+	ExpiryReminderSentAt *time.Time `db:"expiry_reminder_sent_at"`
+	CreatedAt            time.Time  `db:"created_at"`
+	UpdatedAt            time.Time  `db:"updated_at"`
 }
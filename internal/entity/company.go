@@ -17,7 +17,90 @@ type Company struct {
 	EstablishedDate time.Time  `db:"established_date"`
 	CompanyURL      string     `db:"company_url"`
 	RequiredSkill   string     `db:"required_skill"`
+	IsVerified      bool       `db:"is_verified"`
+	VerifiedAt      *time.Time `db:"verified_at"`
 	CreatedAt       time.Time  `db:"created_at"`
 	UpdatedAt       time.Time  `db:"updated_at"`
 	DeletedAt       *time.Time `db:"deleted_at"`
 }
+
+type CompanyRole string
+
+const (
+	CompanyRoleOwner     CompanyRole = "owner"
+	CompanyRoleAdmin     CompanyRole = "admin"
+	CompanyRoleRecruiter CompanyRole = "recruiter"
+	CompanyRoleViewer    CompanyRole = "viewer"
+)
+
+// CompanyMember is a person who logs in on behalf of a company. Each company
+// has exactly one owner, created together with the company.
+type CompanyMember struct {
+	ID        string      `db:"id"`
+	CompanyID string      `db:"company_id"`
+	Email     string      `db:"email"`
+	Password  string      `db:"password"`
+	Name      string      `db:"name"`
+	Role      CompanyRole `db:"role"`
+	CreatedAt time.Time   `db:"created_at"`
+	UpdatedAt time.Time   `db:"updated_at"`
+
+	CompanyName string `db:"-"`
+}
+
+// CompanyInvitation asks someone to join a company as a member. Only the
+// SHA-256 of the emailed token is stored.
+type CompanyInvitation struct {
+	ID         string      `db:"id"`
+	CompanyID  string      `db:"company_id"`
+	Email      string      `db:"email"`
+	Role       CompanyRole `db:"role"`
+	TokenHash  string      `db:"token_hash"`
+	InvitedBy  string      `db:"invited_by"`
+	ExpiresAt  time.Time   `db:"expires_at"`
+	AcceptedAt *time.Time  `db:"accepted_at"`
+	RevokedAt  *time.Time  `db:"revoked_at"`
+	CreatedAt  time.Time   `db:"created_at"`
+
+	CompanyName string `db:"-"`
+}
+
+type VerificationMethod string
+
+const (
+	VerificationMethodDNS         VerificationMethod = "dns_txt"
+	VerificationMethodEmailDomain VerificationMethod = "email_domain"
+	VerificationMethodDocument    VerificationMethod = "document"
+)
+
+type VerificationStatus string
+
+const (
+	VerificationStatusPending   VerificationStatus = "pending"
+	VerificationStatusApproved  VerificationStatus = "approved"
+	VerificationStatusRejected  VerificationStatus = "rejected"
+	VerificationStatusCancelled VerificationStatus = "cancelled"
+)
+
+// CompanyVerification is a company's request to be marked verified. Domain
+// methods prove control of Domain before an admin reviews the request;
+// document requests carry uploaded Documents instead.
+type CompanyVerification struct {
+	ID               string             `db:"id"`
+	CompanyID        string             `db:"company_id"`
+	Method           VerificationMethod `db:"method"`
+	Domain           string             `db:"domain"`
+	Token            string             `db:"token"`
+	Documents        []string           `db:"documents"`
+	Status           VerificationStatus `db:"status"`
+	DomainVerifiedAt *time.Time         `db:"domain_verified_at"`
+	SubmittedBy      string             `db:"submitted_by"`
+	ReviewedBy       string             `db:"reviewed_by"`
+	ReviewNote       string             `db:"review_note"`
+	ReviewedAt       *time.Time         `db:"reviewed_at"`
+	CreatedAt        time.Time          `db:"created_at"`
+	UpdatedAt        time.Time          `db:"updated_at"`
+
+	CompanyName  string `db:"-"`
+	CompanyEmail string `db:"-"`
+}
@@ -0,0 +1,62 @@
+package entity
+
+import "time"
+
+type ReportReason string
+
+const (
+	ReportReasonSpam          ReportReason = "spam"
+	ReportReasonHarassment    ReportReason = "harassment"
+	ReportReasonScam          ReportReason = "scam"
+	ReportReasonInappropriate ReportReason = "inappropriate"
+	ReportReasonOther         ReportReason = "other"
+)
+
+type ReportStatus string
+
+const (
+	ReportStatusOpen ReportStatus = "open"
+)
+
+// Conversation is a thread between a company and a candidate about either a
+// job application or a job invitation sent from a talent pool.
+type Conversation struct {
+	ID               string     `db:"id"`
+	CompanyID        string     `db:"company_id"`
+	CandidateID      string     `db:"candidate_id"`
+	JobApplicationID string     `db:"job_application_id"`
+	JobInvitationID  string     `db:"job_invitation_id"`
+	LastMessageAt    *time.Time `db:"last_message_at"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
+
+	// Filled in when listing conversations for a participant.
+	UnreadCount int      `db:"-"`
+	Blocked     bool     `db:"-"`
+	LastMessage *Message `db:"-"`
+	// LastRead and LastReadByOther are the read markers of the listing
+	// participant and of the other side.
+	LastRead        *time.Time `db:"-"`
+	LastReadByOther *time.Time `db:"-"`
+}
+
+type Message struct {
+	ID             string    `db:"id"`
+	ConversationID string    `db:"conversation_id"`
+	SenderID       string    `db:"sender_id"`
+	Body           string    `db:"body"`
+	AttachmentURL  string    `db:"attachment_url"`
+	AttachmentName string    `db:"attachment_name"`
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+type MessageReport struct {
+	ID             string       `db:"id"`
+	ConversationID string       `db:"conversation_id"`
+	MessageID      string       `db:"message_id"`
+	ReporterID     string       `db:"reporter_id"`
+	Reason         ReportReason `db:"reason"`
+	Details        string       `db:"details"`
+	Status         ReportStatus `db:"status"`
+	CreatedAt      time.Time    `db:"created_at"`
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// SkillEndorsement is one user vouching for a skill on another user's
+// profile. Revoking keeps the row, so endorsing again neither notifies the
+// owner twice nor escapes the endorser's daily limit.
+type SkillEndorsement struct {
+	UserID     string     `db:"user_id"`
+	SkillID    string     `db:"skill_id"`
+	EndorserID string     `db:"endorser_id"`
+	CreatedAt  time.Time  `db:"created_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+// Endorser is a user listed against a skill they endorsed.
+type Endorser struct {
+	ID             string    `db:"id"`
+	Name           string    `db:"name"`
+	Headline       string    `db:"headline"`
+	ProfilePicture string    `db:"profile_picture"`
+	EndorsedAt     time.Time `db:"endorsed_at"`
+}
+
+// SkillEndorsementSummary counts a skill's endorsements and carries the most
+// recent endorsers.
+type SkillEndorsementSummary struct {
+	SkillID   string
+	Count     int
+	Endorsers []Endorser
+}
@@ -0,0 +1,53 @@
+package entity
+
+import "time"
+
+type InterviewFormat string
+
+const (
+	InterviewFormatOnsite InterviewFormat = "onsite"
+	InterviewFormatVideo  InterviewFormat = "video"
+	InterviewFormatPhone  InterviewFormat = "phone"
+)
+
+type InterviewStatus string
+
+const (
+	InterviewStatusProposed  InterviewStatus = "proposed"
+	InterviewStatusScheduled InterviewStatus = "scheduled"
+	InterviewStatusCancelled InterviewStatus = "cancelled"
+)
+
+type Interview struct {
+	ID               string          `db:"id"`
+	JobApplicationID string          `db:"job_application_id"`
+	CompanyID        string          `db:"company_id"`
+	Title            string          `db:"title"`
+	Format           InterviewFormat `db:"format"`
+	Location         string          `db:"location"`
+	MeetingLink      string          `db:"meeting_link"`
+	Timezone         string          `db:"timezone"`
+	DurationMinutes  int             `db:"duration_minutes"`
+	Status           InterviewStatus `db:"status"`
+	ScheduledStart   *time.Time      `db:"scheduled_start"`
+	ScheduledEnd     *time.Time      `db:"scheduled_end"`
+	Sequence         int             `db:"sequence"`
+	CreatedAt        time.Time       `db:"created_at"`
+	UpdatedAt        time.Time       `db:"updated_at"`
+
+	Slots        []InterviewSlot `db:"-"`
+	Interviewers []Interviewer   `db:"-"`
+}
+
+type InterviewSlot struct {
+	ID          string    `db:"id"`
+	InterviewID string    `db:"interview_id"`
+	StartTime   time.Time `db:"start_time"`
+	EndTime     time.Time `db:"end_time"`
+}
+
+type Interviewer struct {
+	InterviewID string `db:"interview_id"`
+	Name        string `db:"name"`
+	Email       string `db:"email"`
+}
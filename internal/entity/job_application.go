@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+type ApplicationStatus string
+
+const (
+	ApplicationStatusApplied   ApplicationStatus = "applied"
+	ApplicationStatusReviewing ApplicationStatus = "reviewing"
+	ApplicationStatusInterview ApplicationStatus = "interview"
+	ApplicationStatusOffer     ApplicationStatus = "offer"
+	ApplicationStatusHired     ApplicationStatus = "hired"
+	ApplicationStatusRejected  ApplicationStatus = "rejected"
+)
+
+type JobApplication struct {
+	ID           string            `db:"id"`
+	JobVacancyID string            `db:"job_vacancy_id"`
+	UserID       string            `db:"user_id"`
+	Status       ApplicationStatus `db:"status"`
+	CoverLetter  string            `db:"cover_letter"`
+	// ScreeningFlagged marks applications that failed a knockout question
+	// configured to flag rather than reject.
+	ScreeningFlagged bool      `db:"screening_flagged"`
+	CreatedAt        time.Time `db:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at"`
+	// StatusUpdatedBy is the company member who last set Status.
+	StatusUpdatedBy string `db:"status_updated_by"`
+}
@@ -3,15 +3,27 @@ package entity
 import "time"
 
 type JobVacancy struct {
-	ID           string
-	RecruiterID  string
-	Title        string
-	Description  string
-	Requirements string
-	Location     string
-	JobType      string
-	Deadline     time.Time
-	IsActive     bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID           string    `db:"id"`
+	RecruiterID  string    `db:"recruiter_id"`
+	Title        string    `db:"title"`
+	Description  string    `db:"description"`
+	Requirements string    `db:"requirements"`
+	Location     string    `db:"location"`
+	JobType      string    `db:"job_type"`
+	Deadline     time.Time `db:"deadline"`
+	IsActive     bool      `db:"is_active"`
+	Headcount    int       `db:"headcount"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+	// CreatedBy and UpdatedBy are the company members who made the change,
+	// empty for API keys.
+	CreatedBy string `db:"created_by"`
+	UpdatedBy string `db:"updated_by"`
+
+	// CompanyVerified is read from the owning company, never written.
+	CompanyVerified bool `db:"-"`
+	// Skills are the required skills, loaded from vacancy_skills.
+	Skills []Skill `db:"-"`
+	// Languages are the language requirements, loaded from vacancy_languages.
+	Languages []LanguageRequirement `db:"-"`
 }
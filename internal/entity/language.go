@@ -0,0 +1,46 @@
+package entity
+
+import "time"
+
+// LanguageLevel is a CEFR proficiency level, with native speakers above C2.
+type LanguageLevel string
+
+const (
+	LanguageLevelA1     LanguageLevel = "A1"
+	LanguageLevelA2     LanguageLevel = "A2"
+	LanguageLevelB1     LanguageLevel = "B1"
+	LanguageLevelB2     LanguageLevel = "B2"
+	LanguageLevelC1     LanguageLevel = "C1"
+	LanguageLevelC2     LanguageLevel = "C2"
+	LanguageLevelNative LanguageLevel = "NATIVE"
+)
+
+// LanguageLevels lists every level from lowest to highest.
+var LanguageLevels = []LanguageLevel{
+	LanguageLevelA1,
+	LanguageLevelA2,
+	LanguageLevelB1,
+	LanguageLevelB2,
+	LanguageLevelC1,
+	LanguageLevelC2,
+	LanguageLevelNative,
+}
+
+// UserLanguage is a language on a candidate's profile. Language is an ISO 639
+// code, and the test fields optionally back the level with a score such as
+// IELTS 7.5.
+type UserLanguage struct {
+	UserID    string        `db:"user_id"`
+	Language  string        `db:"language"`
+	Level     LanguageLevel `db:"level"`
+	TestName  string        `db:"test_name"`
+	TestScore string        `db:"test_score"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt time.Time     `db:"updated_at"`
+}
+
+// LanguageRequirement asks for a language at MinLevel or above.
+type LanguageRequirement struct {
+	Language string        `db:"language"`
+	MinLevel LanguageLevel `db:"min_level"`
+}
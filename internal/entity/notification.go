@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+type NotificationType string
+
+const (
+	NotificationApplicationStatus NotificationType = "APPLICATION_STATUS"
+	NotificationMessage           NotificationType = "MESSAGE"
+	NotificationInterview         NotificationType = "INTERVIEW"
+	NotificationJobInvitation     NotificationType = "JOB_INVITATION"
+	NotificationOffer             NotificationType = "OFFER"
+	NotificationJobAlert          NotificationType = "JOB_ALERT"
+	NotificationCertification     NotificationType = "CERTIFICATION"
+	NotificationEndorsement       NotificationType = "ENDORSEMENT"
+	NotificationVerification      NotificationType = "COMPANY_VERIFICATION"
+	// NotificationMemberInvitation goes to people without an account yet, so
+	// it is email only and has no preference.
+	NotificationMemberInvitation NotificationType = "MEMBER_INVITATION"
+)
+
+// NotificationTypes lists every type a recipient can set preferences for.
+var NotificationTypes = []NotificationType{
+	NotificationApplicationStatus,
+	NotificationMessage,
+	NotificationInterview,
+	NotificationJobInvitation,
+	NotificationOffer,
+	NotificationJobAlert,
+	NotificationCertification,
+	NotificationEndorsement,
+	NotificationVerification,
+}
+
+// Notification is an in-app notification. RecipientID is a user or a company
+// ID, and Data carries the IDs clients need to link to the subject.
+type Notification struct {
+	ID          string            `db:"id"`
+	RecipientID string            `db:"recipient_id"`
+	Type        NotificationType  `db:"type"`
+	Title       string            `db:"title"`
+	Body        string            `db:"body"`
+	Data        map[string]string `db:"data"`
+	ReadAt      *time.Time        `db:"read_at"`
+	CreatedAt   time.Time         `db:"created_at"`
+}
+
+// NotificationPreference picks the channels for one type. Turning both off
+// silences the type; recipients without a stored row get both.
+type NotificationPreference struct {
+	RecipientID string           `db:"recipient_id"`
+	Type        NotificationType `db:"type"`
+	Email       bool             `db:"email"`
+	InApp       bool             `db:"in_app"`
+	UpdatedAt   time.Time        `db:"updated_at"`
+}
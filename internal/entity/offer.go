@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+type OfferStatus string
+
+const (
+	OfferStatusSent      OfferStatus = "sent"
+	OfferStatusAccepted  OfferStatus = "accepted"
+	OfferStatusDeclined  OfferStatus = "declined"
+	OfferStatusWithdrawn OfferStatus = "withdrawn"
+	OfferStatusExpired   OfferStatus = "expired"
+)
+
+type SalaryPeriod string
+
+const (
+	SalaryPeriodHourly  SalaryPeriod = "hourly"
+	SalaryPeriodMonthly SalaryPeriod = "monthly"
+	SalaryPeriodYearly  SalaryPeriod = "yearly"
+)
+
+// Offer stays sent past ExpiresAt until the scheduler marks it expired, so a
+// sent offer past ExpiresAt is reported as expired when read.
+type Offer struct {
+	ID               string       `db:"id"`
+	JobApplicationID string       `db:"job_application_id"`
+	CompanyID        string       `db:"company_id"`
+	SalaryAmount     int64        `db:"salary_amount"`
+	SalaryCurrency   string       `db:"salary_currency"`
+	SalaryPeriod     SalaryPeriod `db:"salary_period"`
+	StartDate        time.Time    `db:"start_date"`
+	ExpiresAt        time.Time    `db:"expires_at"`
+	Terms            string       `db:"terms"`
+	Status           OfferStatus  `db:"status"`
+	AccessToken      string       `db:"access_token"`
+	RespondedAt      *time.Time   `db:"responded_at"`
+	CreatedAt        time.Time    `db:"created_at"`
+	UpdatedAt        time.Time    `db:"updated_at"`
+
+	UserID       string `db:"-"`
+	JobVacancyID string `db:"-"`
+	JobTitle     string `db:"-"`
+	CompanyName  string `db:"-"`
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+type ProfileOwnerType string
+
+const (
+	ProfileOwnerUser    ProfileOwnerType = "user"
+	ProfileOwnerCompany ProfileOwnerType = "company"
+)
+
+// ProfileSlug is a vanity name for a candidate or company profile. Retired
+// slugs stay with their owner so old links keep redirecting to the current one.
+type ProfileSlug struct {
+	Slug      string           `db:"slug"`
+	OwnerType ProfileOwnerType `db:"owner_type"`
+	OwnerID   string           `db:"owner_id"`
+	IsCurrent bool             `db:"is_current"`
+	ClaimedAt time.Time        `db:"claimed_at"`
+	RetiredAt *time.Time       `db:"retired_at"`
+	CreatedAt time.Time        `db:"created_at"`
+}
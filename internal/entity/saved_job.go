@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+type SavedSearchFrequency string
+
+const (
+	SavedSearchFrequencyDaily  SavedSearchFrequency = "DAILY"
+	SavedSearchFrequencyWeekly SavedSearchFrequency = "WEEKLY"
+)
+
+type SavedJob struct {
+	UserID       string    `db:"user_id"`
+	JobVacancyID string    `db:"job_vacancy_id"`
+	CreatedAt    time.Time `db:"created_at"`
+
+	JobVacancy JobVacancy `db:"-"`
+}
+
+type SavedSearch struct {
+	ID               string               `db:"id"`
+	UserID           string               `db:"user_id"`
+	Name             string               `db:"name"`
+	Query            string               `db:"query"`
+	Location         string               `db:"location"`
+	JobType          string               `db:"job_type"`
+	Frequency        SavedSearchFrequency `db:"frequency"`
+	UnsubscribeToken string               `db:"unsubscribe_token"`
+	IsActive         bool                 `db:"is_active"`
+	LastNotifiedAt   *time.Time           `db:"last_notified_at"`
+	CreatedAt        time.Time            `db:"created_at"`
+	UpdatedAt        time.Time            `db:"updated_at"`
+}
@@ -0,0 +1,53 @@
+package entity
+
+import "time"
+
+type ScorecardRecommendation string
+
+const (
+	RecommendationStrongNo  ScorecardRecommendation = "STRONG_NO"
+	RecommendationNo        ScorecardRecommendation = "NO"
+	RecommendationYes       ScorecardRecommendation = "YES"
+	RecommendationStrongYes ScorecardRecommendation = "STRONG_YES"
+)
+
+type ScorecardTemplate struct {
+	ID           string    `db:"id"`
+	JobVacancyID string    `db:"job_vacancy_id"`
+	CompanyID    string    `db:"company_id"`
+	Name         string    `db:"name"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+
+	Competencies []ScorecardCompetency `db:"-"`
+}
+
+type ScorecardCompetency struct {
+	ID          string   `db:"id"`
+	TemplateID  string   `db:"template_id"`
+	Name        string   `db:"name"`
+	Description string   `db:"description"`
+	ScaleMax    int      `db:"scale_max"`
+	ScaleLabels []string `db:"scale_labels"`
+	Position    int      `db:"position"`
+}
+
+type Scorecard struct {
+	ID               string                  `db:"id"`
+	JobApplicationID string                  `db:"job_application_id"`
+	TemplateID       string                  `db:"template_id"`
+	InterviewerID    string                  `db:"interviewer_id"`
+	Recommendation   ScorecardRecommendation `db:"recommendation"`
+	Notes            string                  `db:"notes"`
+	SubmittedAt      time.Time               `db:"submitted_at"`
+	UpdatedAt        time.Time               `db:"updated_at"`
+
+	Ratings []ScorecardRating `db:"-"`
+}
+
+type ScorecardRating struct {
+	ScorecardID  string `db:"scorecard_id"`
+	CompetencyID string `db:"competency_id"`
+	Rating       int    `db:"rating"`
+	Comment      string `db:"comment"`
+}
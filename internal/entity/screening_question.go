@@ -0,0 +1,52 @@
+package entity
+
+import "time"
+
+type ScreeningQuestionType string
+
+const (
+	ScreeningQuestionYesNo          ScreeningQuestionType = "YES_NO"
+	ScreeningQuestionSingleChoice   ScreeningQuestionType = "SINGLE_CHOICE"
+	ScreeningQuestionMultipleChoice ScreeningQuestionType = "MULTIPLE_CHOICE"
+	ScreeningQuestionNumber         ScreeningQuestionType = "NUMBER"
+	ScreeningQuestionText           ScreeningQuestionType = "TEXT"
+	ScreeningQuestionFile           ScreeningQuestionType = "FILE"
+)
+
+type KnockoutAction string
+
+const (
+	KnockoutActionReject KnockoutAction = "REJECT"
+	KnockoutActionFlag   KnockoutAction = "FLAG"
+)
+
+// ScreeningQuestion knocks an applicant out when they pick one of
+// KnockoutAnswers, or for number questions when the answer falls outside
+// KnockoutMin/KnockoutMax. KnockoutAction is empty when there are no criteria.
+type ScreeningQuestion struct {
+	ID              string                `db:"id"`
+	JobVacancyID    string                `db:"job_vacancy_id"`
+	Prompt          string                `db:"prompt"`
+	Type            ScreeningQuestionType `db:"type"`
+	Options         []string              `db:"options"`
+	Required        bool                  `db:"required"`
+	KnockoutAnswers []string              `db:"knockout_answers"`
+	KnockoutMin     *float64              `db:"knockout_min"`
+	KnockoutMax     *float64              `db:"knockout_max"`
+	KnockoutAction  KnockoutAction        `db:"knockout_action"`
+	Position        int                   `db:"position"`
+	CreatedAt       time.Time             `db:"created_at"`
+}
+
+// ScreeningAnswer keeps choice and text answers in Values, number answers in
+// Number and uploaded files in FileURL.
+type ScreeningAnswer struct {
+	ID               string    `db:"id"`
+	JobApplicationID string    `db:"job_application_id"`
+	QuestionID       string    `db:"question_id"`
+	Values           []string  `db:"answer_values"`
+	Number           *float64  `db:"answer_number"`
+	FileURL          string    `db:"file_url"`
+	KnockedOut       bool      `db:"knocked_out"`
+	CreatedAt        time.Time `db:"created_at"`
+}
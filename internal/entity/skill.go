@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+type SkillCategory string
+
+const (
+	SkillCategoryProgramming SkillCategory = "programming"
+	SkillCategoryFramework   SkillCategory = "framework"
+	SkillCategoryDatabase    SkillCategory = "database"
+	SkillCategoryCloud       SkillCategory = "cloud"
+	SkillCategoryDevOps      SkillCategory = "devops"
+	SkillCategoryData        SkillCategory = "data"
+	SkillCategoryDesign      SkillCategory = "design"
+	SkillCategoryManagement  SkillCategory = "management"
+	SkillCategorySoftSkill   SkillCategory = "soft_skill"
+)
+
+// Skill is a catalogue entry. NormalizedName is the lower-cased name that
+// free-text input is matched on; aliases map other spellings onto it.
+// Skills added from user input have no category until one is assigned.
+type Skill struct {
+	ID             string        `db:"id"`
+	Name           string        `db:"name"`
+	NormalizedName string        `db:"normalized_name"`
+	Category       SkillCategory `db:"category"`
+	CreatedAt      time.Time     `db:"created_at"`
+}
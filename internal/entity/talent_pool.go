@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+)
+
+type TalentPool struct {
+	ID          string    `db:"id"`
+	CompanyID   string    `db:"company_id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+
+	CandidateCount int `db:"-"`
+}
+
+type TalentPoolCandidate struct {
+	TalentPoolID string    `db:"talent_pool_id"`
+	UserID       string    `db:"user_id"`
+	Tags         []string  `db:"tags"`
+	Note         string    `db:"note"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+
+	User User `db:"-"`
+}
+
+type JobInvitation struct {
+	ID           string           `db:"id"`
+	JobVacancyID string           `db:"job_vacancy_id"`
+	UserID       string           `db:"user_id"`
+	CompanyID    string           `db:"company_id"`
+	TalentPoolID string           `db:"talent_pool_id"`
+	Message      string           `db:"message"`
+	Status       InvitationStatus `db:"status"`
+	CreatedAt    time.Time        `db:"created_at"`
+	UpdatedAt    time.Time        `db:"updated_at"`
+
+	JobVacancy JobVacancy `db:"-"`
+}
@@ -12,22 +12,39 @@ const (
 	RoleCandidate UserRole = "candidate"
 )
 
+// ProfileVisibility decides who besides the owner can read a candidate's
+// profile and bio.
+type ProfileVisibility string
+
+const (
+	ProfileVisibilityPublic     ProfileVisibility = "public"
+	ProfileVisibilityLoggedIn   ProfileVisibility = "logged_in"
+	ProfileVisibilityRecruiters ProfileVisibility = "recruiters"
+	ProfileVisibilityPrivate    ProfileVisibility = "private"
+)
+
 type User struct {
-	ID             string     `db:"id"`
-	Email          string     `db:"email"`
-	Password       string     `db:"password"`
-	Name           string     `db:"name"`
-	Role           UserRole   `db:"role"`
-	ProfilePicture string     `db:"profile_picture"`
-	BannerPicture  string     `db:"banner_picture"`
-	PhoneNumber    string     `db:"phone_number"`
-	IsPremium      bool       `db:"is_premium"`
-	PremiumUntil   time.Time  `db:"premium_until"`
-	Location       string     `db:"location"`
-	Headline       string     `db:"headline"`
-	CreatedAt      time.Time  `db:"created_at"`
-	UpdatedAt      time.Time  `db:"updated_at"`
-	DeletedAt      *time.Time `db:"deleted_at"`
+	ID             string    `db:"id"`
+	Email          string    `db:"email"`
+	Password       string    `db:"password"`
+	Name           string    `db:"name"`
+	Role           UserRole  `db:"role"`
+	ProfilePicture string    `db:"profile_picture"`
+	BannerPicture  string    `db:"banner_picture"`
+	PhoneNumber    string    `db:"phone_number"`
+	IsPremium      bool      `db:"is_premium"`
+	PremiumUntil   time.Time `db:"premium_until"`
+	Location       string    `db:"location"`
+	Headline       string    `db:"headline"`
+	IsSearchable   bool      `db:"is_searchable"`
+
+	ProfileVisibility ProfileVisibility `db:"profile_visibility"`
+	HideEmail         bool              `db:"hide_email"`
+	HidePhoneNumber   bool              `db:"hide_phone_number"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
 }
 
 type UserLoginData struct {
@@ -36,4 +53,11 @@ type UserLoginData struct {
 	Email     string
 	Role      UserRole
 	IsPremium bool
+
+	// For recruiters ID is the company's ID, so company-scoped code works off
+	// it directly. MemberID and CompanyRole name the member acting for the
+	// company; for API keys that is the key's creator and the key's role.
+	CompanyID   string
+	MemberID    string
+	CompanyRole CompanyRole
 }
@@ -0,0 +1,65 @@
+package entity
+
+import "time"
+
+type WebhookEventType string
+
+const (
+	WebhookApplicationCreated       WebhookEventType = "application.created"
+	WebhookApplicationStatusChanged WebhookEventType = "application.status_changed"
+	WebhookVacancyClosed            WebhookEventType = "vacancy.closed"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookEndpoint receives the company's events listed in Events. Secret
+// signs every payload, so it is kept as is rather than hashed.
+type WebhookEndpoint struct {
+	ID          string    `db:"id"`
+	CompanyID   string    `db:"company_id"`
+	URL         string    `db:"url"`
+	Description string    `db:"description"`
+	Secret      string    `db:"secret"`
+	Events      []string  `db:"events"`
+	IsActive    bool      `db:"is_active"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// WebhookDelivery is one event queued for one endpoint. Payload is the exact
+// body that gets signed and sent; redeliveries copy it under a new ID but
+// keep the EventID so receivers can deduplicate.
+type WebhookDelivery struct {
+	ID             string                `db:"id"`
+	EndpointID     string                `db:"endpoint_id"`
+	EventID        string                `db:"event_id"`
+	EventType      WebhookEventType      `db:"event_type"`
+	Payload        string                `db:"payload"`
+	Status         WebhookDeliveryStatus `db:"status"`
+	Attempts       int                   `db:"attempts"`
+	NextAttemptAt  *time.Time            `db:"next_attempt_at"`
+	LastStatusCode int                   `db:"last_status_code"`
+	LastError      string                `db:"last_error"`
+	DeliveredAt    *time.Time            `db:"delivered_at"`
+	CreatedAt      time.Time             `db:"created_at"`
+	UpdatedAt      time.Time             `db:"updated_at"`
+
+	// Filled in when claiming deliveries for sending.
+	URL    string `db:"-"`
+	Secret string `db:"-"`
+}
+
+type WebhookDeliveryAttempt struct {
+	ID          string    `db:"id"`
+	DeliveryID  string    `db:"delivery_id"`
+	StatusCode  int       `db:"status_code"`
+	Error       string    `db:"error"`
+	DurationMs  int       `db:"duration_ms"`
+	AttemptedAt time.Time `db:"attempted_at"`
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	APIKeyHeader = "X-API-Key"
+	APIKeyIDKey  = "api_key_id"
+)
+
+// APIKeyVerifier resolves the raw key a request presented. Unknown, revoked
+// and expired keys come back as a zero APIKey.
+type APIKeyVerifier interface {
+	VerifyAPIKey(c context.Context, key string) (entity.APIKey, error)
+}
+
+// NewAPIKeyOrTokenMiddleware authenticates a company API key sent in the
+// X-API-Key header and requires it to hold scope. Requests without the header
+// are authenticated like NewTokenMiddleware, whatever the scope. An API key
+// acts as the member who created it, with the key's role.
+func (m *middleware) NewAPIKeyOrTokenMiddleware(scope entity.APIKeyScope) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		raw := ctx.Get(APIKeyHeader)
+		if raw == "" {
+			return m.NewTokenMiddleware(ctx)
+		}
+
+		requestID := ctx.Locals("request_id")
+		c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+		defer cancel()
+
+		key, err := m.apiKeys.VerifyAPIKey(c, raw)
+		if err != nil {
+			m.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("API key verification failed")
+			return ctx.Status(fiber.StatusInternalServerError).SendString("failed to verify api key")
+		}
+
+		if key.ID == "" {
+			m.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+			}).Warn("Invalid API key")
+			return ctx.Status(fiber.StatusUnauthorized).SendString("unauthorized, api key invalid, revoked or expired")
+		}
+
+		if !hasScope(key, scope) {
+			m.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"api_key_id": key.ID,
+				"scope":      scope,
+			}).Warn("API key lacks required scope")
+			return ctx.Status(fiber.StatusForbidden).SendString("forbidden, api key lacks scope " + string(scope))
+		}
+
+		ctx.Locals("user", entity.UserLoginData{
+			ID:          key.CompanyID,
+			Name:        key.CompanyName,
+			Email:       key.CompanyEmail,
+			Role:        entity.RoleRecruiter,
+			CompanyID:   key.CompanyID,
+			MemberID:    key.MemberID,
+			CompanyRole: apiKeyRole(key),
+		})
+		ctx.Locals(APIKeyIDKey, key.ID)
+
+		m.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"api_key_id": key.ID,
+			"company_id": key.CompanyID,
+			"member_id":  key.MemberID,
+		}).Info("API key authentication successful")
+
+		return ctx.Next()
+	}
+}
+
+// apiKeyRole is the key's role, lowered to viewer once the member who created
+// the key is only a viewer.
+func apiKeyRole(key entity.APIKey) entity.CompanyRole {
+	if key.MemberRole == entity.CompanyRoleViewer {
+		return entity.CompanyRoleViewer
+	}
+	return key.Role
+}
+
+func hasScope(key entity.APIKey, scope entity.APIKeyScope) bool {
+	for _, s := range key.Scopes {
+		if s == string(scope) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"ProjectGolang/internal/entity"
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http/httptest"
+	"testing"
+)
+
+type stubAPIKeyVerifier map[string]entity.APIKey
+
+func (s stubAPIKeyVerifier) VerifyAPIKey(_ context.Context, key string) (entity.APIKey, error) {
+	return s[key], nil
+}
+
+func TestNewAPIKeyOrTokenMiddleware(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	m := &middleware{
+		apiKeys: stubAPIKeyVerifier{
+			"pgk_read.secret": {
+				ID:        "key-1",
+				CompanyID: "company-1",
+				MemberID:  "member-1",
+				Role:      entity.CompanyRoleRecruiter,
+				Scopes:    []string{string(entity.ScopeVacanciesRead)},
+			},
+		},
+		log: logger,
+	}
+
+	app := fiber.New()
+	app.Get("/", m.NewAPIKeyOrTokenMiddleware(entity.ScopeVacanciesRead), func(ctx *fiber.Ctx) error {
+		user := ctx.Locals("user").(entity.UserLoginData)
+		return ctx.SendString(user.CompanyID + "/" + user.MemberID + "/" + string(user.CompanyRole))
+	})
+	app.Post("/", m.NewAPIKeyOrTokenMiddleware(entity.ScopeVacanciesWrite), func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(fiber.StatusNoContent)
+	})
+
+	tests := []struct {
+		name     string
+		method   string
+		key      string
+		want     int
+		wantBody string
+	}{
+		{name: "key with the scope", method: fiber.MethodGet, key: "pgk_read.secret", want: fiber.StatusOK, wantBody: "company-1/member-1/recruiter"},
+		{name: "key without the scope", method: fiber.MethodPost, key: "pgk_read.secret", want: fiber.StatusForbidden},
+		{name: "unknown key", method: fiber.MethodGet, key: "pgk_other.secret", want: fiber.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/", nil)
+			req.Header.Set(APIKeyHeader, tt.key)
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.want)
+			}
+			if tt.wantBody != "" {
+				body, _ := io.ReadAll(resp.Body)
+				if string(body) != tt.wantBody {
+					t.Errorf("body = %q, want %q", body, tt.wantBody)
+				}
+			}
+		})
+	}
+}
+
+func TestHasScope(t *testing.T) {
+	key := entity.APIKey{Scopes: []string{string(entity.ScopeVacanciesRead), string(entity.ScopeApplicationsRead)}}
+
+	tests := []struct {
+		scope entity.APIKeyScope
+		want  bool
+	}{
+		{entity.ScopeVacanciesRead, true},
+		{entity.ScopeApplicationsRead, true},
+		{entity.ScopeVacanciesWrite, false},
+		{entity.ScopeApplicationsWrite, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.scope), func(t *testing.T) {
+			if got := hasScope(key, tt.scope); got != tt.want {
+				t.Errorf("hasScope(%s) = %v, want %v", tt.scope, got, tt.want)
+			}
+		})
+	}
+
+	if hasScope(entity.APIKey{}, entity.ScopeVacanciesRead) {
+		t.Error("hasScope() = true for a key without scopes")
+	}
+}
+
+func TestAPIKeyRole(t *testing.T) {
+	tests := []struct {
+		name string
+		key  entity.APIKey
+		want entity.CompanyRole
+	}{
+		{
+			name: "key role when the creator outranks it",
+			key:  entity.APIKey{Role: entity.CompanyRoleViewer, MemberRole: entity.CompanyRoleAdmin},
+			want: entity.CompanyRoleViewer,
+		},
+		{
+			name: "key role for a recruiter creator",
+			key:  entity.APIKey{Role: entity.CompanyRoleRecruiter, MemberRole: entity.CompanyRoleRecruiter},
+			want: entity.CompanyRoleRecruiter,
+		},
+		{
+			name: "lowered to viewer with the creator",
+			key:  entity.APIKey{Role: entity.CompanyRoleRecruiter, MemberRole: entity.CompanyRoleViewer},
+			want: entity.CompanyRoleViewer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := apiKeyRole(tt.key); got != tt.want {
+				t.Errorf("apiKeyRole() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
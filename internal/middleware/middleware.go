@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"ProjectGolang/internal/entity"
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
 )
@@ -8,6 +9,9 @@ import (
 type Middleware interface {
 	NewRateLimiter(ctx *fiber.Ctx) error
 	NewTokenMiddleware(ctx *fiber.Ctx) error
+	NewOptionalTokenMiddleware(ctx *fiber.Ctx) error
+	NewEventStreamTokenMiddleware(ctx *fiber.Ctx) error
+	NewAPIKeyOrTokenMiddleware(scope entity.APIKeyScope) fiber.Handler
 	NewRequestIDMiddleware() fiber.Handler
 	GetRequestID(ctx *fiber.Ctx) string
 }
@@ -17,10 +21,13 @@ type middleware struct {
 	rateLimitter        *rateLimiter
 	loggingMiddleware   *loggingMiddleware
 	requestIDMiddleware fiber.Handler
+	apiKeys             APIKeyVerifier
+	companyOwners       CompanyOwnerResolver
+	streamTickets       StreamTicketRedeemer
 	log                 *logrus.Logger
 }
 
-func New(logger *logrus.Logger) Middleware {
+func New(logger *logrus.Logger, apiKeys APIKeyVerifier, companyOwners CompanyOwnerResolver, streamTickets StreamTicketRedeemer) Middleware {
 	rateLimit := newRateLimiter(50, 100)
 	token := newTokenMiddleware()
 	logging := newLoggingMiddleware(logger)
@@ -31,6 +38,9 @@ func New(logger *logrus.Logger) Middleware {
 		rateLimitter:        rateLimit,
 		loggingMiddleware:   logging,
 		requestIDMiddleware: requestID,
+		apiKeys:             apiKeys,
+		companyOwners:       companyOwners,
+		streamTickets:       streamTickets,
 		log:                 logger,
 	}
 }
@@ -2,16 +2,23 @@ package middleware
 
 import (
 	"ProjectGolang/internal/entity"
+	contextPkg "ProjectGolang/pkg/context"
 	jwtPkg "ProjectGolang/pkg/jwt"
+	"ProjectGolang/pkg/realtime"
+	"context"
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/sirupsen/logrus"
 	"reflect"
 	"strings"
+	"time"
 )
 
 const (
 	AccessTokenSecret = "JWT_ACCESS_TOKEN_SECRET"
+	// TokenExpiresAtKey holds when the request's access token expires, for
+	// long-lived connections that must end with it.
+	TokenExpiresAtKey = "token_expires_at"
 )
 
 type tokenMiddleware struct {
@@ -83,7 +90,36 @@ func (m *middleware) NewTokenMiddleware(ctx *fiber.Ctx) error {
 		Role:      entity.UserRole(claims["role"].(string)),
 		IsPremium: claims["is_premium"].(bool),
 	}
+	// Only recruiter tokens carry the company member claims.
+	if companyID, ok := claims["company_id"].(string); ok {
+		user.CompanyID = companyID
+	}
+	if memberID, ok := claims["member_id"].(string); ok {
+		user.MemberID = memberID
+	}
+	if companyRole, ok := claims["company_role"].(string); ok {
+		user.CompanyRole = entity.CompanyRole(companyRole)
+	}
+	if user.Role == entity.RoleRecruiter && user.CompanyRole == "" {
+		if err := m.resolveCompanyOwner(ctx, &user); err != nil {
+			m.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"error":      err.Error(),
+			}).Error("Failed to resolve company owner for token")
+			return ctx.Status(fiber.StatusInternalServerError).SendString("failed to verify access token")
+		}
+		if user.MemberID == "" {
+			m.log.WithFields(logrus.Fields{
+				"request_id": requestID,
+				"company_id": user.ID,
+			}).Warn("Token company has no owner member")
+			return ctx.Status(fiber.StatusUnauthorized).SendString("unauthorized, access token invalid or expired")
+		}
+	}
 	ctx.Locals("user", user)
+	if expiresAt, err := claims.GetExpirationTime(); err == nil && expiresAt != nil {
+		ctx.Locals(TokenExpiresAtKey, expiresAt.Time)
+	}
 
 	m.log.WithFields(logrus.Fields{
 		"request_id": requestID,
@@ -92,3 +128,81 @@ func (m *middleware) NewTokenMiddleware(ctx *fiber.Ctx) error {
 
 	return ctx.Next()
 }
+
+// CompanyOwnerResolver finds a company's owner member. A zero member means the
+// company has none.
+type CompanyOwnerResolver interface {
+	GetCompanyOwner(c context.Context, companyID string) (entity.CompanyMember, error)
+}
+
+// resolveCompanyOwner fills in the member claims of recruiter tokens issued
+// before company members existed. Those tokens were company logins, which
+// became the owner member, so they keep working until they expire.
+func (m *middleware) resolveCompanyOwner(ctx *fiber.Ctx, user *entity.UserLoginData) error {
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	owner, err := m.companyOwners.GetCompanyOwner(c, user.ID)
+	if err != nil || owner.ID == "" {
+		return err
+	}
+
+	user.CompanyID = owner.CompanyID
+	user.MemberID = owner.ID
+	user.CompanyRole = owner.Role
+	return nil
+}
+
+// NewOptionalTokenMiddleware lets anonymous requests through on public routes
+// whose response depends on who is asking. A token that is sent must still be
+// valid.
+func (m *middleware) NewOptionalTokenMiddleware(ctx *fiber.Ctx) error {
+	if ctx.Get("Authorization") == "" {
+		return ctx.Next()
+	}
+
+	return m.NewTokenMiddleware(ctx)
+}
+
+// StreamTicketRedeemer consumes the single-use tickets event streams are
+// opened with. A zero ticket means it was unknown, expired or already used.
+type StreamTicketRedeemer interface {
+	RedeemTicket(c context.Context, ticket string) (realtime.Ticket, error)
+}
+
+// NewEventStreamTokenMiddleware authenticates like NewTokenMiddleware, or with
+// a stream ticket in the ticket query parameter, since browsers cannot set
+// headers on EventSource connections. Tickets keep access tokens out of URLs
+// and access logs.
+func (m *middleware) NewEventStreamTokenMiddleware(ctx *fiber.Ctx) error {
+	requestID := ctx.Locals("request_id")
+
+	code := ctx.Query("ticket")
+	if ctx.Get("Authorization") != "" || code == "" {
+		return m.NewTokenMiddleware(ctx)
+	}
+
+	c, cancel := context.WithTimeout(contextPkg.FromFiberCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	ticket, err := m.streamTickets.RedeemTicket(c, code)
+	if err != nil {
+		m.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("Failed to redeem stream ticket")
+		return ctx.Status(fiber.StatusInternalServerError).SendString("failed to verify stream ticket")
+	}
+
+	if ticket.User.ID == "" || !ticket.ExpiresAt.After(time.Now()) {
+		m.log.WithFields(logrus.Fields{
+			"request_id": requestID,
+		}).Warn("Stream ticket invalid or expired")
+		return ctx.Status(fiber.StatusUnauthorized).SendString("unauthorized, stream ticket invalid or expired")
+	}
+
+	ctx.Locals("user", ticket.User)
+	ctx.Locals(TokenExpiresAtKey, ticket.ExpiresAt)
+
+	return ctx.Next()
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/realtime"
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type stubStreamTickets map[string]realtime.Ticket
+
+func (s stubStreamTickets) RedeemTicket(_ context.Context, ticket string) (realtime.Ticket, error) {
+	redeemed := s[ticket]
+	delete(s, ticket)
+	return redeemed, nil
+}
+
+func TestNewEventStreamTokenMiddlewareTickets(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	user := entity.UserLoginData{ID: "user-1", Role: entity.RoleCandidate}
+	m := &middleware{
+		streamTickets: stubStreamTickets{
+			"valid":   {User: user, ExpiresAt: time.Now().Add(time.Hour)},
+			"expired": {User: user, ExpiresAt: time.Now().Add(-time.Second)},
+		},
+		log: logger,
+	}
+
+	app := fiber.New()
+	app.Get("/stream", m.NewEventStreamTokenMiddleware, func(ctx *fiber.Ctx) error {
+		if _, ok := ctx.Locals(TokenExpiresAtKey).(time.Time); !ok {
+			return ctx.SendStatus(fiber.StatusInternalServerError)
+		}
+		return ctx.SendString(ctx.Locals("user").(entity.UserLoginData).ID)
+	})
+
+	tests := []struct {
+		name   string
+		ticket string
+		want   int
+	}{
+		{name: "valid ticket", ticket: "valid", want: fiber.StatusOK},
+		{name: "ticket is single use", ticket: "valid", want: fiber.StatusUnauthorized},
+		{name: "access token already expired", ticket: "expired", want: fiber.StatusUnauthorized},
+		{name: "unknown ticket", ticket: "unknown", want: fiber.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/stream?ticket="+tt.ticket, nil))
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Method string
+
+const (
+	MethodRequest Method = "REQUEST"
+	MethodCancel  Method = "CANCEL"
+)
+
+type Attendee struct {
+	Name  string
+	Email string
+}
+
+// Event is a single VEVENT. UID must stay stable across updates; Sequence has
+// to grow with every change so calendar clients replace the previous copy.
+type Event struct {
+	UID         string
+	Sequence    int
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Organizer   Attendee
+	Attendees   []Attendee
+}
+
+const dateTimeFormat = "20060102T150405Z"
+
+// Build renders the event as an RFC 5545 calendar object for the given method.
+func Build(method Method, event Event) []byte {
+	status := "CONFIRMED"
+	if method == MethodCancel {
+		status = "CANCELLED"
+	}
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Arkavidia Academy//Recruitment//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:" + string(method),
+		"BEGIN:VEVENT",
+		"UID:" + event.UID,
+		fmt.Sprintf("SEQUENCE:%d", event.Sequence),
+		"DTSTAMP:" + time.Now().UTC().Format(dateTimeFormat),
+		"DTSTART:" + event.Start.UTC().Format(dateTimeFormat),
+		"DTEND:" + event.End.UTC().Format(dateTimeFormat),
+		"SUMMARY:" + escapeText(event.Summary),
+		"STATUS:" + status,
+	}
+
+	if event.Description != "" {
+		lines = append(lines, "DESCRIPTION:"+escapeText(event.Description))
+	}
+	if event.Location != "" {
+		lines = append(lines, "LOCATION:"+escapeText(event.Location))
+	}
+	if event.URL != "" {
+		lines = append(lines, "URL:"+event.URL)
+	}
+	if event.Organizer.Email != "" {
+		lines = append(lines, fmt.Sprintf("ORGANIZER;CN=%s:mailto:%s",
+			escapeParam(event.Organizer.Name), event.Organizer.Email))
+	}
+	for _, attendee := range event.Attendees {
+		lines = append(lines, fmt.Sprintf("ATTENDEE;CN=%s;ROLE=REQ-PARTICIPANT;PARTSTAT=NEEDS-ACTION;RSVP=TRUE:mailto:%s",
+			escapeParam(attendee.Name), attendee.Email))
+	}
+
+	lines = append(lines, "END:VEVENT", "END:VCALENDAR")
+
+	var out strings.Builder
+	for _, line := range lines {
+		out.WriteString(fold(line))
+		out.WriteString("\r\n")
+	}
+
+	return []byte(out.String())
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+func escapeParam(value string) string {
+	return `"` + strings.NewReplacer(`"`, "'", "\r", "", "\n", " ").Replace(value) + `"`
+}
+
+// fold splits content lines longer than 75 octets as required by RFC 5545,
+// never cutting through a multi-byte character.
+func fold(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var out strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			out.WriteString("\r\n ")
+			width = 1
+		}
+		out.WriteRune(r)
+		width += size
+	}
+
+	return out.String()
+}
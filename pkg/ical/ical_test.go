@@ -0,0 +1,136 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuild(t *testing.T) {
+	start := time.Date(2026, 10, 20, 9, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+	event := Event{
+		UID:         "interview-1@example.com",
+		Sequence:    2,
+		Start:       start,
+		End:         start.Add(time.Hour),
+		Summary:     "Interview: Backend Engineer",
+		Description: "Bring your laptop; we pair, review code",
+		Location:    "Room 1",
+		URL:         "https://meet.example.com/abc",
+		Organizer:   Attendee{Name: "Acme", Email: "hr@acme.example.com"},
+		Attendees:   []Attendee{{Name: `Jane "JJ" Doe`, Email: "jane@example.com"}},
+	}
+
+	tests := []struct {
+		name   string
+		method Method
+		want   []string
+	}{
+		{
+			name:   "request",
+			method: MethodRequest,
+			want: []string{
+				"BEGIN:VCALENDAR\r\n",
+				"METHOD:REQUEST\r\n",
+				"UID:interview-1@example.com\r\n",
+				"SEQUENCE:2\r\n",
+				"DTSTART:20261020T020000Z\r\n",
+				"DTEND:20261020T030000Z\r\n",
+				"STATUS:CONFIRMED\r\n",
+				"DESCRIPTION:Bring your laptop\\; we pair\\, review code\r\n",
+				"LOCATION:Room 1\r\n",
+				"URL:https://meet.example.com/abc\r\n",
+				"ORGANIZER;CN=\"Acme\":mailto:hr@acme.example.com\r\n",
+				"ATTENDEE;CN=\"Jane 'JJ' Doe\";ROLE=REQ-PARTICIPANT;PARTSTAT=NEEDS-ACTION;RSVP\r\n =TRUE:mailto:jane@example.com\r\n",
+				"END:VCALENDAR\r\n",
+			},
+		},
+		{
+			name:   "cancel",
+			method: MethodCancel,
+			want: []string{
+				"METHOD:CANCEL\r\n",
+				"STATUS:CANCELLED\r\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(Build(tt.method, event))
+			for _, line := range tt.want {
+				if !strings.Contains(got, line) {
+					t.Errorf("Build() missing %q in\n%s", line, got)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildOmitsEmptyFields(t *testing.T) {
+	got := string(Build(MethodRequest, Event{UID: "1", Summary: "Interview"}))
+
+	for _, prefix := range []string{"DESCRIPTION:", "LOCATION:", "URL:", "ORGANIZER", "ATTENDEE"} {
+		if strings.Contains(got, prefix) {
+			t.Errorf("Build() contains %q for an empty field", prefix)
+		}
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "plain", value: "Interview", want: "Interview"},
+		{name: "separators", value: "a;b,c", want: `a\;b\,c`},
+		{name: "backslash", value: `a\b`, want: `a\\b`},
+		{name: "newlines", value: "a\r\nb\nc", want: `a\nb\nc`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeText(tt.value); got != tt.want {
+				t.Errorf("escapeText(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "short line untouched",
+			line: "SUMMARY:Interview",
+			want: "SUMMARY:Interview",
+		},
+		{
+			name: "exactly 75 octets untouched",
+			line: strings.Repeat("a", 75),
+			want: strings.Repeat("a", 75),
+		},
+		{
+			name: "long line folded with a leading space",
+			line: strings.Repeat("a", 80),
+			want: strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 5),
+		},
+		{
+			name: "multi-byte character kept whole",
+			line: strings.Repeat("a", 74) + "é",
+			want: strings.Repeat("a", 74) + "\r\n é",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fold(tt.line); got != tt.want {
+				t.Errorf("fold() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	if err := png.Encode(&out, img); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	return out.Bytes()
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	if err := jpeg.Encode(&out, img, nil); err != nil {
+		t.Fatalf("jpeg.Encode() error = %v", err)
+	}
+	return out.Bytes()
+}
+
+func TestDecodeImage(t *testing.T) {
+	transparent := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	transparent.Set(0, 0, color.NRGBA{R: 255, A: 255})
+	transparent.Set(1, 0, color.NRGBA{})
+
+	tests := []struct {
+		name           string
+		data           []byte
+		wantErr        error
+		wantWidth      int
+		wantHeight     int
+		wantColorSpace string
+		wantFilter     string
+	}{
+		{
+			name:           "colour jpeg embedded as is",
+			data:           encodeJPEG(t, image.NewRGBA(image.Rect(0, 0, 4, 3))),
+			wantWidth:      4,
+			wantHeight:     3,
+			wantColorSpace: "DeviceRGB",
+			wantFilter:     "DCTDecode",
+		},
+		{
+			name:           "grayscale jpeg embedded as is",
+			data:           encodeJPEG(t, image.NewGray(image.Rect(0, 0, 2, 5))),
+			wantWidth:      2,
+			wantHeight:     5,
+			wantColorSpace: "DeviceGray",
+			wantFilter:     "DCTDecode",
+		},
+		{
+			name:           "png flattened to rgb",
+			data:           encodePNG(t, transparent),
+			wantWidth:      2,
+			wantHeight:     1,
+			wantColorSpace: "DeviceRGB",
+			wantFilter:     "FlateDecode",
+		},
+		{
+			name:    "not an image",
+			data:    []byte("GIF89a not really"),
+			wantErr: ErrUnsupportedImage,
+		},
+		{
+			name:    "empty",
+			data:    nil,
+			wantErr: ErrUnsupportedImage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, err := decodeImage(tt.data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("decodeImage() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if img.width != tt.wantWidth || img.height != tt.wantHeight {
+				t.Errorf("size = %dx%d, want %dx%d", img.width, img.height, tt.wantWidth, tt.wantHeight)
+			}
+			if img.colorSpace != tt.wantColorSpace || img.filter != tt.wantFilter {
+				t.Errorf("encoding = %s %s, want %s %s", img.colorSpace, img.filter, tt.wantColorSpace, tt.wantFilter)
+			}
+			if img.filter == "DCTDecode" && !bytes.Equal(img.data, tt.data) {
+				t.Errorf("jpeg data was re-encoded")
+			}
+		})
+	}
+}
+
+func TestDecodeImageFlattensOntoWhite(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	src.Set(0, 0, color.NRGBA{R: 255, A: 255})
+	src.Set(1, 0, color.NRGBA{})
+
+	img, err := decodeImage(encodePNG(t, src))
+	if err != nil {
+		t.Fatalf("decodeImage() error = %v", err)
+	}
+
+	zr, err := zlib.NewReader(bytes.NewReader(img.data))
+	if err != nil {
+		t.Fatalf("zlib.NewReader() error = %v", err)
+	}
+	pixels, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("reading pixels: %v", err)
+	}
+
+	want := []byte{255, 0, 0, 255, 255, 255}
+	if !bytes.Equal(pixels, want) {
+		t.Errorf("pixels = %v, want %v", pixels, want)
+	}
+}
+
+func TestImage(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height float64
+		want          string
+	}{
+		{name: "scaled to width", width: 50, height: 100, want: "q 50.00 0 0 25.00 "},
+		{name: "scaled to height", width: 100, height: 20, want: "q 40.00 0 0 20.00 "},
+	}
+
+	data := encodePNG(t, image.NewRGBA(image.Rect(0, 0, 200, 100)))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := New()
+			if err := d.Image(data, tt.width, tt.height); err != nil {
+				t.Fatalf("Image() error = %v", err)
+			}
+
+			document := string(d.Bytes())
+			if !strings.Contains(document, tt.want) {
+				t.Errorf("Bytes() missing %q", tt.want)
+			}
+			if !strings.Contains(document, "/XObject << /Im1 7 0 R >>") {
+				t.Errorf("Bytes() does not reference the image")
+			}
+			checkXref(t, []byte(document))
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type Font int
+
+const (
+	Helvetica Font = iota
+	HelveticaBold
+)
+
+const (
+	pageWidth  = 595.28
+	pageHeight = 841.89
+	margin     = 50.0
+)
+
+// Document is a minimal single-column PDF writer using the standard
+// Helvetica fonts, so no font files have to be embedded. Content flows top to
+// bottom and breaks onto a new page when it runs out of room.
+type Document struct {
+	pages  []*bytes.Buffer
+	images []*pdfImage
+	font   Font
+	size   float64
+	x, y   float64
+}
+
+func New() *Document {
+	d := &Document{font: Helvetica, size: 11}
+	d.AddPage()
+	return d
+}
+
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.x = margin
+	d.y = pageHeight - margin
+}
+
+// Width is the usable width between the page margins.
+func (d *Document) Width() float64 {
+	return pageWidth - 2*margin
+}
+
+func (d *Document) SetFont(font Font, size float64) {
+	d.font = font
+	d.size = size
+}
+
+// Cell writes a single line of text at the cursor and moves right by width.
+// Text wider than width is cut off.
+func (d *Document) Cell(width float64, text string) {
+	d.ensureRoom(d.lineHeight())
+	d.drawText(d.x, truncate(text, d.font, d.size, width))
+	d.x += width
+}
+
+// Ln moves the cursor to the start of the next line.
+func (d *Document) Ln() {
+	d.x = margin
+	d.y -= d.lineHeight()
+}
+
+// Space adds vertical whitespace.
+func (d *Document) Space(height float64) {
+	d.x = margin
+	d.y -= height
+}
+
+// Paragraph writes text wrapped to the page width. Newlines in text start a
+// new line.
+func (d *Document) Paragraph(text string) {
+	d.x = margin
+	for _, raw := range strings.Split(text, "\n") {
+		for _, line := range wrap(raw, d.font, d.size, d.Width()) {
+			d.ensureRoom(d.lineHeight())
+			d.drawText(margin, line)
+			d.y -= d.lineHeight()
+		}
+	}
+}
+
+// Rule draws a thin horizontal line across the page.
+func (d *Document) Rule() {
+	d.ensureRoom(8)
+	d.y -= 4
+	fmt.Fprintf(d.page(), "0.5 w %.2f %.2f m %.2f %.2f l S\n", margin, d.y, pageWidth-margin, d.y)
+	d.y -= 4
+	d.x = margin
+}
+
+// Image draws a JPEG or PNG at the cursor, scaled to fit inside width by
+// height with its aspect ratio kept, and moves the cursor below it.
+func (d *Document) Image(data []byte, width, height float64) error {
+	img, err := decodeImage(data)
+	if err != nil {
+		return err
+	}
+
+	scale := width / float64(img.width)
+	if s := height / float64(img.height); s < scale {
+		scale = s
+	}
+	w, h := float64(img.width)*scale, float64(img.height)*scale
+
+	d.ensureRoom(h)
+	d.images = append(d.images, img)
+	fmt.Fprintf(d.page(), "q %.2f 0 0 %.2f %.2f %.2f cm /Im%d Do Q\n", w, h, d.x, d.y-h, len(d.images))
+	d.y -= h
+	d.x = margin
+
+	return nil
+}
+
+// Bytes renders the document.
+func (d *Document) Bytes() []byte {
+	var out bytes.Buffer
+	offsets := []int{}
+
+	writeObject := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1-4 are fixed; each page then takes a page and a content object,
+	// and the images follow the pages.
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	// Every page shares one resource dictionary, so images may be used on any
+	// page without tracking where they were drawn.
+	resources := "/Font << /F1 3 0 R /F2 4 0 R >>"
+	if len(d.images) > 0 {
+		xobjects := make([]string, len(d.images))
+		for i := range d.images {
+			xobjects[i] = fmt.Sprintf("/Im%d %d 0 R", i+1, 5+2*len(d.pages)+i)
+		}
+		resources += fmt.Sprintf(" /XObject << %s >>", strings.Join(xobjects, " "))
+	}
+
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, content := range d.pages {
+		writeObject(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] "+
+			"/Resources << %s >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, resources, 6+2*i))
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	for _, img := range d.images {
+		writeObject(fmt.Sprintf("<< /Type /XObject /Subtype /Image /Width %d /Height %d "+
+			"/ColorSpace /%s /BitsPerComponent 8 /Filter /%s /Length %d >>\nstream\n%s\nendstream",
+			img.width, img.height, img.colorSpace, img.filter, len(img.data), img.data))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+func (d *Document) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+func (d *Document) lineHeight() float64 {
+	return d.size * 1.4
+}
+
+func (d *Document) ensureRoom(height float64) {
+	if d.y-height < margin {
+		d.AddPage()
+	}
+}
+
+func (d *Document) drawText(x float64, text string) {
+	fontName := "F1"
+	if d.font == HelveticaBold {
+		fontName = "F2"
+	}
+	fmt.Fprintf(d.page(), "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n",
+		fontName, d.size, x, d.y-d.size, escape(encode(text)))
+}
+
+// encode maps text onto WinAnsi, which matches Latin-1 for the printable
+// range; anything outside it becomes a question mark.
+func encode(text string) []byte {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			out = append(out, ' ')
+		case r >= 32 && r < 127, r >= 160 && r < 256:
+			out = append(out, byte(r))
+		default:
+			out = append(out, '?')
+		}
+	}
+	return out
+}
+
+func escape(text []byte) string {
+	var out strings.Builder
+	for _, b := range text {
+		if b == '(' || b == ')' || b == '\\' {
+			out.WriteByte('\\')
+		}
+		out.WriteByte(b)
+	}
+	return out.String()
+}
+
+func textWidth(text string, font Font, size float64) float64 {
+	widths := helveticaWidths
+	if font == HelveticaBold {
+		widths = helveticaBoldWidths
+	}
+
+	var total int
+	for _, b := range encode(text) {
+		if b >= 32 && b < 127 {
+			total += widths[b-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+func wrap(text string, font Font, size float64, width float64) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	var lines []string
+	current := ""
+	for _, word := range words {
+		candidate := word
+		if current != "" {
+			candidate = current + " " + word
+		}
+		if current != "" && textWidth(candidate, font, size) > width {
+			lines = append(lines, current)
+			candidate = word
+		}
+		current = candidate
+	}
+
+	return append(lines, current)
+}
+
+func truncate(text string, font Font, size float64, width float64) string {
+	runes := []rune(text)
+	for len(runes) > 0 && textWidth(string(runes), font, size) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes)
+}
+
+// Glyph widths for ASCII 32-126 from the Adobe core font metrics.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -0,0 +1,210 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "ascii", text: "Offer (v2)", want: "Offer (v2)"},
+		{name: "tab becomes space", text: "a\tb", want: "a b"},
+		{name: "latin-1 kept", text: "café", want: "caf\xe9"},
+		{name: "outside latin-1", text: "Rp 1.000 → 2.000", want: "Rp 1.000 ? 2.000"},
+		{name: "control characters", text: "a\x01b", want: "a?b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(encode(tt.text)); got != tt.want {
+				t.Errorf("encode(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "plain", want: "plain"},
+		{text: "(draft)", want: `\(draft\)`},
+		{text: `C:\path`, want: `C:\\path`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := escape([]byte(tt.text)); got != tt.want {
+				t.Errorf("escape(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		font Font
+		size float64
+		want float64
+	}{
+		{name: "empty", text: "", font: Helvetica, size: 10, want: 0},
+		{name: "regular", text: "Go", font: Helvetica, size: 10, want: 13.34},
+		{name: "bold", text: "Go", font: HelveticaBold, size: 10, want: 13.89},
+		{name: "scales with size", text: "Go", font: Helvetica, size: 20, want: 26.68},
+		{name: "non-ascii uses the default width", text: "é", font: Helvetica, size: 10, want: 5.56},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := textWidth(tt.text, tt.font, tt.size); fmt.Sprintf("%.2f", got) != fmt.Sprintf("%.2f", tt.want) {
+				t.Errorf("textWidth(%q) = %.2f, want %.2f", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	// "aaaa" is 4*556*10/1000 = 22.24 wide and a space 2.78 at size 10.
+	tests := []struct {
+		name  string
+		text  string
+		width float64
+		want  []string
+	}{
+		{name: "empty", text: "   ", width: 100, want: []string{""}},
+		{name: "fits", text: "aaaa aaaa", width: 100, want: []string{"aaaa aaaa"}},
+		{name: "breaks between words", text: "aaaa aaaa aaaa", width: 50, want: []string{"aaaa aaaa", "aaaa"}},
+		{name: "collapses whitespace", text: "aaaa \t  aaaa", width: 100, want: []string{"aaaa aaaa"}},
+		{name: "long word kept whole", text: "aaaaaaaaaaaa aa", width: 20, want: []string{"aaaaaaaaaaaa", "aa"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wrap(tt.text, Helvetica, 10, tt.width)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("wrap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		width float64
+		want  string
+	}{
+		{name: "fits", text: "aaaa", width: 30, want: "aaaa"},
+		{name: "cut to width", text: "aaaaaaaa", width: 12, want: "aa"},
+		{name: "nothing fits", text: "aaaa", width: 1, want: ""},
+		{name: "multi-byte runes kept whole", text: "éééé", width: 12, want: "éé"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncate(tt.text, Helvetica, 10, tt.width); got != tt.want {
+				t.Errorf("truncate(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		name      string
+		build     func(d *Document)
+		wantPages int
+		wantText  []string
+	}{
+		{
+			name:      "empty document",
+			build:     func(d *Document) {},
+			wantPages: 1,
+		},
+		{
+			name: "text is escaped",
+			build: func(d *Document) {
+				d.SetFont(HelveticaBold, 20)
+				d.Paragraph("Offer (final)")
+				d.Rule()
+				d.SetFont(Helvetica, 11)
+				d.Cell(100, "Salary")
+				d.Ln()
+			},
+			wantPages: 1,
+			wantText:  []string{`/F2 20.0 Tf`, `(Offer \(final\)) Tj`, `(Salary) Tj`, " l S\n"},
+		},
+		{
+			name: "overflow starts a new page",
+			build: func(d *Document) {
+				for i := 0; i < 80; i++ {
+					d.Paragraph(fmt.Sprintf("Line %d", i))
+				}
+			},
+			wantPages: 2,
+			wantText:  []string{"(Line 0) Tj", "(Line 79) Tj"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := New()
+			tt.build(d)
+			document := d.Bytes()
+
+			if !bytes.HasPrefix(document, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(document, []byte("%%EOF\n")) {
+				t.Fatalf("Bytes() is missing the PDF header or trailer")
+			}
+			if got := bytes.Count(document, []byte("/Type /Page ")); got != tt.wantPages {
+				t.Errorf("pages = %d, want %d", got, tt.wantPages)
+			}
+			if !bytes.Contains(document, []byte(fmt.Sprintf("/Count %d >>", tt.wantPages))) {
+				t.Errorf("page tree does not count %d pages", tt.wantPages)
+			}
+			for _, text := range tt.wantText {
+				if !bytes.Contains(document, []byte(text)) {
+					t.Errorf("Bytes() missing %q", text)
+				}
+			}
+			checkXref(t, document)
+		})
+	}
+}
+
+// checkXref verifies every cross-reference entry points at its object.
+func checkXref(t *testing.T, document []byte) {
+	t.Helper()
+
+	start := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(document)
+	if start == nil {
+		t.Fatalf("missing startxref")
+	}
+	xref, _ := strconv.Atoi(string(start[1]))
+	if !bytes.HasPrefix(document[xref:], []byte("xref\n")) {
+		t.Fatalf("startxref %d does not point at the xref table", xref)
+	}
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(document[xref:], -1)
+	if len(entries) == 0 {
+		t.Fatalf("xref table has no entries")
+	}
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		want := fmt.Sprintf("%d 0 obj\n", i+1)
+		if !bytes.HasPrefix(document[offset:], []byte(want)) {
+			t.Errorf("xref entry %d points at %q, want %q", i+1, document[offset:offset+len(want)], want)
+		}
+	}
+}
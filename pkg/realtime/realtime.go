@@ -0,0 +1,138 @@
+package realtime
+
+import (
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/redis"
+	"context"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"sync"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventApplicationStatusChanged EventType = "application.status_changed"
+	EventMessageReceived          EventType = "message.received"
+	EventInterviewProposed        EventType = "interview.proposed"
+	EventJobInvitationReceived    EventType = "job_invitation.received"
+	EventJobsMatched              EventType = "jobs.matched"
+	EventNotificationCreated      EventType = "notification.created"
+)
+
+// channelPrefix namespaces the per-user Redis channels. Every replica
+// subscribes to all of them and forwards events to its own connections.
+const channelPrefix = "realtime:user:"
+
+// subscriberBuffer is how many events a slow connection may fall behind
+// before further events to it are dropped.
+const subscriberBuffer = 32
+
+type Event struct {
+	Type      EventType   `json:"type"`
+	Data      interface{} `json:"data"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type ItfRealtime interface {
+	Publish(c context.Context, userID string, event Event) error
+	Subscribe(userID string) (<-chan []byte, func())
+	IssueTicket(c context.Context, user entity.UserLoginData, expiresAt time.Time) (string, time.Time, error)
+	RedeemTicket(c context.Context, ticket string) (Ticket, error)
+	Start()
+	Stop()
+}
+
+type hub struct {
+	redis       redis.ItfRedis
+	log         *logrus.Logger
+	mu          sync.RWMutex
+	subscribers map[string]map[chan []byte]struct{}
+	cancel      context.CancelFunc
+}
+
+func New(redis redis.ItfRedis, log *logrus.Logger) ItfRealtime {
+	return &hub{
+		redis:       redis,
+		log:         log,
+		subscribers: map[string]map[chan []byte]struct{}{},
+	}
+}
+
+// Publish sends the event to every open connection of the user on any
+// replica. Users without a connection simply miss it.
+func (h *hub) Publish(c context.Context, userID string, event Event) error {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return h.redis.Publish(c, channelPrefix+userID, string(payload))
+}
+
+// Subscribe registers a local connection of the user. The returned function
+// unregisters it and closes the channel.
+func (h *hub) Subscribe(userID string) (<-chan []byte, func()) {
+	ch := make(chan []byte, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = map[chan []byte]struct{}{}
+	}
+	h.subscribers[userID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subscribers[userID], ch)
+			if len(h.subscribers[userID]) == 0 {
+				delete(h.subscribers, userID)
+			}
+			h.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+func (h *hub) Start() {
+	c, cancel := context.WithCancel(context.Background())
+	h.cancel = cancel
+
+	messages := h.redis.PSubscribe(c, channelPrefix+"*")
+	go func() {
+		for msg := range messages {
+			h.dispatch(strings.TrimPrefix(msg.Channel, channelPrefix), []byte(msg.Payload))
+		}
+	}()
+
+	h.log.Info("Realtime hub started successfully")
+}
+
+func (h *hub) Stop() {
+	if h.cancel != nil {
+		h.cancel()
+	}
+}
+
+func (h *hub) dispatch(userID string, payload []byte) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subscribers[userID] {
+		select {
+		case ch <- payload:
+		default:
+			h.log.WithFields(logrus.Fields{
+				"user_id": userID,
+			}).Warn("Dropping realtime event for slow subscriber")
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package realtime
+
+import (
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/redis"
+	"context"
+	"encoding/json"
+	"github.com/sirupsen/logrus"
+	"io"
+	"testing"
+	"time"
+)
+
+// memoryRedis keeps caches in a map and records what was published.
+type memoryRedis struct {
+	redis.ItfRedis
+	cache     map[string]string
+	published map[string][]string
+}
+
+func newMemoryRedis() *memoryRedis {
+	return &memoryRedis{cache: map[string]string{}, published: map[string][]string{}}
+}
+
+func (r *memoryRedis) SetCache(_ context.Context, key string, value string, _ time.Duration) error {
+	r.cache[key] = value
+	return nil
+}
+
+func (r *memoryRedis) TakeCache(_ context.Context, key string) (string, error) {
+	value := r.cache[key]
+	delete(r.cache, key)
+	return value, nil
+}
+
+func (r *memoryRedis) Publish(_ context.Context, channel string, payload string) error {
+	r.published[channel] = append(r.published[channel], payload)
+	return nil
+}
+
+func newTestHub(r redis.ItfRedis) *hub {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return New(r, logger).(*hub)
+}
+
+func TestPublishUsesTheUserChannel(t *testing.T) {
+	r := newMemoryRedis()
+	h := newTestHub(r)
+
+	if err := h.Publish(context.Background(), "user-1", Event{Type: EventMessageReceived, Data: "hi"}); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	published := r.published[channelPrefix+"user-1"]
+	if len(published) != 1 {
+		t.Fatalf("published %d events, want 1", len(published))
+	}
+
+	var event Event
+	if err := json.Unmarshal([]byte(published[0]), &event); err != nil {
+		t.Fatalf("payload is not an event: %v", err)
+	}
+	if event.Type != EventMessageReceived || event.CreatedAt.IsZero() {
+		t.Errorf("event = %+v, want message.received with a timestamp", event)
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	h := newTestHub(newMemoryRedis())
+
+	first, unsubscribeFirst := h.Subscribe("user-1")
+	second, unsubscribeSecond := h.Subscribe("user-1")
+	other, unsubscribeOther := h.Subscribe("user-2")
+	defer unsubscribeSecond()
+	defer unsubscribeOther()
+
+	h.dispatch("user-1", []byte("event"))
+
+	for name, ch := range map[string]<-chan []byte{"first": first, "second": second} {
+		select {
+		case got := <-ch:
+			if string(got) != "event" {
+				t.Errorf("%s connection got %q, want event", name, got)
+			}
+		default:
+			t.Errorf("%s connection got nothing", name)
+		}
+	}
+
+	select {
+	case got := <-other:
+		t.Errorf("other user got %q", got)
+	default:
+	}
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+	if _, open := <-first; open {
+		t.Error("channel still open after unsubscribe")
+	}
+
+	h.dispatch("user-1", []byte("again"))
+	if got := <-second; string(got) != "again" {
+		t.Errorf("remaining connection got %q, want again", got)
+	}
+}
+
+func TestDispatchDropsForSlowSubscribers(t *testing.T) {
+	h := newTestHub(newMemoryRedis())
+
+	ch, unsubscribe := h.Subscribe("user-1")
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBuffer+5; i++ {
+		h.dispatch("user-1", []byte("event"))
+	}
+
+	if len(ch) != subscriberBuffer {
+		t.Errorf("buffered %d events, want %d", len(ch), subscriberBuffer)
+	}
+}
+
+func TestTickets(t *testing.T) {
+	r := newMemoryRedis()
+	h := newTestHub(r)
+	c := context.Background()
+
+	user := entity.UserLoginData{ID: "user-1", Role: entity.RoleCandidate}
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	ticket, redeemBy, err := h.IssueTicket(c, user, expiresAt)
+	if err != nil {
+		t.Fatalf("IssueTicket() error = %v", err)
+	}
+	if ticket == "" || !redeemBy.After(time.Now()) {
+		t.Fatalf("IssueTicket() = %q, %v", ticket, redeemBy)
+	}
+	if _, ok := r.cache[ticketPrefix+ticket]; ok {
+		t.Error("ticket stored in plain text")
+	}
+
+	redeemed, err := h.RedeemTicket(c, ticket)
+	if err != nil {
+		t.Fatalf("RedeemTicket() error = %v", err)
+	}
+	if redeemed.User.ID != user.ID || !redeemed.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("RedeemTicket() = %+v, want user-1 until %v", redeemed, expiresAt)
+	}
+
+	again, err := h.RedeemTicket(c, ticket)
+	if err != nil || again.User.ID != "" {
+		t.Errorf("second RedeemTicket() = %+v, %v, want a zero ticket", again, err)
+	}
+
+	unknown, err := h.RedeemTicket(c, "unknown")
+	if err != nil || unknown.User.ID != "" {
+		t.Errorf("RedeemTicket(unknown) = %+v, %v, want a zero ticket", unknown, err)
+	}
+}
@@ -0,0 +1,59 @@
+package realtime
+
+import (
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/utils"
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ticketPrefix namespaces stream tickets in Redis, keyed by their hash.
+const ticketPrefix = "realtime:ticket:"
+
+// ticketTTL is how long a ticket may wait before the stream is opened with it.
+const ticketTTL = 30 * time.Second
+
+// Ticket lets a browser open one event stream without putting its access
+// token in the URL. ExpiresAt is when the issuing access token expires, which
+// is also when the stream is closed.
+type Ticket struct {
+	User      entity.UserLoginData `json:"user"`
+	ExpiresAt time.Time            `json:"expires_at"`
+}
+
+// IssueTicket stores a single-use ticket for the user and returns it with the
+// time it must be redeemed by.
+func (h *hub) IssueTicket(c context.Context, user entity.UserLoginData, expiresAt time.Time) (string, time.Time, error) {
+	payload, err := json.Marshal(Ticket{User: user, ExpiresAt: expiresAt})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	ticket, err := utils.GenerateToken(32)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if err := h.redis.SetCache(c, ticketPrefix+utils.HashToken(ticket), string(payload), ticketTTL); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return ticket, time.Now().Add(ticketTTL), nil
+}
+
+// RedeemTicket consumes a ticket. Unknown, expired and already used tickets
+// return a zero Ticket.
+func (h *hub) RedeemTicket(c context.Context, ticket string) (Ticket, error) {
+	payload, err := h.redis.TakeCache(c, ticketPrefix+utils.HashToken(ticket))
+	if err != nil || payload == "" {
+		return Ticket{}, err
+	}
+
+	var redeemed Ticket
+	if err := json.Unmarshal([]byte(payload), &redeemed); err != nil {
+		return Ticket{}, err
+	}
+
+	return redeemed, nil
+}
@@ -13,6 +13,19 @@ import (
 type ItfRedis interface {
 	SetOTP(c context.Context, email string, code string) error
 	GetOTP(c context.Context, email string) (string, error)
+	SetCache(c context.Context, key string, value string, ttl time.Duration) error
+	GetCache(c context.Context, key string) (string, error)
+	TakeCache(c context.Context, key string) (string, error)
+	DeleteCache(c context.Context, keys ...string) error
+	DeleteCacheByPattern(c context.Context, pattern string) error
+	Publish(c context.Context, channel string, payload string) error
+	PSubscribe(c context.Context, pattern string) <-chan Message
+}
+
+// Message is a payload received on a pub/sub channel.
+type Message struct {
+	Channel string
+	Payload string
 }
 
 type redis struct {
@@ -55,3 +68,85 @@ func (r *redis) GetOTP(c context.Context, email string) (string, error) {
 	}
 	return val, nil
 }
+
+func (r *redis) SetCache(c context.Context, key string, value string, ttl time.Duration) error {
+	return r.client.Set(c, key, value, ttl).Err()
+}
+
+func (r *redis) GetCache(c context.Context, key string) (string, error) {
+	val, err := r.client.Get(c, key).Result()
+	if errors.Is(err, redisPkg.Nil) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return val, nil
+}
+
+// TakeCache reads and deletes a key in one step, so the value can only be
+// read once.
+func (r *redis) TakeCache(c context.Context, key string) (string, error) {
+	val, err := r.client.GetDel(c, key).Result()
+	if errors.Is(err, redisPkg.Nil) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return val, nil
+}
+
+func (r *redis) DeleteCache(c context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return r.client.Del(c, keys...).Err()
+}
+
+func (r *redis) DeleteCacheByPattern(c context.Context, pattern string) error {
+	iter := r.client.Scan(c, 0, pattern, 100).Iterator()
+
+	var keys []string
+	for iter.Next(c) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	return r.DeleteCache(c, keys...)
+}
+
+func (r *redis) Publish(c context.Context, channel string, payload string) error {
+	return r.client.Publish(c, channel, payload).Err()
+}
+
+// PSubscribe delivers messages from every channel matching pattern until c
+// is done. The subscription reconnects on its own after connection errors.
+func (r *redis) PSubscribe(c context.Context, pattern string) <-chan Message {
+	pubsub := r.client.PSubscribe(c, pattern)
+	messages := make(chan Message)
+
+	go func() {
+		defer close(messages)
+		defer pubsub.Close()
+
+		ch := pubsub.Channel()
+		for {
+			select {
+			case <-c.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case messages <- Message{Channel: msg.Channel, Payload: msg.Payload}:
+				case <-c.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return messages
+}
@@ -1,6 +1,7 @@
 package response
 
 import (
+	"errors"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -21,3 +22,25 @@ var (
 	ErrBadRequest          = New(fiber.StatusBadRequest, "Bad Request")
 	ErrForeignKeyViolation = New(fiber.StatusForbidden, "Foreign Key Violation")
 )
+
+// WriteError renders domain errors with their own status and everything else
+// as an internal error carrying the given message.
+func WriteError(ctx *fiber.Ctx, err error, message string) error {
+	var respErr *Error
+	if errors.As(err, &respErr) {
+		return ctx.Status(respErr.Code).JSON(fiber.Map{
+			"errors": fiber.Map{"message": respErr.Err},
+		})
+	}
+
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return ctx.Status(fiberErr.Code).JSON(fiber.Map{
+			"errors": fiber.Map{"message": fiberErr.Message},
+		})
+	}
+
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"errors": fiber.Map{"message": message, "err": err.Error()},
+	})
+}
@@ -1,10 +1,15 @@
 package s3
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"io"
 	"mime/multipart"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -18,8 +23,14 @@ type ItfS3 interface {
 	UploadFile(file *multipart.FileHeader, fileName string) (string, error)
 	PresignUrl(fileName string) (string, error)
 	DeleteFile(fileName string) error
+	UploadBytes(data []byte, key string, contentType string) (string, error)
+	GetFile(key string) ([]byte, error)
+	DeleteOlderThan(prefix string, before time.Time) (int, error)
 }
 
+// ErrNotFound is returned by GetFile when the object does not exist.
+var ErrNotFound = errors.New("s3: object not found")
+
 type s3Client struct {
 	client     *s3.S3
 	session    *session.Session
@@ -94,6 +105,91 @@ func (s *s3Client) DeleteFile(fileName string) error {
 	return err
 }
 
+// UploadBytes stores data under exactly key, replacing any existing object,
+// for generated files whose name the caller has to find again.
+func (s *s3Client) UploadBytes(data []byte, key string, contentType string) (string, error) {
+	uploader := s3manager.NewUploader(s.session)
+
+	uploadOutput, err := uploader.Upload(&s3manager.UploadInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return uploadOutput.Location, nil
+}
+
+// GetFile reads an object. key may also be the location URL returned by an
+// upload.
+func (s *s3Client) GetFile(key string) ([]byte, error) {
+	output, err := s.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(ObjectKey(key)),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	defer output.Body.Close()
+
+	return io.ReadAll(output.Body)
+}
+
+// DeleteOlderThan removes every object under prefix last modified before the
+// given time and reports how many were deleted.
+func (s *s3Client) DeleteOlderThan(prefix string, before time.Time) (int, error) {
+	var objects []*s3.ObjectIdentifier
+	err := s.client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucketName),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			if object.LastModified != nil && object.LastModified.Before(before) {
+				objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// DeleteObjects takes at most 1000 keys per call.
+	for start := 0; start < len(objects); start += 1000 {
+		end := start + 1000
+		if end > len(objects) {
+			end = len(objects)
+		}
+		_, err := s.client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(s.bucketName),
+			Delete: &s3.Delete{Objects: objects[start:end], Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return start, err
+		}
+	}
+
+	return len(objects), nil
+}
+
+// ObjectKey turns a stored location URL back into its object key; plain keys
+// are returned unchanged.
+func ObjectKey(location string) string {
+	parsed, err := url.Parse(location)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return location
+	}
+
+	return strings.TrimPrefix(parsed.Path, "/")
+}
+
 func newSession() (*session.Session, error) {
 	sess, err := session.NewSession(&aws.Config{
 		Region: aws.String(os.Getenv("AWS_REGION")),
@@ -0,0 +1,87 @@
+package scheduler
+
+import (
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/smtp"
+	"context"
+	"fmt"
+	"html"
+	"time"
+)
+
+// certificationReminderWindow is how far ahead of its expiry month a
+// certification's owner is reminded to renew it.
+const certificationReminderWindow = 30 * 24 * time.Hour
+
+// sendCertificationExpiryReminders tells candidates once about each
+// certification whose expiry month starts within the reminder window.
+func (s *Scheduler) sendCertificationExpiryReminders() {
+	s.log.Info("Starting certification expiry reminders")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	bioRepo, err := s.bioRepo.NewClient(false)
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to create bio repository client for certification reminders")
+		return
+	}
+
+	authRepo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to create auth repository client for certification reminders")
+		return
+	}
+
+	// Expiry dates are stored as the first of the month and stay valid
+	// through it, so the current month's certifications are still due.
+	now := time.Now().UTC()
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	certifications, err := bioRepo.Certification.GetCertificationsDueForReminder(ctx, thisMonth, now.Add(certificationReminderWindow))
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to get certifications due for reminders")
+		return
+	}
+
+	sent := 0
+	for _, certification := range certifications {
+		user, err := authRepo.User.GetUserByID(ctx, certification.UserID)
+		if err != nil || user.ID == "" {
+			s.log.WithField("certification_id", certification.ID).Warn("Skipping certification reminder for missing user")
+			continue
+		}
+
+		mail := buildCertificationReminder(user, certification)
+		s.notifier.Notify(ctx, notification.Event{
+			RecipientID: user.ID,
+			Type:        entity.NotificationCertification,
+			Title:       mail.Subject,
+			Body: fmt.Sprintf("Your %s certification from %s expires at the end of %s.",
+				certification.Name, certification.Issuer, certification.ExpiryDate.Format("January 2006")),
+			Data: map[string]string{"certification_id": certification.ID},
+			Mail: &mail,
+		})
+		sent++
+
+		if err := bioRepo.Certification.MarkCertificationReminderSent(ctx, certification.ID, now); err != nil {
+			s.log.WithField("error", err.Error()).Error("Failed to mark certification reminder sent")
+		}
+	}
+
+	s.log.WithField("sent", sent).Info("Finished certification expiry reminders")
+}
+
+func buildCertificationReminder(user entity.User, certification entity.Certification) smtp.Mail {
+	body := fmt.Sprintf("<p>Hello %s,</p><p>Your certification <b>%s</b> from %s expires at the end of %s. "+
+		"Renew it and update your profile so recruiters see it as current.</p>",
+		html.EscapeString(user.Name), html.EscapeString(certification.Name), html.EscapeString(certification.Issuer),
+		certification.ExpiryDate.Format("January 2006"))
+
+	return smtp.Mail{
+		To:      user.Email,
+		Subject: fmt.Sprintf("Your %s certification is about to expire", certification.Name),
+		Body:    body,
+	}
+}
@@ -0,0 +1,31 @@
+package scheduler
+
+import (
+	"ProjectGolang/internal/entity"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildCertificationReminder(t *testing.T) {
+	expiry := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	user := entity.User{Name: "Jane <b>", Email: "jane@example.com"}
+	certification := entity.Certification{Name: "CKA & <i>", Issuer: "CNCF", ExpiryDate: &expiry}
+
+	mail := buildCertificationReminder(user, certification)
+
+	if mail.To != user.Email {
+		t.Errorf("To = %q, want %q", mail.To, user.Email)
+	}
+	if want := "Your CKA & <i> certification is about to expire"; mail.Subject != want {
+		t.Errorf("Subject = %q, want %q", mail.Subject, want)
+	}
+	for _, want := range []string{"Jane &lt;b&gt;", "CKA &amp; &lt;i&gt;", "CNCF", "November 2026"} {
+		if !strings.Contains(mail.Body, want) {
+			t.Errorf("Body = %q, want it to contain %q", mail.Body, want)
+		}
+	}
+	if strings.Contains(mail.Body, "<i>") {
+		t.Errorf("Body = %q, want user input escaped", mail.Body)
+	}
+}
@@ -0,0 +1,169 @@
+package scheduler
+
+import (
+	"ProjectGolang/internal/api/notification"
+	"ProjectGolang/internal/api/recruitment"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/smtp"
+	"context"
+	"fmt"
+	"html"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const maxDigestVacancies = 20
+
+// sendSavedSearchDigests runs every active saved search of the given frequency
+// against vacancies created since it was last notified and emails the matches.
+func (s *Scheduler) sendSavedSearchDigests(frequency entity.SavedSearchFrequency) {
+	s.log.WithField("frequency", frequency).Info("Starting saved search digests")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	recruitmentRepo, err := s.recruitmentRepo.NewClient(false)
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to create recruitment repository client for digests")
+		return
+	}
+
+	authRepo, err := s.repo.NewClient(false)
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to create auth repository client for digests")
+		return
+	}
+
+	searches, err := recruitmentRepo.SavedSearches.GetActiveSavedSearchesByFrequency(ctx, frequency)
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to get saved searches for digests")
+		return
+	}
+
+	if len(searches) == 0 {
+		return
+	}
+
+	// One query covers every search: fetch from the oldest cut-off and
+	// filter per search below.
+	earliest := savedSearchSince(searches[0])
+	for _, search := range searches[1:] {
+		if since := savedSearchSince(search); since.Before(earliest) {
+			earliest = since
+		}
+	}
+
+	now := time.Now()
+	vacancies, err := recruitmentRepo.JobVacancies.GetJobVacanciesCreatedSince(ctx, earliest, now)
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to get new job vacancies for digests")
+		return
+	}
+
+	sent := 0
+	for _, search := range searches {
+		since := savedSearchSince(search)
+
+		var matches []entity.JobVacancy
+		for _, jv := range vacancies {
+			if jv.CreatedAt.After(since) && recruitment.MatchSavedSearch(search, jv) {
+				matches = append(matches, jv)
+			}
+		}
+
+		if len(matches) > 0 {
+			user, err := authRepo.User.GetUserByID(ctx, search.UserID)
+			if err != nil {
+				s.log.WithField("saved_search_id", search.ID).WithField("error", err.Error()).Error("Failed to get user for digest")
+				continue
+			}
+			if user.ID == "" {
+				s.log.WithField("saved_search_id", search.ID).Warn("Skipping digest for missing user")
+				continue
+			}
+
+			mail := buildSavedSearchDigest(user, search, matches)
+			s.notifier.Notify(ctx, notification.Event{
+				RecipientID: user.ID,
+				Type:        entity.NotificationJobAlert,
+				Title:       mail.Subject,
+				Body:        fmt.Sprintf("%d new jobs match your saved search %q.", len(matches), search.Name),
+				Data:        map[string]string{"saved_search_id": search.ID},
+				Mail:        &mail,
+			})
+			sent++
+
+			s.publishMatches(ctx, search, matches)
+		}
+
+		if err := recruitmentRepo.SavedSearches.UpdateSavedSearchLastNotified(ctx, search.ID, now); err != nil {
+			s.log.WithField("error", err.Error()).Error("Failed to update saved search last notified timestamp")
+		}
+	}
+
+	s.log.WithField("frequency", frequency).WithField("sent", sent).Info("Finished saved search digests")
+}
+
+// savedSearchSince is the point after which vacancies are new to a search.
+func savedSearchSince(search entity.SavedSearch) time.Time {
+	if search.LastNotifiedAt != nil {
+		return *search.LastNotifiedAt
+	}
+	return search.CreatedAt
+}
+
+// publishMatches tells the user's open realtime connections about the new
+// vacancies matching one of their saved searches.
+func (s *Scheduler) publishMatches(ctx context.Context, search entity.SavedSearch, matches []entity.JobVacancy) {
+	ids := make([]string, len(matches))
+	for i, jv := range matches {
+		ids[i] = jv.ID
+	}
+
+	event := realtime.Event{
+		Type: realtime.EventJobsMatched,
+		Data: map[string]interface{}{
+			"saved_search_id": search.ID,
+			"job_vacancy_ids": ids,
+		},
+	}
+
+	if err := s.realtime.Publish(ctx, search.UserID, event); err != nil {
+		s.log.WithField("error", err.Error()).Warn("Failed to publish job matches event")
+	}
+}
+
+func buildSavedSearchDigest(user entity.User, search entity.SavedSearch, matches []entity.JobVacancy) smtp.Mail {
+	unsubscribeURL := fmt.Sprintf("%s/api/v1/recruitment/saved_searches/unsubscribe?token=%s",
+		strings.TrimRight(os.Getenv("APP_URL"), "/"), url.QueryEscape(search.UnsubscribeToken))
+
+	var body strings.Builder
+	body.WriteString(fmt.Sprintf("<p>Hello %s,</p>", html.EscapeString(user.Name)))
+	body.WriteString(fmt.Sprintf("<p>%d new job vacancies match your saved search <b>%s</b>:</p><ul>",
+		len(matches), html.EscapeString(search.Name)))
+
+	for i, jv := range matches {
+		if i == maxDigestVacancies {
+			body.WriteString(fmt.Sprintf("<li>and %d more</li>", len(matches)-maxDigestVacancies))
+			break
+		}
+		body.WriteString(fmt.Sprintf("<li><b>%s</b> &middot; %s &middot; %s</li>",
+			html.EscapeString(jv.Title), html.EscapeString(jv.Location), html.EscapeString(jv.JobType)))
+	}
+
+	body.WriteString("</ul>")
+	body.WriteString(fmt.Sprintf("<p><a href=\"%s\">Unsubscribe from this alert</a></p>", unsubscribeURL))
+
+	return smtp.Mail{
+		To:      user.Email,
+		Subject: fmt.Sprintf("New jobs for \"%s\"", search.Name),
+		Body:    body.String(),
+		Headers: map[string]string{
+			"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeURL),
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// expireOffers closes the sent offers whose deadline passed and moves their
+// applications from the offer stage back to interview, so the company can
+// make a new offer or reject the candidate.
+func (s *Scheduler) expireOffers() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	repo, err := s.recruitmentRepo.NewClient(true)
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to create recruitment repository client for offer expiry")
+		return
+	}
+	defer repo.Rollback()
+
+	now := time.Now()
+	jobApplicationIDs, err := repo.Offers.ExpireSentOffers(ctx, now)
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to expire sent offers")
+		return
+	}
+
+	if len(jobApplicationIDs) == 0 {
+		return
+	}
+
+	reopened, err := repo.JobApplications.ReopenOfferedJobApplications(ctx, jobApplicationIDs, now)
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to reopen job applications of expired offers")
+		return
+	}
+
+	if err := repo.Commit(); err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to commit offer expiry")
+		return
+	}
+
+	s.log.WithField("expired", len(jobApplicationIDs)).WithField("reopened", reopened).Info("Expired sent offers")
+}
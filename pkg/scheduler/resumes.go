@@ -0,0 +1,24 @@
+package scheduler
+
+import (
+	"ProjectGolang/internal/api/bio"
+	"time"
+)
+
+// resumeRetention is how long a stored résumé is kept. A profile change gives
+// the next résumé a new key, so anything older is at most regenerated once.
+const resumeRetention = 7 * 24 * time.Hour
+
+// cleanupStaleResumes removes stored résumé PDFs that have outlived
+// resumeRetention, which takes care of the ones orphaned by profile edits.
+func (s *Scheduler) cleanupStaleResumes() {
+	s.log.Info("Starting cleanup of stale resumes")
+
+	deleted, err := s.s3.DeleteOlderThan(bio.ResumeStorageRoot, time.Now().Add(-resumeRetention))
+	if err != nil {
+		s.log.WithField("error", err.Error()).Error("Failed to delete stale resumes")
+		return
+	}
+
+	s.log.WithField("deleted", deleted).Info("Finished cleanup of stale resumes")
+}
@@ -2,6 +2,13 @@ package scheduler
 
 import (
 	authRepository "ProjectGolang/internal/api/auth/repository"
+	bioRepository "ProjectGolang/internal/api/bio/repository"
+	notificationService "ProjectGolang/internal/api/notification/service"
+	recruitmentRepository "ProjectGolang/internal/api/recruitment/repository"
+	webhookService "ProjectGolang/internal/api/webhook/service"
+	"ProjectGolang/internal/entity"
+	"ProjectGolang/pkg/realtime"
+	"ProjectGolang/pkg/s3"
 	"context"
 	"github.com/go-co-op/gocron"
 	"github.com/sirupsen/logrus"
@@ -9,21 +16,47 @@ import (
 )
 
 type Scheduler struct {
-	scheduler *gocron.Scheduler
-	repo      authRepository.Repository
-	log       *logrus.Logger
+	scheduler       *gocron.Scheduler
+	repo            authRepository.Repository
+	recruitmentRepo recruitmentRepository.Repository
+	bioRepo         bioRepository.Repository
+	notifier        notificationService.Notifier
+	webhooks        webhookService.Worker
+	realtime        realtime.ItfRealtime
+	s3              s3.ItfS3
+	log             *logrus.Logger
 }
 
-func NewScheduler(repo authRepository.Repository, log *logrus.Logger) *Scheduler {
+func NewScheduler(repo authRepository.Repository,
+	recruitmentRepo recruitmentRepository.Repository,
+	bioRepo bioRepository.Repository,
+	notifier notificationService.Notifier,
+	webhooks webhookService.Worker,
+	realtime realtime.ItfRealtime,
+	s3 s3.ItfS3,
+	log *logrus.Logger,
+) *Scheduler {
 	return &Scheduler{
-		scheduler: gocron.NewScheduler(time.UTC),
-		repo:      repo,
-		log:       log,
+		scheduler:       gocron.NewScheduler(time.UTC),
+		repo:            repo,
+		recruitmentRepo: recruitmentRepo,
+		bioRepo:         bioRepo,
+		notifier:        notifier,
+		webhooks:        webhooks,
+		realtime:        realtime,
+		s3:              s3,
+		log:             log,
 	}
 }
 
 func (s *Scheduler) Start() {
 	s.scheduler.Every(1).Day().At("03:00").Do(s.cleanupSoftDeletedUsers)
+	s.scheduler.Every(1).Day().At("04:00").Do(s.cleanupStaleResumes)
+	s.scheduler.Every(1).Day().At("07:00").Do(s.sendSavedSearchDigests, entity.SavedSearchFrequencyDaily)
+	s.scheduler.Every(1).Monday().At("07:00").Do(s.sendSavedSearchDigests, entity.SavedSearchFrequencyWeekly)
+	s.scheduler.Every(1).Day().At("08:00").Do(s.sendCertificationExpiryReminders)
+	s.scheduler.Every(1).Hour().SingletonMode().Do(s.expireOffers)
+	s.scheduler.Every(15).Seconds().SingletonMode().Do(s.deliverWebhooks)
 	s.scheduler.StartAsync()
 	s.log.Info("Scheduler started successfully")
 }
@@ -0,0 +1,16 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// deliverWebhooks sends the webhook deliveries that are due. Claims are
+// leased in the database, so replicas running this at once never send the
+// same delivery twice.
+func (s *Scheduler) deliverWebhooks() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	s.webhooks.DeliverDue(ctx)
+}
@@ -1,13 +1,37 @@
 package smtp
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"mime"
 	smtpPkg "net/smtp"
 	"os"
+	"strings"
 )
 
 type ItfSmtp interface {
 	CreateSmtp(userEmail string, otp string) error
+	Send(mail Mail) error
+}
+
+// Mail is a single HTML message. Headers carry extras such as
+// List-Unsubscribe for digest emails. Subjects often hold names users chose,
+// so Send keeps every header value on its own line.
+type Mail struct {
+	To          string
+	Subject     string
+	Body        string
+	Headers     map[string]string
+	Attachments []Attachment
+}
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
 }
 
 type smtp struct {
@@ -38,3 +62,107 @@ func (s *smtp) CreateSmtp(userEmail string, otp string) error {
 
 	return nil
 }
+
+func (s *smtp) Send(mail Mail) error {
+	if strings.ContainsAny(mail.To, "\r\n") {
+		return ErrInvalidHeader
+	}
+	for key := range mail.Headers {
+		if !validHeaderName(key) {
+			return ErrInvalidHeader
+		}
+	}
+
+	var message strings.Builder
+	message.WriteString(fmt.Sprintf("From: %s\r\n", s.mail))
+	message.WriteString(fmt.Sprintf("To: %s\r\n", mail.To))
+	message.WriteString(fmt.Sprintf("Subject: %s\r\n", encodeSubject(mail.Subject)))
+	for key, value := range mail.Headers {
+		message.WriteString(fmt.Sprintf("%s: %s\r\n", key, headerValue(value)))
+	}
+	message.WriteString("MIME-Version: 1.0\r\n")
+
+	if len(mail.Attachments) == 0 {
+		message.WriteString("Content-Type: text/html; charset=\"UTF-8\"\r\n\r\n")
+		message.WriteString(mail.Body)
+	} else {
+		boundary, err := newBoundary()
+		if err != nil {
+			return err
+		}
+
+		message.WriteString(fmt.Sprintf("Content-Type: multipart/mixed; boundary=\"%s\"\r\n\r\n", boundary))
+		message.WriteString(fmt.Sprintf("--%s\r\n", boundary))
+		message.WriteString("Content-Type: text/html; charset=\"UTF-8\"\r\n\r\n")
+		message.WriteString(mail.Body)
+		message.WriteString("\r\n")
+
+		for _, attachment := range mail.Attachments {
+			message.WriteString(fmt.Sprintf("--%s\r\n", boundary))
+			filename := strings.ReplaceAll(headerValue(attachment.Filename), `"`, "'")
+			message.WriteString(fmt.Sprintf("Content-Type: %s; name=\"%s\"\r\n", headerValue(attachment.ContentType), filename))
+			message.WriteString(fmt.Sprintf("Content-Disposition: attachment; filename=\"%s\"\r\n", filename))
+			message.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+			message.WriteString(wrapBase64(attachment.Data))
+		}
+
+		message.WriteString(fmt.Sprintf("--%s--\r\n", boundary))
+	}
+
+	return smtpPkg.SendMail("smtp.gmail.com:587", s.auth, s.mail, []string{mail.To}, []byte(message.String()))
+}
+
+// ErrInvalidHeader is returned for a recipient or header name that would
+// break out of its header line.
+var ErrInvalidHeader = errors.New("smtp: invalid header")
+
+var lineBreaks = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// headerValue keeps a value on one header line, since a CR or LF in it would
+// start a header of the sender's choosing.
+func headerValue(value string) string {
+	return lineBreaks.Replace(value)
+}
+
+// encodeSubject flattens the subject onto one line and Q-encodes it when it
+// is not plain ASCII.
+func encodeSubject(subject string) string {
+	return mime.QEncoding.Encode("utf-8", headerValue(subject))
+}
+
+// validHeaderName allows the printable ASCII RFC 5322 permits in field names.
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r <= ' ' || r > '~' || r == ':' {
+			return false
+		}
+	}
+	return true
+}
+
+func newBoundary() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// wrapBase64 encodes data in 76-character lines as MIME requires.
+func wrapBase64(data []byte) string {
+	encoded := base64.StdEncoding.EncodeToString(data)
+
+	var out strings.Builder
+	for len(encoded) > 76 {
+		out.WriteString(encoded[:76])
+		out.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	out.WriteString(encoded)
+	out.WriteString("\r\n")
+
+	return out.String()
+}
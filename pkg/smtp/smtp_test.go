@@ -0,0 +1,47 @@
+package smtp
+
+import "testing"
+
+func TestEncodeSubject(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+		want    string
+	}{
+		{name: "plain ascii", subject: "New jobs for Backend", want: "New jobs for Backend"},
+		{name: "crlf injection", subject: "Hi\r\nBcc: victim@example.com", want: "Hi Bcc: victim@example.com"},
+		{name: "bare line feed", subject: "Hi\nBcc: x", want: "Hi Bcc: x"},
+		{name: "non-ascii", subject: "Résumé", want: "=?utf-8?q?R=C3=A9sum=C3=A9?="},
+		{name: "non-ascii with injection", subject: "Café\r\nBcc: x", want: "=?utf-8?q?Caf=C3=A9_Bcc:_x?="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := encodeSubject(tt.subject); got != tt.want {
+				t.Errorf("encodeSubject(%q) = %q, want %q", tt.subject, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidHeaderName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "List-Unsubscribe", want: true},
+		{name: "List-Unsubscribe-Post", want: true},
+		{name: "", want: false},
+		{name: "X Header", want: false},
+		{name: "X:Header", want: false},
+		{name: "X-Header\r\nBcc", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validHeaderName(tt.name); got != tt.want {
+				t.Errorf("validHeaderName(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package utils
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"github.com/oklog/ulid/v2"
 	"math/big"
@@ -43,3 +45,20 @@ func NewUlidFromTimestamp(time time.Time) (string, error) {
 
 	return id.String(), nil
 }
+
+// GenerateToken returns size random bytes, hex encoded, for secrets that are
+// handed out once, such as emailed links.
+func GenerateToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// HashToken is the hex SHA-256 of a token. It is what gets stored, so a
+// leaked table does not hand out working tokens.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}